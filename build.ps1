@@ -16,6 +16,11 @@ if (!(Test-Path $artifacts)) {
     New-Item $artifacts -Type Directory | Out-Null
 }
 
+Write-Host "Embedding schema in provider..."
+go generate (Join-Path $PSScriptRoot "cmd\pulumi-resource-knapcode")
+if ($LASTEXITCODE) { throw "go generate failed." }
+
+Write-Host ""
 Write-Host "Building Go tools..."
 go build -o $artifacts @gcflags `
     (Join-Path $PSScriptRoot "cmd\pulumi-resource-knapcode") `
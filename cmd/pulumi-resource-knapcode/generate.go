@@ -0,0 +1,42 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+)
+
+// main embeds the contents of schema.json into schema.go so that the provider can serve and validate against it.
+func main() {
+	schemaContents, err := ioutil.ReadFile("../../schema.json")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = ioutil.WriteFile("./schema.go", []byte(fmt.Sprintf(`// Code generated by generate.go; DO NOT EDIT.
+
+package main
+
+var pulumiSchema = []byte(%q)
+`, schemaContents)), 0600)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -12,4 +12,5 @@ require (
 	github.com/pulumi/pulumi/sdk/v2 v2.21.1
 	github.com/satori/go.uuid v1.2.0 // indirect
 	github.com/spf13/cobra v1.1.3 // indirect
+	google.golang.org/grpc v1.29.1
 )
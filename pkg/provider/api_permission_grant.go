@@ -0,0 +1,462 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+// wellKnownResourceApps are the app IDs of Microsoft's first-party APIs that can be referred to by name.
+var wellKnownResourceApps = map[string]string{
+	"MicrosoftGraph":          "00000003-0000-0000-c000-000000000000",
+	"AzureADGraph":            "00000002-0000-0000-c000-000000000000",
+	"AzureKeyVault":           "cfa8b339-82a2-471a-a3c9-0fc0be7a4093",
+	"AzureServiceManagement":  "797f4846-ba00-4fd7-ba43-dac1f8f63013",
+	"AzureStorage":            "e406a681-f3d4-42a8-90b6-c2b029497af1",
+	"Office365ExchangeOnline": "00000002-0000-0ff1-ce00-000000000000",
+	"SharePointOnline":        "00000003-0000-0ff1-ce00-000000000000",
+}
+
+var guidRegexp = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+type apiPermissionGrantArgs struct {
+	PrincipalID string   `pulumi:"principalId"`
+	ResourceApp string   `pulumi:"resourceApp"`
+	Permissions []string `pulumi:"permissions"`
+}
+
+type apiPermissionGrantState struct {
+	PrincipalID string            `pulumi:"principalId"`
+	ResourceID  string            `pulumi:"resourceId"`
+	AppRoleIDs  map[string]string `pulumi:"appRoleIds"`
+}
+
+// resourceServicePrincipal is the service principal of an API, with the permissions it exposes.
+type resourceServicePrincipal struct {
+	ID                     string               `json:"id"`
+	AppID                  string               `json:"appId"`
+	DisplayName            string               `json:"displayName"`
+	AppRoles               []resourcePermission `json:"appRoles"`
+	Oauth2PermissionScopes []resourcePermission `json:"oauth2PermissionScopes"`
+}
+
+type resourcePermission struct {
+	ID        string `json:"id"`
+	Value     string `json:"value"`
+	IsEnabled bool   `json:"isEnabled"`
+}
+
+// resolveResourceApp turns a well-known API name or an app ID into an app ID.
+func resolveResourceApp(resourceApp string) (string, error) {
+	if appID, ok := wellKnownResourceApps[resourceApp]; ok {
+		return appID, nil
+	}
+
+	if guidRegexp.MatchString(resourceApp) {
+		return strings.ToLower(resourceApp), nil
+	}
+
+	names := make([]string, 0, len(wellKnownResourceApps))
+	for name := range wellKnownResourceApps {
+		names = append(names, fmt.Sprintf("'%s'", name))
+	}
+	sort.Strings(names)
+
+	return "", fmt.Errorf("'%s' is neither an app ID nor one of %s", resourceApp, strings.Join(names, ", "))
+}
+
+// findServicePrincipal finds the service principal of an application by its app ID. The returned boolean is false if
+// there is none.
+func findServicePrincipal(appID string) (*resourceServicePrincipal, bool, error) {
+	var sps []resourceServicePrincipal
+	err := graphList(fmt.Sprintf("servicePrincipals?$select=id,appId,displayName,appRoles,oauth2PermissionScopes&%s",
+		graphFilter("appId eq %s", odataString(appID))), &sps)
+	if err != nil || len(sps) == 0 {
+		return nil, false, err
+	}
+
+	return &sps[0], true, nil
+}
+
+// getResourceServicePrincipal finds the service principal of an API by its app ID.
+func getResourceServicePrincipal(appID string) (*resourceServicePrincipal, error) {
+	sp, found, err := findServicePrincipal(appID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, fmt.Errorf("no service principal was found for app ID %s", appID)
+	}
+
+	return sp, nil
+}
+
+// findPermission finds the ID of an enabled permission by its value.
+func findPermission(permissions []resourcePermission, value string) (string, bool) {
+	for _, p := range permissions {
+		if p.IsEnabled && p.Value == value {
+			return p.ID, true
+		}
+	}
+
+	return "", false
+}
+
+// resolveAppRoles finds the IDs of the application permissions (app roles) with the given names.
+func (sp *resourceServicePrincipal) resolveAppRoles(names []string) (map[string]string, error) {
+	ids := map[string]string{}
+	for _, name := range names {
+		id, ok := findPermission(sp.AppRoles, name)
+		if !ok {
+			if _, isScope := findPermission(sp.Oauth2PermissionScopes, name); isScope {
+				return nil, fmt.Errorf("'%s' is a delegated permission of %s, only application permissions can be granted to a principal", name, sp.DisplayName)
+			}
+
+			return nil, fmt.Errorf("%s has no application permission named '%s'", sp.DisplayName, name)
+		}
+
+		ids[name] = id
+	}
+
+	return ids, nil
+}
+
+// checkAPIPermissionGrant validates the resource app locally.
+func checkAPIPermissionGrant(inputs resource.PropertyMap) []*rpc.CheckFailure {
+	v := inputs["resourceApp"]
+	if !v.IsString() {
+		return nil
+	}
+
+	_, err := resolveResourceApp(v.StringValue())
+	if err != nil {
+		return []*rpc.CheckFailure{{Property: "resourceApp", Reason: err.Error()}}
+	}
+
+	return nil
+}
+
+// diffAPIPermissionGrant updates the permissions in place and replaces the grant when it is for another principal or
+// another API. The API is compared by its app ID, so switching between a well-known name like 'MicrosoftGraph' and its
+// app ID, as after an import, is not a replacement. A replacement is therefore never for the same principal and API, so
+// deleting the old grant can't revoke permissions the new grant still wants.
+func diffAPIPermissionGrant(olds, news resource.PropertyMap) ([]string, []string, map[string]*rpc.PropertyDiff) {
+	diffs, replaces, detailedDiff := diffInputs(olds, news, []string{"permissions"}, []string{"principalId", "resourceApp"})
+
+	same := map[string]bool{}
+	if oldID, newID := olds["principalId"], news["principalId"]; oldID.IsString() && newID.IsString() {
+		same["principalId"] = strings.EqualFold(oldID.StringValue(), newID.StringValue())
+	}
+
+	if oldApp, newApp := olds["resourceApp"], news["resourceApp"]; oldApp.IsString() && newApp.IsString() {
+		oldAppID, oldErr := resolveResourceApp(oldApp.StringValue())
+		newAppID, newErr := resolveResourceApp(newApp.StringValue())
+		same["resourceApp"] = oldErr == nil && newErr == nil && oldAppID == newAppID
+	}
+
+	remaining := []string{}
+	for _, k := range replaces {
+		if same[k] {
+			detailedDiff[k].Kind = rpc.PropertyDiff_UPDATE
+		} else {
+			remaining = append(remaining, k)
+		}
+	}
+
+	return diffs, remaining, detailedDiff
+}
+
+// listAppRoleAssignments lists the app roles of a resource service principal that are assigned to a principal.
+func listAppRoleAssignments(principalID, resourceID string) ([]appRoleAssignment, error) {
+	var all []appRoleAssignment
+	err := graphList(fmt.Sprintf("servicePrincipals/%s/appRoleAssignments", principalID), &all)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments := []appRoleAssignment{}
+	for _, a := range all {
+		if strings.EqualFold(a.ResourceID, resourceID) {
+			assignments = append(assignments, a)
+		}
+	}
+
+	return assignments, nil
+}
+
+// syncAppRoleAssignments assigns the desired app roles that are missing and removes the assignments of the app roles
+// in remove. Assignments this resource does not know about are left alone. A new principal can take a moment to
+// replicate, so assigning is retried until Microsoft Graph can see it.
+func syncAppRoleAssignments(principalID, resourceID string, desired, remove map[string]string) error {
+	assignments, err := listAppRoleAssignments(principalID, resourceID)
+	if err != nil {
+		if !isNotFoundError(err) {
+			return err
+		}
+
+		assignments = nil
+	}
+
+	assigned := map[string]string{}
+	for _, a := range assignments {
+		assigned[strings.ToLower(a.AppRoleID)] = a.ID
+	}
+
+	for _, name := range sortedStringKeys(remove) {
+		roleID := strings.ToLower(remove[name])
+		assignmentID, ok := assigned[roleID]
+		if !ok || containsString(desired, roleID) {
+			continue
+		}
+
+		err = graphRequest("DELETE", fmt.Sprintf("servicePrincipals/%s/appRoleAssignments/%s", principalID, assignmentID), nil, nil)
+		if err != nil && !isNotFoundError(err) {
+			return err
+		}
+	}
+
+	for _, name := range sortedStringKeys(desired) {
+		roleID := desired[name]
+		if _, ok := assigned[strings.ToLower(roleID)]; ok {
+			continue
+		}
+
+		body := appRoleAssignment{AppRoleID: roleID, PrincipalID: principalID, ResourceID: resourceID}
+		done, err := poll(func() (bool, error) {
+			err := graphRequest("POST", fmt.Sprintf("servicePrincipals/%s/appRoleAssignments", principalID), body, nil)
+			if err != nil {
+				if principalNotReplicatedRegexp.MatchString(err.Error()) {
+					return false, nil
+				}
+
+				return false, err
+			}
+
+			return true, nil
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if !done {
+			return fmt.Errorf("the principal with object ID %s could not be found", principalID)
+		}
+	}
+
+	return nil
+}
+
+func sortedStringKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func containsString(m map[string]string, value string) bool {
+	for _, v := range m {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func apiPermissionGrantOutputs(inputs resource.PropertyMap, sp *resourceServicePrincipal, appRoleIDs map[string]string) map[string]interface{} {
+	ids := map[string]interface{}{}
+	for k, v := range appRoleIDs {
+		ids[k] = v
+	}
+
+	outputs := inputs.Mappable()
+	outputs["resourceAppId"] = sp.AppID
+	outputs["resourceId"] = sp.ID
+	outputs["appRoleIds"] = ids
+
+	return outputs
+}
+
+// createAPIPermissionGrant grants the application permissions to the principal. The resource ID is the principal's
+// object ID and the API's app ID separated by a slash, so that the grant can be imported.
+func createAPIPermissionGrant(inputs resource.PropertyMap) (string, map[string]interface{}, error) {
+	var args apiPermissionGrantArgs
+	err := decodeInputs(inputs, &args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	appID, err := resolveResourceApp(args.ResourceApp)
+	if err != nil {
+		return "", nil, err
+	}
+
+	sp, err := getResourceServicePrincipal(appID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	appRoleIDs, err := sp.resolveAppRoles(args.Permissions)
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = syncAppRoleAssignments(args.PrincipalID, sp.ID, appRoleIDs, nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return args.PrincipalID + "/" + sp.AppID, apiPermissionGrantOutputs(inputs, sp, appRoleIDs), nil
+}
+
+// updateAPIPermissionGrant grants the added permissions and revokes the removed ones.
+func updateAPIPermissionGrant(olds, news resource.PropertyMap) (map[string]interface{}, error) {
+	var state apiPermissionGrantState
+	err := decodeInputs(olds, &state)
+	if err != nil {
+		return nil, err
+	}
+
+	var args apiPermissionGrantArgs
+	err = decodeInputs(news, &args)
+	if err != nil {
+		return nil, err
+	}
+
+	appID, err := resolveResourceApp(args.ResourceApp)
+	if err != nil {
+		return nil, err
+	}
+
+	sp, err := getResourceServicePrincipal(appID)
+	if err != nil {
+		return nil, err
+	}
+
+	appRoleIDs, err := sp.resolveAppRoles(args.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	err = syncAppRoleAssignments(args.PrincipalID, sp.ID, appRoleIDs, state.AppRoleIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiPermissionGrantOutputs(news, sp, appRoleIDs), nil
+}
+
+// readAPIPermissionGrant refreshes the granted permissions that the resource manages. When importing, every application
+// permission of the API that is granted to the principal is taken over.
+func readAPIPermissionGrant(id string, state, inputs resource.PropertyMap) (string, map[string]interface{}, map[string]interface{}, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 {
+		return "", nil, nil, fmt.Errorf("expected an ID in the form '<principal object ID>/<API app ID>' but got '%s'", id)
+	}
+
+	principalID, appID := parts[0], parts[1]
+
+	sp, err := getResourceServicePrincipal(appID)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	assignments, err := listAppRoleAssignments(principalID, sp.ID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return "", nil, nil, nil
+		}
+
+		return "", nil, nil, err
+	}
+
+	var args apiPermissionGrantArgs
+	err = decodeInputs(state, &args)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	importing := len(inputs) == 0
+
+	tracked := map[string]bool{}
+	for _, name := range args.Permissions {
+		tracked[name] = true
+	}
+
+	// Grants of permissions this resource doesn't manage are left out, since the next update would revoke them.
+	granted := map[string]string{}
+	for _, a := range assignments {
+		for _, r := range sp.AppRoles {
+			if strings.EqualFold(r.ID, a.AppRoleID) && (importing || tracked[r.Value]) {
+				granted[r.Value] = r.ID
+			}
+		}
+	}
+
+	// Keep the order of the permissions in the state and, when importing, add the others at the end.
+	permissions := []interface{}{}
+	for _, name := range args.Permissions {
+		if _, ok := granted[name]; ok {
+			permissions = append(permissions, name)
+		}
+	}
+	for _, name := range sortedStringKeys(granted) {
+		if !tracked[name] {
+			permissions = append(permissions, name)
+		}
+	}
+
+	outputs := apiPermissionGrantOutputs(state, sp, granted)
+	outputs["principalId"] = principalID
+	outputs["permissions"] = permissions
+
+	readInputs := inputs.Mappable()
+	if importing {
+		readInputs = map[string]interface{}{
+			"principalId": principalID,
+			"resourceApp": sp.AppID,
+			"permissions": permissions,
+		}
+		outputs["resourceApp"] = sp.AppID
+	}
+
+	return id, outputs, readInputs, nil
+}
+
+// deleteAPIPermissionGrant revokes the permissions granted by this resource.
+func deleteAPIPermissionGrant(state resource.PropertyMap) error {
+	var args apiPermissionGrantState
+	err := decodeInputs(state, &args)
+	if err != nil {
+		return err
+	}
+
+	err = syncAppRoleAssignments(args.PrincipalID, args.ResourceID, nil, args.AppRoleIDs)
+	if err != nil && !isNotFoundError(err) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,182 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+func TestResolveResourceApp(t *testing.T) {
+	tests := []struct {
+		resourceApp string
+		expected    string
+		valid       bool
+	}{
+		{"MicrosoftGraph", "00000003-0000-0000-c000-000000000000", true},
+		{"AzureKeyVault", "cfa8b339-82a2-471a-a3c9-0fc0be7a4093", true},
+		{"00000003-0000-0000-C000-000000000000", "00000003-0000-0000-c000-000000000000", true},
+		{"microsoftgraph", "", false},
+		{"https://graph.microsoft.com", "", false},
+		{"", "", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.resourceApp, func(t *testing.T) {
+			appID, err := resolveResourceApp(test.resourceApp)
+			if (err == nil) != test.valid {
+				t.Fatalf("expected valid to be %v, got error %v", test.valid, err)
+			}
+
+			if appID != test.expected {
+				t.Errorf("expected '%s', got '%s'", test.expected, appID)
+			}
+		})
+	}
+}
+
+func TestResolveAppRoles(t *testing.T) {
+	sp := &resourceServicePrincipal{
+		DisplayName: "Microsoft Graph",
+		AppRoles: []resourcePermission{
+			{ID: "role-1", Value: "User.Read.All", IsEnabled: true},
+			{ID: "role-2", Value: "Group.Read.All", IsEnabled: true},
+			{ID: "role-3", Value: "Disabled.All", IsEnabled: false},
+		},
+		Oauth2PermissionScopes: []resourcePermission{
+			{ID: "scope-1", Value: "User.Read", IsEnabled: true},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		names    []string
+		expected map[string]string
+		valid    bool
+	}{
+		{"none", nil, map[string]string{}, true},
+		{"app roles", []string{"User.Read.All", "Group.Read.All"}, map[string]string{"User.Read.All": "role-1", "Group.Read.All": "role-2"}, true},
+		{"disabled app role", []string{"Disabled.All"}, nil, false},
+		{"delegated permission", []string{"User.Read"}, nil, false},
+		{"unknown", []string{"Nope.All"}, nil, false},
+		{"case sensitive", []string{"user.read.all"}, nil, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ids, err := sp.resolveAppRoles(test.names)
+			if (err == nil) != test.valid {
+				t.Fatalf("expected valid to be %v, got error %v", test.valid, err)
+			}
+
+			if test.valid && !reflect.DeepEqual(test.expected, ids) {
+				t.Errorf("expected %v, got %v", test.expected, ids)
+			}
+		})
+	}
+}
+
+func TestDiffAPIPermissionGrant(t *testing.T) {
+	const principalID = "aaaaaaaa-1111-1111-1111-111111111111"
+	olds := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"principalId":   principalID,
+		"resourceApp":   "00000003-0000-0000-c000-000000000000",
+		"permissions":   []interface{}{"User.Read.All"},
+		"resourceAppId": "00000003-0000-0000-c000-000000000000",
+	})
+
+	tests := []struct {
+		name             string
+		news             map[string]interface{}
+		expectedDiffs    []string
+		expectedReplaces []string
+		expectedKinds    map[string]rpc.PropertyDiff_Kind
+	}{
+		{
+			"no changes",
+			map[string]interface{}{"principalId": principalID, "resourceApp": "00000003-0000-0000-c000-000000000000", "permissions": []interface{}{"User.Read.All"}},
+			[]string{},
+			[]string{},
+			map[string]rpc.PropertyDiff_Kind{},
+		},
+		{
+			"well-known name for the same API is updated in place",
+			map[string]interface{}{"principalId": principalID, "resourceApp": "MicrosoftGraph", "permissions": []interface{}{"User.Read.All"}},
+			[]string{"resourceApp"},
+			[]string{},
+			map[string]rpc.PropertyDiff_Kind{"resourceApp": rpc.PropertyDiff_UPDATE},
+		},
+		{
+			"app ID case is updated in place",
+			map[string]interface{}{"principalId": principalID, "resourceApp": "00000003-0000-0000-C000-000000000000", "permissions": []interface{}{"User.Read.All"}},
+			[]string{"resourceApp"},
+			[]string{},
+			map[string]rpc.PropertyDiff_Kind{"resourceApp": rpc.PropertyDiff_UPDATE},
+		},
+		{
+			"principal ID case is updated in place",
+			map[string]interface{}{"principalId": "AAAAAAAA-1111-1111-1111-111111111111", "resourceApp": "00000003-0000-0000-c000-000000000000", "permissions": []interface{}{"User.Read.All"}},
+			[]string{"principalId"},
+			[]string{},
+			map[string]rpc.PropertyDiff_Kind{"principalId": rpc.PropertyDiff_UPDATE},
+		},
+		{
+			"permissions are updated in place",
+			map[string]interface{}{"principalId": principalID, "resourceApp": "00000003-0000-0000-c000-000000000000", "permissions": []interface{}{"User.Read.All", "Group.Read.All"}},
+			[]string{"permissions"},
+			[]string{},
+			map[string]rpc.PropertyDiff_Kind{"permissions": rpc.PropertyDiff_UPDATE},
+		},
+		{
+			"other API is replaced",
+			map[string]interface{}{"principalId": principalID, "resourceApp": "AzureKeyVault", "permissions": []interface{}{"User.Read.All"}},
+			[]string{"resourceApp"},
+			[]string{"resourceApp"},
+			map[string]rpc.PropertyDiff_Kind{"resourceApp": rpc.PropertyDiff_UPDATE_REPLACE},
+		},
+		{
+			"other principal is replaced",
+			map[string]interface{}{"principalId": "22222222-2222-2222-2222-222222222222", "resourceApp": "00000003-0000-0000-c000-000000000000", "permissions": []interface{}{"User.Read.All"}},
+			[]string{"principalId"},
+			[]string{"principalId"},
+			map[string]rpc.PropertyDiff_Kind{"principalId": rpc.PropertyDiff_UPDATE_REPLACE},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			diffs, replaces, detailedDiff := diffAPIPermissionGrant(olds, resource.NewPropertyMapFromMap(test.news))
+			if !reflect.DeepEqual(test.expectedDiffs, diffs) {
+				t.Errorf("expected diffs %v, got %v", test.expectedDiffs, diffs)
+			}
+
+			if !reflect.DeepEqual(test.expectedReplaces, replaces) {
+				t.Errorf("expected replaces %v, got %v", test.expectedReplaces, replaces)
+			}
+
+			kinds := map[string]rpc.PropertyDiff_Kind{}
+			for k, d := range detailedDiff {
+				kinds[k] = d.Kind
+			}
+
+			if !reflect.DeepEqual(test.expectedKinds, kinds) {
+				t.Errorf("expected detailed diff %v, got %v", test.expectedKinds, kinds)
+			}
+		})
+	}
+}
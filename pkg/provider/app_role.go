@@ -0,0 +1,231 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+var appRoleInputs = []string{"value", "displayName", "description", "allowedMemberTypes", "isEnabled"}
+
+type appRoleArgs struct {
+	ObjectID           string   `pulumi:"objectId"`
+	RoleID             string   `pulumi:"roleId"`
+	Value              string   `pulumi:"value"`
+	DisplayName        string   `pulumi:"displayName"`
+	Description        string   `pulumi:"description"`
+	AllowedMemberTypes []string `pulumi:"allowedMemberTypes"`
+	IsEnabled          bool     `pulumi:"isEnabled"`
+}
+
+// toGraph returns the role as it is stored in the application's appRoles.
+func (args appRoleArgs) toGraph() map[string]interface{} {
+	allowedMemberTypes := []interface{}{}
+	for _, t := range args.AllowedMemberTypes {
+		allowedMemberTypes = append(allowedMemberTypes, t)
+	}
+
+	role := map[string]interface{}{
+		"id":                 args.RoleID,
+		"value":              nil,
+		"displayName":        args.DisplayName,
+		"description":        args.Description,
+		"allowedMemberTypes": allowedMemberTypes,
+		"isEnabled":          args.IsEnabled,
+	}
+
+	if args.Value != "" {
+		role["value"] = args.Value
+	}
+
+	return role
+}
+
+// checkAppRole gives the role a stable ID derived from its value and enables it, unless these are set.
+func checkAppRole(inputs resource.PropertyMap) []*rpc.CheckFailure {
+	return fillEntryDefaults("", "appRole", "roleId", inputs)
+}
+
+// getAppRoles reads the app roles of an application. The returned boolean is false if the application does not exist.
+func getAppRoles(objectID string) ([]interface{}, bool, error) {
+	var app struct {
+		AppRoles []interface{} `json:"appRoles"`
+	}
+	found, err := graphGet(fmt.Sprintf("applications/%s?$select=appRoles", objectID), &app)
+	if err != nil || !found {
+		return nil, found, err
+	}
+
+	if app.AppRoles == nil {
+		app.AppRoles = []interface{}{}
+	}
+
+	return app.AppRoles, true, nil
+}
+
+func setAppRoles(objectID string, roles []interface{}) error {
+	return graphRequest("PATCH", "applications/"+objectID, map[string]interface{}{"appRoles": roles}, nil)
+}
+
+// findEntry returns the index of the app role or permission scope with the given ID, or -1 if there is none.
+func findEntry(entries []interface{}, id string) int {
+	for i, e := range entries {
+		if obj, ok := e.(map[string]interface{}); ok {
+			if entryID, ok := obj["id"].(string); ok && strings.EqualFold(entryID, id) {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
+
+// createAppRole adds the role to the application's app roles, keeping the others. Changes to the app roles of an
+// application are serialized, since the whole collection is written back. The resource ID is the application's
+// object ID and the role ID separated by a slash, so that the role can be imported.
+func createAppRole(inputs resource.PropertyMap) (string, map[string]interface{}, error) {
+	var args appRoleArgs
+	err := decodeInputs(inputs, &args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = waitForApp(args.ObjectID, true)
+	if err != nil {
+		return "", nil, err
+	}
+
+	defer lockApplication(args.ObjectID)()
+
+	roles, _, err := getAppRoles(args.ObjectID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if findEntry(roles, args.RoleID) >= 0 {
+		return "", nil, fmt.Errorf("the application with object ID %s already has an app role with ID %s", args.ObjectID, args.RoleID)
+	}
+
+	err = setAppRoles(args.ObjectID, append(roles, args.toGraph()))
+	if err != nil {
+		return "", nil, err
+	}
+
+	return args.ObjectID + "/" + args.RoleID, inputs.Mappable(), nil
+}
+
+// updateAppRole replaces the role in the application's app roles, keeping the others.
+func updateAppRole(news resource.PropertyMap) (map[string]interface{}, error) {
+	var args appRoleArgs
+	err := decodeInputs(news, &args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer lockApplication(args.ObjectID)()
+
+	roles, found, err := getAppRoles(args.ObjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	i := findEntry(roles, args.RoleID)
+	if !found || i < 0 {
+		return nil, fmt.Errorf("the app role with ID %s was not found on the application with object ID %s", args.RoleID, args.ObjectID)
+	}
+
+	roles[i] = args.toGraph()
+	err = setAppRoles(args.ObjectID, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	return news.Mappable(), nil
+}
+
+// readAppRole refreshes the role from the application. When importing, there is no state so the application's object
+// ID and the role ID are taken from the resource ID.
+func readAppRole(id string, state, inputs resource.PropertyMap) (string, map[string]interface{}, map[string]interface{}, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 {
+		return "", nil, nil, fmt.Errorf("expected an ID in the form '<application object ID>/<role ID>' but got '%s'", id)
+	}
+
+	objectID, roleID := parts[0], parts[1]
+
+	roles, found, err := getAppRoles(objectID)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	i := findEntry(roles, roleID)
+	if !found || i < 0 {
+		return "", nil, nil, nil
+	}
+
+	role := roles[i].(map[string]interface{})
+	live := map[string]interface{}{"objectId": objectID, "roleId": role["id"]}
+	for _, k := range appRoleInputs {
+		live[k] = role[k]
+	}
+
+	outputs := state.Mappable()
+	for k, v := range live {
+		outputs[k] = v
+	}
+
+	readInputs := inputs.Mappable()
+	if len(inputs) == 0 {
+		readInputs = live
+	}
+
+	return id, outputs, readInputs, nil
+}
+
+// deleteAppRole removes the role from the application's app roles. Microsoft Graph refuses to remove an enabled role,
+// so it is disabled in a separate update first. A role or application that is already gone is not an error.
+func deleteAppRole(state resource.PropertyMap) error {
+	var args appRoleArgs
+	err := decodeInputs(state, &args)
+	if err != nil {
+		return err
+	}
+
+	defer lockApplication(args.ObjectID)()
+
+	roles, found, err := getAppRoles(args.ObjectID)
+	if err != nil {
+		return err
+	}
+
+	i := findEntry(roles, args.RoleID)
+	if !found || i < 0 {
+		return nil
+	}
+
+	disabled, changed := disableRemovedEntries(roles, append(append([]interface{}{}, roles[:i]...), roles[i+1:]...))
+	if changed {
+		err = setAppRoles(args.ObjectID, disabled)
+		if err != nil {
+			return err
+		}
+	}
+
+	return setAppRoles(args.ObjectID, append(disabled[:i], disabled[i+1:]...))
+}
@@ -0,0 +1,227 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+// defaultAppRoleID is the app role ID used to assign a principal to an application that does not define app roles.
+const defaultAppRoleID = "00000000-0000-0000-0000-000000000000"
+
+var (
+	// appRoleAssignmentInputs are all immutable since Microsoft Graph can only add and remove assignments.
+	appRoleAssignmentInputs = []string{"resourceId", "principalId", "appRole", "appRoleId"}
+
+	// principalNotReplicatedRegexp matches the error returned when the principal was created so recently that it can't
+	// be assigned yet.
+	principalNotReplicatedRegexp = regexp.MustCompile("(?i)does not exist or one of its queried reference-property objects are not present")
+
+	appRoleAssignmentExistsRegexp = regexp.MustCompile("(?i)Permission being assigned already exists")
+)
+
+type appRoleAssignmentArgs struct {
+	ResourceID  string `pulumi:"resourceId"`
+	PrincipalID string `pulumi:"principalId"`
+	AppRole     string `pulumi:"appRole"`
+	AppRoleID   string `pulumi:"appRoleId"`
+}
+
+type appRoleAssignmentState struct {
+	ResourceID   string `pulumi:"resourceId"`
+	AssignmentID string `pulumi:"assignmentId"`
+}
+
+type appRoleAssignment struct {
+	ID                   string `json:"id,omitempty"`
+	AppRoleID            string `json:"appRoleId"`
+	PrincipalID          string `json:"principalId"`
+	ResourceID           string `json:"resourceId"`
+	PrincipalType        string `json:"principalType,omitempty"`
+	PrincipalDisplayName string `json:"principalDisplayName,omitempty"`
+	ResourceDisplayName  string `json:"resourceDisplayName,omitempty"`
+}
+
+// checkAppRoleAssignment makes sure the role is given either by value or by ID.
+func checkAppRoleAssignment(inputs resource.PropertyMap) []*rpc.CheckFailure {
+	if inputs.HasValue("appRole") && inputs.HasValue("appRoleId") {
+		return []*rpc.CheckFailure{{
+			Property: "appRoleId",
+			Reason:   "only one of 'appRole' and 'appRoleId' can be set",
+		}}
+	}
+
+	return nil
+}
+
+// resolveAppRole finds the ID of the enabled app role with the given value on the resource service principal.
+func resolveAppRole(resourceID, value string) (string, error) {
+	var sp struct {
+		AppRoles []struct {
+			ID        string `json:"id"`
+			Value     string `json:"value"`
+			IsEnabled bool   `json:"isEnabled"`
+		} `json:"appRoles"`
+	}
+	err := graphRequest("GET", fmt.Sprintf("servicePrincipals/%s?$select=appRoles", resourceID), nil, &sp)
+	if err != nil {
+		return "", err
+	}
+
+	values := []string{}
+	for _, r := range sp.AppRoles {
+		if !r.IsEnabled {
+			continue
+		}
+
+		if r.Value == value {
+			return r.ID, nil
+		}
+
+		values = append(values, fmt.Sprintf("'%s'", r.Value))
+	}
+
+	return "", fmt.Errorf("the service principal with object ID %s has no enabled app role with value '%s', the available values are: %s",
+		resourceID, value, strings.Join(values, ", "))
+}
+
+func appRoleAssignmentOutputs(inputs resource.PropertyMap, a appRoleAssignment) map[string]interface{} {
+	outputs := inputs.Mappable()
+	outputs["assignmentId"] = a.ID
+	outputs["resolvedAppRoleId"] = a.AppRoleID
+	outputs["principalType"] = a.PrincipalType
+	outputs["principalDisplayName"] = a.PrincipalDisplayName
+	outputs["resourceDisplayName"] = a.ResourceDisplayName
+
+	return outputs
+}
+
+// createAppRoleAssignment assigns the app role to the principal. A new principal or resource service principal can
+// take a moment to replicate, so the assignment is retried until Microsoft Graph can see both. The resource ID is the
+// resource service principal's object ID and the assignment ID separated by a slash, so that the assignment can be
+// imported.
+func createAppRoleAssignment(inputs resource.PropertyMap) (string, map[string]interface{}, error) {
+	var args appRoleAssignmentArgs
+	err := decodeInputs(inputs, &args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = waitForObject("servicePrincipals/"+args.ResourceID, fmt.Sprintf("service principal with object ID %s", args.ResourceID), true)
+	if err != nil {
+		return "", nil, err
+	}
+
+	appRoleID := args.AppRoleID
+	switch {
+	case args.AppRole != "":
+		appRoleID, err = resolveAppRole(args.ResourceID, args.AppRole)
+		if err != nil {
+			return "", nil, err
+		}
+	case appRoleID == "":
+		appRoleID = defaultAppRoleID
+	}
+
+	body := appRoleAssignment{
+		AppRoleID:   appRoleID,
+		PrincipalID: args.PrincipalID,
+		ResourceID:  args.ResourceID,
+	}
+
+	var created appRoleAssignment
+	done, err := poll(func() (bool, error) {
+		err := graphRequest("POST", fmt.Sprintf("servicePrincipals/%s/appRoleAssignedTo", args.ResourceID), body, &created)
+		if err != nil {
+			if principalNotReplicatedRegexp.MatchString(err.Error()) {
+				return false, nil
+			}
+
+			if appRoleAssignmentExistsRegexp.MatchString(err.Error()) {
+				return false, fmt.Errorf("the principal with object ID %s already has app role %s, import the assignment instead: %v", args.PrincipalID, appRoleID, err)
+			}
+
+			return false, err
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	if !done {
+		return "", nil, fmt.Errorf("the principal with object ID %s could not be found", args.PrincipalID)
+	}
+
+	return args.ResourceID + "/" + created.ID, appRoleAssignmentOutputs(inputs, created), nil
+}
+
+// readAppRoleAssignment refreshes the assignment. When importing, there is no state so the resource service
+// principal's object ID and the assignment ID are taken from the resource ID.
+func readAppRoleAssignment(id string, state, inputs resource.PropertyMap) (string, map[string]interface{}, map[string]interface{}, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 {
+		return "", nil, nil, fmt.Errorf("expected an ID in the form '<resource service principal object ID>/<assignment ID>' but got '%s'", id)
+	}
+
+	var a appRoleAssignment
+	found, err := graphGet(fmt.Sprintf("servicePrincipals/%s/appRoleAssignedTo/%s", parts[0], parts[1]), &a)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	if !found {
+		return "", nil, nil, nil
+	}
+
+	outputs := appRoleAssignmentOutputs(state, a)
+
+	readInputs := inputs.Mappable()
+	if len(inputs) == 0 {
+		readInputs = map[string]interface{}{
+			"resourceId":  a.ResourceID,
+			"principalId": a.PrincipalID,
+			"appRoleId":   a.AppRoleID,
+		}
+		for k, v := range readInputs {
+			outputs[k] = v
+		}
+	}
+
+	return id, outputs, readInputs, nil
+}
+
+// deleteAppRoleAssignment removes the assignment. An assignment that is already gone is not an error.
+func deleteAppRoleAssignment(state resource.PropertyMap) error {
+	var args appRoleAssignmentState
+	err := decodeInputs(state, &args)
+	if err != nil {
+		return err
+	}
+
+	err = graphRequest("DELETE", fmt.Sprintf("servicePrincipals/%s/appRoleAssignedTo/%s", args.ResourceID, args.AssignmentID), nil, nil)
+	if err != nil && !isNotFoundError(err) {
+		return err
+	}
+
+	return nil
+}
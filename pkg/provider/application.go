@@ -0,0 +1,388 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	pschema "github.com/pulumi/pulumi/pkg/v2/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+// applicationFields are the application settings managed by the Application resource. The input names match the
+// Microsoft Graph property names, so inputs are sent as they are.
+var applicationFields = []string{
+	"displayName",
+	"signInAudience",
+	"identifierUris",
+	"web",
+	"spa",
+	"publicClient",
+	"api",
+	"appRoles",
+	"optionalClaims",
+	"requiredResourceAccess",
+	"tags",
+	"notes",
+}
+
+// applicationResets are the values sent for settings that are not set, so that removing an input resets the setting.
+// Nested objects are filled in from these values too, since Microsoft Graph keeps nested settings that are left out.
+var applicationResets = map[string]interface{}{
+	"signInAudience": "AzureADMyOrg",
+	"identifierUris": []interface{}{},
+	"web": map[string]interface{}{
+		"homePageUrl":  nil,
+		"logoutUrl":    nil,
+		"redirectUris": []interface{}{},
+		"implicitGrantSettings": map[string]interface{}{
+			"enableAccessTokenIssuance": false,
+			"enableIdTokenIssuance":     false,
+		},
+	},
+	"spa": map[string]interface{}{
+		"redirectUris": []interface{}{},
+	},
+	"publicClient": map[string]interface{}{
+		"redirectUris": []interface{}{},
+	},
+	"api": map[string]interface{}{
+		"acceptMappedClaims":          nil,
+		"knownClientApplications":     []interface{}{},
+		"oauth2PermissionScopes":      []interface{}{},
+		"preAuthorizedApplications":   []interface{}{},
+		"requestedAccessTokenVersion": nil,
+	},
+	"appRoles": []interface{}{},
+	"optionalClaims": map[string]interface{}{
+		"accessToken": []interface{}{},
+		"idToken":     []interface{}{},
+		"saml2Token":  []interface{}{},
+	},
+	"requiredResourceAccess": []interface{}{},
+	"tags":                   []interface{}{},
+	"notes":                  nil,
+}
+
+type applicationState struct {
+	ObjectID      string `pulumi:"objectId"`
+	PurgeOnDelete bool   `pulumi:"purgeOnDelete"`
+}
+
+type createdApplication struct {
+	ID    string `json:"id"`
+	AppID string `json:"appId"`
+}
+
+// checkApplication fills in the IDs of app roles and permission scopes from their values, and enables them unless
+// they are explicitly disabled. The inputs are changed in place.
+func checkApplication(inputs resource.PropertyMap) []*rpc.CheckFailure {
+	var failures []*rpc.CheckFailure
+
+	if roles := inputs["appRoles"]; roles.IsArray() {
+		for i, role := range roles.ArrayValue() {
+			if role.IsObject() {
+				failures = append(failures, fillEntryDefaults(fmt.Sprintf("appRoles[%d]", i), "appRole", "id", role.ObjectValue())...)
+			}
+		}
+	}
+
+	if api := inputs["api"]; api.IsObject() {
+		if scopes := api.ObjectValue()["oauth2PermissionScopes"]; scopes.IsArray() {
+			for i, scope := range scopes.ArrayValue() {
+				if scope.IsObject() {
+					obj := scope.ObjectValue()
+					failures = append(failures, fillEntryDefaults(fmt.Sprintf("api.oauth2PermissionScopes[%d]", i), "oauth2PermissionScope", "id", obj)...)
+					if !obj.HasValue("type") {
+						obj["type"] = resource.NewStringProperty("User")
+					}
+				}
+			}
+		}
+	}
+
+	return failures
+}
+
+// fillEntryDefaults gives an app role or permission scope a stable ID derived from its value and enables it, unless
+// these are already set. The ID is stored under idKey.
+func fillEntryDefaults(path, kind, idKey string, entry resource.PropertyMap) []*rpc.CheckFailure {
+	if !entry.HasValue("isEnabled") {
+		entry["isEnabled"] = resource.NewBoolProperty(true)
+	}
+
+	key := resource.PropertyKey(idKey)
+	if entry.HasValue(key) {
+		return nil
+	}
+
+	value := entry["value"]
+	switch {
+	case value.IsString():
+		entry[key] = resource.NewStringProperty(stableGUID(kind, value.StringValue()))
+	case value.ContainsUnknowns():
+		entry[key] = resource.MakeComputed(resource.NewStringProperty(""))
+	default:
+		return []*rpc.CheckFailure{{
+			Property: joinPath(path, idKey),
+			Reason:   fmt.Sprintf("'%s' must be set when '%s' is not", joinPath(path, idKey), joinPath(path, "value")),
+		}}
+	}
+
+	return nil
+}
+
+// diffApplication compares each setting after filling in the defaults and removing empty values, which Microsoft Graph
+// uses for unset settings. Nested settings are reported under their own paths. Changing purgeOnDelete is an update
+// that only changes the state.
+func diffApplication(olds, news resource.PropertyMap) ([]string, map[string]*rpc.PropertyDiff) {
+	diffs, _, detailedDiff := diffInputs(olds, news, []string{"purgeOnDelete"}, nil)
+
+	settingDiffs, settingDetailedDiff := diffSettings(olds, news, applicationFields, applicationResets)
+	diffs = append(diffs, settingDiffs...)
+	for k, v := range settingDetailedDiff {
+		detailedDiff[k] = v
+	}
+
+	return diffs, detailedDiff
+}
+
+// createApplication creates the application and waits for it to be available.
+func createApplication(inputs resource.PropertyMap) (string, map[string]interface{}, error) {
+	values := plainProperties(inputs)
+
+	body := map[string]interface{}{}
+	for _, k := range applicationFields {
+		if v, has := values[k]; has && v != nil {
+			body[k] = withResets(applicationResets[k], v)
+		}
+	}
+
+	var created createdApplication
+	err := graphRequest("POST", "applications", body, &created)
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = waitForApp(created.ID, true)
+	if err != nil {
+		return "", nil, err
+	}
+
+	outputs := inputs.Mappable()
+	outputs["objectId"] = created.ID
+	outputs["appId"] = created.AppID
+
+	return created.ID, outputs, nil
+}
+
+// updateApplication patches the settings that changed. App roles and permission scopes that are removed are disabled
+// first, since Microsoft Graph refuses to remove them while they are enabled.
+func updateApplication(olds, news resource.PropertyMap) (map[string]interface{}, error) {
+	var state applicationState
+	err := decodeInputs(olds, &state)
+	if err != nil {
+		return nil, err
+	}
+
+	diffs, _ := diffSettings(olds, news, applicationFields, applicationResets)
+	values := plainProperties(news)
+
+	body := map[string]interface{}{}
+	for _, k := range diffs {
+		body[k] = withResets(applicationResets[k], values[k])
+
+		switch k {
+		case "appRoles":
+			err = disableRemovedAppRoles(state.ObjectID, body[k])
+		case "api":
+			err = disableRemovedPermissionScopes(state.ObjectID, body[k])
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(body) > 0 {
+		err = graphRequest("PATCH", "applications/"+state.ObjectID, body, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	outputs := news.Mappable()
+	outputs["objectId"] = state.ObjectID
+	outputs["appId"] = olds["appId"].Mappable()
+
+	return outputs, nil
+}
+
+// disableRemovedEntries disables the app roles or permission scopes that are enabled and not in the desired list. It
+// returns false if there is nothing to disable.
+func disableRemovedEntries(entries []interface{}, desired []interface{}) ([]interface{}, bool) {
+	keep := map[string]bool{}
+	for _, e := range desired {
+		if obj, ok := e.(map[string]interface{}); ok {
+			if id, ok := obj["id"].(string); ok {
+				keep[strings.ToLower(id)] = true
+			}
+		}
+	}
+
+	changed := false
+	result := make([]interface{}, 0, len(entries))
+	for _, e := range entries {
+		obj, ok := e.(map[string]interface{})
+		if ok {
+			id, _ := obj["id"].(string)
+			if enabled, _ := obj["isEnabled"].(bool); enabled && !keep[strings.ToLower(id)] {
+				disabled := map[string]interface{}{}
+				for k, v := range obj {
+					disabled[k] = v
+				}
+				disabled["isEnabled"] = false
+				e = disabled
+				changed = true
+			}
+		}
+
+		result = append(result, e)
+	}
+
+	return result, changed
+}
+
+func disableRemovedAppRoles(objectID string, desired interface{}) error {
+	liveRoles, _, err := getAppRoles(objectID)
+	if err != nil {
+		return err
+	}
+
+	desiredRoles, _ := desired.([]interface{})
+	roles, changed := disableRemovedEntries(liveRoles, desiredRoles)
+	if !changed {
+		return nil
+	}
+
+	return setAppRoles(objectID, roles)
+}
+
+func disableRemovedPermissionScopes(objectID string, desiredAPI interface{}) error {
+	var live struct {
+		API map[string]interface{} `json:"api"`
+	}
+	err := graphRequest("GET", fmt.Sprintf("applications/%s?$select=api", objectID), nil, &live)
+	if err != nil {
+		return err
+	}
+
+	if live.API == nil {
+		return nil
+	}
+
+	var desiredScopes []interface{}
+	if api, ok := desiredAPI.(map[string]interface{}); ok {
+		desiredScopes, _ = api["oauth2PermissionScopes"].([]interface{})
+	}
+
+	liveScopes, _ := live.API["oauth2PermissionScopes"].([]interface{})
+	scopes, changed := disableRemovedEntries(liveScopes, desiredScopes)
+	if !changed {
+		return nil
+	}
+
+	live.API["oauth2PermissionScopes"] = scopes
+	return graphRequest("PATCH", "applications/"+objectID, map[string]interface{}{"api": live.API}, nil)
+}
+
+// readApplication refreshes the application's settings by its object ID. Only the settings declared in the schema are
+// kept, and empty settings are left out so that they match inputs that are not set. Settings the program does not set
+// are not read back, since they may be managed by the AppRole, ExposeApi, RequiredResourceAccess or TokenConfiguration
+// resources and the next update would otherwise reset them. When importing, every setting is read.
+func readApplication(spec *pschema.PackageSpec, id string, state, inputs resource.PropertyMap) (string, map[string]interface{}, map[string]interface{}, error) {
+	var live map[string]interface{}
+	path := fmt.Sprintf("applications/%s?$select=id,appId,%s", id, strings.Join(applicationFields, ","))
+	found, err := graphGet(path, &live)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	if !found {
+		return "", nil, nil, nil
+	}
+
+	settings := projectOntoSchema(spec, spec.Resources["knapcode:index:Application"].InputProperties, live)
+
+	outputs := state.Mappable()
+	readInputs := map[string]interface{}{}
+	for _, k := range applicationFields {
+		if len(inputs) > 0 && !inputs.HasValue(resource.PropertyKey(k)) {
+			outputs[k] = nil
+			continue
+		}
+
+		v, ok := pruneEmpty(settings[k])
+		if !ok {
+			v = nil
+		}
+
+		outputs[k] = v
+		readInputs[k] = v
+	}
+	outputs["objectId"] = live["id"]
+	outputs["appId"] = live["appId"]
+
+	if len(inputs) > 0 {
+		readInputs = inputs.Mappable()
+	}
+
+	return id, outputs, readInputs, nil
+}
+
+// deleteApplication deletes the application and waits for it to be gone, then purges it from the deleted items if
+// purgeOnDelete is set. An application that is already gone is not an error.
+func deleteApplication(state resource.PropertyMap) error {
+	var args applicationState
+	err := decodeInputs(state, &args)
+	if err != nil {
+		return err
+	}
+
+	justDeleted := true
+	err = graphRequest("DELETE", "applications/"+args.ObjectID, nil, nil)
+	if err != nil {
+		if !isNotFoundError(err) {
+			return err
+		}
+
+		justDeleted = false
+	}
+
+	if justDeleted {
+		err = waitForApp(args.ObjectID, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if args.PurgeOnDelete {
+		return purgeDeletedApp(args.ObjectID, justDeleted)
+	}
+
+	return nil
+}
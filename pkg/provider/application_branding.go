@@ -0,0 +1,263 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+// maxBrandingURLLength is the longest URL Microsoft Graph accepts in the info of an application.
+const maxBrandingURLLength = 2048
+
+var (
+	// brandingURLFields are the URLs in the info of an application, in the order they are shown in the Azure portal.
+	brandingURLFields = []string{"marketingUrl", "supportUrl", "termsOfServiceUrl", "privacyStatementUrl"}
+
+	// brandingURLPaths are the paths the URLs are derived from the host name with.
+	brandingURLPaths = map[string]string{
+		"marketingUrl":        "",
+		"supportUrl":          "/support",
+		"termsOfServiceUrl":   "/terms",
+		"privacyStatementUrl": "/privacy",
+	}
+
+	applicationBrandingInputs = append([]string{"hostName", "publisherDomain"}, brandingURLFields...)
+
+	domainRegexp = regexp.MustCompile(`^(?i)[a-z0-9]([a-z0-9-]*[a-z0-9])?(\.[a-z0-9]([a-z0-9-]*[a-z0-9])?)+$`)
+)
+
+type applicationBrandingArgs struct {
+	ObjectID            string `pulumi:"objectId"`
+	MarketingURL        string `pulumi:"marketingUrl"`
+	SupportURL          string `pulumi:"supportUrl"`
+	TermsOfServiceURL   string `pulumi:"termsOfServiceUrl"`
+	PrivacyStatementURL string `pulumi:"privacyStatementUrl"`
+	PublisherDomain     string `pulumi:"publisherDomain"`
+}
+
+type informationalURL struct {
+	MarketingURL        *string `json:"marketingUrl"`
+	SupportURL          *string `json:"supportUrl"`
+	TermsOfServiceURL   *string `json:"termsOfServiceUrl"`
+	PrivacyStatementURL *string `json:"privacyStatementUrl"`
+}
+
+type applicationBranding struct {
+	Info            informationalURL `json:"info"`
+	PublisherDomain string           `json:"publisherDomain,omitempty"`
+}
+
+// checkApplicationBranding derives the URLs that are not set from the host name and validates the URLs and the
+// publisher domain. The inputs are changed in place.
+func checkApplicationBranding(inputs resource.PropertyMap) []*rpc.CheckFailure {
+	var failures []*rpc.CheckFailure
+
+	objectID := inputs["objectId"]
+	if objectID.IsString() && !guidRegexp.MatchString(objectID.StringValue()) {
+		failures = append(failures, &rpc.CheckFailure{
+			Property: "objectId",
+			Reason:   fmt.Sprintf("'objectId' must be an object ID but got '%s'", objectID.StringValue()),
+		})
+	}
+
+	hostName := inputs["hostName"]
+	if hostName.IsString() && strings.ContainsAny(hostName.StringValue(), "/?#") {
+		failures = append(failures, &rpc.CheckFailure{
+			Property: "hostName",
+			Reason:   fmt.Sprintf("'hostName' must be a host name like 'example.com' without a scheme or path but got '%s'", hostName.StringValue()),
+		})
+
+		return failures
+	}
+
+	for _, k := range brandingURLFields {
+		key := resource.PropertyKey(k)
+		if inputs.HasValue(key) {
+			continue
+		}
+
+		switch {
+		case hostName.IsString() && hostName.StringValue() != "":
+			inputs[key] = resource.NewStringProperty(fmt.Sprintf("https://%s%s", hostName.StringValue(), brandingURLPaths[k]))
+		case hostName.ContainsUnknowns():
+			inputs[key] = resource.MakeComputed(resource.NewStringProperty(""))
+		}
+	}
+
+	for _, k := range brandingURLFields {
+		v := inputs[resource.PropertyKey(k)]
+		if !v.IsString() {
+			continue
+		}
+
+		u, err := url.Parse(v.StringValue())
+		switch {
+		case err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "":
+			failures = append(failures, &rpc.CheckFailure{
+				Property: k,
+				Reason:   fmt.Sprintf("'%s' must be an absolute http or https URL but got '%s'", k, v.StringValue()),
+			})
+		case len(v.StringValue()) > maxBrandingURLLength:
+			failures = append(failures, &rpc.CheckFailure{
+				Property: k,
+				Reason:   fmt.Sprintf("'%s' can be at most %d characters long", k, maxBrandingURLLength),
+			})
+		}
+	}
+
+	domain := inputs["publisherDomain"]
+	if domain.IsString() && !domainRegexp.MatchString(domain.StringValue()) {
+		failures = append(failures, &rpc.CheckFailure{
+			Property: "publisherDomain",
+			Reason:   fmt.Sprintf("'publisherDomain' must be a domain like 'contoso.com' but got '%s'", domain.StringValue()),
+		})
+	}
+
+	return failures
+}
+
+// toApplicationBranding builds the Microsoft Graph settings for the inputs. URLs that are not set are cleared, while
+// the publisher domain is only changed when it is set.
+func (args applicationBrandingArgs) toApplicationBranding() applicationBranding {
+	optional := func(v string) *string {
+		if v == "" {
+			return nil
+		}
+
+		return &v
+	}
+
+	return applicationBranding{
+		Info: informationalURL{
+			MarketingURL:        optional(args.MarketingURL),
+			SupportURL:          optional(args.SupportURL),
+			TermsOfServiceURL:   optional(args.TermsOfServiceURL),
+			PrivacyStatementURL: optional(args.PrivacyStatementURL),
+		},
+		PublisherDomain: args.PublisherDomain,
+	}
+}
+
+// applyApplicationBranding writes the URLs and the publisher domain to the application.
+func applyApplicationBranding(inputs resource.PropertyMap) error {
+	var args applicationBrandingArgs
+	err := decodeInputs(inputs, &args)
+	if err != nil {
+		return err
+	}
+
+	return graphRequest("PATCH", "applications/"+args.ObjectID, args.toApplicationBranding(), nil)
+}
+
+// createApplicationBranding sets the branding once the application is available.
+func createApplicationBranding(inputs resource.PropertyMap) (string, map[string]interface{}, error) {
+	var args applicationBrandingArgs
+	err := decodeInputs(inputs, &args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = waitForApp(args.ObjectID, true)
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = applyApplicationBranding(inputs)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return args.ObjectID, inputs.Mappable(), nil
+}
+
+// updateApplicationBranding applies the new branding.
+func updateApplicationBranding(news resource.PropertyMap) (map[string]interface{}, error) {
+	err := applyApplicationBranding(news)
+	if err != nil {
+		return nil, err
+	}
+
+	return news.Mappable(), nil
+}
+
+// readApplicationBranding refreshes the branding of the application with the given object ID. When importing, the
+// URLs are taken over as they are, without a host name.
+func readApplicationBranding(id string, state, inputs resource.PropertyMap) (string, map[string]interface{}, map[string]interface{}, error) {
+	var b applicationBranding
+	found, err := graphGet(fmt.Sprintf("applications/%s?$select=info,publisherDomain", id), &b)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	if !found {
+		return "", nil, nil, nil
+	}
+
+	live := map[string]interface{}{}
+	for k, v := range map[string]*string{
+		"marketingUrl":        b.Info.MarketingURL,
+		"supportUrl":          b.Info.SupportURL,
+		"termsOfServiceUrl":   b.Info.TermsOfServiceURL,
+		"privacyStatementUrl": b.Info.PrivacyStatementURL,
+	} {
+		live[k] = nil
+		if v != nil && *v != "" {
+			live[k] = *v
+		}
+	}
+
+	// The publisher domain always has a value, so it is only reported when it is set explicitly.
+	if state.HasValue("publisherDomain") || len(state) == 0 {
+		live["publisherDomain"] = b.PublisherDomain
+	}
+
+	outputs := state.Mappable()
+	outputs["objectId"] = id
+	for k, v := range live {
+		outputs[k] = v
+	}
+
+	readInputs := inputs.Mappable()
+	if len(inputs) == 0 {
+		readInputs = live
+		readInputs["objectId"] = id
+	}
+
+	return id, outputs, readInputs, nil
+}
+
+// deleteApplicationBranding clears the URLs of the application. The publisher domain is left in place since the
+// application always has one. An application that is already gone is not an error.
+func deleteApplicationBranding(state resource.PropertyMap) error {
+	var args applicationBrandingArgs
+	err := decodeInputs(state, &args)
+	if err != nil {
+		return err
+	}
+
+	body := applicationBrandingArgs{ObjectID: args.ObjectID}.toApplicationBranding()
+	err = graphRequest("PATCH", "applications/"+args.ObjectID, body, nil)
+	if err != nil && !isNotFoundError(err) {
+		return err
+	}
+
+	return nil
+}
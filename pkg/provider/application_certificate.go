@@ -0,0 +1,321 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+// applicationCertificateReplaceInputs identify the key credential. The display name is updated in place.
+var applicationCertificateReplaceInputs = []string{"objectId", "certificate"}
+
+type applicationCertificateArgs struct {
+	ObjectID    string `pulumi:"objectId"`
+	Certificate string `pulumi:"certificate"`
+	DisplayName string `pulumi:"displayName"`
+}
+
+type applicationCertificateState struct {
+	ObjectID string `pulumi:"objectId"`
+	KeyID    string `pulumi:"keyId"`
+}
+
+// keyCredential is a certificate on an application. Microsoft Graph never returns the key itself, but it keeps the
+// existing key of any credential that is sent back with its key ID.
+type keyCredential struct {
+	CustomKeyIdentifier *string `json:"customKeyIdentifier"`
+	DisplayName         *string `json:"displayName"`
+	EndDateTime         *string `json:"endDateTime"`
+	Key                 *string `json:"key"`
+	KeyID               string  `json:"keyId"`
+	StartDateTime       *string `json:"startDateTime"`
+	Type                string  `json:"type"`
+	Usage               string  `json:"usage"`
+}
+
+type appKeyCredentials struct {
+	KeyCredentials []keyCredential `json:"keyCredentials"`
+}
+
+// parseCertificate accepts a PEM encoded certificate or a base64 encoded DER certificate.
+func parseCertificate(certificate string) (*x509.Certificate, error) {
+	var der []byte
+	if strings.Contains(certificate, "-----BEGIN") {
+		block, _ := pem.Decode([]byte(certificate))
+		if block == nil || block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("no PEM encoded CERTIFICATE block was found")
+		}
+
+		der = block.Bytes
+	} else {
+		var err error
+		der, err = base64.StdEncoding.DecodeString(strings.TrimSpace(certificate))
+		if err != nil {
+			return nil, fmt.Errorf("the certificate is neither PEM encoded nor base64 encoded DER: %v", err)
+		}
+	}
+
+	return x509.ParseCertificate(der)
+}
+
+// checkApplicationCertificate validates the certificate locally so that problems are reported before anything is
+// sent to Microsoft Graph.
+func checkApplicationCertificate(inputs resource.PropertyMap) []*rpc.CheckFailure {
+	v := inputs["certificate"]
+	if !v.IsString() {
+		return nil
+	}
+
+	cert, err := parseCertificate(v.StringValue())
+	if err != nil {
+		return []*rpc.CheckFailure{{Property: "certificate", Reason: fmt.Sprintf("invalid certificate: %v", err)}}
+	}
+
+	var failures []*rpc.CheckFailure
+
+	if !cert.NotAfter.After(time.Now()) {
+		failures = append(failures, &rpc.CheckFailure{
+			Property: "certificate",
+			Reason:   fmt.Sprintf("the certificate expired on %s", cert.NotAfter.Format(time.RFC3339)),
+		})
+	}
+
+	if cert.KeyUsage != 0 && cert.KeyUsage&x509.KeyUsageDigitalSignature == 0 {
+		failures = append(failures, &rpc.CheckFailure{
+			Property: "certificate",
+			Reason:   "the certificate's key usage must include digital signature to be used for client authentication",
+		})
+	}
+
+	if len(cert.ExtKeyUsage) > 0 {
+		allowed := false
+		for _, u := range cert.ExtKeyUsage {
+			if u == x509.ExtKeyUsageClientAuth || u == x509.ExtKeyUsageAny {
+				allowed = true
+			}
+		}
+
+		if !allowed {
+			failures = append(failures, &rpc.CheckFailure{
+				Property: "certificate",
+				Reason:   "the certificate's extended key usage must include client authentication",
+			})
+		}
+	}
+
+	return failures
+}
+
+// certificateKeyID returns the key ID and the thumbprint of the certificate on the application. The key ID is derived
+// from both, so adding the same certificate again updates the existing key credential instead of duplicating it.
+func certificateKeyID(objectID string, cert *x509.Certificate) (string, string) {
+	thumbprintBytes := sha1.Sum(cert.Raw)
+	thumbprint := strings.ToUpper(hex.EncodeToString(thumbprintBytes[:]))
+
+	return stableGUID("keyCredential", objectID, thumbprint), thumbprint
+}
+
+// diffApplicationCertificate updates the display name in place and replaces the key credential otherwise. A
+// replacement that keeps the application and the certificate, e.g. when a PEM certificate is changed to base64 DER,
+// gets the same key ID, so the old resource must be deleted first. Otherwise deleting it would remove the new
+// certificate. The returned boolean is true in that case.
+func diffApplicationCertificate(olds, news resource.PropertyMap) ([]string, []string, map[string]*rpc.PropertyDiff, bool) {
+	diffs, replaces, detailedDiff := diffInputs(olds, news, []string{"displayName"}, applicationCertificateReplaceInputs)
+	if len(replaces) == 0 || !news["objectId"].IsString() || !news["certificate"].IsString() || !olds["keyId"].IsString() {
+		return diffs, replaces, detailedDiff, false
+	}
+
+	cert, err := parseCertificate(news["certificate"].StringValue())
+	if err != nil {
+		return diffs, replaces, detailedDiff, false
+	}
+
+	keyID, _ := certificateKeyID(news["objectId"].StringValue(), cert)
+
+	return diffs, replaces, detailedDiff, strings.EqualFold(keyID, olds["keyId"].StringValue())
+}
+
+// createApplicationCertificate adds the certificate to the application's key credentials, keeping the others.
+// Changes to the key credentials of an application are serialized, since the whole collection is written back.
+func createApplicationCertificate(inputs resource.PropertyMap) (string, map[string]interface{}, error) {
+	var args applicationCertificateArgs
+	err := decodeInputs(inputs, &args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	cert, err := parseCertificate(args.Certificate)
+	if err != nil {
+		return "", nil, err
+	}
+
+	keyID, thumbprint := certificateKeyID(args.ObjectID, cert)
+	thumbprintBytes := sha1.Sum(cert.Raw)
+
+	displayName := args.DisplayName
+	if displayName == "" {
+		displayName = "CN=" + cert.Subject.CommonName
+	}
+
+	customKeyIdentifier := base64.StdEncoding.EncodeToString(thumbprintBytes[:])
+	key := base64.StdEncoding.EncodeToString(cert.Raw)
+	startDateTime := cert.NotBefore.UTC().Format(time.RFC3339)
+	endDateTime := cert.NotAfter.UTC().Format(time.RFC3339)
+
+	err = waitForApp(args.ObjectID, true)
+	if err != nil {
+		return "", nil, err
+	}
+
+	defer lockApplication(args.ObjectID)()
+
+	var app appKeyCredentials
+	err = graphRequest("GET", fmt.Sprintf("applications/%s?$select=keyCredentials", args.ObjectID), nil, &app)
+	if err != nil {
+		return "", nil, err
+	}
+
+	credentials := []keyCredential{}
+	for _, c := range app.KeyCredentials {
+		if c.KeyID != keyID {
+			credentials = append(credentials, c)
+		}
+	}
+
+	credentials = append(credentials, keyCredential{
+		CustomKeyIdentifier: &customKeyIdentifier,
+		DisplayName:         &displayName,
+		EndDateTime:         &endDateTime,
+		Key:                 &key,
+		KeyID:               keyID,
+		StartDateTime:       &startDateTime,
+		Type:                "AsymmetricX509Cert",
+		Usage:               "Verify",
+	})
+
+	err = graphRequest("PATCH", fmt.Sprintf("applications/%s", args.ObjectID), appKeyCredentials{KeyCredentials: credentials}, nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	outputs := inputs.Mappable()
+	outputs["keyId"] = keyID
+	outputs["thumbprint"] = thumbprint
+	outputs["startDateTime"] = startDateTime
+	outputs["endDateTime"] = endDateTime
+
+	return keyID, outputs, nil
+}
+
+// updateApplicationCertificate changes the display name of the resource's key credential, keeping the others.
+func updateApplicationCertificate(olds, news resource.PropertyMap) (map[string]interface{}, error) {
+	var args applicationCertificateArgs
+	err := decodeInputs(news, &args)
+	if err != nil {
+		return nil, err
+	}
+
+	var state applicationCertificateState
+	err = decodeInputs(olds, &state)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := parseCertificate(args.Certificate)
+	if err != nil {
+		return nil, err
+	}
+
+	displayName := args.DisplayName
+	if displayName == "" {
+		displayName = "CN=" + cert.Subject.CommonName
+	}
+
+	defer lockApplication(args.ObjectID)()
+
+	var app appKeyCredentials
+	err = graphRequest("GET", fmt.Sprintf("applications/%s?$select=keyCredentials", args.ObjectID), nil, &app)
+	if err != nil {
+		return nil, err
+	}
+
+	found := false
+	for i := range app.KeyCredentials {
+		if strings.EqualFold(app.KeyCredentials[i].KeyID, state.KeyID) {
+			app.KeyCredentials[i].DisplayName = &displayName
+			found = true
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("the application with object ID %s no longer has the key credential %s", args.ObjectID, state.KeyID)
+	}
+
+	err = graphRequest("PATCH", fmt.Sprintf("applications/%s", args.ObjectID), app, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := news.Mappable()
+	for _, k := range []resource.PropertyKey{"keyId", "thumbprint", "startDateTime", "endDateTime"} {
+		outputs[string(k)] = olds[k].Mappable()
+	}
+
+	return outputs, nil
+}
+
+// deleteApplicationCertificate removes only this resource's certificate from the application's key credentials.
+func deleteApplicationCertificate(state resource.PropertyMap) error {
+	var args applicationCertificateState
+	err := decodeInputs(state, &args)
+	if err != nil {
+		return err
+	}
+
+	defer lockApplication(args.ObjectID)()
+
+	var app appKeyCredentials
+	found, err := graphGet(fmt.Sprintf("applications/%s?$select=keyCredentials", args.ObjectID), &app)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return nil
+	}
+
+	credentials := []keyCredential{}
+	for _, c := range app.KeyCredentials {
+		if c.KeyID != args.KeyID {
+			credentials = append(credentials, c)
+		}
+	}
+
+	if len(credentials) == len(app.KeyCredentials) {
+		return nil
+	}
+
+	return graphRequest("PATCH", fmt.Sprintf("applications/%s", args.ObjectID), appKeyCredentials{KeyCredentials: credentials}, nil)
+}
@@ -0,0 +1,141 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+)
+
+// newTestCertificate returns a self-signed certificate, both PEM encoded and as base64 encoded DER.
+func newTestCertificate(t *testing.T, commonName string) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), base64.StdEncoding.EncodeToString(der)
+}
+
+func TestDiffApplicationCertificate(t *testing.T) {
+	const objectID = "00000000-0000-0000-0000-000000000001"
+	pemCertificate, derCertificate := newTestCertificate(t, "first")
+	otherCertificate, _ := newTestCertificate(t, "second")
+
+	cert, err := parseCertificate(pemCertificate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyID, _ := certificateKeyID(objectID, cert)
+
+	olds := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"objectId":    objectID,
+		"certificate": pemCertificate,
+		"displayName": "old",
+		"keyId":       keyID,
+	})
+
+	tests := []struct {
+		name                string
+		news                map[string]interface{}
+		expectedDiffs       []string
+		expectedReplaces    []string
+		deleteBeforeReplace bool
+	}{
+		{
+			"no changes",
+			map[string]interface{}{"objectId": objectID, "certificate": pemCertificate, "displayName": "old"},
+			[]string{},
+			[]string{},
+			false,
+		},
+		{
+			"display name is updated in place",
+			map[string]interface{}{"objectId": objectID, "certificate": pemCertificate, "displayName": "new"},
+			[]string{"displayName"},
+			[]string{},
+			false,
+		},
+		{
+			"other certificate is created before the old one is deleted",
+			map[string]interface{}{"objectId": objectID, "certificate": otherCertificate, "displayName": "old"},
+			[]string{"certificate"},
+			[]string{"certificate"},
+			false,
+		},
+		{
+			"same certificate with the same key ID is deleted first",
+			map[string]interface{}{"objectId": objectID, "certificate": derCertificate, "displayName": "old"},
+			[]string{"certificate"},
+			[]string{"certificate"},
+			true,
+		},
+		{
+			"unknown certificate is created before the old one is deleted",
+			map[string]interface{}{"objectId": objectID, "displayName": "old"},
+			[]string{"certificate"},
+			[]string{"certificate"},
+			false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			news := resource.NewPropertyMapFromMap(test.news)
+			if _, ok := test.news["certificate"]; !ok {
+				news["certificate"] = resource.MakeComputed(resource.NewStringProperty(""))
+			}
+
+			diffs, replaces, _, deleteBeforeReplace := diffApplicationCertificate(olds, news)
+			if !reflect.DeepEqual(test.expectedDiffs, diffs) {
+				t.Errorf("expected diffs %v, got %v", test.expectedDiffs, diffs)
+			}
+
+			if !reflect.DeepEqual(test.expectedReplaces, replaces) {
+				t.Errorf("expected replaces %v, got %v", test.expectedReplaces, replaces)
+			}
+
+			if deleteBeforeReplace != test.deleteBeforeReplace {
+				t.Errorf("expected delete before replace to be %v, got %v", test.deleteBeforeReplace, deleteBeforeReplace)
+			}
+		})
+	}
+}
@@ -0,0 +1,254 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+// maxLogoSize is the largest logo the Azure portal accepts, in bytes.
+const maxLogoSize = 100 * 1024
+
+// logoContentTypes are the image types that can be used as the logo of an application.
+var logoContentTypes = []string{"image/png", "image/jpeg", "image/gif", "image/bmp"}
+
+type applicationLogoArgs struct {
+	ObjectID      string `pulumi:"objectId"`
+	Source        string `pulumi:"source"`
+	Content       string `pulumi:"content"`
+	ContentSHA256 string `pulumi:"contentSha256"`
+}
+
+// load reads the logo from the file or decodes the base64 content.
+func (args applicationLogoArgs) load() ([]byte, error) {
+	if args.Source != "" {
+		content, err := ioutil.ReadFile(args.Source)
+		if err != nil {
+			return nil, fmt.Errorf("could not read the logo from '%s': %v", args.Source, err)
+		}
+
+		return content, nil
+	}
+
+	content, err := base64.StdEncoding.DecodeString(args.Content)
+	if err != nil {
+		return nil, fmt.Errorf("the logo content must be base64 encoded: %v", err)
+	}
+
+	return content, nil
+}
+
+// logoContentType detects the image type of the logo. The returned boolean is false if it is not a supported image.
+func logoContentType(content []byte) (string, bool) {
+	contentType := http.DetectContentType(content)
+	for _, t := range logoContentTypes {
+		if t == contentType {
+			return contentType, true
+		}
+	}
+
+	return contentType, false
+}
+
+func logoSHA256(content []byte) string {
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
+
+// checkApplicationLogo makes sure exactly one of the file and the content is set and that the logo is a supported
+// image within the size limit. The file is only read here: the SHA-256 of the logo is added to the inputs, so that Diff
+// can compare it with the state without the file, e.g. on a machine that only refreshes or destroys the stack. An
+// imported logo only has the SHA-256 of the live logo, which is accepted as it is.
+func checkApplicationLogo(inputs resource.PropertyMap) []*rpc.CheckFailure {
+	objectID := inputs["objectId"]
+	if objectID.IsString() && !guidRegexp.MatchString(objectID.StringValue()) {
+		return []*rpc.CheckFailure{{
+			Property: "objectId",
+			Reason:   fmt.Sprintf("'objectId' must be an object ID but got '%s'", objectID.StringValue()),
+		}}
+	}
+
+	source, content := inputs["source"], inputs["content"]
+	if source.ContainsUnknowns() || content.ContainsUnknowns() {
+		inputs["contentSha256"] = resource.MakeComputed(resource.NewStringProperty(""))
+		return nil
+	}
+
+	property := "source"
+	switch {
+	case inputs.HasValue("source") && inputs.HasValue("content"):
+		return []*rpc.CheckFailure{{
+			Property: "content",
+			Reason:   "only one of 'source' and 'content' can be set",
+		}}
+	case inputs.HasValue("content"):
+		property = "content"
+	case !inputs.HasValue("source") && inputs["contentSha256"].IsString():
+		return nil
+	case !inputs.HasValue("source"):
+		return []*rpc.CheckFailure{{
+			Property: "source",
+			Reason:   "one of 'source' and 'content' must be set",
+		}}
+	}
+
+	var args applicationLogoArgs
+	err := decodeInputs(inputs, &args)
+	if err != nil {
+		return []*rpc.CheckFailure{{Reason: err.Error()}}
+	}
+
+	logo, err := args.load()
+	if err != nil {
+		return []*rpc.CheckFailure{{Property: property, Reason: err.Error()}}
+	}
+
+	if len(logo) == 0 {
+		return []*rpc.CheckFailure{{Property: property, Reason: "the logo is empty"}}
+	}
+
+	if len(logo) > maxLogoSize {
+		return []*rpc.CheckFailure{{
+			Property: property,
+			Reason:   fmt.Sprintf("the logo can be at most %d KB but is %d bytes", maxLogoSize/1024, len(logo)),
+		}}
+	}
+
+	if contentType, ok := logoContentType(logo); !ok {
+		return []*rpc.CheckFailure{{
+			Property: property,
+			Reason:   fmt.Sprintf("the logo must be a PNG, JPEG, GIF or BMP image but got '%s'", contentType),
+		}}
+	}
+
+	inputs["contentSha256"] = resource.NewStringProperty(logoSHA256(logo))
+
+	return nil
+}
+
+// diffApplicationLogo replaces the resource when the application changes and otherwise compares the SHA-256 of the
+// logo from Check with the one in the state, so moving the file or switching between a file and the same content is not
+// a change.
+func diffApplicationLogo(olds, news resource.PropertyMap) ([]string, []string, map[string]*rpc.PropertyDiff) {
+	diffs, replaces, detailedDiff := diffInputs(olds, news, nil, []string{"objectId"})
+
+	hash := news["contentSha256"]
+	if !hash.ContainsUnknowns() && hash.DeepEquals(olds["contentSha256"]) {
+		return diffs, replaces, detailedDiff
+	}
+
+	property := "source"
+	if news.HasValue("content") {
+		property = "content"
+	}
+
+	diffs = append(diffs, property)
+	detailedDiff[property] = &rpc.PropertyDiff{Kind: rpc.PropertyDiff_UPDATE, InputDiff: true}
+
+	return diffs, replaces, detailedDiff
+}
+
+// uploadApplicationLogo streams the logo to the application.
+func uploadApplicationLogo(inputs resource.PropertyMap) (map[string]interface{}, error) {
+	var args applicationLogoArgs
+	err := decodeInputs(inputs, &args)
+	if err != nil {
+		return nil, err
+	}
+
+	if args.Source == "" && args.Content == "" {
+		return nil, fmt.Errorf("one of 'source' and 'content' must be set to upload the logo")
+	}
+
+	logo, err := args.load()
+	if err != nil {
+		return nil, err
+	}
+
+	if args.ContentSHA256 != "" && args.ContentSHA256 != logoSHA256(logo) {
+		return nil, fmt.Errorf("the logo changed since it was checked, run the update again")
+	}
+
+	contentType, _ := logoContentType(logo)
+	_, err = graphContentRequest("PUT", fmt.Sprintf("applications/%s/logo", args.ObjectID), contentType, bytes.NewReader(logo))
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := inputs.Mappable()
+	outputs["contentSha256"] = logoSHA256(logo)
+	outputs["contentType"] = contentType
+
+	return outputs, nil
+}
+
+// createApplicationLogo uploads the logo once the application is available.
+func createApplicationLogo(inputs resource.PropertyMap) (string, map[string]interface{}, error) {
+	var args applicationLogoArgs
+	err := decodeInputs(inputs, &args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = waitForApp(args.ObjectID, true)
+	if err != nil {
+		return "", nil, err
+	}
+
+	outputs, err := uploadApplicationLogo(inputs)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return args.ObjectID, outputs, nil
+}
+
+// readApplicationLogo downloads the logo of the application with the given object ID and refreshes its SHA-256, so
+// that a logo changed outside of Pulumi is uploaded again. When importing, only the SHA-256 of the logo is taken over,
+// so the logo itself never ends up in the state. The program sets 'source' or 'content', and the logo is only uploaded
+// if it differs.
+func readApplicationLogo(id string, state, inputs resource.PropertyMap) (string, map[string]interface{}, map[string]interface{}, error) {
+	logo, err := graphContentRequest("GET", fmt.Sprintf("applications/%s/logo", id), "", nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			return "", nil, nil, nil
+		}
+
+		return "", nil, nil, err
+	}
+
+	contentType, _ := logoContentType(logo)
+
+	outputs := state.Mappable()
+	outputs["objectId"] = id
+	outputs["contentSha256"] = logoSHA256(logo)
+	outputs["contentType"] = contentType
+
+	readInputs := inputs.Mappable()
+	if len(inputs) == 0 {
+		readInputs = map[string]interface{}{"objectId": id, "contentSha256": outputs["contentSha256"]}
+	}
+
+	return id, outputs, readInputs, nil
+}
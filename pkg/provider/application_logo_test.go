@@ -0,0 +1,68 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	pschema "github.com/pulumi/pulumi/pkg/v2/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+)
+
+func TestCheckImportedApplicationLogo(t *testing.T) {
+	schema, err := ioutil.ReadFile("../../schema.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var spec pschema.PackageSpec
+	if err := json.Unmarshal(schema, &spec); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		inputs map[string]interface{}
+		valid  bool
+	}{
+		{
+			"imported logo",
+			map[string]interface{}{"objectId": "00000000-0000-0000-0000-000000000001", "contentSha256": "abc"},
+			true,
+		},
+		{
+			"neither source nor content",
+			map[string]interface{}{"objectId": "00000000-0000-0000-0000-000000000001"},
+			false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			inputs := resource.NewPropertyMapFromMap(test.inputs)
+			failures, err := checkInputs(&spec, "knapcode:index:ApplicationLogo", inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			failures = append(failures, checkApplicationLogo(inputs)...)
+			if (len(failures) == 0) != test.valid {
+				t.Errorf("expected valid to be %v, got %v", test.valid, failures)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+// applicationOwnerInputs are all immutable since an owner is only a reference between two objects.
+var applicationOwnerInputs = []string{"objectId", "ownerId"}
+
+type applicationOwnerArgs struct {
+	ObjectID string `pulumi:"objectId"`
+	OwnerID  string `pulumi:"ownerId"`
+}
+
+// checkApplicationOwner makes sure both object IDs are GUIDs, since they end up in Microsoft Graph paths.
+func checkApplicationOwner(inputs resource.PropertyMap) []*rpc.CheckFailure {
+	var failures []*rpc.CheckFailure
+	for _, k := range applicationOwnerInputs {
+		v := inputs[resource.PropertyKey(k)]
+		if v.IsString() && !guidRegexp.MatchString(v.StringValue()) {
+			failures = append(failures, &rpc.CheckFailure{
+				Property: k,
+				Reason:   fmt.Sprintf("'%s' must be an object ID but got '%s'", k, v.StringValue()),
+			})
+		}
+	}
+
+	return failures
+}
+
+// parseDefaultOwners reads the defaultOwners provider setting, which is a JSON array when it comes from stack
+// configuration.
+func parseDefaultOwners(value resource.PropertyValue) ([]string, error) {
+	owners := []string{}
+	switch {
+	case value.IsNull():
+	case value.IsString():
+		err := json.Unmarshal([]byte(value.StringValue()), &owners)
+		if err != nil {
+			return nil, fmt.Errorf("'defaultOwners' must be a JSON array of object IDs: %v", err)
+		}
+	case value.IsArray():
+		for _, v := range value.ArrayValue() {
+			if !v.IsString() {
+				return nil, fmt.Errorf("'defaultOwners' must only contain object IDs")
+			}
+			owners = append(owners, v.StringValue())
+		}
+	default:
+		return nil, fmt.Errorf("'defaultOwners' must be an array of object IDs")
+	}
+
+	for _, owner := range owners {
+		if !guidRegexp.MatchString(owner) {
+			return nil, fmt.Errorf("'defaultOwners' must only contain object IDs but got '%s'", owner)
+		}
+	}
+
+	return owners, nil
+}
+
+// addOwner adds an owner to an application or service principal, given as the Microsoft Graph path of the object.
+func addOwner(path, ownerID string) error {
+	return addReference(path+"/owners", ownerID, fmt.Sprintf("owner with object ID %s", ownerID))
+}
+
+// addDefaultOwners adds the owners from the provider configuration to an application or service principal created by
+// this provider.
+func addDefaultOwners(path string, owners []string) error {
+	for _, owner := range owners {
+		err := addOwner(path, owner)
+		if err != nil {
+			return fmt.Errorf("could not add default owner %s to %s: %v", owner, path, err)
+		}
+	}
+
+	return nil
+}
+
+// findOwner looks for the owner in the owners of the application. The returned boolean is false if the application
+// does not exist or the principal is not one of its owners.
+func findOwner(objectID, ownerID string) (*directoryObject, bool, error) {
+	return findReference(fmt.Sprintf("applications/%s/owners", objectID), ownerID)
+}
+
+func applicationOwnerOutputs(inputs resource.PropertyMap, owner *directoryObject) map[string]interface{} {
+	outputs := inputs.Mappable()
+	outputs["ownerType"] = strings.TrimPrefix(owner.ODataType, "#microsoft.graph.")
+	outputs["ownerDisplayName"] = owner.DisplayName
+
+	return outputs
+}
+
+// createApplicationOwner adds the owner once the application is available. The resource ID is the application's
+// object ID and the owner's object ID separated by a slash, so that the owner can be imported.
+func createApplicationOwner(inputs resource.PropertyMap) (string, map[string]interface{}, error) {
+	var args applicationOwnerArgs
+	err := decodeInputs(inputs, &args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = waitForApp(args.ObjectID, true)
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = addOwner("applications/"+args.ObjectID, args.OwnerID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	owner, found, err := findOwner(args.ObjectID, args.OwnerID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if !found {
+		owner = &directoryObject{ID: args.OwnerID}
+	}
+
+	return args.ObjectID + "/" + args.OwnerID, applicationOwnerOutputs(inputs, owner), nil
+}
+
+// readApplicationOwner refreshes the owner. When importing, there is no state so the object IDs are taken from the
+// resource ID.
+func readApplicationOwner(id string, state, inputs resource.PropertyMap) (string, map[string]interface{}, map[string]interface{}, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 {
+		return "", nil, nil, fmt.Errorf("expected an ID in the form '<application object ID>/<owner object ID>' but got '%s'", id)
+	}
+
+	owner, found, err := findOwner(parts[0], parts[1])
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	if !found {
+		return "", nil, nil, nil
+	}
+
+	outputs := applicationOwnerOutputs(state, owner)
+
+	readInputs := inputs.Mappable()
+	if len(inputs) == 0 {
+		readInputs = map[string]interface{}{
+			"objectId": parts[0],
+			"ownerId":  parts[1],
+		}
+		for k, v := range readInputs {
+			outputs[k] = v
+		}
+	}
+
+	return id, outputs, readInputs, nil
+}
+
+// deleteApplicationOwner removes the owner. An owner or application that is already gone is not an error.
+func deleteApplicationOwner(state resource.PropertyMap) error {
+	var args applicationOwnerArgs
+	err := decodeInputs(state, &args)
+	if err != nil {
+		return err
+	}
+
+	return removeReference(fmt.Sprintf("applications/%s/owners", args.ObjectID), args.OwnerID)
+}
@@ -0,0 +1,167 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+// applicationPasswordInputs are all immutable since Microsoft Graph can only add and remove passwords.
+var applicationPasswordInputs = []string{"objectId", "displayName", "startDateTime", "endDateTime", "rotateWhenChanged"}
+
+type applicationPasswordArgs struct {
+	ObjectID          string            `pulumi:"objectId"`
+	DisplayName       string            `pulumi:"displayName"`
+	StartDateTime     string            `pulumi:"startDateTime"`
+	EndDateTime       string            `pulumi:"endDateTime"`
+	RotateWhenChanged map[string]string `pulumi:"rotateWhenChanged"`
+}
+
+type applicationPasswordState struct {
+	ObjectID string `pulumi:"objectId"`
+	KeyID    string `pulumi:"keyId"`
+}
+
+type passwordCredential struct {
+	KeyID         string `json:"keyId,omitempty"`
+	DisplayName   string `json:"displayName,omitempty"`
+	StartDateTime string `json:"startDateTime,omitempty"`
+	EndDateTime   string `json:"endDateTime,omitempty"`
+	SecretText    string `json:"secretText,omitempty"`
+	Hint          string `json:"hint,omitempty"`
+}
+
+type addPasswordRequest struct {
+	PasswordCredential passwordCredential `json:"passwordCredential"`
+}
+
+type removePasswordRequest struct {
+	KeyID string `json:"keyId"`
+}
+
+type appPasswordCredentials struct {
+	PasswordCredentials []passwordCredential `json:"passwordCredentials"`
+}
+
+// checkApplicationPassword validates the password's validity period.
+func checkApplicationPassword(inputs resource.PropertyMap) []*rpc.CheckFailure {
+	var failures []*rpc.CheckFailure
+
+	start, startFailure := checkDateTime(inputs, "startDateTime")
+	if startFailure != nil {
+		failures = append(failures, startFailure)
+	}
+
+	end, endFailure := checkDateTime(inputs, "endDateTime")
+	if endFailure != nil {
+		failures = append(failures, endFailure)
+	}
+
+	if !start.IsZero() && !end.IsZero() && !end.After(start) {
+		failures = append(failures, &rpc.CheckFailure{
+			Property: "endDateTime",
+			Reason:   "'endDateTime' must be after 'startDateTime'",
+		})
+	}
+
+	return failures
+}
+
+// checkDateTime parses an optional RFC 3339 date and time input. The zero time is returned if the input is not set
+// or not known yet.
+func checkDateTime(inputs resource.PropertyMap, key resource.PropertyKey) (time.Time, *rpc.CheckFailure) {
+	v := inputs[key]
+	if !v.IsString() {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, v.StringValue())
+	if err != nil {
+		return time.Time{}, &rpc.CheckFailure{
+			Property: string(key),
+			Reason:   fmt.Sprintf("'%s' must be an RFC 3339 date and time, e.g. 2021-01-01T00:00:00Z", key),
+		}
+	}
+
+	return t, nil
+}
+
+// createApplicationPassword adds a client secret to an application. The secret text is only returned by Graph when
+// the password is added, so it is kept as a secret output.
+func createApplicationPassword(inputs resource.PropertyMap) (string, map[string]interface{}, error) {
+	var args applicationPasswordArgs
+	err := decodeInputs(inputs, &args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = waitForApp(args.ObjectID, true)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var created passwordCredential
+	err = graphRequest("POST", fmt.Sprintf("applications/%s/addPassword", args.ObjectID), addPasswordRequest{
+		PasswordCredential: passwordCredential{
+			DisplayName:   args.DisplayName,
+			StartDateTime: args.StartDateTime,
+			EndDateTime:   args.EndDateTime,
+		},
+	}, &created)
+	if err != nil {
+		return "", nil, err
+	}
+
+	outputs := inputs.Mappable()
+	outputs["keyId"] = created.KeyID
+	outputs["hint"] = created.Hint
+	outputs["secretText"] = &resource.Secret{Element: resource.NewStringProperty(created.SecretText)}
+
+	return created.KeyID, outputs, nil
+}
+
+// deleteApplicationPassword removes the client secret. A password or application that is already gone is not an
+// error.
+func deleteApplicationPassword(state resource.PropertyMap) error {
+	var args applicationPasswordState
+	err := decodeInputs(state, &args)
+	if err != nil {
+		return err
+	}
+
+	var app appPasswordCredentials
+	found, err := graphGet(fmt.Sprintf("applications/%s?$select=passwordCredentials", args.ObjectID), &app)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return nil
+	}
+
+	for _, p := range app.PasswordCredentials {
+		if p.KeyID == args.KeyID {
+			return graphRequest("POST", fmt.Sprintf("applications/%s/removePassword", args.ObjectID), removePasswordRequest{
+				KeyID: args.KeyID,
+			}, nil)
+		}
+	}
+
+	return nil
+}
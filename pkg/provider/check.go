@@ -0,0 +1,292 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	pschema "github.com/pulumi/pulumi/pkg/v2/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/mapper"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+// computedInputs are inputs that Check adds itself. Programs don't set them, so they are not declared in the schema,
+// but Read returns them when a resource is imported.
+var computedInputs = map[string][]string{
+	"knapcode:index:ApplicationLogo": {"contentSha256"},
+}
+
+// checkInputs validates a resource's input property bag against the input properties declared in the schema.
+func checkInputs(spec *pschema.PackageSpec, ty string, inputs resource.PropertyMap) ([]*rpc.CheckFailure, error) {
+	res, ok := spec.Resources[ty]
+	if !ok {
+		return nil, fmt.Errorf("resource type '%s' is not declared in the schema", ty)
+	}
+
+	properties := res.InputProperties
+	if len(computedInputs[ty]) > 0 {
+		properties = map[string]pschema.PropertySpec{}
+		for k, v := range res.InputProperties {
+			properties[k] = v
+		}
+		for _, k := range computedInputs[ty] {
+			properties[k] = pschema.PropertySpec{TypeSpec: pschema.TypeSpec{Type: "string"}}
+		}
+	}
+
+	c := &inputChecker{spec: spec}
+	c.checkObject("", properties, res.RequiredInputs, inputs)
+	return c.failures, nil
+}
+
+// checkFunctionInputs validates an Invoke's arguments against the function inputs declared in the schema.
+func checkFunctionInputs(spec *pschema.PackageSpec, tok string, args resource.PropertyMap) ([]*rpc.CheckFailure, error) {
+	fn, ok := spec.Functions[tok]
+	if !ok {
+		return nil, fmt.Errorf("function '%s' is not declared in the schema", tok)
+	}
+
+	c := &inputChecker{spec: spec}
+	if fn.Inputs != nil {
+		c.checkObject("", fn.Inputs.Properties, fn.Inputs.Required, args)
+	}
+	return c.failures, nil
+}
+
+type inputChecker struct {
+	spec     *pschema.PackageSpec
+	failures []*rpc.CheckFailure
+}
+
+func (c *inputChecker) fail(path, format string, args ...interface{}) {
+	c.failures = append(c.failures, &rpc.CheckFailure{
+		Property: path,
+		Reason:   fmt.Sprintf(format, args...),
+	})
+}
+
+func (c *inputChecker) checkObject(path string, properties map[string]pschema.PropertySpec, required []string, obj resource.PropertyMap) {
+	for _, name := range required {
+		v, has := obj[resource.PropertyKey(name)]
+		if !has || v.IsNull() {
+			c.fail(joinPath(path, name), "missing required property '%s'", joinPath(path, name))
+		}
+	}
+
+	// Visit the properties in a stable order so that failures are reported deterministically.
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, string(k))
+	}
+	sort.Strings(keys)
+
+	for _, name := range keys {
+		// Reserved keys such as "__defaults" are maintained by the engine rather than the program.
+		if strings.HasPrefix(name, "__") {
+			continue
+		}
+
+		p, ok := properties[name]
+		if !ok {
+			c.fail(joinPath(path, name), "unknown property '%s'", joinPath(path, name))
+			continue
+		}
+
+		c.checkValue(joinPath(path, name), p.TypeSpec, obj[resource.PropertyKey(name)])
+	}
+}
+
+func (c *inputChecker) checkValue(path string, t pschema.TypeSpec, v resource.PropertyValue) {
+	if v.IsSecret() {
+		v = v.SecretValue().Element
+	}
+
+	// Unknown values are checked again once they are resolved, and nulls are treated as absent.
+	if v.IsComputed() || v.IsOutput() || v.IsNull() {
+		return
+	}
+
+	if t.Ref != "" {
+		c.checkRef(path, t.Ref, v)
+		return
+	}
+
+	if len(t.OneOf) > 0 {
+		for _, alt := range t.OneOf {
+			inner := &inputChecker{spec: c.spec}
+			inner.checkValue(path, alt, v)
+			if len(inner.failures) == 0 {
+				return
+			}
+		}
+		c.fail(path, "property '%s' does not match any of the allowed types", path)
+		return
+	}
+
+	switch t.Type {
+	case "string":
+		if !v.IsString() {
+			c.fail(path, "expected property '%s' of type 'string' but got '%s'", path, v.TypeString())
+		}
+	case "boolean":
+		if !v.IsBool() {
+			c.fail(path, "expected property '%s' of type 'boolean' but got '%s'", path, v.TypeString())
+		}
+	case "number":
+		if !v.IsNumber() {
+			c.fail(path, "expected property '%s' of type 'number' but got '%s'", path, v.TypeString())
+		}
+	case "integer":
+		if !v.IsNumber() || v.NumberValue() != math.Trunc(v.NumberValue()) {
+			c.fail(path, "expected property '%s' of type 'integer' but got '%s'", path, v.TypeString())
+		}
+	case "array":
+		if !v.IsArray() {
+			c.fail(path, "expected property '%s' of type 'array' but got '%s'", path, v.TypeString())
+			return
+		}
+		if t.Items != nil {
+			for i, e := range v.ArrayValue() {
+				c.checkValue(fmt.Sprintf("%s[%d]", path, i), *t.Items, e)
+			}
+		}
+	case "object":
+		if !v.IsObject() {
+			c.fail(path, "expected property '%s' of type 'object' but got '%s'", path, v.TypeString())
+			return
+		}
+		if t.AdditionalProperties != nil {
+			obj := v.ObjectValue()
+			for _, k := range obj.StableKeys() {
+				c.checkValue(fmt.Sprintf("%s[\"%s\"]", path, k), *t.AdditionalProperties, obj[k])
+			}
+		}
+	}
+}
+
+func (c *inputChecker) checkRef(path, ref string, v resource.PropertyValue) {
+	if ref == "pulumi.json#/Any" {
+		return
+	}
+
+	if !strings.HasPrefix(ref, "#/types/") {
+		c.fail(path, "property '%s' references unsupported type '%s'", path, ref)
+		return
+	}
+
+	tok := strings.TrimPrefix(ref, "#/types/")
+	typ, ok := c.spec.Types[tok]
+	if !ok {
+		c.fail(path, "property '%s' references undeclared type '%s'", path, tok)
+		return
+	}
+
+	if len(typ.Enum) > 0 {
+		c.checkValue(path, pschema.TypeSpec{Type: typ.Type}, v)
+		allowed := make([]string, 0, len(typ.Enum))
+		for _, e := range typ.Enum {
+			if fmt.Sprintf("%v", e.Value) == fmt.Sprintf("%v", v.V) {
+				return
+			}
+			allowed = append(allowed, fmt.Sprintf("'%v'", e.Value))
+		}
+		c.fail(path, "property '%s' must be one of %s but got '%v'", path, strings.Join(allowed, ", "), v.V)
+		return
+	}
+
+	if !v.IsObject() {
+		c.fail(path, "expected property '%s' of type '%s' but got '%s'", path, tok, v.TypeString())
+		return
+	}
+
+	c.checkObject(path, typ.Properties, typ.Required, v.ObjectValue())
+}
+
+// projectOntoSchema keeps only the properties of a plain JSON-like object that are declared in the schema, descending
+// into object types and arrays. This is used to compare objects read from Microsoft Graph with resource inputs.
+func projectOntoSchema(spec *pschema.PackageSpec, properties map[string]pschema.PropertySpec, obj map[string]interface{}) map[string]interface{} {
+	projected := map[string]interface{}{}
+	for name, p := range properties {
+		if v, has := obj[name]; has {
+			projected[name] = projectValueOntoSchema(spec, p.TypeSpec, v)
+		}
+	}
+
+	return projected
+}
+
+func projectValueOntoSchema(spec *pschema.PackageSpec, t pschema.TypeSpec, v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if typ, ok := spec.Types[strings.TrimPrefix(t.Ref, "#/types/")]; ok && len(typ.Enum) == 0 {
+			return projectOntoSchema(spec, typ.Properties, v)
+		}
+	case []interface{}:
+		if t.Items != nil {
+			projected := make([]interface{}, 0, len(v))
+			for _, e := range v {
+				projected = append(projected, projectValueOntoSchema(spec, *t.Items, e))
+			}
+			return projected
+		}
+	}
+
+	return v
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// plainProperties converts a property bag to plain JSON-like values. Secrets are unwrapped and unknown values become
+// nil.
+func plainProperties(props resource.PropertyMap) map[string]interface{} {
+	var replv func(resource.PropertyValue) (interface{}, bool)
+	replv = func(v resource.PropertyValue) (interface{}, bool) {
+		if v.IsSecret() {
+			return v.SecretValue().Element.MapRepl(nil, replv), true
+		}
+		if v.IsComputed() || v.IsOutput() {
+			return nil, true
+		}
+		return nil, false
+	}
+
+	return props.MapRepl(nil, replv)
+}
+
+// decodeInputs maps a property bag onto a struct using its `pulumi` field tags. Secrets are unwrapped and unknown
+// values are left unset, so callers that run during preview should check for unknowns before relying on a field.
+func decodeInputs(inputs resource.PropertyMap, target interface{}) error {
+	obj := plainProperties(inputs)
+
+	md := mapper.New(&mapper.Opts{
+		Tags:               []string{"pulumi"},
+		IgnoreMissing:      true,
+		IgnoreUnrecognized: true,
+	})
+	if err := md.Decode(obj, target); err != nil {
+		return err
+	}
+
+	return nil
+}
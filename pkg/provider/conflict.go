@@ -0,0 +1,177 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const (
+	conflictPolicyOverwrite = "overwrite"
+	conflictPolicyFail      = "fail"
+	conflictPolicyMerge     = "merge"
+)
+
+// fingerprint hashes the canonical JSON form of an object. Map keys are serialized in sorted order so equal objects
+// always produce the same fingerprint.
+func fingerprint(obj map[string]interface{}) (string, error) {
+	jsonBytes, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(jsonBytes)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// projectOnto returns the parts of the live object that have a counterpart in the shape object, so that a live
+// application can be compared with the patch that was written to it.
+func projectOnto(live, shape map[string]interface{}) map[string]interface{} {
+	projected := map[string]interface{}{}
+	for k, v := range shape {
+		liveValue := live[k]
+		shapeObject, isShapeObject := v.(map[string]interface{})
+		liveObject, isLiveObject := liveValue.(map[string]interface{})
+		if isShapeObject && isLiveObject {
+			projected[k] = projectOnto(liveObject, shapeObject)
+		} else {
+			projected[k] = liveValue
+		}
+	}
+
+	return projected
+}
+
+// describeChanges lists the settings that differ between what was written and what is live, one line per setting.
+func describeChanges(path string, written, live map[string]interface{}) []string {
+	lines := []string{}
+	for _, k := range sortedKeys(written) {
+		p := joinPath(path, k)
+		writtenObject, isWrittenObject := written[k].(map[string]interface{})
+		liveObject, isLiveObject := live[k].(map[string]interface{})
+		if isWrittenObject && isLiveObject {
+			lines = append(lines, describeChanges(p, writtenObject, liveObject)...)
+		} else if !reflect.DeepEqual(written[k], live[k]) {
+			lines = append(lines, fmt.Sprintf("  %s: wrote %s, found %s", p, toJSONString(written[k]), toJSONString(live[k])))
+		}
+	}
+
+	return lines
+}
+
+// mergeChanges performs a three-way merge of the desired patch with the live application. Settings this update does
+// not change (i.e. the desired value matches what was last written) keep their live value. Arrays, such as redirect
+// URIs, keep any values that were added outside of Pulumi.
+func mergeChanges(written, live, desired map[string]interface{}) map[string]interface{} {
+	merged := map[string]interface{}{}
+	for k, desiredValue := range desired {
+		writtenValue := written[k]
+		liveValue, hasLive := live[k]
+
+		desiredObject, isDesiredObject := desiredValue.(map[string]interface{})
+		writtenObject, _ := writtenValue.(map[string]interface{})
+		liveObject, isLiveObject := liveValue.(map[string]interface{})
+		if isDesiredObject && isLiveObject {
+			merged[k] = mergeChanges(writtenObject, liveObject, desiredObject)
+			continue
+		}
+
+		desiredArray, isDesiredArray := desiredValue.([]interface{})
+		liveArray, isLiveArray := liveValue.([]interface{})
+		if isDesiredArray && isLiveArray {
+			writtenArray, _ := writtenValue.([]interface{})
+			merged[k] = union(desiredArray, except(liveArray, writtenArray))
+			continue
+		}
+
+		if hasLive && reflect.DeepEqual(desiredValue, writtenValue) {
+			merged[k] = liveValue
+		} else {
+			merged[k] = desiredValue
+		}
+	}
+
+	return merged
+}
+
+// reconcileAppUpdate compares the live application with the patch that was last applied. It returns the patch to send
+// and, if the application was changed outside of Pulumi, a description of the changes. The conflict policy decides
+// whether the desired patch is merged with those changes or overwrites them.
+func reconcileAppUpdate(policy, appliedFingerprint string, applied, live, desired map[string]interface{}) (map[string]interface{}, []string, error) {
+	// Resources created before fingerprints were recorded have nothing to compare with.
+	if appliedFingerprint == "" || applied == nil {
+		return desired, nil, nil
+	}
+
+	written := projectOnto(live, applied)
+	liveFingerprint, err := fingerprint(written)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if liveFingerprint == appliedFingerprint {
+		return desired, nil, nil
+	}
+
+	changes := describeChanges("", applied, written)
+	if policy == conflictPolicyMerge {
+		return mergeChanges(applied, written, desired), changes, nil
+	}
+
+	return desired, changes, nil
+}
+
+func union(first, second []interface{}) []interface{} {
+	return append(append([]interface{}{}, first...), except(second, first)...)
+}
+
+func except(values, excluded []interface{}) []interface{} {
+	result := []interface{}{}
+	for _, v := range values {
+		if !containsValue(excluded, v) && !containsValue(result, v) {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+func containsValue(values []interface{}, v interface{}) bool {
+	for _, u := range values {
+		if reflect.DeepEqual(u, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func toJSONString(v interface{}) string {
+	jsonBytes, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return string(jsonBytes)
+}
+
+func formatExternalChanges(objectID string, lines []string) string {
+	return fmt.Sprintf("application with object ID %s was changed outside of Pulumi:\n%s", objectID, strings.Join(lines, "\n"))
+}
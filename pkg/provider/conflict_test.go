@@ -0,0 +1,252 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func parseJSONObject(t *testing.T, s string) map[string]interface{} {
+	t.Helper()
+
+	var obj map[string]interface{}
+	err := json.Unmarshal([]byte(s), &obj)
+	if err != nil {
+		t.Fatalf("invalid JSON %s: %v", s, err)
+	}
+
+	return obj
+}
+
+func TestFingerprint(t *testing.T) {
+	tests := []struct {
+		name  string
+		a, b  string
+		equal bool
+	}{
+		{"same object", `{"a":1,"b":{"c":[1,2]}}`, `{"a":1,"b":{"c":[1,2]}}`, true},
+		{"key order", `{"a":1,"b":2}`, `{"b":2,"a":1}`, true},
+		{"nested key order", `{"web":{"x":1,"y":2}}`, `{"web":{"y":2,"x":1}}`, true},
+		{"different value", `{"a":1}`, `{"a":2}`, false},
+		{"array order", `{"a":[1,2]}`, `{"a":[2,1]}`, false},
+		{"null and missing", `{"a":null}`, `{}`, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a, err := fingerprint(parseJSONObject(t, test.a))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			b, err := fingerprint(parseJSONObject(t, test.b))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if (a == b) != test.equal {
+				t.Errorf("expected equal fingerprints to be %v, got %s and %s", test.equal, a, b)
+			}
+		})
+	}
+}
+
+func TestProjectOnto(t *testing.T) {
+	tests := []struct {
+		name     string
+		live     string
+		shape    string
+		expected string
+	}{
+		{
+			"keeps only the keys of the shape",
+			`{"a":1,"b":2,"c":3}`,
+			`{"a":0,"c":0}`,
+			`{"a":1,"c":3}`,
+		},
+		{
+			"descends into objects",
+			`{"web":{"homePageUrl":"https://a","logoutUrl":"https://b"},"api":{}}`,
+			`{"web":{"homePageUrl":""}}`,
+			`{"web":{"homePageUrl":"https://a"}}`,
+		},
+		{
+			"missing live values are null",
+			`{}`,
+			`{"a":1,"web":{"b":2}}`,
+			`{"a":null,"web":null}`,
+		},
+		{
+			"arrays are not projected",
+			`{"a":[1,2,3]}`,
+			`{"a":[1]}`,
+			`{"a":[1,2,3]}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := projectOnto(parseJSONObject(t, test.live), parseJSONObject(t, test.shape))
+			expected := parseJSONObject(t, test.expected)
+			if !reflect.DeepEqual(expected, actual) {
+				t.Errorf("expected %s, got %s", toJSONString(expected), toJSONString(actual))
+			}
+		})
+	}
+}
+
+func TestMergeChanges(t *testing.T) {
+	tests := []struct {
+		name     string
+		written  string
+		live     string
+		desired  string
+		expected string
+	}{
+		{
+			"unchanged setting keeps the live value",
+			`{"signInAudience":"AzureADMyOrg"}`,
+			`{"signInAudience":"AzureADMultipleOrgs"}`,
+			`{"signInAudience":"AzureADMyOrg"}`,
+			`{"signInAudience":"AzureADMultipleOrgs"}`,
+		},
+		{
+			"changed setting takes the desired value",
+			`{"signInAudience":"AzureADMyOrg"}`,
+			`{"signInAudience":"AzureADMultipleOrgs"}`,
+			`{"signInAudience":"PersonalMicrosoftAccount"}`,
+			`{"signInAudience":"PersonalMicrosoftAccount"}`,
+		},
+		{
+			"missing live value takes the desired value",
+			`{"a":1}`,
+			`{}`,
+			`{"a":1}`,
+			`{"a":1}`,
+		},
+		{
+			"arrays keep values added outside of Pulumi",
+			`{"web":{"redirectUris":["https://a"]}}`,
+			`{"web":{"redirectUris":["https://a","https://external"]}}`,
+			`{"web":{"redirectUris":["https://a","https://b"]}}`,
+			`{"web":{"redirectUris":["https://a","https://b","https://external"]}}`,
+		},
+		{
+			"arrays drop values the update removes",
+			`{"web":{"redirectUris":["https://a","https://b"]}}`,
+			`{"web":{"redirectUris":["https://a","https://b"]}}`,
+			`{"web":{"redirectUris":["https://a"]}}`,
+			`{"web":{"redirectUris":["https://a"]}}`,
+		},
+		{
+			"objects are merged property by property",
+			`{"web":{"homePageUrl":"https://a","logoutUrl":"https://a/logout"}}`,
+			`{"web":{"homePageUrl":"https://external","logoutUrl":"https://a/logout"}}`,
+			`{"web":{"homePageUrl":"https://a","logoutUrl":"https://b/logout"}}`,
+			`{"web":{"homePageUrl":"https://external","logoutUrl":"https://b/logout"}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := mergeChanges(parseJSONObject(t, test.written), parseJSONObject(t, test.live), parseJSONObject(t, test.desired))
+			expected := parseJSONObject(t, test.expected)
+			if !reflect.DeepEqual(expected, actual) {
+				t.Errorf("expected %s, got %s", toJSONString(expected), toJSONString(actual))
+			}
+		})
+	}
+}
+
+func TestReconcileAppUpdate(t *testing.T) {
+	applied := `{"signInAudience":"AzureADMyOrg","web":{"redirectUris":["https://a"]}}`
+	external := `{"signInAudience":"AzureADMultipleOrgs","web":{"redirectUris":["https://a","https://external"]}}`
+
+	tests := []struct {
+		name            string
+		policy          string
+		live            string
+		expectedPatch   string
+		expectedChanges bool
+	}{
+		{"no changes", conflictPolicyMerge, applied, applied, false},
+		{"overwrite", conflictPolicyOverwrite, external, applied, true},
+		{"fail", conflictPolicyFail, external, applied, true},
+		{"merge", conflictPolicyMerge, external, external, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			appliedFingerprint, err := fingerprint(parseJSONObject(t, applied))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			patch, changes, err := reconcileAppUpdate(
+				test.policy,
+				appliedFingerprint,
+				parseJSONObject(t, applied),
+				parseJSONObject(t, test.live),
+				parseJSONObject(t, applied))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expected := parseJSONObject(t, test.expectedPatch)
+			if !reflect.DeepEqual(expected, patch) {
+				t.Errorf("expected patch %s, got %s", toJSONString(expected), toJSONString(patch))
+			}
+
+			if (changes != nil) != test.expectedChanges {
+				t.Errorf("expected changes to be reported to be %v, got %v", test.expectedChanges, changes)
+			}
+		})
+	}
+}
+
+// TestReconcileAppUpdateMergeTwice runs two updates in a row with the same program after the application was changed
+// outside of Pulumi. The second update must keep the merged changes rather than overwrite them.
+func TestReconcileAppUpdateMergeTwice(t *testing.T) {
+	desired := parseJSONObject(t, `{"signInAudience":"AzureADMyOrg","web":{"redirectUris":["https://a"]}}`)
+	live := parseJSONObject(t, `{"signInAudience":"AzureADMultipleOrgs","web":{"redirectUris":["https://a","https://external"]}}`)
+	expected := parseJSONObject(t, toJSONString(live))
+
+	applied := desired
+	for i := 1; i <= 2; i++ {
+		appliedFingerprint, err := fingerprint(applied)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		patch, changes, err := reconcileAppUpdate(conflictPolicyMerge, appliedFingerprint, applied, live, desired)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if changes == nil {
+			t.Errorf("update %d: expected the changes made outside of Pulumi to be reported", i)
+		}
+
+		if !reflect.DeepEqual(expected, patch) {
+			t.Errorf("update %d: expected patch %s, got %s", i, toJSONString(expected), toJSONString(patch))
+		}
+
+		// update() sends the patch and records the program's patch, like applyAppUpdate.
+		live = parseJSONObject(t, toJSONString(patch))
+		applied = desired
+	}
+}
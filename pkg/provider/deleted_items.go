@@ -0,0 +1,51 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+)
+
+// purgeDeletedApp permanently deletes an application from the directory's recycle bin. Deleted applications are kept
+// there for 30 days and keep their identifier URIs reserved, which gets in the way of recreating them. If
+// justDeleted is false, the application may have been purged already so a missing deleted item is not an error.
+func purgeDeletedApp(objectID string, justDeleted bool) error {
+	path := fmt.Sprintf("directory/deletedItems/%s", objectID)
+	description := fmt.Sprintf("deleted application with object ID %s", objectID)
+
+	if justDeleted {
+		// The application shows up in the deleted items some time after it is deleted.
+		err := waitForObject(path, description, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		found, err := graphGet(path, nil)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return nil
+		}
+	}
+
+	err := graphRequest("DELETE", path, nil, nil)
+	if err != nil && !isNotFoundError(err) {
+		return err
+	}
+
+	return waitForObject(path, description, false)
+}
@@ -0,0 +1,193 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+// diffInputs compares the inputs echoed in a resource's old outputs with its new inputs. A change to any of the
+// replaceKeys requires the resource to be replaced, while changes to the other keys can be applied in place. It
+// returns the changed keys and the subset of them that require a replacement.
+func diffInputs(olds, news resource.PropertyMap, keys []string, replaceKeys []string) ([]string, []string, map[string]*rpc.PropertyDiff) {
+	diffs := []string{}
+	replaces := []string{}
+	detailedDiff := map[string]*rpc.PropertyDiff{}
+
+	for _, k := range append(append([]string{}, keys...), replaceKeys...) {
+		oldValue := olds[resource.PropertyKey(k)]
+		newValue := news[resource.PropertyKey(k)]
+
+		if !newValue.ContainsUnknowns() && oldValue.DeepEquals(newValue) {
+			continue
+		}
+
+		replace := false
+		for _, r := range replaceKeys {
+			if r == k {
+				replace = true
+			}
+		}
+
+		var kind rpc.PropertyDiff_Kind
+		switch {
+		case oldValue.IsNull() && replace:
+			kind = rpc.PropertyDiff_ADD_REPLACE
+		case oldValue.IsNull():
+			kind = rpc.PropertyDiff_ADD
+		case newValue.IsNull() && replace:
+			kind = rpc.PropertyDiff_DELETE_REPLACE
+		case newValue.IsNull():
+			kind = rpc.PropertyDiff_DELETE
+		case replace:
+			kind = rpc.PropertyDiff_UPDATE_REPLACE
+		default:
+			kind = rpc.PropertyDiff_UPDATE
+		}
+
+		if replace {
+			replaces = append(replaces, k)
+		}
+
+		diffs = append(diffs, k)
+		detailedDiff[k] = &rpc.PropertyDiff{Kind: kind, InputDiff: true}
+	}
+
+	return diffs, replaces, detailedDiff
+}
+
+// diffJSON records the differences between two plain JSON-like objects in detailedDiff, descending into nested objects
+// so that each changed field is reported under its own path. Arrays and other values are compared as a whole.
+func diffJSON(path string, olds, news map[string]interface{}, detailedDiff map[string]*rpc.PropertyDiff) {
+	keys := map[string]interface{}{}
+	for k := range olds {
+		keys[k] = nil
+	}
+	for k := range news {
+		keys[k] = nil
+	}
+
+	for _, k := range sortedKeys(keys) {
+		p := joinPath(path, k)
+		oldValue, hasOld := olds[k]
+		newValue, hasNew := news[k]
+		hasOld = hasOld && oldValue != nil
+		hasNew = hasNew && newValue != nil
+
+		switch {
+		case !hasOld && !hasNew:
+		case !hasOld:
+			detailedDiff[p] = &rpc.PropertyDiff{Kind: rpc.PropertyDiff_ADD, InputDiff: true}
+		case !hasNew:
+			detailedDiff[p] = &rpc.PropertyDiff{Kind: rpc.PropertyDiff_DELETE, InputDiff: true}
+		default:
+			oldObject, isOldObject := oldValue.(map[string]interface{})
+			newObject, isNewObject := newValue.(map[string]interface{})
+			if isOldObject && isNewObject {
+				diffJSON(p, oldObject, newObject, detailedDiff)
+			} else if !reflect.DeepEqual(oldValue, newValue) {
+				detailedDiff[p] = &rpc.PropertyDiff{Kind: rpc.PropertyDiff_UPDATE, InputDiff: true}
+			}
+		}
+	}
+}
+
+// pruneEmpty removes nulls, empty strings, false, empty arrays and empty objects from a plain JSON-like value, since
+// Microsoft Graph reports unset settings with those values. It returns false if nothing is left of the value. Array
+// elements are pruned but never removed, so that positions are kept.
+func pruneEmpty(v interface{}) (interface{}, bool) {
+	switch v := v.(type) {
+	case nil:
+		return nil, false
+	case string:
+		return v, v != ""
+	case bool:
+		return v, v
+	case []interface{}:
+		pruned := make([]interface{}, 0, len(v))
+		for _, e := range v {
+			p, _ := pruneEmpty(e)
+			pruned = append(pruned, p)
+		}
+		return pruned, len(pruned) > 0
+	case map[string]interface{}:
+		pruned := map[string]interface{}{}
+		for k, e := range v {
+			if p, ok := pruneEmpty(e); ok {
+				pruned[k] = p
+			}
+		}
+		return pruned, len(pruned) > 0
+	default:
+		return v, true
+	}
+}
+
+// diffSettings compares the given settings after filling in their reset values and removing empty values, which
+// Microsoft Graph uses for unset settings. Nested settings are reported under their own paths.
+func diffSettings(olds, news resource.PropertyMap, fields []string, resets map[string]interface{}) ([]string, map[string]*rpc.PropertyDiff) {
+	diffs := []string{}
+	detailedDiff := map[string]*rpc.PropertyDiff{}
+
+	oldValues := plainProperties(olds)
+	newValues := plainProperties(news)
+
+	for _, k := range fields {
+		if news[resource.PropertyKey(k)].ContainsUnknowns() {
+			diffs = append(diffs, k)
+			detailedDiff[k] = &rpc.PropertyDiff{Kind: rpc.PropertyDiff_UPDATE, InputDiff: true}
+			continue
+		}
+
+		oldValue, _ := pruneEmpty(withResets(resets[k], oldValues[k]))
+		newValue, _ := pruneEmpty(withResets(resets[k], newValues[k]))
+
+		changes := len(detailedDiff)
+		diffJSON("", map[string]interface{}{k: oldValue}, map[string]interface{}{k: newValue}, detailedDiff)
+		if len(detailedDiff) > changes {
+			diffs = append(diffs, k)
+		}
+	}
+
+	return diffs, detailedDiff
+}
+
+// withResets fills in the settings of a value that are not set from the corresponding reset value.
+func withResets(reset, v interface{}) interface{} {
+	if v == nil {
+		return reset
+	}
+
+	resetObject, isResetObject := reset.(map[string]interface{})
+	object, isObject := v.(map[string]interface{})
+	if !isResetObject || !isObject {
+		return v
+	}
+
+	merged := map[string]interface{}{}
+	for k, r := range resetObject {
+		merged[k] = withResets(r, object[k])
+	}
+	for k, e := range object {
+		if _, has := resetObject[k]; !has {
+			merged[k] = e
+		}
+	}
+
+	return merged
+}
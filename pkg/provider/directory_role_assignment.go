@@ -0,0 +1,236 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+var (
+	// directoryRoleAssignmentInputs are all immutable since Microsoft Graph can only add and remove role assignments.
+	directoryRoleAssignmentInputs = []string{"principalId", "role", "administrativeUnitId", "applicationObjectId"}
+
+	roleAssignmentExistsRegexp = regexp.MustCompile("(?i)A conflicting object with one or more of the specified property values is present")
+)
+
+type directoryRoleAssignmentArgs struct {
+	PrincipalID          string `pulumi:"principalId"`
+	Role                 string `pulumi:"role"`
+	AdministrativeUnitID string `pulumi:"administrativeUnitId"`
+	ApplicationObjectID  string `pulumi:"applicationObjectId"`
+}
+
+type directoryRoleAssignmentState struct {
+	AssignmentID string `pulumi:"assignmentId"`
+}
+
+type unifiedRoleAssignment struct {
+	ID               string `json:"id,omitempty"`
+	PrincipalID      string `json:"principalId"`
+	RoleDefinitionID string `json:"roleDefinitionId"`
+	DirectoryScopeID string `json:"directoryScopeId"`
+}
+
+type unifiedRoleDefinition struct {
+	ID          string `json:"id"`
+	TemplateID  string `json:"templateId"`
+	DisplayName string `json:"displayName"`
+}
+
+// checkDirectoryRoleAssignment makes sure there is at most one scope and that the object IDs are GUIDs.
+func checkDirectoryRoleAssignment(inputs resource.PropertyMap) []*rpc.CheckFailure {
+	var failures []*rpc.CheckFailure
+
+	for _, k := range []string{"principalId", "administrativeUnitId", "applicationObjectId"} {
+		v := inputs[resource.PropertyKey(k)]
+		if v.IsString() && !guidRegexp.MatchString(v.StringValue()) {
+			failures = append(failures, &rpc.CheckFailure{
+				Property: k,
+				Reason:   fmt.Sprintf("'%s' must be an object ID but got '%s'", k, v.StringValue()),
+			})
+		}
+	}
+
+	if inputs.HasValue("administrativeUnitId") && inputs.HasValue("applicationObjectId") {
+		failures = append(failures, &rpc.CheckFailure{
+			Property: "applicationObjectId",
+			Reason:   "only one of 'administrativeUnitId' and 'applicationObjectId' can be set",
+		})
+	}
+
+	return failures
+}
+
+// directoryScopeID returns the scope of the role assignment: the whole directory, an administrative unit or an
+// application.
+func (args directoryRoleAssignmentArgs) directoryScopeID() string {
+	switch {
+	case args.AdministrativeUnitID != "":
+		return "/administrativeUnits/" + args.AdministrativeUnitID
+	case args.ApplicationObjectID != "":
+		return "/" + args.ApplicationObjectID
+	default:
+		return "/"
+	}
+}
+
+// resolveRoleDefinition finds a role definition by its display name or by its ID or template ID. Built-in roles are
+// identified by their template ID, which is the same in every tenant.
+func resolveRoleDefinition(role string) (*unifiedRoleDefinition, error) {
+	filter := graphFilter("displayName eq %s", odataString(role))
+	if guidRegexp.MatchString(role) {
+		filter = graphFilter("id eq %s or templateId eq %s", odataString(role), odataString(role))
+	}
+
+	var definitions []unifiedRoleDefinition
+	err := graphList("roleManagement/directory/roleDefinitions?$select=id,templateId,displayName&"+filter, &definitions)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(definitions) == 0 {
+		return nil, fmt.Errorf("no directory role named '%s' was found, use the display name of a role like 'Application Administrator' or its template ID", role)
+	}
+
+	d := definitions[0]
+	if d.TemplateID == "" {
+		d.TemplateID = d.ID
+	}
+
+	return &d, nil
+}
+
+func directoryRoleAssignmentOutputs(inputs resource.PropertyMap, a unifiedRoleAssignment, roleDisplayName string) map[string]interface{} {
+	outputs := inputs.Mappable()
+	outputs["assignmentId"] = a.ID
+	outputs["roleDefinitionId"] = a.RoleDefinitionID
+	outputs["roleDisplayName"] = roleDisplayName
+	outputs["directoryScopeId"] = a.DirectoryScopeID
+
+	return outputs
+}
+
+// createDirectoryRoleAssignment assigns the role to the principal in the scope. A new principal can take a moment to
+// replicate, so the assignment is retried until Microsoft Graph can see it.
+func createDirectoryRoleAssignment(inputs resource.PropertyMap) (string, map[string]interface{}, error) {
+	var args directoryRoleAssignmentArgs
+	err := decodeInputs(inputs, &args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	definition, err := resolveRoleDefinition(args.Role)
+	if err != nil {
+		return "", nil, err
+	}
+
+	body := unifiedRoleAssignment{
+		PrincipalID:      args.PrincipalID,
+		RoleDefinitionID: definition.TemplateID,
+		DirectoryScopeID: args.directoryScopeID(),
+	}
+
+	var created unifiedRoleAssignment
+	done, err := poll(func() (bool, error) {
+		err := graphRequest("POST", "roleManagement/directory/roleAssignments", body, &created)
+		if err != nil {
+			if principalNotReplicatedRegexp.MatchString(err.Error()) {
+				return false, nil
+			}
+
+			if roleAssignmentExistsRegexp.MatchString(err.Error()) {
+				return false, fmt.Errorf("the principal with object ID %s already has the role '%s' in scope '%s', import the assignment instead: %v",
+					args.PrincipalID, definition.DisplayName, body.DirectoryScopeID, err)
+			}
+
+			return false, err
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	if !done {
+		return "", nil, fmt.Errorf("the principal with object ID %s could not be found", args.PrincipalID)
+	}
+
+	return created.ID, directoryRoleAssignmentOutputs(inputs, created, definition.DisplayName), nil
+}
+
+// readDirectoryRoleAssignment refreshes the role assignment with the given ID. When importing, the role is taken over
+// by its template ID and the scope is taken from the directory scope ID.
+func readDirectoryRoleAssignment(id string, state, inputs resource.PropertyMap) (string, map[string]interface{}, map[string]interface{}, error) {
+	var a unifiedRoleAssignment
+	found, err := graphGet("roleManagement/directory/roleAssignments/"+id, &a)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	if !found {
+		return "", nil, nil, nil
+	}
+
+	definition, err := resolveRoleDefinition(a.RoleDefinitionID)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	outputs := directoryRoleAssignmentOutputs(state, a, definition.DisplayName)
+
+	readInputs := inputs.Mappable()
+	if len(inputs) == 0 {
+		readInputs = map[string]interface{}{
+			"principalId": a.PrincipalID,
+			"role":        a.RoleDefinitionID,
+		}
+
+		switch {
+		case strings.HasPrefix(a.DirectoryScopeID, "/administrativeUnits/"):
+			readInputs["administrativeUnitId"] = strings.TrimPrefix(a.DirectoryScopeID, "/administrativeUnits/")
+		case a.DirectoryScopeID != "/":
+			readInputs["applicationObjectId"] = strings.TrimPrefix(a.DirectoryScopeID, "/")
+		}
+
+		for k, v := range readInputs {
+			outputs[k] = v
+		}
+	}
+
+	return id, outputs, readInputs, nil
+}
+
+// deleteDirectoryRoleAssignment removes the role assignment. An assignment that is already gone is not an error.
+func deleteDirectoryRoleAssignment(state resource.PropertyMap) error {
+	var args directoryRoleAssignmentState
+	err := decodeInputs(state, &args)
+	if err != nil {
+		return err
+	}
+
+	err = graphRequest("DELETE", "roleManagement/directory/roleAssignments/"+args.AssignmentID, nil, nil)
+	if err != nil && !isNotFoundError(err) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,369 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+var exposeAPIInputs = []string{"identifierUri", "scopes", "preAuthorizedApplications", "knownClientApplications"}
+
+// exposeAPIScopeFields are the scope settings that are copied between the inputs and the application.
+var exposeAPIScopeFields = []string{
+	"value",
+	"type",
+	"adminConsentDisplayName",
+	"adminConsentDescription",
+	"userConsentDisplayName",
+	"userConsentDescription",
+}
+
+type exposeAPIArgs struct {
+	ObjectID                  string                          `pulumi:"objectId"`
+	IdentifierURI             string                          `pulumi:"identifierUri"`
+	Scopes                    []map[string]interface{}        `pulumi:"scopes"`
+	PreAuthorizedApplications []exposeAPIPreAuthorizedAppArgs `pulumi:"preAuthorizedApplications"`
+	KnownClientApplications   []string                        `pulumi:"knownClientApplications"`
+}
+
+type exposeAPIPreAuthorizedAppArgs struct {
+	AppID  string   `pulumi:"appId"`
+	Scopes []string `pulumi:"scopes"`
+}
+
+type appAPI struct {
+	AppID          string                 `json:"appId"`
+	IdentifierUris []string               `json:"identifierUris"`
+	API            map[string]interface{} `json:"api"`
+}
+
+// checkExposeAPI gives each scope a stable ID derived from its value, enables it and makes it consentable by users
+// unless these are set. It also makes sure pre-authorized applications only refer to scopes of this resource.
+func checkExposeAPI(inputs resource.PropertyMap) []*rpc.CheckFailure {
+	var failures []*rpc.CheckFailure
+
+	values := map[string]bool{}
+	if scopes := inputs["scopes"]; scopes.IsArray() {
+		for i, scope := range scopes.ArrayValue() {
+			if !scope.IsObject() {
+				continue
+			}
+
+			obj := scope.ObjectValue()
+			if obj["value"].IsString() {
+				values[obj["value"].StringValue()] = true
+			}
+
+			failures = append(failures, fillEntryDefaults(fmt.Sprintf("scopes[%d]", i), "oauth2PermissionScope", "id", obj)...)
+			if !obj.HasValue("type") {
+				obj["type"] = resource.NewStringProperty("User")
+			}
+		}
+	}
+
+	if inputs.ContainsUnknowns() {
+		return failures
+	}
+
+	var args exposeAPIArgs
+	err := decodeInputs(inputs, &args)
+	if err != nil {
+		return append(failures, &rpc.CheckFailure{Reason: err.Error()})
+	}
+
+	for i, app := range args.PreAuthorizedApplications {
+		for _, scope := range app.Scopes {
+			if !values[scope] {
+				failures = append(failures, &rpc.CheckFailure{
+					Property: fmt.Sprintf("preAuthorizedApplications[%d].scopes", i),
+					Reason:   fmt.Sprintf("'%s' is not the value of one of the scopes", scope),
+				})
+			}
+		}
+	}
+
+	return failures
+}
+
+// getAppAPI reads the app ID, identifier URIs and API settings of an application. The returned boolean is false if the
+// application does not exist.
+func getAppAPI(objectID string) (*appAPI, bool, error) {
+	var app appAPI
+	found, err := graphGet(fmt.Sprintf("applications/%s?$select=appId,identifierUris,api", objectID), &app)
+	if err != nil || !found {
+		return nil, found, err
+	}
+
+	if app.API == nil {
+		app.API = map[string]interface{}{}
+	}
+
+	return &app, true, nil
+}
+
+// desiredAPI returns the application's API settings with the scopes, pre-authorized applications and known client
+// applications replaced by the ones in the inputs. Other API settings are kept.
+func (args exposeAPIArgs) desiredAPI(live map[string]interface{}) map[string]interface{} {
+	api := map[string]interface{}{}
+	for k, v := range live {
+		api[k] = v
+	}
+
+	scopes := []interface{}{}
+	scopeIDs := map[string]string{}
+	for _, s := range args.Scopes {
+		scope := map[string]interface{}{"id": s["id"], "isEnabled": s["isEnabled"]}
+		for _, k := range exposeAPIScopeFields {
+			scope[k] = s[k]
+		}
+
+		scopes = append(scopes, scope)
+		if value, ok := s["value"].(string); ok {
+			scopeIDs[value], _ = s["id"].(string)
+		}
+	}
+
+	preAuthorized := []interface{}{}
+	for _, app := range args.PreAuthorizedApplications {
+		ids := []interface{}{}
+		for _, scope := range app.Scopes {
+			ids = append(ids, scopeIDs[scope])
+		}
+
+		preAuthorized = append(preAuthorized, map[string]interface{}{"appId": app.AppID, "delegatedPermissionIds": ids})
+	}
+
+	known := []interface{}{}
+	for _, appID := range args.KnownClientApplications {
+		known = append(known, appID)
+	}
+
+	api["oauth2PermissionScopes"] = scopes
+	api["preAuthorizedApplications"] = preAuthorized
+	api["knownClientApplications"] = known
+
+	return api
+}
+
+// applyExposeAPI writes the identifier URI and API settings to the application, or removes the identifier URIs if
+// remove is true. Scopes that are removed are disabled first, together with the pre-authorizations that refer to them,
+// since Microsoft Graph refuses to remove enabled scopes. Changes to the API of an application are serialized, since
+// the whole api block is written back.
+func applyExposeAPI(args exposeAPIArgs, remove bool) (map[string]interface{}, error) {
+	defer lockApplication(args.ObjectID)()
+
+	app, found, err := getAppAPI(args.ObjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, fmt.Errorf("the application with object ID %s could not be found", args.ObjectID)
+	}
+
+	desired := args.desiredAPI(app.API)
+
+	liveScopes, _ := app.API["oauth2PermissionScopes"].([]interface{})
+	desiredScopes, _ := desired["oauth2PermissionScopes"].([]interface{})
+	scopes, changed := disableRemovedEntries(liveScopes, desiredScopes)
+	if changed {
+		disabled := map[string]interface{}{}
+		for k, v := range app.API {
+			disabled[k] = v
+		}
+		disabled["oauth2PermissionScopes"] = scopes
+		disabled["preAuthorizedApplications"] = []interface{}{}
+
+		err = graphRequest("PATCH", "applications/"+args.ObjectID, map[string]interface{}{"api": disabled}, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	identifierUris := []interface{}{}
+	if !remove {
+		identifierURI := args.IdentifierURI
+		if identifierURI == "" {
+			identifierURI = "api://" + app.AppID
+		}
+
+		identifierUris = append(identifierUris, identifierURI)
+	}
+
+	err = graphRequest("PATCH", "applications/"+args.ObjectID, map[string]interface{}{
+		"identifierUris": identifierUris,
+		"api":            desired,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"appId":          app.AppID,
+		"identifierUris": identifierUris,
+	}, nil
+}
+
+func exposeAPIOutputs(inputs resource.PropertyMap, applied map[string]interface{}) map[string]interface{} {
+	outputs := inputs.Mappable()
+	for k, v := range applied {
+		outputs[k] = v
+	}
+
+	return outputs
+}
+
+// createExposeAPI sets the identifier URI and the API settings once the application is available.
+func createExposeAPI(inputs resource.PropertyMap) (string, map[string]interface{}, error) {
+	var args exposeAPIArgs
+	err := decodeInputs(inputs, &args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = waitForApp(args.ObjectID, true)
+	if err != nil {
+		return "", nil, err
+	}
+
+	applied, err := applyExposeAPI(args, false)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return args.ObjectID, exposeAPIOutputs(inputs, applied), nil
+}
+
+// updateExposeAPI applies the new identifier URI and API settings.
+func updateExposeAPI(news resource.PropertyMap) (map[string]interface{}, error) {
+	var args exposeAPIArgs
+	err := decodeInputs(news, &args)
+	if err != nil {
+		return nil, err
+	}
+
+	applied, err := applyExposeAPI(args, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return exposeAPIOutputs(news, applied), nil
+}
+
+// readExposeAPI refreshes the identifier URI and API settings of the application with the given object ID. Scopes in
+// pre-authorizations are reported by value.
+func readExposeAPI(id string, state, inputs resource.PropertyMap) (string, map[string]interface{}, map[string]interface{}, error) {
+	app, found, err := getAppAPI(id)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	if !found {
+		return "", nil, nil, nil
+	}
+
+	live := map[string]interface{}{"objectId": id}
+
+	scopes := []interface{}{}
+	scopeValues := map[string]interface{}{}
+	liveScopes, _ := app.API["oauth2PermissionScopes"].([]interface{})
+	for _, e := range liveScopes {
+		s, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		scope := map[string]interface{}{"id": s["id"], "isEnabled": s["isEnabled"]}
+		for _, k := range exposeAPIScopeFields {
+			if v, ok := s[k].(string); ok && v != "" {
+				scope[k] = v
+			}
+		}
+
+		scopes = append(scopes, scope)
+		if scopeID, ok := s["id"].(string); ok {
+			scopeValues[scopeID] = s["value"]
+		}
+	}
+	live["scopes"] = scopes
+
+	preAuthorized := []interface{}{}
+	livePreAuthorized, _ := app.API["preAuthorizedApplications"].([]interface{})
+	for _, e := range livePreAuthorized {
+		p, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		values := []interface{}{}
+		ids, _ := p["delegatedPermissionIds"].([]interface{})
+		for _, scopeID := range ids {
+			if s, ok := scopeID.(string); ok {
+				values = append(values, scopeValues[s])
+			}
+		}
+
+		preAuthorized = append(preAuthorized, map[string]interface{}{"appId": p["appId"], "scopes": values})
+	}
+	live["preAuthorizedApplications"] = preAuthorized
+
+	known, _ := app.API["knownClientApplications"].([]interface{})
+	live["knownClientApplications"] = known
+
+	// The identifier URI is only reported when it is set explicitly or differs from the default.
+	live["identifierUri"] = nil
+	if len(app.IdentifierUris) > 0 && (state.HasValue("identifierUri") || app.IdentifierUris[0] != "api://"+app.AppID) {
+		live["identifierUri"] = app.IdentifierUris[0]
+	}
+
+	outputs := state.Mappable()
+	for k, v := range live {
+		outputs[k] = v
+	}
+	outputs["appId"] = app.AppID
+	identifierUris := []interface{}{}
+	for _, uri := range app.IdentifierUris {
+		identifierUris = append(identifierUris, uri)
+	}
+	outputs["identifierUris"] = identifierUris
+
+	readInputs := inputs.Mappable()
+	if len(inputs) == 0 {
+		readInputs = live
+	}
+
+	return id, outputs, readInputs, nil
+}
+
+// deleteExposeAPI removes the identifier URIs, scopes, pre-authorized applications and known client applications from
+// the application. An application that is already gone is not an error.
+func deleteExposeAPI(state resource.PropertyMap) error {
+	var args exposeAPIArgs
+	err := decodeInputs(state, &args)
+	if err != nil {
+		return err
+	}
+
+	_, found, err := getAppAPI(args.ObjectID)
+	if err != nil || !found {
+		return err
+	}
+
+	_, err = applyExposeAPI(exposeAPIArgs{ObjectID: args.ObjectID}, true)
+	return err
+}
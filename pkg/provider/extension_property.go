@@ -0,0 +1,268 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+// maxExtensionAttributeNameLength is the longest full attribute name Microsoft Graph accepts, including the
+// "extension_{appId}_" prefix.
+const maxExtensionAttributeNameLength = 120
+
+var (
+	// extensionPropertyInputs are all immutable since Microsoft Graph can't update an extension property.
+	extensionPropertyInputs = []string{"objectId", "name", "dataType", "targetObjects", "isMultiValued"}
+
+	extensionPropertyNameRegexp = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+	extensionPropertyExistsRegexp = regexp.MustCompile("(?i)Property .* already exists")
+
+	// extensionPropertyTargetObjects are the kinds of directory objects an extension property can be set on.
+	extensionPropertyTargetObjects = []string{"User", "Group", "AdministrativeUnit", "Application", "Device", "Organization"}
+)
+
+type extensionPropertyArgs struct {
+	ObjectID      string   `pulumi:"objectId"`
+	Name          string   `pulumi:"name"`
+	DataType      string   `pulumi:"dataType"`
+	TargetObjects []string `pulumi:"targetObjects"`
+	IsMultiValued bool     `pulumi:"isMultiValued"`
+}
+
+type extensionPropertyState struct {
+	ObjectID            string `pulumi:"objectId"`
+	ExtensionPropertyID string `pulumi:"extensionPropertyId"`
+}
+
+type extensionProperty struct {
+	ID            string   `json:"id,omitempty"`
+	Name          string   `json:"name"`
+	DataType      string   `json:"dataType"`
+	TargetObjects []string `json:"targetObjects"`
+	IsMultiValued bool     `json:"isMultiValued,omitempty"`
+}
+
+// checkExtensionProperty validates the name and the target objects. The data type is checked against the schema enum.
+func checkExtensionProperty(inputs resource.PropertyMap) []*rpc.CheckFailure {
+	var failures []*rpc.CheckFailure
+
+	objectID := inputs["objectId"]
+	if objectID.IsString() && !guidRegexp.MatchString(objectID.StringValue()) {
+		failures = append(failures, &rpc.CheckFailure{
+			Property: "objectId",
+			Reason:   fmt.Sprintf("'objectId' must be an object ID but got '%s'", objectID.StringValue()),
+		})
+	}
+
+	name := inputs["name"]
+	if name.IsString() {
+		// The application ID without hyphens is 32 characters, so the prefix is always the same length.
+		prefixLength := len("extension__") + 32
+		switch {
+		case !extensionPropertyNameRegexp.MatchString(name.StringValue()):
+			failures = append(failures, &rpc.CheckFailure{
+				Property: "name",
+				Reason:   fmt.Sprintf("the name must start with a letter and only contain letters, digits and '_' but got '%s'", name.StringValue()),
+			})
+		case prefixLength+len(name.StringValue()) > maxExtensionAttributeNameLength:
+			failures = append(failures, &rpc.CheckFailure{
+				Property: "name",
+				Reason:   fmt.Sprintf("the name can be at most %d characters long", maxExtensionAttributeNameLength-prefixLength),
+			})
+		}
+	}
+
+	targetObjects := inputs["targetObjects"]
+	if targetObjects.IsArray() {
+		if len(targetObjects.ArrayValue()) == 0 {
+			failures = append(failures, &rpc.CheckFailure{
+				Property: "targetObjects",
+				Reason:   "at least one kind of target object must be set",
+			})
+		}
+
+		seen := map[string]bool{}
+		for i, v := range targetObjects.ArrayValue() {
+			if !v.IsString() {
+				continue
+			}
+
+			valid := false
+			for _, t := range extensionPropertyTargetObjects {
+				valid = valid || t == v.StringValue()
+			}
+
+			if !valid {
+				failures = append(failures, &rpc.CheckFailure{
+					Property: fmt.Sprintf("targetObjects[%d]", i),
+					Reason: fmt.Sprintf("'%s' is not a kind of target object, the valid values are: %s",
+						v.StringValue(), strings.Join(extensionPropertyTargetObjects, ", ")),
+				})
+			}
+
+			if seen[v.StringValue()] {
+				failures = append(failures, &rpc.CheckFailure{
+					Property: fmt.Sprintf("targetObjects[%d]", i),
+					Reason:   fmt.Sprintf("'%s' is listed more than once", v.StringValue()),
+				})
+			}
+			seen[v.StringValue()] = true
+		}
+	}
+
+	return failures
+}
+
+// extensionPropertyShortName removes the "extension_{appId}_" prefix Microsoft Graph adds to the name of an extension
+// property.
+func extensionPropertyShortName(name string) string {
+	parts := strings.SplitN(name, "_", 3)
+	if len(parts) == 3 && parts[0] == "extension" {
+		return parts[2]
+	}
+
+	return name
+}
+
+func extensionPropertyOutputs(inputs resource.PropertyMap, objectID string, p extensionProperty) map[string]interface{} {
+	outputs := inputs.Mappable()
+	outputs["objectId"] = objectID
+	outputs["extensionPropertyId"] = p.ID
+	outputs["attributeName"] = p.Name
+
+	return outputs
+}
+
+// findExtensionProperty looks for the extension property in the extension properties of the application. The
+// returned boolean is false if the application or the extension property does not exist.
+func findExtensionProperty(objectID, extensionPropertyID string) (*extensionProperty, bool, error) {
+	var properties []extensionProperty
+	err := graphList(fmt.Sprintf("applications/%s/extensionProperties", objectID), &properties)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	for _, p := range properties {
+		if p.ID == extensionPropertyID {
+			return &p, true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+// createExtensionProperty registers the extension property once the application is available. The resource ID is the
+// application's object ID and the extension property ID separated by a slash, so that the extension property can be
+// imported.
+func createExtensionProperty(inputs resource.PropertyMap) (string, map[string]interface{}, error) {
+	var args extensionPropertyArgs
+	err := decodeInputs(inputs, &args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = waitForApp(args.ObjectID, true)
+	if err != nil {
+		return "", nil, err
+	}
+
+	body := extensionProperty{
+		Name:          args.Name,
+		DataType:      args.DataType,
+		TargetObjects: args.TargetObjects,
+		IsMultiValued: args.IsMultiValued,
+	}
+
+	var created extensionProperty
+	err = graphRequest("POST", fmt.Sprintf("applications/%s/extensionProperties", args.ObjectID), body, &created)
+	if err != nil {
+		if extensionPropertyExistsRegexp.MatchString(err.Error()) {
+			return "", nil, fmt.Errorf("the application with object ID %s already has an extension property named '%s', import it instead: %v",
+				args.ObjectID, args.Name, err)
+		}
+
+		return "", nil, err
+	}
+
+	return args.ObjectID + "/" + created.ID, extensionPropertyOutputs(inputs, args.ObjectID, created), nil
+}
+
+// readExtensionProperty refreshes the extension property. When importing, there is no state so the inputs are taken
+// from the extension property, with the name stripped of its "extension_{appId}_" prefix.
+func readExtensionProperty(id string, state, inputs resource.PropertyMap) (string, map[string]interface{}, map[string]interface{}, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 {
+		return "", nil, nil, fmt.Errorf("expected an ID in the form '<application object ID>/<extension property ID>' but got '%s'", id)
+	}
+
+	objectID, extensionPropertyID := parts[0], parts[1]
+
+	p, found, err := findExtensionProperty(objectID, extensionPropertyID)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	if !found {
+		return "", nil, nil, nil
+	}
+
+	outputs := extensionPropertyOutputs(state, objectID, *p)
+
+	readInputs := inputs.Mappable()
+	if len(inputs) == 0 {
+		readInputs = map[string]interface{}{
+			"objectId":      objectID,
+			"name":          extensionPropertyShortName(p.Name),
+			"dataType":      p.DataType,
+			"targetObjects": p.TargetObjects,
+		}
+		if p.IsMultiValued {
+			readInputs["isMultiValued"] = true
+		}
+
+		for k, v := range readInputs {
+			outputs[k] = v
+		}
+	}
+
+	return id, outputs, readInputs, nil
+}
+
+// deleteExtensionProperty removes the extension property, which also removes its values from the target objects. An
+// extension property or application that is already gone is not an error.
+func deleteExtensionProperty(state resource.PropertyMap) error {
+	var args extensionPropertyState
+	err := decodeInputs(state, &args)
+	if err != nil {
+		return err
+	}
+
+	err = graphRequest("DELETE", fmt.Sprintf("applications/%s/extensionProperties/%s", args.ObjectID, args.ExtensionPropertyID), nil, nil)
+	if err != nil && !isNotFoundError(err) {
+		return err
+	}
+
+	return nil
+}
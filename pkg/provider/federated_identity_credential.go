@@ -0,0 +1,361 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+const (
+	gitHubActionsIssuer       = "https://token.actions.githubusercontent.com"
+	defaultFederatedAudience  = "api://AzureADTokenExchange"
+	federatedCredentialFields = "id,name,issuer,subject,audiences,description"
+)
+
+var (
+	federatedCredentialNameRegexp = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]{2,119}$`)
+	gitHubSubjectRegexp           = regexp.MustCompile(`^repo:[^/:]+/[^/:]+:.+$`)
+	kubernetesSubjectRegexp       = regexp.MustCompile(`^system:serviceaccount:[a-z0-9]([-a-z0-9]*[a-z0-9])?:[a-z0-9]([-.a-z0-9]*[a-z0-9])?$`)
+)
+
+type federatedIdentityCredentialArgs struct {
+	ObjectID    string                          `pulumi:"objectId"`
+	Name        string                          `pulumi:"name"`
+	Issuer      string                          `pulumi:"issuer"`
+	Subject     string                          `pulumi:"subject"`
+	Audiences   []string                        `pulumi:"audiences"`
+	Description string                          `pulumi:"description"`
+	GitHub      *gitHubFederatedSubjectArgs     `pulumi:"github"`
+	Kubernetes  *kubernetesFederatedSubjectArgs `pulumi:"kubernetes"`
+}
+
+type gitHubFederatedSubjectArgs struct {
+	Repository  string `pulumi:"repository"`
+	Branch      string `pulumi:"branch"`
+	Tag         string `pulumi:"tag"`
+	Environment string `pulumi:"environment"`
+	PullRequest bool   `pulumi:"pullRequest"`
+}
+
+type kubernetesFederatedSubjectArgs struct {
+	Namespace      string `pulumi:"namespace"`
+	ServiceAccount string `pulumi:"serviceAccount"`
+}
+
+type federatedIdentityCredentialState struct {
+	ObjectID     string `pulumi:"objectId"`
+	CredentialID string `pulumi:"credentialId"`
+}
+
+type federatedIdentityCredential struct {
+	ID          string   `json:"id,omitempty"`
+	Name        string   `json:"name,omitempty"`
+	Issuer      string   `json:"issuer"`
+	Subject     string   `json:"subject"`
+	Audiences   []string `json:"audiences"`
+	Description *string  `json:"description"`
+}
+
+// resolve turns the inputs, including the GitHub and Kubernetes helpers, into the credential sent to Microsoft Graph.
+func (args federatedIdentityCredentialArgs) resolve() federatedIdentityCredential {
+	c := federatedIdentityCredential{
+		Name:      args.Name,
+		Issuer:    args.Issuer,
+		Subject:   args.Subject,
+		Audiences: args.Audiences,
+	}
+
+	if args.Description != "" {
+		c.Description = &args.Description
+	}
+
+	if args.GitHub != nil {
+		if c.Issuer == "" {
+			c.Issuer = gitHubActionsIssuer
+		}
+
+		gh := args.GitHub
+		switch {
+		case gh.Environment != "":
+			c.Subject = fmt.Sprintf("repo:%s:environment:%s", gh.Repository, gh.Environment)
+		case gh.PullRequest:
+			c.Subject = fmt.Sprintf("repo:%s:pull_request", gh.Repository)
+		case gh.Tag != "":
+			c.Subject = fmt.Sprintf("repo:%s:ref:refs/tags/%s", gh.Repository, gh.Tag)
+		default:
+			c.Subject = fmt.Sprintf("repo:%s:ref:refs/heads/%s", gh.Repository, gh.Branch)
+		}
+	}
+
+	if args.Kubernetes != nil {
+		c.Subject = fmt.Sprintf("system:serviceaccount:%s:%s", args.Kubernetes.Namespace, args.Kubernetes.ServiceAccount)
+	}
+
+	if len(c.Audiences) == 0 {
+		c.Audiences = []string{defaultFederatedAudience}
+	}
+
+	return c
+}
+
+// checkFederatedIdentityCredential validates the name, issuer and subject locally.
+func checkFederatedIdentityCredential(inputs resource.PropertyMap) []*rpc.CheckFailure {
+	var failures []*rpc.CheckFailure
+	fail := func(property, format string, args ...interface{}) {
+		failures = append(failures, &rpc.CheckFailure{Property: property, Reason: fmt.Sprintf(format, args...)})
+	}
+
+	subjectSources := 0
+	for _, k := range []resource.PropertyKey{"subject", "github", "kubernetes"} {
+		if inputs[k].HasValue() {
+			subjectSources++
+		}
+	}
+
+	if subjectSources != 1 {
+		fail("subject", "exactly one of 'subject', 'github' and 'kubernetes' must be set")
+	}
+
+	if inputs["kubernetes"].HasValue() && !inputs["issuer"].HasValue() {
+		fail("issuer", "'issuer' must be set to the cluster's OIDC issuer URL when 'kubernetes' is used")
+	}
+
+	if !inputs["github"].HasValue() && !inputs["kubernetes"].HasValue() && !inputs["issuer"].HasValue() {
+		fail("issuer", "'issuer' must be set unless 'github' is used")
+	}
+
+	if inputs.ContainsUnknowns() {
+		return failures
+	}
+
+	var args federatedIdentityCredentialArgs
+	err := decodeInputs(inputs, &args)
+	if err != nil {
+		fail("", "%v", err)
+		return failures
+	}
+
+	if !federatedCredentialNameRegexp.MatchString(args.Name) {
+		fail("name", "'name' must be 3 to 120 characters long and contain only letters, digits, '-' and '_'")
+	}
+
+	if args.GitHub != nil {
+		gh := args.GitHub
+		if strings.Count(gh.Repository, "/") != 1 {
+			fail("github.repository", "'github.repository' must be in the form 'owner/repository'")
+		}
+
+		refs := 0
+		for _, set := range []bool{gh.Branch != "", gh.Tag != "", gh.Environment != "", gh.PullRequest} {
+			if set {
+				refs++
+			}
+		}
+
+		if refs != 1 {
+			fail("github", "exactly one of 'branch', 'tag', 'environment' and 'pullRequest' must be set")
+		}
+	}
+
+	c := args.resolve()
+
+	u, err := url.Parse(c.Issuer)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		fail("issuer", "'issuer' must be an absolute https URL but got '%s'", c.Issuer)
+	}
+
+	if c.Issuer == gitHubActionsIssuer && !gitHubSubjectRegexp.MatchString(c.Subject) {
+		fail("subject", "GitHub Actions subjects look like 'repo:owner/repository:ref:refs/heads/main' but got '%s'", c.Subject)
+	}
+
+	if strings.HasPrefix(c.Subject, "system:serviceaccount:") && !kubernetesSubjectRegexp.MatchString(c.Subject) {
+		fail("subject", "Kubernetes subjects look like 'system:serviceaccount:namespace:name' but got '%s'", c.Subject)
+	}
+
+	return failures
+}
+
+// diffFederatedIdentityCredential replaces the credential when its application or name changes and otherwise
+// compares the resolved issuer, subject and audiences, so switching between a helper and an explicit subject that
+// produce the same value is not a change.
+func diffFederatedIdentityCredential(olds, news resource.PropertyMap) ([]string, []string, map[string]*rpc.PropertyDiff, error) {
+	diffs, replaces, detailedDiff := diffInputs(olds, news, []string{"github", "kubernetes"}, []string{"objectId", "name"})
+
+	if news.ContainsUnknowns() {
+		for _, k := range []string{"issuer", "subject", "audiences", "description"} {
+			if news[resource.PropertyKey(k)].ContainsUnknowns() {
+				diffs = append(diffs, k)
+				detailedDiff[k] = &rpc.PropertyDiff{Kind: rpc.PropertyDiff_UPDATE, InputDiff: true}
+			}
+		}
+
+		return diffs, replaces, detailedDiff, nil
+	}
+
+	var args federatedIdentityCredentialArgs
+	err := decodeInputs(news, &args)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	desired, err := toJSONObject(args.resolve())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	current := olds.Mappable()
+	for _, k := range []string{"issuer", "subject", "audiences", "description"} {
+		if _, isDiff := detailedDiff[k]; isDiff || reflect.DeepEqual(desired[k], current[k]) {
+			continue
+		}
+
+		diffs = append(diffs, k)
+		detailedDiff[k] = &rpc.PropertyDiff{Kind: rpc.PropertyDiff_UPDATE, InputDiff: true}
+	}
+
+	return diffs, replaces, detailedDiff, nil
+}
+
+func federatedIdentityCredentialOutputs(inputs resource.PropertyMap, objectID string, c federatedIdentityCredential) map[string]interface{} {
+	outputs := inputs.Mappable()
+	outputs["objectId"] = objectID
+	outputs["credentialId"] = c.ID
+	outputs["name"] = c.Name
+	outputs["issuer"] = c.Issuer
+	outputs["subject"] = c.Subject
+	outputs["audiences"] = c.Audiences
+	outputs["description"] = nil
+	if c.Description != nil {
+		outputs["description"] = *c.Description
+	}
+
+	return outputs
+}
+
+// createFederatedIdentityCredential adds the credential to the application. The resource ID is the application's
+// object ID and the credential ID separated by a slash, so that the credential can be imported.
+func createFederatedIdentityCredential(inputs resource.PropertyMap) (string, map[string]interface{}, error) {
+	var args federatedIdentityCredentialArgs
+	err := decodeInputs(inputs, &args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = waitForApp(args.ObjectID, true)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var created federatedIdentityCredential
+	err = graphRequest("POST", fmt.Sprintf("applications/%s/federatedIdentityCredentials", args.ObjectID), args.resolve(), &created)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return args.ObjectID + "/" + created.ID, federatedIdentityCredentialOutputs(inputs, args.ObjectID, created), nil
+}
+
+// updateFederatedIdentityCredential updates the issuer, subject, audiences and description in place.
+func updateFederatedIdentityCredential(olds, news resource.PropertyMap) (map[string]interface{}, error) {
+	var state federatedIdentityCredentialState
+	err := decodeInputs(olds, &state)
+	if err != nil {
+		return nil, err
+	}
+
+	var args federatedIdentityCredentialArgs
+	err = decodeInputs(news, &args)
+	if err != nil {
+		return nil, err
+	}
+
+	c := args.resolve()
+	path := fmt.Sprintf("applications/%s/federatedIdentityCredentials/%s", state.ObjectID, state.CredentialID)
+
+	// The name can't be changed, so it is left out of the update.
+	name := c.Name
+	c.Name = ""
+	err = graphRequest("PATCH", path, c, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.ID = state.CredentialID
+	c.Name = name
+	return federatedIdentityCredentialOutputs(news, state.ObjectID, c), nil
+}
+
+// readFederatedIdentityCredential refreshes the credential from Microsoft Graph. When importing, there is no state so
+// the application's object ID and the credential ID are taken from the resource ID.
+func readFederatedIdentityCredential(id string, state, inputs resource.PropertyMap) (string, map[string]interface{}, map[string]interface{}, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 {
+		return "", nil, nil, fmt.Errorf("expected an ID in the form '<application object ID>/<credential ID>' but got '%s'", id)
+	}
+
+	objectID, credentialID := parts[0], parts[1]
+
+	var c federatedIdentityCredential
+	found, err := graphGet(fmt.Sprintf("applications/%s/federatedIdentityCredentials/%s?$select=%s", objectID, credentialID, federatedCredentialFields), &c)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	if !found {
+		return "", nil, nil, nil
+	}
+
+	outputs := federatedIdentityCredentialOutputs(state, objectID, c)
+
+	readInputs := inputs.Mappable()
+	if len(inputs) == 0 {
+		readInputs = map[string]interface{}{
+			"objectId":  objectID,
+			"name":      c.Name,
+			"issuer":    c.Issuer,
+			"subject":   c.Subject,
+			"audiences": c.Audiences,
+		}
+		if c.Description != nil {
+			readInputs["description"] = *c.Description
+		}
+	}
+
+	return id, outputs, readInputs, nil
+}
+
+// deleteFederatedIdentityCredential removes the credential. A credential or application that is already gone is not
+// an error.
+func deleteFederatedIdentityCredential(state resource.PropertyMap) error {
+	var args federatedIdentityCredentialState
+	err := decodeInputs(state, &args)
+	if err != nil {
+		return err
+	}
+
+	err = graphRequest("DELETE", fmt.Sprintf("applications/%s/federatedIdentityCredentials/%s", args.ObjectID, args.CredentialID), nil, nil)
+	if err != nil && !isNotFoundError(err) {
+		return err
+	}
+
+	return nil
+}
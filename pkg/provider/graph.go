@@ -0,0 +1,291 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+
+	logger "github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+)
+
+const graphBaseURL = "https://graph.microsoft.com/v1.0"
+
+var (
+	// notFoundRegexp matches the 404 status that the Azure CLI logs with --verbose, or the Microsoft Graph error code,
+	// rather than free text that other errors can contain, like a missing Azure CLI executable.
+	notFoundRegexp = regexp.MustCompile(`Response status: 404\b|"code":\s*"(Request_)?ResourceNotFound"`)
+
+	referenceExistsRegexp   = regexp.MustCompile("(?i)One or more added object references already exist")
+	referenceNotFoundRegexp = regexp.MustCompile("(?i)One or more removed object references do not exist")
+)
+
+// applicationLocks holds a lock per application object ID. See lockApplication.
+var applicationLocks = struct {
+	sync.Mutex
+	locks map[string]*sync.Mutex
+}{locks: map[string]*sync.Mutex{}}
+
+// lockApplication serializes changes to a collection of an application that are made by reading the collection,
+// changing it and writing it back. Pulumi creates sibling resources in parallel, so without the lock one PATCH could
+// overwrite the entry added by another. The returned function releases the lock.
+func lockApplication(objectID string) func() {
+	applicationLocks.Lock()
+	lock, ok := applicationLocks.locks[strings.ToLower(objectID)]
+	if !ok {
+		lock = &sync.Mutex{}
+		applicationLocks.locks[strings.ToLower(objectID)] = lock
+	}
+	applicationLocks.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
+}
+
+type directoryObject struct {
+	ID          string `json:"id"`
+	ODataType   string `json:"@odata.type"`
+	DisplayName string `json:"displayName"`
+}
+
+// graphRequest sends a request to Microsoft Graph through the Azure CLI. If body is not nil, it is serialized as the
+// JSON request body. If result is not nil, the JSON response is deserialized into it.
+func graphRequest(method, path string, body interface{}, result interface{}) error {
+	args := []string{"rest",
+		"--method", method,
+		"--uri", fmt.Sprintf("%s/%s", graphBaseURL, strings.TrimPrefix(path, "/")),
+		"--verbose"}
+
+	if body != nil {
+		jsonBytes, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+
+		args = append(args,
+			"--headers", "Content-Type=application/json",
+			"--body", string(jsonBytes))
+	}
+
+	stdout, err := executeWithOutput("az", args...)
+	if err != nil {
+		return err
+	}
+
+	if result != nil && strings.TrimSpace(stdout) != "" {
+		err = json.Unmarshal([]byte(stdout), result)
+		if err != nil {
+			return fmt.Errorf("could not parse response from %s %s: %v", method, path, err)
+		}
+	}
+
+	return nil
+}
+
+// graphContentRequest sends a request for the raw content of a Microsoft Graph object, like the logo of an
+// application. The Azure CLI can only send text bodies, so the request is sent directly with an access token from the
+// Azure CLI and the body is streamed as is. The response body is returned.
+func graphContentRequest(method, path, contentType string, body io.Reader) ([]byte, error) {
+	token, err := executeWithOutput("az", "account", "get-access-token",
+		"--resource-type", "ms-graph",
+		"--query", "accessToken",
+		"--output", "tsv")
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(method, fmt.Sprintf("%s/%s", graphBaseURL, strings.TrimPrefix(path, "/")), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(token))
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	logger.V(9).Infof("Sending request: %s %s", method, req.URL)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	content, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("%s %s failed with %s\nResponse status: %d\n%s", method, path, resp.Status, resp.StatusCode, string(content))
+	}
+
+	return content, nil
+}
+
+// graphGet fetches an object from Microsoft Graph. The returned boolean is false if the object does not exist.
+func graphGet(path string, result interface{}) (bool, error) {
+	err := graphRequest("GET", path, nil, result)
+	if err != nil {
+		if isNotFoundError(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// graphList fetches every page of a Microsoft Graph collection and deserializes the items into the slice that items
+// points to.
+func graphList(path string, items interface{}) error {
+	all := []json.RawMessage{}
+	for path != "" {
+		var page struct {
+			Value    []json.RawMessage `json:"value"`
+			NextLink string            `json:"@odata.nextLink"`
+		}
+		err := graphRequest("GET", path, nil, &page)
+		if err != nil {
+			return err
+		}
+
+		all = append(all, page.Value...)
+		path = strings.TrimPrefix(page.NextLink, graphBaseURL)
+	}
+
+	jsonBytes, err := json.Marshal(all)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(jsonBytes, items)
+}
+
+// waitForObject polls a Microsoft Graph object until it exists or, if waitForAvailable is false, until it is gone.
+func waitForObject(path, description string, waitForAvailable bool) error {
+	done, err := poll(func() (bool, error) {
+		found, err := graphGet(path, nil)
+		if err != nil {
+			return false, err
+		}
+
+		return found == waitForAvailable, nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if done {
+		return nil
+	}
+
+	if waitForAvailable {
+		return fmt.Errorf("%s could not be found", description)
+	}
+
+	return fmt.Errorf("%s still exists", description)
+}
+
+// addReference adds a directory object to a reference collection, like the owners of an application or the members of
+// a group. A new object can take a moment to replicate, so adding is retried until Microsoft Graph can see it. An
+// object that was already added is not an error.
+func addReference(collectionPath, objectID, description string) error {
+	body := map[string]interface{}{"@odata.id": fmt.Sprintf("%s/directoryObjects/%s", graphBaseURL, objectID)}
+
+	done, err := poll(func() (bool, error) {
+		err := graphRequest("POST", collectionPath+"/$ref", body, nil)
+		if err != nil {
+			if principalNotReplicatedRegexp.MatchString(err.Error()) {
+				return false, nil
+			}
+
+			if referenceExistsRegexp.MatchString(err.Error()) {
+				return true, nil
+			}
+
+			return false, err
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if !done {
+		return fmt.Errorf("the %s could not be found", description)
+	}
+
+	return nil
+}
+
+// findReference looks for a directory object in a reference collection. The returned boolean is false if the object
+// owning the collection does not exist or the directory object is not in the collection.
+func findReference(collectionPath, objectID string) (*directoryObject, bool, error) {
+	var objects []directoryObject
+	err := graphList(collectionPath+"?$select=id,displayName", &objects)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	for i, o := range objects {
+		if strings.EqualFold(o.ID, objectID) {
+			return &objects[i], true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+// removeReference removes a directory object from a reference collection. An object that was already removed, or a
+// collection owner that is gone, is not an error.
+func removeReference(collectionPath, objectID string) error {
+	err := graphRequest("DELETE", fmt.Sprintf("%s/%s/$ref", collectionPath, objectID), nil, nil)
+	if err != nil && !isNotFoundError(err) && !referenceNotFoundRegexp.MatchString(err.Error()) {
+		return err
+	}
+
+	return nil
+}
+
+// graphFilter builds an encoded $filter query string parameter from an OData expression.
+func graphFilter(format string, args ...interface{}) string {
+	return "$filter=" + strings.Replace(url.QueryEscape(fmt.Sprintf(format, args...)), "+", "%20", -1)
+}
+
+// odataString quotes a value as an OData string literal.
+func odataString(value string) string {
+	return "'" + strings.Replace(value, "'", "''", -1) + "'"
+}
+
+func isNotFoundError(err error) bool {
+	return notFoundRegexp.MatchString(err.Error())
+}
@@ -0,0 +1,372 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+const (
+	groupTypeSecurity     = "Security"
+	groupTypeMicrosoft365 = "Microsoft365"
+)
+
+var (
+	groupInputs = []string{"displayName", "mailNickname", "description"}
+
+	// groupReplaceInputs can only be set when the group is created.
+	groupReplaceInputs = []string{"type", "isAssignableToRole"}
+
+	// groupMemberInputs are all immutable since a member is only a reference between two objects.
+	groupMemberInputs = []string{"groupId", "memberId"}
+
+	mailNicknameRegexp        = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+	invalidMailNicknameRegexp = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+)
+
+type groupArgs struct {
+	DisplayName        string `pulumi:"displayName"`
+	MailNickname       string `pulumi:"mailNickname"`
+	Description        string `pulumi:"description"`
+	Type               string `pulumi:"type"`
+	IsAssignableToRole bool   `pulumi:"isAssignableToRole"`
+}
+
+type groupState struct {
+	ObjectID string `pulumi:"objectId"`
+}
+
+type group struct {
+	ID                 string   `json:"id,omitempty"`
+	DisplayName        string   `json:"displayName,omitempty"`
+	MailNickname       string   `json:"mailNickname,omitempty"`
+	Description        string   `json:"description,omitempty"`
+	GroupTypes         []string `json:"groupTypes"`
+	MailEnabled        bool     `json:"mailEnabled"`
+	SecurityEnabled    bool     `json:"securityEnabled"`
+	IsAssignableToRole bool     `json:"isAssignableToRole,omitempty"`
+	Mail               string   `json:"mail,omitempty"`
+}
+
+type groupMemberArgs struct {
+	GroupID  string `pulumi:"groupId"`
+	MemberID string `pulumi:"memberId"`
+}
+
+// checkGroup derives the mail nickname from the display name unless it is set, and validates it. The inputs are
+// changed in place.
+func checkGroup(inputs resource.PropertyMap) []*rpc.CheckFailure {
+	nickname := inputs["mailNickname"]
+	if nickname.IsString() {
+		if !mailNicknameRegexp.MatchString(nickname.StringValue()) {
+			return []*rpc.CheckFailure{{
+				Property: "mailNickname",
+				Reason:   fmt.Sprintf("the mail nickname can only contain letters, digits, '.', '-' and '_' but got '%s'", nickname.StringValue()),
+			}}
+		}
+
+		return nil
+	}
+
+	if inputs.HasValue("mailNickname") {
+		return nil
+	}
+
+	displayName := inputs["displayName"]
+	switch {
+	case displayName.IsString():
+		derived := invalidMailNicknameRegexp.ReplaceAllString(displayName.StringValue(), "")
+		if derived == "" {
+			return []*rpc.CheckFailure{{
+				Property: "mailNickname",
+				Reason:   "'mailNickname' must be set since it can't be derived from 'displayName'",
+			}}
+		}
+
+		inputs["mailNickname"] = resource.NewStringProperty(derived)
+	case displayName.ContainsUnknowns():
+		inputs["mailNickname"] = resource.MakeComputed(resource.NewStringProperty(""))
+	}
+
+	return nil
+}
+
+// toGroup builds the Microsoft Graph group for the inputs. Both kinds of groups are security enabled, so that they can
+// be assigned app roles.
+func (args groupArgs) toGroup() group {
+	g := group{
+		DisplayName:        args.DisplayName,
+		MailNickname:       args.MailNickname,
+		Description:        args.Description,
+		GroupTypes:         []string{},
+		SecurityEnabled:    true,
+		IsAssignableToRole: args.IsAssignableToRole,
+	}
+
+	if args.Type == groupTypeMicrosoft365 {
+		g.GroupTypes = []string{"Unified"}
+		g.MailEnabled = true
+	}
+
+	return g
+}
+
+func groupOutputs(inputs resource.PropertyMap, g group) map[string]interface{} {
+	outputs := inputs.Mappable()
+	outputs["objectId"] = g.ID
+	outputs["mail"] = nil
+	if g.Mail != "" {
+		outputs["mail"] = g.Mail
+	}
+
+	return outputs
+}
+
+// createGroup creates the group and waits for it to be available.
+func createGroup(inputs resource.PropertyMap) (string, map[string]interface{}, error) {
+	var args groupArgs
+	err := decodeInputs(inputs, &args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var created group
+	err = graphRequest("POST", "groups", args.toGroup(), &created)
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = waitForObject("groups/"+created.ID, fmt.Sprintf("group with object ID %s", created.ID), true)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return created.ID, groupOutputs(inputs, created), nil
+}
+
+// updateGroup applies the display name, mail nickname and description in place. A description that is removed is
+// cleared.
+func updateGroup(olds, news resource.PropertyMap) (map[string]interface{}, error) {
+	var state groupState
+	err := decodeInputs(olds, &state)
+	if err != nil {
+		return nil, err
+	}
+
+	var args groupArgs
+	err = decodeInputs(news, &args)
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"displayName":  args.DisplayName,
+		"mailNickname": args.MailNickname,
+		"description":  nil,
+	}
+	if args.Description != "" {
+		body["description"] = args.Description
+	}
+
+	err = graphRequest("PATCH", "groups/"+state.ObjectID, body, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var g group
+	err = graphRequest("GET", fmt.Sprintf("groups/%s?$select=id,mail", state.ObjectID), nil, &g)
+	if err != nil {
+		return nil, err
+	}
+
+	return groupOutputs(news, g), nil
+}
+
+// readGroup refreshes the group with the given object ID.
+func readGroup(id string, state, inputs resource.PropertyMap) (string, map[string]interface{}, map[string]interface{}, error) {
+	var g group
+	found, err := graphGet(fmt.Sprintf("groups/%s?$select=id,displayName,mailNickname,description,groupTypes,isAssignableToRole,mail", id), &g)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	if !found {
+		return "", nil, nil, nil
+	}
+
+	live := map[string]interface{}{
+		"displayName":        g.DisplayName,
+		"mailNickname":       g.MailNickname,
+		"description":        nil,
+		"type":               groupTypeSecurity,
+		"isAssignableToRole": nil,
+	}
+
+	if g.Description != "" {
+		live["description"] = g.Description
+	}
+
+	for _, t := range g.GroupTypes {
+		if t == "Unified" {
+			live["type"] = groupTypeMicrosoft365
+		}
+	}
+
+	// The defaults are only reported when they are set explicitly, so that refresh does not add them to the state.
+	if !state.HasValue("type") && live["type"] == groupTypeSecurity {
+		live["type"] = nil
+	}
+
+	if g.IsAssignableToRole {
+		live["isAssignableToRole"] = true
+	}
+
+	outputs := groupOutputs(state, g)
+	for k, v := range live {
+		outputs[k] = v
+	}
+
+	readInputs := inputs.Mappable()
+	if len(inputs) == 0 {
+		readInputs = live
+	}
+
+	return id, outputs, readInputs, nil
+}
+
+// deleteGroup deletes the group and waits for it to be gone. A group that is already gone is not an error.
+func deleteGroup(state resource.PropertyMap) error {
+	var args groupState
+	err := decodeInputs(state, &args)
+	if err != nil {
+		return err
+	}
+
+	err = graphRequest("DELETE", "groups/"+args.ObjectID, nil, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	return waitForObject("groups/"+args.ObjectID, fmt.Sprintf("group with object ID %s", args.ObjectID), false)
+}
+
+// checkGroupMember makes sure both object IDs are GUIDs, since they end up in Microsoft Graph paths.
+func checkGroupMember(inputs resource.PropertyMap) []*rpc.CheckFailure {
+	var failures []*rpc.CheckFailure
+	for _, k := range groupMemberInputs {
+		v := inputs[resource.PropertyKey(k)]
+		if v.IsString() && !guidRegexp.MatchString(v.StringValue()) {
+			failures = append(failures, &rpc.CheckFailure{
+				Property: k,
+				Reason:   fmt.Sprintf("'%s' must be an object ID but got '%s'", k, v.StringValue()),
+			})
+		}
+	}
+
+	return failures
+}
+
+func groupMemberOutputs(inputs resource.PropertyMap, member *directoryObject) map[string]interface{} {
+	outputs := inputs.Mappable()
+	outputs["memberType"] = strings.TrimPrefix(member.ODataType, "#microsoft.graph.")
+	outputs["memberDisplayName"] = member.DisplayName
+
+	return outputs
+}
+
+// createGroupMember adds the member once the group is available. A member that is already in the group is not an
+// error. The resource ID is the group's object ID and the member's object ID separated by a slash, so that the member
+// can be imported.
+func createGroupMember(inputs resource.PropertyMap) (string, map[string]interface{}, error) {
+	var args groupMemberArgs
+	err := decodeInputs(inputs, &args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = waitForObject("groups/"+args.GroupID, fmt.Sprintf("group with object ID %s", args.GroupID), true)
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = addReference(fmt.Sprintf("groups/%s/members", args.GroupID), args.MemberID, fmt.Sprintf("member with object ID %s", args.MemberID))
+	if err != nil {
+		return "", nil, err
+	}
+
+	member, found, err := findReference(fmt.Sprintf("groups/%s/members", args.GroupID), args.MemberID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if !found {
+		member = &directoryObject{ID: args.MemberID}
+	}
+
+	return args.GroupID + "/" + args.MemberID, groupMemberOutputs(inputs, member), nil
+}
+
+// readGroupMember refreshes the member. When importing, there is no state so the object IDs are taken from the
+// resource ID.
+func readGroupMember(id string, state, inputs resource.PropertyMap) (string, map[string]interface{}, map[string]interface{}, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 {
+		return "", nil, nil, fmt.Errorf("expected an ID in the form '<group object ID>/<member object ID>' but got '%s'", id)
+	}
+
+	member, found, err := findReference(fmt.Sprintf("groups/%s/members", parts[0]), parts[1])
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	if !found {
+		return "", nil, nil, nil
+	}
+
+	outputs := groupMemberOutputs(state, member)
+
+	readInputs := inputs.Mappable()
+	if len(inputs) == 0 {
+		readInputs = map[string]interface{}{
+			"groupId":  parts[0],
+			"memberId": parts[1],
+		}
+		for k, v := range readInputs {
+			outputs[k] = v
+		}
+	}
+
+	return id, outputs, readInputs, nil
+}
+
+// deleteGroupMember removes the member. A member or group that is already gone is not an error.
+func deleteGroupMember(state resource.PropertyMap) error {
+	var args groupMemberArgs
+	err := decodeInputs(state, &args)
+	if err != nil {
+		return err
+	}
+
+	return removeReference(fmt.Sprintf("groups/%s/members", args.GroupID), args.MemberID)
+}
@@ -0,0 +1,40 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"strings"
+)
+
+// guidNamespace is the namespace for the name-based GUIDs generated by this provider.
+var guidNamespace = [16]byte{0x6b, 0x2e, 0x51, 0x0f, 0x7c, 0x3a, 0x4d, 0x1e, 0x9a, 0x55, 0x0c, 0x8d, 0x2f, 0x41, 0xb3, 0x97}
+
+// stableGUID derives a name-based (version 5) GUID from the given parts, so the same parts always produce the same
+// GUID. This is used for IDs that Microsoft Graph expects the client to pick, like app role and key IDs.
+func stableGUID(parts ...string) string {
+	h := sha1.New()
+	h.Write(guidNamespace[:])
+	h.Write([]byte(strings.Join(parts, "\x00")))
+	sum := h.Sum(nil)
+
+	var b [16]byte
+	copy(b[:], sum)
+	b[6] = (b[6] & 0x0f) | 0x50
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
@@ -0,0 +1,60 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestStableGUID(t *testing.T) {
+	tests := []struct {
+		name  string
+		a, b  []string
+		equal bool
+	}{
+		{"same parts", []string{"keyCredential", "app", "ABC"}, []string{"keyCredential", "app", "ABC"}, true},
+		{"different part", []string{"keyCredential", "app", "ABC"}, []string{"keyCredential", "app", "ABD"}, false},
+		{"different kind", []string{"keyCredential", "app"}, []string{"appRole", "app"}, false},
+		{"part boundaries", []string{"ab", "c"}, []string{"a", "bc"}, false},
+		{"extra empty part", []string{"a"}, []string{"a", ""}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a, b := stableGUID(test.a...), stableGUID(test.b...)
+			if (a == b) != test.equal {
+				t.Errorf("expected equal GUIDs to be %v, got %s and %s", test.equal, a, b)
+			}
+		})
+	}
+}
+
+func TestStableGUIDFormat(t *testing.T) {
+	for _, parts := range [][]string{{}, {""}, {"keyCredential", "00000000-0000-0000-0000-000000000001", "ABC"}} {
+		id := stableGUID(parts...)
+		if !guidRegexp.MatchString(id) {
+			t.Errorf("expected a GUID for %q, got '%s'", parts, id)
+		}
+
+		// Name-based GUIDs are version 5 with the RFC 4122 variant.
+		if id[14] != '5' {
+			t.Errorf("expected version 5 for %q, got '%s'", parts, id)
+		}
+
+		if v := id[19]; v != '8' && v != '9' && v != 'a' && v != 'b' {
+			t.Errorf("expected the RFC 4122 variant for %q, got '%s'", parts, id)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+)
+
+// ownershipTagPrefix starts the application tag that records which resource manages the application. The rest of the
+// tag is the resource URN, which includes the stack and project names.
+const ownershipTagPrefix = "knapcode:managedBy="
+
+type appTags struct {
+	Tags []string `json:"tags"`
+}
+
+func ownershipTag(urn resource.URN) string {
+	return ownershipTagPrefix + string(urn)
+}
+
+// stampOwnership adds the resource's ownership marker to the application's tags, keeping any existing tags.
+func stampOwnership(objectID string, urn resource.URN) error {
+	defer lockApplication(objectID)()
+
+	var app appTags
+	err := graphRequest("GET", fmt.Sprintf("applications/%s?$select=tags", objectID), nil, &app)
+	if err != nil {
+		return err
+	}
+
+	tag := ownershipTag(urn)
+	for _, t := range app.Tags {
+		if t == tag {
+			return nil
+		}
+	}
+
+	return graphRequest("PATCH", fmt.Sprintf("applications/%s", objectID), appTags{Tags: append(app.Tags, tag)}, nil)
+}
+
+// checkOwnership returns an error if the application is marked as managed by another resource, meaning that the
+// object ID may point at an application this resource never managed. An application without any ownership marker was
+// either last updated by a version of the provider that did not add the marker, or is not managed by any resource, so
+// it is not refused.
+func checkOwnership(objectID string, urn resource.URN) error {
+	var app appTags
+	err := graphRequest("GET", fmt.Sprintf("applications/%s?$select=tags", objectID), nil, &app)
+	if err != nil {
+		return err
+	}
+
+	tag := ownershipTag(urn)
+	var others []string
+	for _, t := range app.Tags {
+		if t == tag {
+			return nil
+		}
+
+		if strings.HasPrefix(t, ownershipTagPrefix) {
+			others = append(others, t)
+		}
+	}
+
+	if len(others) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("refusing to delete application with object ID %s because it has the tag '%s' instead of '%s'. "+
+		"Set the 'force' input to true to delete it anyway", objectID, strings.Join(others, "', '"), tag)
+}
@@ -0,0 +1,554 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+var (
+	// policyFields are the policy settings that are updated in place, including the definition.
+	policyFields = []string{"displayName", "isOrganizationDefault", "definition"}
+
+	// policyAssignmentInputs are all immutable since an assignment is only a reference between two objects.
+	policyAssignmentInputs = []string{"servicePrincipalId", "policyId"}
+
+	claimSources = []string{"user", "application", "resource", "audience", "company", "transformation"}
+
+	timeSpanRegexp = regexp.MustCompile(`^(?:(\d+)\.)?(\d{1,2}):(\d{2}):(\d{2})$`)
+)
+
+const (
+	minAccessTokenLifetime = 10 * time.Minute
+	maxAccessTokenLifetime = 24 * time.Hour
+)
+
+// policyKind describes one kind of Microsoft Graph policy: where the policies live, how their structured definition is
+// turned into the JSON string Microsoft Graph expects and back, and where they are assigned.
+type policyKind struct {
+	collection  string
+	description string
+	toJSON      func(definition resource.PropertyMap) (string, error)
+	fromJSON    func(definition string) (map[string]interface{}, error)
+
+	// assignments returns the Microsoft Graph path of the policies assigned to a service principal.
+	assignments func(servicePrincipalID string) (string, error)
+}
+
+var claimsMappingPolicyKind = policyKind{
+	collection:  "claimsMappingPolicies",
+	description: "claims mapping policy",
+	toJSON:      claimsMappingDefinitionJSON,
+	fromJSON:    parseClaimsMappingDefinition,
+	assignments: func(servicePrincipalID string) (string, error) {
+		return fmt.Sprintf("servicePrincipals/%s/claimsMappingPolicies", servicePrincipalID), nil
+	},
+}
+
+var tokenLifetimePolicyKind = policyKind{
+	collection:  "tokenLifetimePolicies",
+	description: "token lifetime policy",
+	toJSON:      tokenLifetimeDefinitionJSON,
+	fromJSON:    parseTokenLifetimeDefinition,
+	assignments: tokenLifetimePolicyAssignments,
+}
+
+type policyArgs struct {
+	DisplayName           string `pulumi:"displayName"`
+	IsOrganizationDefault bool   `pulumi:"isOrganizationDefault"`
+}
+
+type policyState struct {
+	PolicyID string `pulumi:"policyId"`
+}
+
+type policy struct {
+	ID                    string   `json:"id,omitempty"`
+	DisplayName           string   `json:"displayName"`
+	Definition            []string `json:"definition"`
+	IsOrganizationDefault bool     `json:"isOrganizationDefault"`
+}
+
+type policyAssignmentArgs struct {
+	ServicePrincipalID string `pulumi:"servicePrincipalId"`
+	PolicyID           string `pulumi:"policyId"`
+}
+
+type claimsMappingPolicyDefinition struct {
+	IncludeBasicClaimSet bool                `pulumi:"includeBasicClaimSet"`
+	ClaimsSchema         []claimsSchemaEntry `pulumi:"claimsSchema"`
+}
+
+// claimsSchemaEntry is a claim emitted by a claims mapping policy. The JSON names are the ones used in the policy
+// definition.
+type claimsSchemaEntry struct {
+	Source         string `json:"Source,omitempty" pulumi:"source"`
+	ID             string `json:"ID,omitempty" pulumi:"id"`
+	Value          string `json:"Value,omitempty" pulumi:"value"`
+	SamlClaimType  string `json:"SamlClaimType,omitempty" pulumi:"samlClaimType"`
+	SamlNameFormat string `json:"SamlNameFormat,omitempty" pulumi:"samlNameFormat"`
+	JwtClaimType   string `json:"JwtClaimType,omitempty" pulumi:"jwtClaimType"`
+}
+
+type claimsMappingPolicyJSON struct {
+	ClaimsMappingPolicy struct {
+		Version              int                 `json:"Version"`
+		IncludeBasicClaimSet string              `json:"IncludeBasicClaimSet"`
+		ClaimsSchema         []claimsSchemaEntry `json:"ClaimsSchema"`
+	} `json:"ClaimsMappingPolicy"`
+}
+
+type tokenLifetimePolicyDefinition struct {
+	AccessTokenLifetime string `pulumi:"accessTokenLifetime"`
+}
+
+type tokenLifetimePolicyJSON struct {
+	TokenLifetimePolicy struct {
+		Version             int    `json:"Version"`
+		AccessTokenLifetime string `json:"AccessTokenLifetime"`
+	} `json:"TokenLifetimePolicy"`
+}
+
+// checkClaimsMappingPolicy makes sure every claim either has a constant value or a source and an ID, and that the
+// sources are valid.
+func checkClaimsMappingPolicy(inputs resource.PropertyMap) []*rpc.CheckFailure {
+	var failures []*rpc.CheckFailure
+
+	var definition claimsMappingPolicyDefinition
+	err := decodeInputs(objectProperty(inputs, "definition"), &definition)
+	if err != nil {
+		return []*rpc.CheckFailure{{Property: "definition", Reason: err.Error()}}
+	}
+
+	for i, claim := range definition.ClaimsSchema {
+		path := fmt.Sprintf("definition.claimsSchema[%d]", i)
+
+		switch {
+		case claim.Value != "" && (claim.Source != "" || claim.ID != ""):
+			failures = append(failures, &rpc.CheckFailure{
+				Property: joinPath(path, "value"),
+				Reason:   "a claim has either a constant 'value' or a 'source' and an 'id', not both",
+			})
+		case claim.Value == "" && (claim.Source == "") != (claim.ID == ""):
+			failures = append(failures, &rpc.CheckFailure{
+				Property: path,
+				Reason:   "'source' and 'id' must be set together",
+			})
+		}
+
+		if claim.Source != "" {
+			valid := false
+			for _, s := range claimSources {
+				valid = valid || s == claim.Source
+			}
+
+			if !valid {
+				failures = append(failures, &rpc.CheckFailure{
+					Property: joinPath(path, "source"),
+					Reason:   fmt.Sprintf("the source must be one of %s but got '%s'", strings.Join(claimSources, ", "), claim.Source),
+				})
+			}
+		}
+	}
+
+	return failures
+}
+
+// checkTokenLifetimePolicy makes sure the access token lifetime is a time span Microsoft Graph accepts.
+func checkTokenLifetimePolicy(inputs resource.PropertyMap) []*rpc.CheckFailure {
+	lifetime := objectProperty(inputs, "definition")["accessTokenLifetime"]
+	if !lifetime.IsString() {
+		return nil
+	}
+
+	d, err := parseTimeSpan(lifetime.StringValue())
+	if err == nil && (d < minAccessTokenLifetime || d > maxAccessTokenLifetime) {
+		err = fmt.Errorf("the access token lifetime must be between 00:10:00 and 1.00:00:00 but got '%s'", lifetime.StringValue())
+	}
+
+	if err != nil {
+		return []*rpc.CheckFailure{{Property: "definition.accessTokenLifetime", Reason: err.Error()}}
+	}
+
+	return nil
+}
+
+// parseTimeSpan parses a time span in the '[d.]hh:mm:ss' format used by policy definitions.
+func parseTimeSpan(s string) (time.Duration, error) {
+	m := timeSpanRegexp.FindStringSubmatch(s)
+	if m == nil {
+		return 0, fmt.Errorf("expected a time span like '01:30:00' or '1.00:00:00' but got '%s'", s)
+	}
+
+	var parts [4]int
+	for i, p := range m[1:] {
+		if p != "" {
+			parts[i], _ = strconv.Atoi(p)
+		}
+	}
+
+	if parts[2] > 59 || parts[3] > 59 {
+		return 0, fmt.Errorf("expected a time span like '01:30:00' or '1.00:00:00' but got '%s'", s)
+	}
+
+	return time.Duration(parts[0])*24*time.Hour +
+		time.Duration(parts[1])*time.Hour +
+		time.Duration(parts[2])*time.Minute +
+		time.Duration(parts[3])*time.Second, nil
+}
+
+// objectProperty returns an object property of a property bag, or an empty bag if it is not an object.
+func objectProperty(props resource.PropertyMap, key resource.PropertyKey) resource.PropertyMap {
+	v := props[key]
+	if v.IsSecret() {
+		v = v.SecretValue().Element
+	}
+
+	if !v.IsObject() {
+		return resource.PropertyMap{}
+	}
+
+	return v.ObjectValue()
+}
+
+func claimsMappingDefinitionJSON(props resource.PropertyMap) (string, error) {
+	var definition claimsMappingPolicyDefinition
+	err := decodeInputs(props, &definition)
+	if err != nil {
+		return "", err
+	}
+
+	var policy claimsMappingPolicyJSON
+	policy.ClaimsMappingPolicy.Version = 1
+	policy.ClaimsMappingPolicy.IncludeBasicClaimSet = strconv.FormatBool(definition.IncludeBasicClaimSet)
+	policy.ClaimsMappingPolicy.ClaimsSchema = append([]claimsSchemaEntry{}, definition.ClaimsSchema...)
+
+	jsonBytes, err := json.Marshal(policy)
+	return string(jsonBytes), err
+}
+
+func parseClaimsMappingDefinition(s string) (map[string]interface{}, error) {
+	var policy claimsMappingPolicyJSON
+	err := json.Unmarshal([]byte(s), &policy)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse the claims mapping policy definition: %v", err)
+	}
+
+	claims := []interface{}{}
+	for _, c := range policy.ClaimsMappingPolicy.ClaimsSchema {
+		claim := map[string]interface{}{}
+		for k, v := range map[string]string{
+			"source":         c.Source,
+			"id":             c.ID,
+			"value":          c.Value,
+			"samlClaimType":  c.SamlClaimType,
+			"samlNameFormat": c.SamlNameFormat,
+			"jwtClaimType":   c.JwtClaimType,
+		} {
+			if v != "" {
+				claim[k] = v
+			}
+		}
+
+		claims = append(claims, claim)
+	}
+
+	definition := map[string]interface{}{"claimsSchema": claims}
+	if strings.EqualFold(policy.ClaimsMappingPolicy.IncludeBasicClaimSet, "true") {
+		definition["includeBasicClaimSet"] = true
+	}
+
+	return definition, nil
+}
+
+func tokenLifetimeDefinitionJSON(props resource.PropertyMap) (string, error) {
+	var definition tokenLifetimePolicyDefinition
+	err := decodeInputs(props, &definition)
+	if err != nil {
+		return "", err
+	}
+
+	var policy tokenLifetimePolicyJSON
+	policy.TokenLifetimePolicy.Version = 1
+	policy.TokenLifetimePolicy.AccessTokenLifetime = definition.AccessTokenLifetime
+
+	jsonBytes, err := json.Marshal(policy)
+	return string(jsonBytes), err
+}
+
+func parseTokenLifetimeDefinition(s string) (map[string]interface{}, error) {
+	var policy tokenLifetimePolicyJSON
+	err := json.Unmarshal([]byte(s), &policy)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse the token lifetime policy definition: %v", err)
+	}
+
+	return map[string]interface{}{"accessTokenLifetime": policy.TokenLifetimePolicy.AccessTokenLifetime}, nil
+}
+
+// tokenLifetimePolicyAssignments returns the Microsoft Graph path of the token lifetime policies of the application
+// behind a service principal. Microsoft Graph only assigns token lifetime policies to applications, so the service
+// principal must belong to an application in this tenant.
+func tokenLifetimePolicyAssignments(servicePrincipalID string) (string, error) {
+	var sp struct {
+		AppID string `json:"appId"`
+	}
+	err := graphRequest("GET", fmt.Sprintf("servicePrincipals/%s?$select=appId", servicePrincipalID), nil, &sp)
+	if err != nil {
+		return "", err
+	}
+
+	var apps []struct {
+		ID string `json:"id"`
+	}
+	err = graphList("applications?$select=id&"+graphFilter("appId eq %s", odataString(sp.AppID)), &apps)
+	if err != nil {
+		return "", err
+	}
+
+	if len(apps) == 0 {
+		return "", fmt.Errorf("the application of the service principal with object ID %s is not in this tenant, token lifetime policies can only be assigned to applications in this tenant", servicePrincipalID)
+	}
+
+	return fmt.Sprintf("applications/%s/tokenLifetimePolicies", apps[0].ID), nil
+}
+
+// diffPolicy compares the settings after removing empty values, so that a definition read from Microsoft Graph
+// matches the inputs it was written from. Every change is made in place.
+func diffPolicy(olds, news resource.PropertyMap) ([]string, map[string]*rpc.PropertyDiff) {
+	return diffSettings(olds, news, policyFields, nil)
+}
+
+func (kind policyKind) toPolicy(inputs resource.PropertyMap) (policy, error) {
+	var args policyArgs
+	err := decodeInputs(inputs, &args)
+	if err != nil {
+		return policy{}, err
+	}
+
+	definition, err := kind.toJSON(objectProperty(inputs, "definition"))
+	if err != nil {
+		return policy{}, err
+	}
+
+	return policy{
+		DisplayName:           args.DisplayName,
+		Definition:            []string{definition},
+		IsOrganizationDefault: args.IsOrganizationDefault,
+	}, nil
+}
+
+// createPolicy creates the policy and waits for it to be available.
+func createPolicy(kind policyKind, inputs resource.PropertyMap) (string, map[string]interface{}, error) {
+	body, err := kind.toPolicy(inputs)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var created policy
+	err = graphRequest("POST", "policies/"+kind.collection, body, &created)
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = waitForObject(fmt.Sprintf("policies/%s/%s", kind.collection, created.ID), fmt.Sprintf("%s with ID %s", kind.description, created.ID), true)
+	if err != nil {
+		return "", nil, err
+	}
+
+	outputs := inputs.Mappable()
+	outputs["policyId"] = created.ID
+
+	return created.ID, outputs, nil
+}
+
+// updatePolicy applies the display name, definition and organization default in place.
+func updatePolicy(kind policyKind, olds, news resource.PropertyMap) (map[string]interface{}, error) {
+	var state policyState
+	err := decodeInputs(olds, &state)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := kind.toPolicy(news)
+	if err != nil {
+		return nil, err
+	}
+
+	err = graphRequest("PATCH", fmt.Sprintf("policies/%s/%s", kind.collection, state.PolicyID), body, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := news.Mappable()
+	outputs["policyId"] = state.PolicyID
+
+	return outputs, nil
+}
+
+// readPolicy refreshes the policy with the given ID, turning its definition back into the structured form.
+func readPolicy(kind policyKind, id string, state, inputs resource.PropertyMap) (string, map[string]interface{}, map[string]interface{}, error) {
+	var p policy
+	found, err := graphGet(fmt.Sprintf("policies/%s/%s", kind.collection, id), &p)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	if !found {
+		return "", nil, nil, nil
+	}
+
+	if len(p.Definition) != 1 {
+		return "", nil, nil, fmt.Errorf("the %s with ID %s has %d definitions, expected one", kind.description, id, len(p.Definition))
+	}
+
+	definition, err := kind.fromJSON(p.Definition[0])
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	live := map[string]interface{}{
+		"displayName":           p.DisplayName,
+		"definition":            definition,
+		"isOrganizationDefault": nil,
+	}
+
+	if p.IsOrganizationDefault {
+		live["isOrganizationDefault"] = true
+	}
+
+	outputs := state.Mappable()
+	for k, v := range live {
+		outputs[k] = v
+	}
+	outputs["policyId"] = id
+
+	readInputs := inputs.Mappable()
+	if len(inputs) == 0 {
+		readInputs = live
+	}
+
+	return id, outputs, readInputs, nil
+}
+
+// deletePolicy deletes the policy. A policy that is already gone is not an error.
+func deletePolicy(kind policyKind, state resource.PropertyMap) error {
+	var args policyState
+	err := decodeInputs(state, &args)
+	if err != nil {
+		return err
+	}
+
+	err = graphRequest("DELETE", fmt.Sprintf("policies/%s/%s", kind.collection, args.PolicyID), nil, nil)
+	if err != nil && !isNotFoundError(err) {
+		return err
+	}
+
+	return nil
+}
+
+// createPolicyAssignment assigns the policy to the service principal once both are available. The resource ID is the
+// service principal's object ID and the policy ID separated by a slash, so that the assignment can be imported.
+func createPolicyAssignment(kind policyKind, inputs resource.PropertyMap) (string, map[string]interface{}, error) {
+	var args policyAssignmentArgs
+	err := decodeInputs(inputs, &args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = waitForObject("servicePrincipals/"+args.ServicePrincipalID, fmt.Sprintf("service principal with object ID %s", args.ServicePrincipalID), true)
+	if err != nil {
+		return "", nil, err
+	}
+
+	path, err := kind.assignments(args.ServicePrincipalID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = addReference(path, args.PolicyID, fmt.Sprintf("%s with ID %s", kind.description, args.PolicyID))
+	if err != nil {
+		return "", nil, err
+	}
+
+	return args.ServicePrincipalID + "/" + args.PolicyID, inputs.Mappable(), nil
+}
+
+// readPolicyAssignment refreshes the assignment. When importing, there is no state so the service principal's object
+// ID and the policy ID are taken from the resource ID.
+func readPolicyAssignment(kind policyKind, id string, state, inputs resource.PropertyMap) (string, map[string]interface{}, map[string]interface{}, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 {
+		return "", nil, nil, fmt.Errorf("expected an ID in the form '<service principal object ID>/<policy ID>' but got '%s'", id)
+	}
+
+	path, err := kind.assignments(parts[0])
+	if err != nil {
+		if isNotFoundError(err) {
+			return "", nil, nil, nil
+		}
+
+		return "", nil, nil, err
+	}
+
+	_, found, err := findReference(path, parts[1])
+	if err != nil || !found {
+		return "", nil, nil, err
+	}
+
+	outputs := state.Mappable()
+
+	readInputs := inputs.Mappable()
+	if len(inputs) == 0 {
+		readInputs = map[string]interface{}{
+			"servicePrincipalId": parts[0],
+			"policyId":           parts[1],
+		}
+		for k, v := range readInputs {
+			outputs[k] = v
+		}
+	}
+
+	return id, outputs, readInputs, nil
+}
+
+// deletePolicyAssignment removes the policy from the service principal. An assignment that is already gone is not an
+// error.
+func deletePolicyAssignment(kind policyKind, state resource.PropertyMap) error {
+	var args policyAssignmentArgs
+	err := decodeInputs(state, &args)
+	if err != nil {
+		return err
+	}
+
+	path, err := kind.assignments(args.ServicePrincipalID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	return removeReference(path, args.PolicyID)
+}
@@ -20,37 +20,68 @@ import (
 	"encoding/json"
 	"fmt"
 	"os/exec"
+	"reflect"
 	"regexp"
 	"strings"
 	"time"
 
+	pschema "github.com/pulumi/pulumi/pkg/v2/codegen/schema"
 	"github.com/pulumi/pulumi/pkg/v2/resource/provider"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/diag"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
 	logger "github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/rpcutil/rpcerror"
 	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
 
 	pbempty "github.com/golang/protobuf/ptypes/empty"
+	pbstruct "github.com/golang/protobuf/ptypes/struct"
+	"google.golang.org/grpc/codes"
 )
 
 type knapcodeProvider struct {
 	host    *provider.HostClient
 	name    string
 	version string
+	schema  []byte
+	spec    *pschema.PackageSpec
+
+	// defaultOwners are the object IDs of the principals added as owners of every application and service principal
+	// created by this provider.
+	defaultOwners []string
 }
 
-func makeProvider(host *provider.HostClient, name, version string) (rpc.ResourceProviderServer, error) {
+func makeProvider(host *provider.HostClient, name, version string, schema []byte) (rpc.ResourceProviderServer, error) {
+	var spec pschema.PackageSpec
+	if err := json.Unmarshal(schema, &spec); err != nil {
+		return nil, fmt.Errorf("reading schema: %v", err)
+	}
+
 	// Return the new provider
 	return &knapcodeProvider{
 		host:    host,
 		name:    name,
 		version: version,
+		schema:  schema,
+		spec:    &spec,
 	}, nil
 }
 
 // CheckConfig validates the configuration for this provider.
 func (k *knapcodeProvider) CheckConfig(ctx context.Context, req *rpc.CheckRequest) (*rpc.CheckResponse, error) {
-	return &rpc.CheckResponse{Inputs: req.GetNews()}, nil
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true})
+	if err != nil {
+		return nil, err
+	}
+
+	var failures []*rpc.CheckFailure
+	if v := news["defaultOwners"]; !v.ContainsUnknowns() {
+		if _, err := parseDefaultOwners(v); err != nil {
+			failures = append(failures, &rpc.CheckFailure{Property: "defaultOwners", Reason: err.Error()})
+		}
+	}
+
+	return &rpc.CheckResponse{Inputs: req.GetNews(), Failures: failures}, nil
 }
 
 // DiffConfig diffs the configuration for this provider.
@@ -60,13 +91,78 @@ func (k *knapcodeProvider) DiffConfig(ctx context.Context, req *rpc.DiffRequest)
 
 // Configure configures the resource provider with "globals" that control its behavior.
 func (k *knapcodeProvider) Configure(_ context.Context, req *rpc.ConfigureRequest) (*rpc.ConfigureResponse, error) {
-	return &rpc.ConfigureResponse{}, nil
+	var defaultOwners resource.PropertyValue
+	if v, ok := req.GetVariables()["knapcode:config:defaultOwners"]; ok {
+		defaultOwners = resource.NewStringProperty(v)
+	}
+
+	owners, err := parseDefaultOwners(defaultOwners)
+	if err != nil {
+		return nil, err
+	}
+	k.defaultOwners = owners
+
+	return &rpc.ConfigureResponse{AcceptSecrets: true, SupportsPreview: true}, nil
 }
 
 // Invoke dynamically executes a built-in function in the provider.
 func (k *knapcodeProvider) Invoke(_ context.Context, req *rpc.InvokeRequest) (*rpc.InvokeResponse, error) {
 	tok := req.GetTok()
-	return nil, fmt.Errorf("unknown Invoke token '%s'", tok)
+
+	args, err := plugin.UnmarshalProperties(req.GetArgs(), plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true})
+	if err != nil {
+		return nil, err
+	}
+
+	failures, err := checkFunctionInputs(k.spec, tok, args)
+	if err != nil {
+		return nil, err
+	}
+
+	var outputs map[string]interface{}
+
+	switch tok {
+
+	case "knapcode:index:restoreDeletedApplication":
+		failures = append(failures, checkRestoreDeletedApplication(args)...)
+		if len(failures) > 0 {
+			return &rpc.InvokeResponse{Failures: failures}, nil
+		}
+
+		_, outputs, err = restoreDeletedApplication(args)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:getDeletedApplication":
+		failures = append(failures, checkRestoreDeletedApplication(args)...)
+		if len(failures) > 0 {
+			return &rpc.InvokeResponse{Failures: failures}, nil
+		}
+
+		outputs, err = getDeletedApplication(args)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown Invoke token '%s'", tok)
+
+	}
+
+	outputProperties, err := plugin.MarshalProperties(
+		resource.NewPropertyMapFromMap(outputs),
+		plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.InvokeResponse{
+		Return:   outputProperties,
+		Failures: failures,
+	}, nil
 }
 
 // StreamInvoke dynamically executes a built-in function in the provider. The result is streamed
@@ -86,16 +182,162 @@ func (k *knapcodeProvider) Check(ctx context.Context, req *rpc.CheckRequest) (*r
 	urn := resource.URN(req.GetUrn())
 	ty := urn.Type()
 
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true})
+	if err != nil {
+		return nil, err
+	}
+
+	failures, err := checkInputs(k.spec, string(ty), news)
+	if err != nil {
+		return nil, err
+	}
+
+	checked := req.GetNews()
+
 	switch ty {
 
 	case "knapcode:index:PrepareAppForWebSignIn":
+		failures = append(failures, checkPrepareAppForWebSignIn(news)...)
+
+	case "knapcode:index:RestoredApplication":
+		failures = append(failures, checkRestoreDeletedApplication(news)...)
+
+	case "knapcode:index:ApplicationPassword":
+		failures = append(failures, checkApplicationPassword(news)...)
+
+	case "knapcode:index:ApplicationCertificate":
+		failures = append(failures, checkApplicationCertificate(news)...)
+
+	case "knapcode:index:FederatedIdentityCredential":
+		failures = append(failures, checkFederatedIdentityCredential(news)...)
+
+	case "knapcode:index:ServicePrincipal":
+
+	case "knapcode:index:Application":
+		checked, err = fillDefaults(req.GetNews(), checkApplication, &failures)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:AppRole":
+		checked, err = fillDefaults(req.GetNews(), checkAppRole, &failures)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:AppRoleAssignment":
+		failures = append(failures, checkAppRoleAssignment(news)...)
+
+	case "knapcode:index:ApiPermissionGrant":
+		failures = append(failures, checkAPIPermissionGrant(news)...)
+
+	case "knapcode:index:ExposeApi":
+		checked, err = fillDefaults(req.GetNews(), checkExposeAPI, &failures)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:RequiredResourceAccess":
+		failures = append(failures, checkRequiredResourceAccess(news)...)
+
+	case "knapcode:index:TokenConfiguration":
+		failures = append(failures, checkTokenConfiguration(news)...)
+
+	case "knapcode:index:ApplicationOwner":
+		failures = append(failures, checkApplicationOwner(news)...)
+
+	case "knapcode:index:Group":
+		checked, err = fillDefaults(req.GetNews(), checkGroup, &failures)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:GroupMember":
+		failures = append(failures, checkGroupMember(news)...)
+
+	case "knapcode:index:DirectoryRoleAssignment":
+		failures = append(failures, checkDirectoryRoleAssignment(news)...)
+
+	case "knapcode:index:ClaimsMappingPolicy":
+		failures = append(failures, checkClaimsMappingPolicy(news)...)
+
+	case "knapcode:index:ClaimsMappingPolicyAssignment":
+
+	case "knapcode:index:TokenLifetimePolicy":
+		failures = append(failures, checkTokenLifetimePolicy(news)...)
+
+	case "knapcode:index:TokenLifetimePolicyAssignment":
+
+	case "knapcode:index:ExtensionProperty":
+		failures = append(failures, checkExtensionProperty(news)...)
+
+	case "knapcode:index:ApplicationLogo":
+		checked, err = fillDefaults(req.GetNews(), checkApplicationLogo, &failures)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ApplicationBranding":
+		checked, err = fillDefaults(req.GetNews(), checkApplicationBranding, &failures)
+		if err != nil {
+			return nil, err
+		}
 
 	default:
 		return nil, fmt.Errorf("Check: unknown resource type '%s'", ty)
 
 	}
 
-	return &rpc.CheckResponse{Inputs: req.News, Failures: nil}, nil
+	return &rpc.CheckResponse{Inputs: checked, Failures: failures}, nil
+}
+
+// partialCreateError reports that the object was created but could not be fully set up, for example because a default
+// owner could not be added. The engine still records the resource with its ID and outputs, so that the next update does
+// not create a duplicate.
+func partialCreateError(id string, outputs map[string]interface{}, inputs *pbstruct.Struct, err error) error {
+	properties, marshalErr := marshalOutputs(outputs, inputs)
+	if marshalErr != nil {
+		return err
+	}
+
+	return rpcerror.WithDetails(rpcerror.New(codes.Unknown, err.Error()), &rpc.ErrorResourceInitFailed{
+		Id:         id,
+		Properties: properties,
+		Inputs:     inputs,
+		Reasons:    []string{err.Error()},
+	})
+}
+
+// marshalOutputs marshals the outputs of a resource, marking an output as secret when the input with the same name
+// contains a secret. Resources decode their inputs without secrets and mostly copy them into their outputs, so without
+// this a secret input would be stored in plain text. The engine does the same for providers that don't accept secrets.
+func marshalOutputs(outputs map[string]interface{}, inputs *pbstruct.Struct) (*pbstruct.Struct, error) {
+	secrets, err := plugin.UnmarshalProperties(inputs, plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true, KeepSecrets: true})
+	if err != nil {
+		return nil, err
+	}
+
+	properties := resource.NewPropertyMapFromMap(outputs)
+	for k, v := range properties {
+		if secrets[k].ContainsSecrets() && !v.ContainsSecrets() {
+			properties[k] = resource.MakeSecret(v)
+		}
+	}
+
+	return plugin.MarshalProperties(properties, plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true, KeepSecrets: true})
+}
+
+// fillDefaults runs a check that fills in default inputs and returns the checked inputs in place of the original ones.
+// Secrets are kept, and any failures are appended to the given failures.
+func fillDefaults(news *pbstruct.Struct, check func(resource.PropertyMap) []*rpc.CheckFailure, failures *[]*rpc.CheckFailure) (*pbstruct.Struct, error) {
+	inputs, err := plugin.UnmarshalProperties(news, plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true, KeepSecrets: true})
+	if err != nil {
+		return nil, err
+	}
+
+	*failures = append(*failures, check(inputs)...)
+
+	return plugin.MarshalProperties(inputs, plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true, KeepSecrets: true})
 }
 
 // Diff checks what impacts a hypothetical update will have on the resource's properties.
@@ -113,32 +355,109 @@ func (k *knapcodeProvider) Diff(ctx context.Context, req *rpc.DiffRequest) (*rpc
 		return nil, err
 	}
 
-	diffs := []string{}
-	changes := rpc.DiffResponse_DIFF_NONE
+	var diffs []string
+	var replaces []string
+	var detailedDiff map[string]*rpc.PropertyDiff
+	var deleteBeforeReplace bool
 
 	switch ty {
 
 	case "knapcode:index:PrepareAppForWebSignIn":
-		d := olds.Diff(news)
-		if d != nil {
-			if d.Changed("objectId") {
-				changes = rpc.DiffResponse_DIFF_SOME
-				diffs = append(diffs, "objectId")
-			}
-			if d.Changed("hostName") {
-				changes = rpc.DiffResponse_DIFF_SOME
-				diffs = append(diffs, "hostName")
-			}
+		diffs, detailedDiff, err = diffAppUpdate(olds, news)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:RestoredApplication":
+		replaces, detailedDiff = diffRestoreDeletedApplication(olds, news)
+		diffs = replaces
+
+	case "knapcode:index:ApplicationPassword":
+		diffs, replaces, detailedDiff = diffInputs(olds, news, nil, applicationPasswordInputs)
+
+	case "knapcode:index:ApplicationCertificate":
+		diffs, replaces, detailedDiff, deleteBeforeReplace = diffApplicationCertificate(olds, news)
+
+	case "knapcode:index:FederatedIdentityCredential":
+		diffs, replaces, detailedDiff, err = diffFederatedIdentityCredential(olds, news)
+		if err != nil {
+			return nil, err
 		}
 
+	case "knapcode:index:ServicePrincipal":
+		diffs, replaces, detailedDiff = diffInputs(olds, news, servicePrincipalInputs, []string{"appId"})
+
+	case "knapcode:index:Application":
+		diffs, detailedDiff = diffApplication(olds, news)
+
+	case "knapcode:index:AppRole":
+		diffs, replaces, detailedDiff = diffInputs(olds, news, appRoleInputs, []string{"objectId", "roleId"})
+
+	case "knapcode:index:AppRoleAssignment":
+		diffs, replaces, detailedDiff = diffInputs(olds, news, nil, appRoleAssignmentInputs)
+
+	case "knapcode:index:ApiPermissionGrant":
+		diffs, replaces, detailedDiff = diffAPIPermissionGrant(olds, news)
+
+	case "knapcode:index:ExposeApi":
+		diffs, replaces, detailedDiff = diffInputs(olds, news, exposeAPIInputs, []string{"objectId"})
+
+	case "knapcode:index:RequiredResourceAccess":
+		diffs, replaces, detailedDiff = diffInputs(olds, news, requiredResourceAccessInputs, []string{"objectId"})
+
+	case "knapcode:index:TokenConfiguration":
+		diffs, replaces, detailedDiff = diffTokenConfiguration(olds, news)
+
+	case "knapcode:index:ApplicationOwner":
+		diffs, replaces, detailedDiff = diffInputs(olds, news, nil, applicationOwnerInputs)
+
+	case "knapcode:index:Group":
+		diffs, replaces, detailedDiff = diffInputs(olds, news, groupInputs, groupReplaceInputs)
+
+	case "knapcode:index:GroupMember":
+		diffs, replaces, detailedDiff = diffInputs(olds, news, nil, groupMemberInputs)
+
+	case "knapcode:index:DirectoryRoleAssignment":
+		diffs, replaces, detailedDiff = diffInputs(olds, news, nil, directoryRoleAssignmentInputs)
+
+	case "knapcode:index:ClaimsMappingPolicy":
+		diffs, detailedDiff = diffPolicy(olds, news)
+
+	case "knapcode:index:ClaimsMappingPolicyAssignment":
+		diffs, replaces, detailedDiff = diffInputs(olds, news, nil, policyAssignmentInputs)
+
+	case "knapcode:index:TokenLifetimePolicy":
+		diffs, detailedDiff = diffPolicy(olds, news)
+
+	case "knapcode:index:TokenLifetimePolicyAssignment":
+		diffs, replaces, detailedDiff = diffInputs(olds, news, nil, policyAssignmentInputs)
+
+	case "knapcode:index:ExtensionProperty":
+		diffs, replaces, detailedDiff = diffInputs(olds, news, nil, extensionPropertyInputs)
+
+	case "knapcode:index:ApplicationLogo":
+		diffs, replaces, detailedDiff = diffApplicationLogo(olds, news)
+
+	case "knapcode:index:ApplicationBranding":
+		diffs, replaces, detailedDiff = diffInputs(olds, news, applicationBrandingInputs, []string{"objectId"})
+
 	default:
 		return nil, fmt.Errorf("Diff: unknown resource type '%s'", ty)
 
 	}
 
+	changes := rpc.DiffResponse_DIFF_NONE
+	if len(diffs) > 0 || len(detailedDiff) > 0 {
+		changes = rpc.DiffResponse_DIFF_SOME
+	}
+
 	return &rpc.DiffResponse{
-		Changes: changes,
-		Diffs:   diffs,
+		Changes:             changes,
+		Diffs:               diffs,
+		Replaces:            replaces,
+		DeleteBeforeReplace: deleteBeforeReplace,
+		DetailedDiff:        detailedDiff,
+		HasDetailedDiff:     detailedDiff != nil,
 	}, nil
 }
 
@@ -147,15 +466,58 @@ type aadAppUpdateAPI struct {
 }
 
 type aadAppUpdateWeb struct {
-	HomePageURL  string   `json:"homePageUrl"`
+	HomePageURL           string                             `json:"homePageUrl"`
+	RedirectUris          []string                           `json:"redirectUris"`
+	LogoutURL             string                             `json:"logoutUrl"`
+	ImplicitGrantSettings *aadAppUpdateImplicitGrantSettings `json:"implicitGrantSettings,omitempty"`
+}
+
+type aadAppUpdateImplicitGrantSettings struct {
+	EnableIDTokenIssuance     bool `json:"enableIdTokenIssuance" pulumi:"enableIdTokenIssuance"`
+	EnableAccessTokenIssuance bool `json:"enableAccessTokenIssuance" pulumi:"enableAccessTokenIssuance"`
+}
+
+type aadAppUpdateRedirectUris struct {
 	RedirectUris []string `json:"redirectUris"`
-	LogoutURL    string   `json:"logoutUrl"`
+}
+
+type prepareAppForWebSignInInputs struct {
+	ObjectID                 string                             `pulumi:"objectId"`
+	HostName                 string                             `pulumi:"hostName"`
+	SpaRedirectPaths         []string                           `pulumi:"spaRedirectPaths"`
+	PublicClientRedirectUris []string                           `pulumi:"publicClientRedirectUris"`
+	IsFallbackPublicClient   bool                               `pulumi:"isFallbackPublicClient"`
+	ImplicitGrantSettings    *aadAppUpdateImplicitGrantSettings `pulumi:"implicitGrantSettings"`
+	ConflictPolicy           string                             `pulumi:"conflictPolicy"`
+	Force                    bool                               `pulumi:"force"`
+	PurgeOnDelete            bool                               `pulumi:"purgeOnDelete"`
+}
+
+type prepareAppForWebSignInState struct {
+	Fingerprint  string                 `pulumi:"fingerprint"`
+	AppliedPatch map[string]interface{} `pulumi:"appliedPatch"`
 }
 
 type aadAppUpdate struct {
-	API            aadAppUpdateAPI `json:"api"`
-	SignInAudience string          `json:"signInAudience"`
-	Web            aadAppUpdateWeb `json:"web"`
+	API                    aadAppUpdateAPI           `json:"api"`
+	SignInAudience         string                    `json:"signInAudience"`
+	Web                    aadAppUpdateWeb           `json:"web"`
+	Spa                    *aadAppUpdateRedirectUris `json:"spa,omitempty"`
+	PublicClient           *aadAppUpdateRedirectUris `json:"publicClient,omitempty"`
+	IsFallbackPublicClient *bool                     `json:"isFallbackPublicClient,omitempty"`
+}
+
+// appUpdateResets are the values written for optional platform settings when they are removed from the inputs, so
+// that the settings previously written by the resource are undone.
+var appUpdateResets = map[string]interface{}{
+	"spa":                    map[string]interface{}{"redirectUris": []interface{}{}},
+	"publicClient":           map[string]interface{}{"redirectUris": []interface{}{}},
+	"isFallbackPublicClient": false,
+}
+
+var implicitGrantSettingsReset = map[string]interface{}{
+	"enableIdTokenIssuance":     false,
+	"enableAccessTokenIssuance": false,
 }
 
 // Create allocates a new instance of the provided resource and returns its unique ID afterwards.
@@ -163,6 +525,11 @@ func (k *knapcodeProvider) Create(ctx context.Context, req *rpc.CreateRequest) (
 	urn := resource.URN(req.GetUrn())
 	ty := urn.Type()
 
+	// During preview the outputs mirror the inputs, leaving any unknown inputs as computed values.
+	if req.GetPreview() {
+		return &rpc.CreateResponse{Properties: req.GetProperties()}, nil
+	}
+
 	inputs, err := plugin.UnmarshalProperties(req.GetProperties(), plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true})
 	if err != nil {
 		return nil, err
@@ -174,7 +541,160 @@ func (k *knapcodeProvider) Create(ctx context.Context, req *rpc.CreateRequest) (
 	switch ty {
 
 	case "knapcode:index:PrepareAppForWebSignIn":
-		result, outputs, err = create(inputs)
+		result, outputs, err = create(urn, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+		err = addDefaultOwners("applications/"+result, k.defaultOwners)
+		if err != nil {
+			return nil, partialCreateError(result, outputs, req.GetProperties(), err)
+		}
+
+	case "knapcode:index:RestoredApplication":
+		result, outputs, err = restoreDeletedApplication(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ApplicationPassword":
+		result, outputs, err = createApplicationPassword(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ApplicationCertificate":
+		result, outputs, err = createApplicationCertificate(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:FederatedIdentityCredential":
+		result, outputs, err = createFederatedIdentityCredential(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ServicePrincipal":
+		result, outputs, err = createServicePrincipal(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+		err = addDefaultOwners("servicePrincipals/"+result, k.defaultOwners)
+		if err != nil {
+			return nil, partialCreateError(result, outputs, req.GetProperties(), err)
+		}
+
+	case "knapcode:index:Application":
+		result, outputs, err = createApplication(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+		err = addDefaultOwners("applications/"+result, k.defaultOwners)
+		if err != nil {
+			return nil, partialCreateError(result, outputs, req.GetProperties(), err)
+		}
+
+	case "knapcode:index:AppRole":
+		result, outputs, err = createAppRole(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:AppRoleAssignment":
+		result, outputs, err = createAppRoleAssignment(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ApiPermissionGrant":
+		result, outputs, err = createAPIPermissionGrant(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ExposeApi":
+		result, outputs, err = createExposeAPI(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:RequiredResourceAccess":
+		result, outputs, err = createRequiredResourceAccess(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:TokenConfiguration":
+		result, outputs, err = createTokenConfiguration(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ApplicationOwner":
+		result, outputs, err = createApplicationOwner(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:Group":
+		result, outputs, err = createGroup(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:GroupMember":
+		result, outputs, err = createGroupMember(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:DirectoryRoleAssignment":
+		result, outputs, err = createDirectoryRoleAssignment(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ClaimsMappingPolicy":
+		result, outputs, err = createPolicy(claimsMappingPolicyKind, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ClaimsMappingPolicyAssignment":
+		result, outputs, err = createPolicyAssignment(claimsMappingPolicyKind, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:TokenLifetimePolicy":
+		result, outputs, err = createPolicy(tokenLifetimePolicyKind, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:TokenLifetimePolicyAssignment":
+		result, outputs, err = createPolicyAssignment(tokenLifetimePolicyKind, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ExtensionProperty":
+		result, outputs, err = createExtensionProperty(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ApplicationLogo":
+		result, outputs, err = createApplicationLogo(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ApplicationBranding":
+		result, outputs, err = createApplicationBranding(inputs)
 		if err != nil {
 			return nil, err
 		}
@@ -184,10 +704,7 @@ func (k *knapcodeProvider) Create(ctx context.Context, req *rpc.CreateRequest) (
 
 	}
 
-	outputProperties, err := plugin.MarshalProperties(
-		resource.NewPropertyMapFromMap(outputs),
-		plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true},
-	)
+	outputProperties, err := marshalOutputs(outputs, req.GetProperties())
 
 	if err != nil {
 		return nil, err
@@ -201,7 +718,177 @@ func (k *knapcodeProvider) Create(ctx context.Context, req *rpc.CreateRequest) (
 
 // Read the current live state associated with a resource.
 func (k *knapcodeProvider) Read(ctx context.Context, req *rpc.ReadRequest) (*rpc.ReadResponse, error) {
-	panic("Read not implemented.")
+	urn := resource.URN(req.GetUrn())
+	ty := urn.Type()
+
+	state, err := plugin.UnmarshalProperties(req.GetProperties(), plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true, KeepSecrets: true})
+	if err != nil {
+		return nil, err
+	}
+
+	inputs, err := plugin.UnmarshalProperties(req.GetInputs(), plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true, KeepSecrets: true})
+	if err != nil {
+		return nil, err
+	}
+
+	var id string
+	var outputs map[string]interface{}
+	var readInputs map[string]interface{}
+
+	switch ty {
+
+	case "knapcode:index:FederatedIdentityCredential":
+		id, outputs, readInputs, err = readFederatedIdentityCredential(req.GetId(), state, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ServicePrincipal":
+		id, outputs, readInputs, err = readServicePrincipal(req.GetId(), state, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:Application":
+		id, outputs, readInputs, err = readApplication(k.spec, req.GetId(), state, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:AppRole":
+		id, outputs, readInputs, err = readAppRole(req.GetId(), state, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:AppRoleAssignment":
+		id, outputs, readInputs, err = readAppRoleAssignment(req.GetId(), state, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ApiPermissionGrant":
+		id, outputs, readInputs, err = readAPIPermissionGrant(req.GetId(), state, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ExposeApi":
+		id, outputs, readInputs, err = readExposeAPI(req.GetId(), state, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:RequiredResourceAccess":
+		id, outputs, readInputs, err = readRequiredResourceAccess(req.GetId(), state, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:TokenConfiguration":
+		id, outputs, readInputs, err = readTokenConfiguration(k.spec, req.GetId(), state, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ApplicationOwner":
+		id, outputs, readInputs, err = readApplicationOwner(req.GetId(), state, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:Group":
+		id, outputs, readInputs, err = readGroup(req.GetId(), state, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:GroupMember":
+		id, outputs, readInputs, err = readGroupMember(req.GetId(), state, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:DirectoryRoleAssignment":
+		id, outputs, readInputs, err = readDirectoryRoleAssignment(req.GetId(), state, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ClaimsMappingPolicy":
+		id, outputs, readInputs, err = readPolicy(claimsMappingPolicyKind, req.GetId(), state, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ClaimsMappingPolicyAssignment":
+		id, outputs, readInputs, err = readPolicyAssignment(claimsMappingPolicyKind, req.GetId(), state, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:TokenLifetimePolicy":
+		id, outputs, readInputs, err = readPolicy(tokenLifetimePolicyKind, req.GetId(), state, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:TokenLifetimePolicyAssignment":
+		id, outputs, readInputs, err = readPolicyAssignment(tokenLifetimePolicyKind, req.GetId(), state, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ExtensionProperty":
+		id, outputs, readInputs, err = readExtensionProperty(req.GetId(), state, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ApplicationLogo":
+		id, outputs, readInputs, err = readApplicationLogo(req.GetId(), state, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ApplicationBranding":
+		id, outputs, readInputs, err = readApplicationBranding(req.GetId(), state, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:PrepareAppForWebSignIn",
+		"knapcode:index:RestoredApplication",
+		"knapcode:index:ApplicationPassword",
+		"knapcode:index:ApplicationCertificate":
+		// These resources can't be refreshed yet, so the current state is kept as is.
+		return &rpc.ReadResponse{Id: req.GetId(), Properties: req.GetProperties(), Inputs: req.GetInputs()}, nil
+
+	default:
+		return nil, fmt.Errorf("Read: unknown resource type '%s'", ty)
+
+	}
+
+	// An empty ID means the resource no longer exists.
+	if id == "" {
+		return &rpc.ReadResponse{}, nil
+	}
+
+	outputProperties, err := marshalOutputs(outputs, req.GetProperties())
+	if err != nil {
+		return nil, err
+	}
+
+	inputProperties, err := marshalOutputs(readInputs, req.GetInputs())
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.ReadResponse{
+		Id:         id,
+		Properties: outputProperties,
+		Inputs:     inputProperties,
+	}, nil
 }
 
 // Update updates an existing resource with new values.
@@ -209,39 +896,145 @@ func (k *knapcodeProvider) Update(ctx context.Context, req *rpc.UpdateRequest) (
 	urn := resource.URN(req.GetUrn())
 	ty := urn.Type()
 
+	// During preview the outputs mirror the inputs, leaving any unknown inputs as computed values.
+	if req.GetPreview() {
+		return &rpc.UpdateResponse{Properties: req.GetNews()}, nil
+	}
+
 	olds, err := plugin.UnmarshalProperties(req.GetOlds(), plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true})
 	if err != nil {
 		return nil, err
 	}
 
-	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true})
-	if err != nil {
-		return nil, err
-	}
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true})
+	if err != nil {
+		return nil, err
+	}
+
+	var outputs map[string]interface{}
+
+	switch ty {
+
+	case "knapcode:index:PrepareAppForWebSignIn":
+		d := olds.Diff(news)
+		if d != nil && d.Changed("objectId") {
+			err = delete(urn, olds)
+			if err != nil {
+				return nil, err
+			}
+
+			_, outputs, err = create(urn, news)
+			if err != nil {
+				return nil, err
+			}
+
+			err = addDefaultOwners("applications/"+news["objectId"].StringValue(), k.defaultOwners)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			// The patch is re-applied even when the inputs are unchanged since Diff may have found changes made
+			// outside of Pulumi.
+			outputs, err = k.update(ctx, urn, olds, news)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+	case "knapcode:index:RestoredApplication",
+		"knapcode:index:ApplicationPassword",
+		"knapcode:index:AppRoleAssignment",
+		"knapcode:index:ApplicationOwner",
+		"knapcode:index:GroupMember",
+		"knapcode:index:DirectoryRoleAssignment",
+		"knapcode:index:ClaimsMappingPolicyAssignment",
+		"knapcode:index:TokenLifetimePolicyAssignment",
+		"knapcode:index:ExtensionProperty":
+		// Every input change replaces the resource, so there is nothing to update.
+		outputs = olds.Mappable()
+
+	case "knapcode:index:FederatedIdentityCredential":
+		outputs, err = updateFederatedIdentityCredential(olds, news)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ApplicationCertificate":
+		outputs, err = updateApplicationCertificate(olds, news)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ServicePrincipal":
+		outputs, err = updateServicePrincipal(olds, news)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:Application":
+		outputs, err = updateApplication(olds, news)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:AppRole":
+		outputs, err = updateAppRole(news)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ApiPermissionGrant":
+		outputs, err = updateAPIPermissionGrant(olds, news)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ExposeApi":
+		outputs, err = updateExposeAPI(news)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:RequiredResourceAccess":
+		outputs, err = updateRequiredResourceAccess(olds, news)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:TokenConfiguration":
+		outputs, err = updateTokenConfiguration(news)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:Group":
+		outputs, err = updateGroup(olds, news)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ClaimsMappingPolicy":
+		outputs, err = updatePolicy(claimsMappingPolicyKind, olds, news)
+		if err != nil {
+			return nil, err
+		}
 
-	var outputs map[string]interface{}
+	case "knapcode:index:TokenLifetimePolicy":
+		outputs, err = updatePolicy(tokenLifetimePolicyKind, olds, news)
+		if err != nil {
+			return nil, err
+		}
 
-	switch ty {
+	case "knapcode:index:ApplicationLogo":
+		outputs, err = uploadApplicationLogo(news)
+		if err != nil {
+			return nil, err
+		}
 
-	case "knapcode:index:PrepareAppForWebSignIn":
-		d := olds.Diff(news)
-		if d != nil {
-			if d.Changed("objectId") {
-				err = delete(olds)
-				if err != nil {
-					return nil, err
-				}
-
-				_, outputs, err = create(news)
-				if err != nil {
-					return nil, err
-				}
-			} else if d.Changed("hostName") {
-				_, outputs, err = create(news)
-				if err != nil {
-					return nil, err
-				}
-			}
+	case "knapcode:index:ApplicationBranding":
+		outputs, err = updateApplicationBranding(news)
+		if err != nil {
+			return nil, err
 		}
 
 	default:
@@ -249,10 +1042,7 @@ func (k *knapcodeProvider) Update(ctx context.Context, req *rpc.UpdateRequest) (
 
 	}
 
-	outputProperties, err := plugin.MarshalProperties(
-		resource.NewPropertyMapFromMap(outputs),
-		plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true},
-	)
+	outputProperties, err := marshalOutputs(outputs, req.GetNews())
 
 	if err != nil {
 		return nil, err
@@ -277,7 +1067,140 @@ func (k *knapcodeProvider) Delete(ctx context.Context, req *rpc.DeleteRequest) (
 	switch ty {
 
 	case "knapcode:index:PrepareAppForWebSignIn":
-		err = delete(inputs)
+		err = delete(urn, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:RestoredApplication":
+		// The restored application is left in place. Deleting it is up to whichever resource manages it.
+
+	case "knapcode:index:ApplicationPassword":
+		err = deleteApplicationPassword(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ApplicationCertificate":
+		err = deleteApplicationCertificate(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:FederatedIdentityCredential":
+		err = deleteFederatedIdentityCredential(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ServicePrincipal":
+		err = deleteServicePrincipal(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:Application":
+		err = deleteApplication(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:AppRole":
+		err = deleteAppRole(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:AppRoleAssignment":
+		err = deleteAppRoleAssignment(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ApiPermissionGrant":
+		err = deleteAPIPermissionGrant(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ExposeApi":
+		err = deleteExposeAPI(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:RequiredResourceAccess":
+		err = deleteRequiredResourceAccess(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:TokenConfiguration":
+		err = deleteTokenConfiguration(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ApplicationOwner":
+		err = deleteApplicationOwner(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:Group":
+		err = deleteGroup(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:GroupMember":
+		err = deleteGroupMember(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:DirectoryRoleAssignment":
+		err = deleteDirectoryRoleAssignment(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ClaimsMappingPolicy":
+		err = deletePolicy(claimsMappingPolicyKind, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ClaimsMappingPolicyAssignment":
+		err = deletePolicyAssignment(claimsMappingPolicyKind, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:TokenLifetimePolicy":
+		err = deletePolicy(tokenLifetimePolicyKind, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:TokenLifetimePolicyAssignment":
+		err = deletePolicyAssignment(tokenLifetimePolicyKind, inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ExtensionProperty":
+		err = deleteExtensionProperty(inputs)
+		if err != nil {
+			return nil, err
+		}
+
+	case "knapcode:index:ApplicationLogo":
+		// Microsoft Graph can't remove the logo of an application, so it stays until it is replaced or the
+		// application is deleted.
+
+	case "knapcode:index:ApplicationBranding":
+		err = deleteApplicationBranding(inputs)
 		if err != nil {
 			return nil, err
 		}
@@ -304,7 +1227,7 @@ func (k *knapcodeProvider) GetPluginInfo(context.Context, *pbempty.Empty) (*rpc.
 
 // GetSchema returns the JSON-serialized schema for the provider.
 func (k *knapcodeProvider) GetSchema(ctx context.Context, req *rpc.GetSchemaRequest) (*rpc.GetSchemaResponse, error) {
-	return &rpc.GetSchemaResponse{}, nil
+	return &rpc.GetSchemaResponse{Schema: string(k.schema)}, nil
 }
 
 // Cancel signals the provider to gracefully shut down and abort any ongoing resource operations.
@@ -317,27 +1240,180 @@ func (k *knapcodeProvider) Cancel(context.Context, *pbempty.Empty) (*pbempty.Emp
 	return &pbempty.Empty{}, nil
 }
 
-func create(inputs resource.PropertyMap) (string, map[string]interface{}, error) {
+func create(urn resource.URN, inputs resource.PropertyMap) (string, map[string]interface{}, error) {
+
+	var args prepareAppForWebSignInInputs
+	err := decodeInputs(inputs, &args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	objectID := args.ObjectID
+
+	err = waitForApp(objectID, true)
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	patch, err := desiredAppUpdate(args, nil)
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	return applyAppUpdate(urn, args, patch, patch)
+}
+
+// update re-applies the application patch, first checking whether the application was changed outside of Pulumi
+// since it was last written. The resource's conflict policy decides whether those changes are overwritten, merged or
+// reported as an error.
+func (k *knapcodeProvider) update(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap) (map[string]interface{}, error) {
+	var args prepareAppForWebSignInInputs
+	err := decodeInputs(news, &args)
+	if err != nil {
+		return nil, err
+	}
+
+	var state prepareAppForWebSignInState
+	err = decodeInputs(olds, &state)
+	if err != nil {
+		return nil, err
+	}
+
+	err = waitForApp(args.ObjectID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	desired, err := desiredAppUpdate(args, state.AppliedPatch)
+	if err != nil {
+		return nil, err
+	}
+
+	selected := map[string]interface{}{}
+	for k, v := range state.AppliedPatch {
+		selected[k] = v
+	}
+	for k, v := range desired {
+		selected[k] = v
+	}
+
+	var live map[string]interface{}
+	_, err = graphGet(fmt.Sprintf("applications/%s?$select=%s", args.ObjectID, strings.Join(sortedKeys(selected), ",")), &live)
+	if err != nil {
+		return nil, err
+	}
+
+	patch, changes, err := reconcileAppUpdate(args.ConflictPolicy, state.Fingerprint, state.AppliedPatch, live, desired)
+	if err != nil {
+		return nil, err
+	}
+
+	if changes != nil {
+		message := formatExternalChanges(args.ObjectID, changes)
+
+		switch args.ConflictPolicy {
+		case conflictPolicyFail:
+			return nil, fmt.Errorf("%s\nSet conflictPolicy to 'overwrite' or 'merge' to apply the update anyway", message)
+		case conflictPolicyMerge:
+			err = k.host.Log(ctx, diag.Warning, urn, message+"\nThese changes are merged with the update")
+		default:
+			err = k.host.Log(ctx, diag.Warning, urn, message+"\nThese changes are overwritten by the update")
+		}
 
-	if !inputs["objectId"].IsString() {
-		return "", nil, fmt.Errorf("expected input property 'objectId' of type 'string' but got '%s", inputs["string"].TypeString())
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	if !inputs["hostName"].IsString() {
-		return "", nil, fmt.Errorf("expected input property 'hostName' of type 'string' but got '%s", inputs["string"].TypeString())
+	// The program's patch is recorded rather than the merged one, so that the next update finds the merged changes
+	// again and keeps them instead of overwriting them.
+	_, outputs, err := applyAppUpdate(urn, args, desired, changedSections(live, patch))
+	return outputs, err
+}
+
+// changedSections returns the top-level settings of the patch that differ from the live application, so that an
+// update of one platform, like the SPA redirect URIs, does not rewrite the others.
+func changedSections(live, patch map[string]interface{}) map[string]interface{} {
+	projected := projectOnto(live, patch)
+
+	changed := map[string]interface{}{}
+	for k, v := range patch {
+		if !reflect.DeepEqual(projected[k], v) {
+			changed[k] = v
+		}
 	}
 
-	objectID := inputs["objectId"].StringValue()
+	return changed
+}
+
+// applyAppUpdate sends the changed settings, marks the application as managed by the resource and records the whole
+// patch and its fingerprint so later updates can detect changes made outside of Pulumi.
+func applyAppUpdate(urn resource.URN, args prepareAppForWebSignInInputs, patch, changed map[string]interface{}) (string, map[string]interface{}, error) {
+	if len(changed) > 0 {
+		err := graphRequest("PATCH", fmt.Sprintf("applications/%s", args.ObjectID), changed, nil)
+		if err != nil {
+			return "", nil, err
+		}
+	}
 
-	err := waitForApp(objectID, true)
+	err := stampOwnership(args.ObjectID, urn)
+	if err != nil {
+		return "", nil, err
+	}
 
+	patchFingerprint, err := fingerprint(patch)
 	if err != nil {
 		return "", nil, err
 	}
 
-	hostName := inputs["hostName"].StringValue()
+	outputs := map[string]interface{}{
+		"objectId":     args.ObjectID,
+		"hostName":     args.HostName,
+		"fingerprint":  patchFingerprint,
+		"appliedPatch": patch,
+	}
+
+	if len(args.SpaRedirectPaths) > 0 {
+		outputs["spaRedirectPaths"] = toInterfaceSlice(args.SpaRedirectPaths)
+	}
+
+	if len(args.PublicClientRedirectUris) > 0 {
+		outputs["publicClientRedirectUris"] = toInterfaceSlice(args.PublicClientRedirectUris)
+	}
+
+	if args.IsFallbackPublicClient {
+		outputs["isFallbackPublicClient"] = args.IsFallbackPublicClient
+	}
+
+	if args.ImplicitGrantSettings != nil {
+		outputs["implicitGrantSettings"] = map[string]interface{}{
+			"enableIdTokenIssuance":     args.ImplicitGrantSettings.EnableIDTokenIssuance,
+			"enableAccessTokenIssuance": args.ImplicitGrantSettings.EnableAccessTokenIssuance,
+		}
+	}
+
+	if args.ConflictPolicy != "" {
+		outputs["conflictPolicy"] = args.ConflictPolicy
+	}
+
+	if args.Force {
+		outputs["force"] = args.Force
+	}
+
+	if args.PurgeOnDelete {
+		outputs["purgeOnDelete"] = args.PurgeOnDelete
+	}
+
+	return args.ObjectID, outputs, nil
+}
 
-	jsonBytes, err := json.Marshal(aadAppUpdate{
+// newAppUpdate builds the application patch that prepares an app registration for sign-in on the given host. The
+// SPA and public client platforms are only included when they are configured.
+func newAppUpdate(args prepareAppForWebSignInInputs) aadAppUpdate {
+	hostName := args.HostName
+	update := aadAppUpdate{
 		API: aadAppUpdateAPI{
 			RequestAccessTokenVersion: 2,
 		},
@@ -347,39 +1423,88 @@ func create(inputs resource.PropertyMap) (string, map[string]interface{}, error)
 			RedirectUris: []string{
 				fmt.Sprintf("https://%s/signin-oidc", hostName),
 			},
-			LogoutURL: fmt.Sprintf("https://%s/signout-oidc", hostName),
+			LogoutURL:             fmt.Sprintf("https://%s/signout-oidc", hostName),
+			ImplicitGrantSettings: args.ImplicitGrantSettings,
 		},
-	})
+	}
 
-	if err != nil {
-		return "", nil, err
+	if len(args.SpaRedirectPaths) > 0 {
+		update.Spa = &aadAppUpdateRedirectUris{RedirectUris: []string{}}
+		for _, path := range args.SpaRedirectPaths {
+			update.Spa.RedirectUris = append(update.Spa.RedirectUris, fmt.Sprintf("https://%s%s", hostName, path))
+		}
 	}
 
-	err = execute("az", "rest",
-		"--method", "PATCH",
-		"--headers", "Content-Type=application/json",
-		"--uri", fmt.Sprintf("https://graph.microsoft.com/v1.0/applications/%s", objectID),
-		"--body", string(jsonBytes),
-		"--verbose")
+	if len(args.PublicClientRedirectUris) > 0 || args.IsFallbackPublicClient {
+		update.PublicClient = &aadAppUpdateRedirectUris{RedirectUris: append([]string{}, args.PublicClientRedirectUris...)}
+		isFallbackPublicClient := args.IsFallbackPublicClient
+		update.IsFallbackPublicClient = &isFallbackPublicClient
+	}
+
+	return update
+}
 
+// desiredAppUpdate builds the application patch for the inputs. Optional platform settings that were previously
+// written but are no longer configured are reset.
+func desiredAppUpdate(args prepareAppForWebSignInInputs, applied map[string]interface{}) (map[string]interface{}, error) {
+	patch, err := toJSONObject(newAppUpdate(args))
 	if err != nil {
-		return "", nil, err
+		return nil, err
 	}
 
-	outputs := map[string]interface{}{
-		"objectId": objectID,
-		"hostName": hostName,
+	for k, reset := range appUpdateResets {
+		if _, wasApplied := applied[k]; wasApplied && patch[k] == nil {
+			patch[k] = reset
+		}
+	}
+
+	appliedWeb, _ := applied["web"].(map[string]interface{})
+	web, _ := patch["web"].(map[string]interface{})
+	if _, wasApplied := appliedWeb["implicitGrantSettings"]; wasApplied && web != nil && web["implicitGrantSettings"] == nil {
+		web["implicitGrantSettings"] = implicitGrantSettingsReset
+	}
+
+	return patch, nil
+}
+
+// checkPrepareAppForWebSignIn makes sure the SPA redirect paths can be appended to the host name.
+func checkPrepareAppForWebSignIn(inputs resource.PropertyMap) []*rpc.CheckFailure {
+	var failures []*rpc.CheckFailure
+
+	paths := inputs["spaRedirectPaths"]
+	if !paths.IsArray() {
+		return nil
+	}
+
+	for i, path := range paths.ArrayValue() {
+		if path.IsString() && !strings.HasPrefix(path.StringValue(), "/") {
+			failures = append(failures, &rpc.CheckFailure{
+				Property: fmt.Sprintf("spaRedirectPaths[%d]", i),
+				Reason:   fmt.Sprintf("the path must start with '/' but got '%s'", path.StringValue()),
+			})
+		}
+	}
+
+	return failures
+}
+
+func toInterfaceSlice(values []string) []interface{} {
+	result := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		result = append(result, v)
 	}
 
-	return objectID, outputs, nil
+	return result
 }
 
-func delete(inputs resource.PropertyMap) error {
-	if !inputs["objectId"].IsString() {
-		return fmt.Errorf("expected input property 'objectId' of type 'string' but got '%s", inputs["string"].TypeString())
+func delete(urn resource.URN, inputs resource.PropertyMap) error {
+	var args prepareAppForWebSignInInputs
+	err := decodeInputs(inputs, &args)
+	if err != nil {
+		return err
 	}
 
-	objectID := inputs["objectId"].StringValue()
+	objectID := args.ObjectID
 
 	notFound, err := isAppNotFound(objectID)
 	if err != nil {
@@ -387,6 +1512,13 @@ func delete(inputs resource.PropertyMap) error {
 	}
 
 	if !notFound {
+		if !args.Force {
+			err = checkOwnership(objectID, urn)
+			if err != nil {
+				return err
+			}
+		}
+
 		err = execute("az", "rest",
 			"--method", "DELETE",
 			"--headers", "Content-Type=application/json",
@@ -408,6 +1540,14 @@ func delete(inputs resource.PropertyMap) error {
 		}
 	}
 
+	if args.PurgeOnDelete {
+		err = purgeDeletedApp(objectID, !notFound)
+
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -437,33 +1577,60 @@ func isAppNotFound(objectID string) (bool, error) {
 func waitForApp(objectID string, waitForAvailable bool) error {
 
 	// Poll for the application to become available.
+	done, err := poll(func() (bool, error) {
+		notFound, err := isAppNotFound(objectID)
+
+		if err != nil {
+			return false, err
+		}
+
+		return waitForAvailable != notFound, nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if done {
+		return nil
+	}
+
+	return fmt.Errorf("application with object ID %s could not be found", objectID)
+}
+
+// poll calls check once a second until it reports that it is done, giving up after 30 attempts. Directory objects
+// take a while to replicate so a read right after a write often does not see the change yet.
+func poll(check func() (bool, error)) (bool, error) {
 	attempt := 0
 	for {
 		attempt++
 
-		notFound, err := isAppNotFound(objectID)
+		done, err := check()
 
 		if err != nil {
-			return err
+			return false, err
 		}
 
-		if waitForAvailable != notFound {
-			return nil
+		if done {
+			return true, nil
 		}
 
 		if attempt < 30 {
 			time.Sleep(1 * time.Second)
-		} else if err != nil {
-			return err
 		} else {
 			break
 		}
 	}
 
-	return fmt.Errorf("application with object ID %s could not be found", objectID)
+	return false, nil
 }
 
 func execute(name string, arg ...string) error {
+	_, err := executeWithOutput(name, arg...)
+	return err
+}
+
+func executeWithOutput(name string, arg ...string) (string, error) {
 	cmd := exec.Command(name, arg...)
 
 	logger.V(9).Infof("Executing command: %v", cmd.Args)
@@ -483,5 +1650,5 @@ func execute(name string, arg ...string) error {
 		err = fmt.Errorf("%s failed with %v\n%v", name, err, stderr.String())
 	}
 
-	return err
+	return stdout.String(), err
 }
@@ -0,0 +1,67 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+)
+
+func TestMarshalOutputs(t *testing.T) {
+	inputs, err := plugin.MarshalProperties(resource.PropertyMap{
+		"objectId": resource.NewStringProperty("00000000-0000-0000-0000-000000000001"),
+		"content":  resource.MakeSecret(resource.NewStringProperty("bG9nbw==")),
+		"tags": resource.NewArrayProperty([]resource.PropertyValue{
+			resource.NewStringProperty("a"),
+			resource.MakeSecret(resource.NewStringProperty("b")),
+		}),
+	}, plugin.MarshalOptions{KeepSecrets: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Like most resources, the outputs copy the inputs after they were decoded without secrets.
+	outputs := map[string]interface{}{
+		"objectId":      "00000000-0000-0000-0000-000000000001",
+		"content":       "bG9nbw==",
+		"tags":          []interface{}{"a", "b"},
+		"contentSha256": "abc",
+		"secretText":    &resource.Secret{Element: resource.NewStringProperty("generated")},
+	}
+
+	marshaled, err := marshalOutputs(outputs, inputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	properties, err := plugin.UnmarshalProperties(marshaled, plugin.MarshalOptions{KeepSecrets: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for k, secret := range map[resource.PropertyKey]bool{
+		"objectId":      false,
+		"content":       true,
+		"tags":          true,
+		"contentSha256": false,
+		"secretText":    true,
+	} {
+		if properties[k].ContainsSecrets() != secret {
+			t.Errorf("expected '%s' to be secret to be %v, got %v", k, secret, properties[k])
+		}
+	}
+}
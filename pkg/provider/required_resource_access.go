@@ -0,0 +1,515 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+var requiredResourceAccessInputs = []string{"resources", "grantAdminConsent"}
+
+type requiredResourceAccessArgs struct {
+	ObjectID          string                 `pulumi:"objectId"`
+	Resources         []requiredResourceArgs `pulumi:"resources"`
+	GrantAdminConsent bool                   `pulumi:"grantAdminConsent"`
+}
+
+type requiredResourceArgs struct {
+	ResourceApp            string   `pulumi:"resourceApp"`
+	DelegatedPermissions   []string `pulumi:"delegatedPermissions"`
+	ApplicationPermissions []string `pulumi:"applicationPermissions"`
+}
+
+type requiredResourceAccessState struct {
+	ObjectID           string             `pulumi:"objectId"`
+	GrantAdminConsent  bool               `pulumi:"grantAdminConsent"`
+	ServicePrincipalID string             `pulumi:"servicePrincipalId"`
+	ResolvedResources  []resolvedResource `pulumi:"resolvedResources"`
+}
+
+// resolvedResource is an API the application requires permissions on, with the permission names resolved to IDs.
+type resolvedResource struct {
+	ResourceAppID string            `pulumi:"resourceAppId"`
+	ResourceID    string            `pulumi:"resourceId"`
+	ScopeIDs      map[string]string `pulumi:"scopeIds"`
+	AppRoleIDs    map[string]string `pulumi:"appRoleIds"`
+}
+
+func (r resolvedResource) toOutput() map[string]interface{} {
+	scopeIDs := map[string]interface{}{}
+	for k, v := range r.ScopeIDs {
+		scopeIDs[k] = v
+	}
+
+	appRoleIDs := map[string]interface{}{}
+	for k, v := range r.AppRoleIDs {
+		appRoleIDs[k] = v
+	}
+
+	return map[string]interface{}{
+		"resourceAppId": r.ResourceAppID,
+		"resourceId":    r.ResourceID,
+		"scopeIds":      scopeIDs,
+		"appRoleIds":    appRoleIDs,
+	}
+}
+
+// toGraph returns the entry of the application's requiredResourceAccess for the API.
+func (r resolvedResource) toGraph() map[string]interface{} {
+	access := []interface{}{}
+	for _, name := range sortedStringKeys(r.ScopeIDs) {
+		access = append(access, map[string]interface{}{"id": r.ScopeIDs[name], "type": "Scope"})
+	}
+	for _, name := range sortedStringKeys(r.AppRoleIDs) {
+		access = append(access, map[string]interface{}{"id": r.AppRoleIDs[name], "type": "Role"})
+	}
+
+	return map[string]interface{}{"resourceAppId": r.ResourceAppID, "resourceAccess": access}
+}
+
+type oauth2PermissionGrant struct {
+	ID          string `json:"id,omitempty"`
+	ClientID    string `json:"clientId,omitempty"`
+	ConsentType string `json:"consentType,omitempty"`
+	ResourceID  string `json:"resourceId,omitempty"`
+	Scope       string `json:"scope"`
+}
+
+// checkRequiredResourceAccess validates the APIs locally and makes sure each one is only listed once.
+func checkRequiredResourceAccess(inputs resource.PropertyMap) []*rpc.CheckFailure {
+	var failures []*rpc.CheckFailure
+
+	resources := inputs["resources"]
+	if !resources.IsArray() {
+		return nil
+	}
+
+	seen := map[string]bool{}
+	for i, r := range resources.ArrayValue() {
+		if !r.IsObject() || !r.ObjectValue()["resourceApp"].IsString() {
+			continue
+		}
+
+		path := fmt.Sprintf("resources[%d].resourceApp", i)
+		appID, err := resolveResourceApp(r.ObjectValue()["resourceApp"].StringValue())
+		if err != nil {
+			failures = append(failures, &rpc.CheckFailure{Property: path, Reason: err.Error()})
+			continue
+		}
+
+		if seen[appID] {
+			failures = append(failures, &rpc.CheckFailure{Property: path, Reason: fmt.Sprintf("the API with app ID %s is listed more than once", appID)})
+		}
+		seen[appID] = true
+	}
+
+	return failures
+}
+
+// resolveResources looks up the service principal of each API and resolves the permission names to IDs.
+func resolveResources(resources []requiredResourceArgs) ([]resolvedResource, error) {
+	resolved := []resolvedResource{}
+	for _, r := range resources {
+		appID, err := resolveResourceApp(r.ResourceApp)
+		if err != nil {
+			return nil, err
+		}
+
+		sp, err := getResourceServicePrincipal(appID)
+		if err != nil {
+			return nil, err
+		}
+
+		scopeIDs := map[string]string{}
+		for _, name := range r.DelegatedPermissions {
+			id, ok := findPermission(sp.Oauth2PermissionScopes, name)
+			if !ok {
+				if _, isRole := findPermission(sp.AppRoles, name); isRole {
+					return nil, fmt.Errorf("'%s' is an application permission of %s, not a delegated permission", name, sp.DisplayName)
+				}
+
+				return nil, fmt.Errorf("%s has no delegated permission named '%s'", sp.DisplayName, name)
+			}
+
+			scopeIDs[name] = id
+		}
+
+		appRoleIDs, err := sp.resolveAppRoles(r.ApplicationPermissions)
+		if err != nil {
+			return nil, err
+		}
+
+		resolved = append(resolved, resolvedResource{
+			ResourceAppID: sp.AppID,
+			ResourceID:    sp.ID,
+			ScopeIDs:      scopeIDs,
+			AppRoleIDs:    appRoleIDs,
+		})
+	}
+
+	return resolved, nil
+}
+
+// findResolvedResource returns the resolved API with the given app ID, or nil if there is none.
+func findResolvedResource(resources []resolvedResource, appID string) *resolvedResource {
+	for i := range resources {
+		if strings.EqualFold(resources[i].ResourceAppID, appID) {
+			return &resources[i]
+		}
+	}
+
+	return nil
+}
+
+// mergeRequiredResourceAccess replaces the requiredResourceAccess entries for the desired and previous APIs with the
+// desired ones. Entries for other APIs are kept.
+func mergeRequiredResourceAccess(live []interface{}, desired, previous []resolvedResource) []interface{} {
+	entries := []interface{}{}
+	for _, e := range live {
+		obj, _ := e.(map[string]interface{})
+		appID, _ := obj["resourceAppId"].(string)
+		if findResolvedResource(desired, appID) == nil && findResolvedResource(previous, appID) == nil {
+			entries = append(entries, e)
+		}
+	}
+
+	for _, r := range desired {
+		entries = append(entries, r.toGraph())
+	}
+
+	return entries
+}
+
+// applyRequiredResourceAccess writes the desired requiredResourceAccess entries to the application, removing the
+// previous ones. It returns the application's app ID. Changes to the requiredResourceAccess of an application are
+// serialized, since the whole collection is written back.
+func applyRequiredResourceAccess(objectID string, desired, previous []resolvedResource) (string, error) {
+	defer lockApplication(objectID)()
+
+	var app struct {
+		AppID                  string        `json:"appId"`
+		RequiredResourceAccess []interface{} `json:"requiredResourceAccess"`
+	}
+	err := graphRequest("GET", fmt.Sprintf("applications/%s?$select=appId,requiredResourceAccess", objectID), nil, &app)
+	if err != nil {
+		return "", err
+	}
+
+	entries := mergeRequiredResourceAccess(app.RequiredResourceAccess, desired, previous)
+	err = graphRequest("PATCH", "applications/"+objectID, map[string]interface{}{"requiredResourceAccess": entries}, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return app.AppID, nil
+}
+
+// mergeScopes adds the scopes in add to the space separated scopes of a delegated permission grant and removes the
+// ones in remove that are not also added. Other scopes, granted by an admin or another resource, are kept in place.
+func mergeScopes(scope string, add, remove []string) string {
+	removed := map[string]bool{}
+	for _, s := range remove {
+		removed[s] = true
+	}
+	for _, s := range add {
+		removed[s] = false
+	}
+
+	seen := map[string]bool{}
+	scopes := []string{}
+	for _, s := range append(strings.Fields(scope), add...) {
+		if !removed[s] && !seen[s] {
+			scopes = append(scopes, s)
+			seen[s] = true
+		}
+	}
+
+	return strings.Join(scopes, " ")
+}
+
+// updateDelegatedGrant adds and removes scopes of the tenant-wide delegated permission grant of the client on the API,
+// keeping the scopes that were granted outside of this resource. The grant is created when it doesn't exist yet and
+// deleted when no scopes are left. Changes to the grants of a client are serialized, since the scopes are written back
+// as a whole.
+func updateDelegatedGrant(clientID, resourceID string, add, remove []string) error {
+	defer lockApplication(clientID)()
+
+	var grants []oauth2PermissionGrant
+	err := graphList("oauth2PermissionGrants?"+graphFilter("clientId eq %s", odataString(clientID)), &grants)
+	if err != nil {
+		return err
+	}
+
+	var existing *oauth2PermissionGrant
+	for i, g := range grants {
+		if g.ConsentType == "AllPrincipals" && strings.EqualFold(g.ResourceID, resourceID) {
+			existing = &grants[i]
+		}
+	}
+
+	switch {
+	case existing == nil && len(add) == 0:
+		return nil
+	case existing == nil:
+		return graphRequest("POST", "oauth2PermissionGrants", oauth2PermissionGrant{
+			ClientID:    clientID,
+			ConsentType: "AllPrincipals",
+			ResourceID:  resourceID,
+			Scope:       mergeScopes("", add, nil),
+		}, nil)
+	}
+
+	scope := mergeScopes(existing.Scope, add, remove)
+
+	switch {
+	case scope == "":
+		err = graphRequest("DELETE", "oauth2PermissionGrants/"+existing.ID, nil, nil)
+		if err != nil && !isNotFoundError(err) {
+			return err
+		}
+		return nil
+	case scope != strings.Join(strings.Fields(existing.Scope), " "):
+		return graphRequest("PATCH", "oauth2PermissionGrants/"+existing.ID, oauth2PermissionGrant{Scope: scope}, nil)
+	default:
+		return nil
+	}
+}
+
+// grantAdminConsent grants the delegated and application permissions of the desired APIs to the client service
+// principal for the whole tenant, and revokes the ones that were previously granted and are no longer desired.
+func grantAdminConsent(clientID string, desired, previous []resolvedResource) error {
+	for _, r := range previous {
+		if findResolvedResource(desired, r.ResourceAppID) == nil {
+			err := revokeAdminConsent(clientID, r)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, r := range desired {
+		var previousAppRoleIDs, previousScopeIDs map[string]string
+		if p := findResolvedResource(previous, r.ResourceAppID); p != nil {
+			previousAppRoleIDs = p.AppRoleIDs
+			previousScopeIDs = p.ScopeIDs
+		}
+
+		err := syncAppRoleAssignments(clientID, r.ResourceID, r.AppRoleIDs, previousAppRoleIDs)
+		if err != nil {
+			return err
+		}
+
+		err = updateDelegatedGrant(clientID, r.ResourceID, sortedStringKeys(r.ScopeIDs), sortedStringKeys(previousScopeIDs))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func revokeAdminConsent(clientID string, r resolvedResource) error {
+	err := updateDelegatedGrant(clientID, r.ResourceID, nil, sortedStringKeys(r.ScopeIDs))
+	if err != nil {
+		return err
+	}
+
+	return syncAppRoleAssignments(clientID, r.ResourceID, nil, r.AppRoleIDs)
+}
+
+// waitForServicePrincipal waits for the service principal of an application to be available.
+func waitForServicePrincipal(appID string) (*resourceServicePrincipal, error) {
+	var sp *resourceServicePrincipal
+	done, err := poll(func() (bool, error) {
+		var found bool
+		var err error
+		sp, found, err = findServicePrincipal(appID)
+		return found, err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !done {
+		return nil, fmt.Errorf("no service principal was found for app ID %s, admin consent needs one", appID)
+	}
+
+	return sp, nil
+}
+
+func requiredResourceAccessOutputs(inputs resource.PropertyMap, appID, servicePrincipalID string, resolved []resolvedResource) map[string]interface{} {
+	resources := []interface{}{}
+	for _, r := range resolved {
+		resources = append(resources, r.toOutput())
+	}
+
+	outputs := inputs.Mappable()
+	outputs["appId"] = appID
+	outputs["resolvedResources"] = resources
+	outputs["servicePrincipalId"] = nil
+	if servicePrincipalID != "" {
+		outputs["servicePrincipalId"] = servicePrincipalID
+	}
+
+	return outputs
+}
+
+// createRequiredResourceAccess sets the required permissions once the application is available and, in consent mode,
+// grants them for the whole tenant.
+func createRequiredResourceAccess(inputs resource.PropertyMap) (string, map[string]interface{}, error) {
+	var args requiredResourceAccessArgs
+	err := decodeInputs(inputs, &args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	resolved, err := resolveResources(args.Resources)
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = waitForApp(args.ObjectID, true)
+	if err != nil {
+		return "", nil, err
+	}
+
+	appID, err := applyRequiredResourceAccess(args.ObjectID, resolved, nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	servicePrincipalID := ""
+	if args.GrantAdminConsent {
+		sp, err := waitForServicePrincipal(appID)
+		if err != nil {
+			return "", nil, err
+		}
+
+		servicePrincipalID = sp.ID
+		err = grantAdminConsent(servicePrincipalID, resolved, nil)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	return args.ObjectID, requiredResourceAccessOutputs(inputs, appID, servicePrincipalID, resolved), nil
+}
+
+// updateRequiredResourceAccess applies the new required permissions and brings the admin consent in line with them.
+func updateRequiredResourceAccess(olds, news resource.PropertyMap) (map[string]interface{}, error) {
+	var state requiredResourceAccessState
+	err := decodeInputs(olds, &state)
+	if err != nil {
+		return nil, err
+	}
+
+	var args requiredResourceAccessArgs
+	err = decodeInputs(news, &args)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved, err := resolveResources(args.Resources)
+	if err != nil {
+		return nil, err
+	}
+
+	appID, err := applyRequiredResourceAccess(args.ObjectID, resolved, state.ResolvedResources)
+	if err != nil {
+		return nil, err
+	}
+
+	servicePrincipalID := ""
+	switch {
+	case args.GrantAdminConsent:
+		sp, err := waitForServicePrincipal(appID)
+		if err != nil {
+			return nil, err
+		}
+
+		var previous []resolvedResource
+		if state.GrantAdminConsent {
+			previous = state.ResolvedResources
+		}
+
+		servicePrincipalID = sp.ID
+		err = grantAdminConsent(servicePrincipalID, resolved, previous)
+		if err != nil {
+			return nil, err
+		}
+	case state.GrantAdminConsent:
+		for _, r := range state.ResolvedResources {
+			err = revokeAdminConsent(state.ServicePrincipalID, r)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return requiredResourceAccessOutputs(news, appID, servicePrincipalID, resolved), nil
+}
+
+// readRequiredResourceAccess only checks that the application still exists. The permissions are resolved by name
+// when they are applied, so the state is kept as is.
+func readRequiredResourceAccess(id string, state, inputs resource.PropertyMap) (string, map[string]interface{}, map[string]interface{}, error) {
+	if len(inputs) == 0 {
+		return "", nil, nil, fmt.Errorf("required resource access can't be imported, add the resource to the program instead")
+	}
+
+	var app struct {
+		ID string `json:"id"`
+	}
+	found, err := graphGet(fmt.Sprintf("applications/%s?$select=id", id), &app)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	if !found {
+		return "", nil, nil, nil
+	}
+
+	return id, state.Mappable(), inputs.Mappable(), nil
+}
+
+// deleteRequiredResourceAccess revokes the admin consent, if it was granted, and removes the required permissions
+// managed by this resource. An application that is already gone is not an error.
+func deleteRequiredResourceAccess(state resource.PropertyMap) error {
+	var args requiredResourceAccessState
+	err := decodeInputs(state, &args)
+	if err != nil {
+		return err
+	}
+
+	if args.GrantAdminConsent {
+		for _, r := range args.ResolvedResources {
+			err = revokeAdminConsent(args.ServicePrincipalID, r)
+			if err != nil && !isNotFoundError(err) {
+				return err
+			}
+		}
+	}
+
+	_, err = applyRequiredResourceAccess(args.ObjectID, nil, args.ResolvedResources)
+	if err != nil && !isNotFoundError(err) {
+		return err
+	}
+
+	return nil
+}
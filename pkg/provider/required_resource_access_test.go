@@ -0,0 +1,154 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+const (
+	testGraphAppID    = "00000003-0000-0000-c000-000000000000"
+	testKeyVaultAppID = "cfa8b339-82a2-471a-a3c9-0fc0be7a4093"
+	testOtherAppID    = "99999999-9999-9999-9999-999999999999"
+)
+
+func TestMergeRequiredResourceAccess(t *testing.T) {
+	graph := resolvedResource{
+		ResourceAppID: testGraphAppID,
+		ScopeIDs:      map[string]string{"User.Read": "scope-1", "openid": "scope-2"},
+		AppRoleIDs:    map[string]string{"User.Read.All": "role-1"},
+	}
+	keyVault := resolvedResource{
+		ResourceAppID: testKeyVaultAppID,
+		ScopeIDs:      map[string]string{"user_impersonation": "scope-3"},
+	}
+
+	other := `{"resourceAppId":"` + testOtherAppID + `","resourceAccess":[{"id":"x","type":"Scope"}]}`
+	liveGraph := `{"resourceAppId":"` + testGraphAppID + `","resourceAccess":[{"id":"old","type":"Scope"}]}`
+	liveKeyVault := `{"resourceAppId":"` + testKeyVaultAppID + `","resourceAccess":[{"id":"scope-3","type":"Scope"}]}`
+	desiredGraph := `{"resourceAppId":"` + testGraphAppID + `","resourceAccess":[` +
+		`{"id":"scope-1","type":"Scope"},{"id":"scope-2","type":"Scope"},{"id":"role-1","type":"Role"}]}`
+	desiredKeyVault := `{"resourceAppId":"` + testKeyVaultAppID + `","resourceAccess":[{"id":"scope-3","type":"Scope"}]}`
+
+	tests := []struct {
+		name     string
+		live     string
+		desired  []resolvedResource
+		previous []resolvedResource
+		expected string
+	}{
+		{
+			"adds to an empty application",
+			`[]`,
+			[]resolvedResource{graph},
+			nil,
+			`[` + desiredGraph + `]`,
+		},
+		{
+			"keeps other APIs",
+			`[` + other + `]`,
+			[]resolvedResource{graph},
+			nil,
+			`[` + other + `,` + desiredGraph + `]`,
+		},
+		{
+			"replaces the entry for a desired API",
+			`[` + liveGraph + `,` + other + `]`,
+			[]resolvedResource{graph},
+			nil,
+			`[` + other + `,` + desiredGraph + `]`,
+		},
+		{
+			"removes the entry for a previous API",
+			`[` + liveGraph + `,` + liveKeyVault + `,` + other + `]`,
+			[]resolvedResource{graph},
+			[]resolvedResource{graph, keyVault},
+			`[` + other + `,` + desiredGraph + `]`,
+		},
+		{
+			"removes all entries of the resource on delete",
+			`[` + liveGraph + `,` + liveKeyVault + `,` + other + `]`,
+			nil,
+			[]resolvedResource{graph, keyVault},
+			`[` + other + `]`,
+		},
+		{
+			"keeps the order of the desired APIs",
+			`[]`,
+			[]resolvedResource{keyVault, graph},
+			nil,
+			`[` + desiredKeyVault + `,` + desiredGraph + `]`,
+		},
+		{
+			"matches app IDs case-insensitively",
+			`[{"resourceAppId":"CFA8B339-82A2-471A-A3C9-0FC0BE7A4093","resourceAccess":[]}]`,
+			[]resolvedResource{keyVault},
+			nil,
+			`[` + desiredKeyVault + `]`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var live, expected []interface{}
+			if err := json.Unmarshal([]byte(test.live), &live); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(test.expected), &expected); err != nil {
+				t.Fatal(err)
+			}
+
+			// Compare the JSON forms, since the desired entries are built from Go values.
+			actual := mergeRequiredResourceAccess(live, test.desired, test.previous)
+			var normalized []interface{}
+			if err := json.Unmarshal([]byte(toJSONString(actual)), &normalized); err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(expected, normalized) {
+				t.Errorf("expected %s, got %s", toJSONString(expected), toJSONString(normalized))
+			}
+		})
+	}
+}
+
+func TestMergeScopes(t *testing.T) {
+	tests := []struct {
+		name     string
+		scope    string
+		add      []string
+		remove   []string
+		expected string
+	}{
+		{"new grant", "", []string{"User.Read", "openid"}, nil, "User.Read openid"},
+		{"keeps scopes granted by others", "profile email", []string{"User.Read"}, nil, "profile email User.Read"},
+		{"already granted", "User.Read profile", []string{"User.Read"}, []string{"User.Read"}, "User.Read profile"},
+		{"removes only the resource's scopes", "User.Read profile openid", []string{"openid"}, []string{"User.Read", "openid"}, "profile openid"},
+		{"removes everything", "User.Read", nil, []string{"User.Read"}, ""},
+		{"removing a scope that is not granted", "profile", nil, []string{"User.Read"}, "profile"},
+		{"normalizes whitespace and duplicates", "  profile  profile email ", nil, nil, "profile email"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := mergeScopes(test.scope, test.add, test.remove)
+			if actual != test.expected {
+				t.Errorf("expected '%s', got '%s'", test.expected, actual)
+			}
+		})
+	}
+}
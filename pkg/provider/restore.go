@@ -0,0 +1,186 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+type restoreDeletedApplicationInputs struct {
+	ObjectID    string `pulumi:"objectId"`
+	DisplayName string `pulumi:"displayName"`
+}
+
+type graphApplication struct {
+	ID          string `json:"id"`
+	AppID       string `json:"appId"`
+	DisplayName string `json:"displayName"`
+}
+
+type graphApplicationList struct {
+	Value []graphApplication `json:"value"`
+}
+
+// checkRestoreDeletedApplication validates the inputs that the schema alone cannot express.
+func checkRestoreDeletedApplication(inputs resource.PropertyMap) []*rpc.CheckFailure {
+	objectID := inputs["objectId"]
+	displayName := inputs["displayName"]
+
+	hasObjectID := objectID.HasValue()
+	hasDisplayName := displayName.HasValue()
+
+	if hasObjectID && hasDisplayName {
+		return []*rpc.CheckFailure{{
+			Property: "displayName",
+			Reason:   "only one of 'objectId' and 'displayName' can be set",
+		}}
+	}
+
+	if !hasObjectID && !hasDisplayName {
+		return []*rpc.CheckFailure{{
+			Property: "objectId",
+			Reason:   "one of 'objectId' and 'displayName' must be set",
+		}}
+	}
+
+	return nil
+}
+
+// diffRestoreDeletedApplication returns the inputs that identify a different application than the one that was
+// restored. The outputs always include both the object ID and display name, so only inputs that are set are compared.
+func diffRestoreDeletedApplication(olds, news resource.PropertyMap) ([]string, map[string]*rpc.PropertyDiff) {
+	replaces := []string{}
+	detailedDiff := map[string]*rpc.PropertyDiff{}
+
+	for _, k := range []resource.PropertyKey{"objectId", "displayName"} {
+		if news[k].HasValue() && (news[k].ContainsUnknowns() || !news[k].DeepEquals(olds[k])) {
+			replaces = append(replaces, string(k))
+			detailedDiff[string(k)] = &rpc.PropertyDiff{Kind: rpc.PropertyDiff_UPDATE_REPLACE, InputDiff: true}
+		}
+	}
+
+	return replaces, detailedDiff
+}
+
+// findDeletedApplication finds the application by object ID, either live or in the directory's deleted items, or the
+// deleted application with the display name. The returned boolean is true if the application is deleted.
+func findDeletedApplication(args restoreDeletedApplicationInputs) (graphApplication, bool, error) {
+	var app graphApplication
+
+	if args.ObjectID != "" {
+		found, err := graphGet(fmt.Sprintf("applications/%s?$select=id,appId,displayName", args.ObjectID), &app)
+		if err != nil {
+			return app, false, err
+		}
+
+		if found {
+			return app, false, nil
+		}
+
+		found, err = graphGet(fmt.Sprintf("directory/deletedItems/%s?$select=id,appId,displayName", args.ObjectID), &app)
+		if err != nil {
+			return app, false, err
+		}
+
+		if !found {
+			return app, false, fmt.Errorf("no application or deleted application with object ID %s could be found", args.ObjectID)
+		}
+
+		return app, true, nil
+	}
+
+	var deleted graphApplicationList
+	err := graphRequest("GET", fmt.Sprintf("directory/deletedItems/microsoft.graph.application?$select=id,appId,displayName&%s",
+		graphFilter("displayName eq %s", odataString(args.DisplayName))), nil, &deleted)
+	if err != nil {
+		return app, false, err
+	}
+
+	if len(deleted.Value) == 0 {
+		return app, false, fmt.Errorf("no deleted application with display name '%s' could be found", args.DisplayName)
+	}
+
+	if len(deleted.Value) > 1 {
+		return app, false, fmt.Errorf("%d deleted applications have the display name '%s', use the 'objectId' input to pick one", len(deleted.Value), args.DisplayName)
+	}
+
+	return deleted.Value[0], true, nil
+}
+
+// getDeletedApplication looks up a deleted application without restoring it. Invokes also run during previews and
+// Pulumi doesn't tell the provider when it is previewing, so this lets a program check for a deleted application
+// without changing the directory.
+func getDeletedApplication(inputs resource.PropertyMap) (map[string]interface{}, error) {
+	var args restoreDeletedApplicationInputs
+	err := decodeInputs(inputs, &args)
+	if err != nil {
+		return nil, err
+	}
+
+	app, deleted, err := findDeletedApplication(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"objectId":    app.ID,
+		"appId":       app.AppID,
+		"displayName": app.DisplayName,
+		"isDeleted":   deleted,
+	}, nil
+}
+
+// restoreDeletedApplication restores an application from the directory's deleted items and waits for it to be
+// available. An application that is not deleted is left as-is, so restoring is safe to repeat. It backs both the
+// restoreDeletedApplication invoke, which also restores during previews, and the RestoredApplication resource.
+func restoreDeletedApplication(inputs resource.PropertyMap) (string, map[string]interface{}, error) {
+	var args restoreDeletedApplicationInputs
+	err := decodeInputs(inputs, &args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	app, deleted, err := findDeletedApplication(args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if deleted {
+		err = restoreDeletedItem(app.ID)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	outputs := map[string]interface{}{
+		"objectId":    app.ID,
+		"appId":       app.AppID,
+		"displayName": app.DisplayName,
+	}
+
+	return app.ID, outputs, nil
+}
+
+func restoreDeletedItem(objectID string) error {
+	err := graphRequest("POST", fmt.Sprintf("directory/deletedItems/%s/restore", objectID), nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return waitForApp(objectID, true)
+}
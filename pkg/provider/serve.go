@@ -21,10 +21,10 @@ import (
 )
 
 // Serve launches the gRPC server for the resource provider.
-func Serve(providerName, version string) {
+func Serve(providerName, version string, schema []byte) {
 	// Start gRPC service.
 	err := provider.Main(providerName, func(host *provider.HostClient) (rpc.ResourceProviderServer, error) {
-		return makeProvider(host, providerName, version)
+		return makeProvider(host, providerName, version, schema)
 	})
 	if err != nil {
 		cmdutil.ExitError(err.Error())
@@ -0,0 +1,240 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+)
+
+const servicePrincipalFields = "id,appId,displayName,accountEnabled,appRoleAssignmentRequired,tags,notes"
+
+var (
+	servicePrincipalInputs = []string{"appRoleAssignmentRequired", "tags", "notes", "accountEnabled"}
+
+	// appNotReplicatedRegexp matches the error returned when the application was created so recently that the service
+	// principal endpoint can't see it yet.
+	appNotReplicatedRegexp = regexp.MustCompile("(?i)does not reference a valid application object")
+
+	servicePrincipalExistsRegexp = regexp.MustCompile("(?i)same value for property appId already exists")
+)
+
+type servicePrincipalArgs struct {
+	AppID                     string   `pulumi:"appId"`
+	AppRoleAssignmentRequired bool     `pulumi:"appRoleAssignmentRequired"`
+	Tags                      []string `pulumi:"tags"`
+	Notes                     string   `pulumi:"notes"`
+	AccountEnabled            *bool    `pulumi:"accountEnabled"`
+}
+
+type servicePrincipalState struct {
+	ObjectID string `pulumi:"objectId"`
+}
+
+type servicePrincipal struct {
+	ID                        string    `json:"id,omitempty"`
+	AppID                     string    `json:"appId,omitempty"`
+	DisplayName               string    `json:"displayName,omitempty"`
+	AccountEnabled            bool      `json:"accountEnabled"`
+	AppRoleAssignmentRequired bool      `json:"appRoleAssignmentRequired"`
+	Tags                      *[]string `json:"tags,omitempty"`
+	Notes                     *string   `json:"notes"`
+}
+
+// toServicePrincipal fills in Microsoft Graph's defaults for the inputs that are not set, so that removing an input
+// resets the setting. Tags are the exception: Microsoft Graph and the Azure portal add tags of their own, like
+// WindowsAzureActiveDirectoryIntegratedApp, so they are only sent when they are set.
+func (args servicePrincipalArgs) toServicePrincipal() servicePrincipal {
+	sp := servicePrincipal{
+		AccountEnabled:            true,
+		AppRoleAssignmentRequired: args.AppRoleAssignmentRequired,
+	}
+
+	if args.AccountEnabled != nil {
+		sp.AccountEnabled = *args.AccountEnabled
+	}
+
+	if args.Tags != nil {
+		tags := args.Tags
+		sp.Tags = &tags
+	}
+
+	if args.Notes != "" {
+		sp.Notes = &args.Notes
+	}
+
+	return sp
+}
+
+// settings returns the managed settings of the service principal, leaving out the ones that have their default value
+// unless they are already tracked in the given properties. Tags are only returned when they are tracked, or when there
+// is nothing tracked yet because the service principal is imported.
+func (sp servicePrincipal) settings(tracked resource.PropertyMap) map[string]interface{} {
+	settings := map[string]interface{}{}
+
+	if !sp.AccountEnabled || tracked.HasValue("accountEnabled") {
+		settings["accountEnabled"] = sp.AccountEnabled
+	}
+
+	if sp.AppRoleAssignmentRequired || tracked.HasValue("appRoleAssignmentRequired") {
+		settings["appRoleAssignmentRequired"] = sp.AppRoleAssignmentRequired
+	}
+
+	if sp.Tags != nil && (tracked.HasValue("tags") || (len(tracked) == 0 && len(*sp.Tags) > 0)) {
+		tags := []interface{}{}
+		for _, t := range *sp.Tags {
+			tags = append(tags, t)
+		}
+		settings["tags"] = tags
+	}
+
+	if sp.Notes != nil || tracked.HasValue("notes") {
+		settings["notes"] = nil
+		if sp.Notes != nil {
+			settings["notes"] = *sp.Notes
+		}
+	}
+
+	return settings
+}
+
+// createServicePrincipal creates the service principal for an application. A new application can take a moment to
+// replicate, so the creation is retried until Microsoft Graph can see the application.
+func createServicePrincipal(inputs resource.PropertyMap) (string, map[string]interface{}, error) {
+	var args servicePrincipalArgs
+	err := decodeInputs(inputs, &args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	body := args.toServicePrincipal()
+	body.AppID = args.AppID
+
+	var created servicePrincipal
+	done, err := poll(func() (bool, error) {
+		err := graphRequest("POST", "servicePrincipals", body, &created)
+		if err != nil {
+			if appNotReplicatedRegexp.MatchString(err.Error()) {
+				return false, nil
+			}
+
+			if servicePrincipalExistsRegexp.MatchString(err.Error()) {
+				return false, fmt.Errorf("a service principal already exists for app ID %s, import it instead: %v", args.AppID, err)
+			}
+
+			return false, err
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	if !done {
+		return "", nil, fmt.Errorf("the application with app ID %s could not be found", args.AppID)
+	}
+
+	err = waitForObject("servicePrincipals/"+created.ID, fmt.Sprintf("service principal with object ID %s", created.ID), true)
+	if err != nil {
+		return "", nil, err
+	}
+
+	outputs := inputs.Mappable()
+	outputs["objectId"] = created.ID
+	outputs["displayName"] = created.DisplayName
+
+	return created.ID, outputs, nil
+}
+
+// updateServicePrincipal applies the managed settings in place.
+func updateServicePrincipal(olds, news resource.PropertyMap) (map[string]interface{}, error) {
+	var state servicePrincipalState
+	err := decodeInputs(olds, &state)
+	if err != nil {
+		return nil, err
+	}
+
+	var args servicePrincipalArgs
+	err = decodeInputs(news, &args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = graphRequest("PATCH", "servicePrincipals/"+state.ObjectID, args.toServicePrincipal(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := news.Mappable()
+	outputs["objectId"] = state.ObjectID
+	outputs["displayName"] = olds["displayName"].Mappable()
+
+	return outputs, nil
+}
+
+// readServicePrincipal refreshes the service principal by its object ID. Settings that are not managed by the
+// resource are only reported when they differ from their default, so they show up as drift.
+func readServicePrincipal(id string, state, inputs resource.PropertyMap) (string, map[string]interface{}, map[string]interface{}, error) {
+	var sp servicePrincipal
+	found, err := graphGet(fmt.Sprintf("servicePrincipals/%s?$select=%s", id, servicePrincipalFields), &sp)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	if !found {
+		return "", nil, nil, nil
+	}
+
+	outputs := state.Mappable()
+	for k, v := range sp.settings(state) {
+		outputs[k] = v
+	}
+	outputs["appId"] = sp.AppID
+	outputs["objectId"] = sp.ID
+	outputs["displayName"] = sp.DisplayName
+
+	readInputs := inputs.Mappable()
+	if len(inputs) == 0 {
+		readInputs = sp.settings(inputs)
+		readInputs["appId"] = sp.AppID
+	}
+
+	return sp.ID, outputs, readInputs, nil
+}
+
+// deleteServicePrincipal deletes the service principal and waits for it to be gone. A service principal that is
+// already gone is not an error.
+func deleteServicePrincipal(state resource.PropertyMap) error {
+	var args servicePrincipalState
+	err := decodeInputs(state, &args)
+	if err != nil {
+		return err
+	}
+
+	err = graphRequest("DELETE", "servicePrincipals/"+args.ObjectID, nil, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	return waitForObject("servicePrincipals/"+args.ObjectID, fmt.Sprintf("service principal with object ID %s", args.ObjectID), false)
+}
@@ -0,0 +1,310 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	pschema "github.com/pulumi/pulumi/pkg/v2/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+// tokenConfigurationFields are the application settings managed by the TokenConfiguration resource. Like for the
+// Application resource, the input names match the Microsoft Graph property names.
+var tokenConfigurationFields = []string{"optionalClaims", "groupMembershipClaims"}
+
+var tokenConfigurationResets = map[string]interface{}{
+	"optionalClaims":        applicationResets["optionalClaims"],
+	"groupMembershipClaims": "None",
+}
+
+// tokenTypes are the kinds of tokens optional claims can be added to, in the order of the optionalClaims settings.
+var tokenTypes = []string{"idToken", "accessToken", "saml2Token"}
+
+// optionalClaimTokenTypes are the built-in optional claims and the kinds of tokens they can be added to.
+var optionalClaimTokenTypes = map[string][]string{
+	"acct":                     tokenTypes,
+	"aud":                      {"accessToken"},
+	"auth_time":                {"idToken", "accessToken"},
+	"ctry":                     {"idToken", "accessToken"},
+	"email":                    tokenTypes,
+	"family_name":              tokenTypes,
+	"fwd":                      {"idToken", "accessToken"},
+	"given_name":               tokenTypes,
+	"groups":                   tokenTypes,
+	"idtyp":                    {"accessToken"},
+	"in_corp":                  {"idToken", "accessToken"},
+	"login_hint":               {"idToken"},
+	"onprem_sid":               tokenTypes,
+	"pwd_exp":                  {"idToken"},
+	"pwd_url":                  {"idToken"},
+	"sid":                      {"idToken", "accessToken"},
+	"tenant_ctry":              tokenTypes,
+	"tenant_region_scope":      tokenTypes,
+	"upn":                      tokenTypes,
+	"verified_primary_email":   {"idToken", "accessToken"},
+	"verified_secondary_email": {"idToken", "accessToken"},
+	"vnet":                     {"idToken", "accessToken"},
+	"xms_cc":                   {"idToken", "accessToken"},
+	"xms_edov":                 tokenTypes,
+	"xms_pdl":                  {"idToken", "accessToken"},
+	"xms_pl":                   {"idToken", "accessToken"},
+	"xms_tpl":                  {"idToken", "accessToken"},
+	"ztdid":                    {"idToken"},
+}
+
+// optionalClaimAdditionalProperties are the additional properties the built-in optional claims accept.
+var optionalClaimAdditionalProperties = map[string][]string{
+	"aud": {"use_guid"},
+	"groups": {
+		"sam_account_name",
+		"dns_domain_and_sam_account_name",
+		"netbios_domain_and_sam_account_name",
+		"emit_as_roles",
+		"cloud_displayname",
+	},
+	"upn": {"include_externally_authenticated_upn", "include_externally_authenticated_upn_without_hash"},
+}
+
+type tokenConfigurationArgs struct {
+	ObjectID              string                         `pulumi:"objectId"`
+	OptionalClaims        map[string][]optionalClaimArgs `pulumi:"optionalClaims"`
+	GroupMembershipClaims string                         `pulumi:"groupMembershipClaims"`
+}
+
+type optionalClaimArgs struct {
+	Name                 string   `pulumi:"name"`
+	Source               string   `pulumi:"source"`
+	AdditionalProperties []string `pulumi:"additionalProperties"`
+}
+
+// checkTokenConfiguration validates the optional claims: built-in claims must exist for the kind of token and only
+// accept their own additional properties, and the groups claim needs group membership claims to be configured.
+func checkTokenConfiguration(inputs resource.PropertyMap) []*rpc.CheckFailure {
+	var failures []*rpc.CheckFailure
+
+	var args tokenConfigurationArgs
+	err := decodeInputs(inputs, &args)
+	if err != nil {
+		return []*rpc.CheckFailure{{Reason: err.Error()}}
+	}
+
+	hasGroupsClaim := false
+	for _, tokenType := range tokenTypes {
+		seen := map[string]bool{}
+		for i, claim := range args.OptionalClaims[tokenType] {
+			path := fmt.Sprintf("optionalClaims.%s[%d]", tokenType, i)
+			if claim.Name == "" {
+				continue
+			}
+
+			if seen[claim.Name] {
+				failures = append(failures, &rpc.CheckFailure{
+					Property: joinPath(path, "name"),
+					Reason:   fmt.Sprintf("the claim '%s' is listed more than once for %s", claim.Name, tokenType),
+				})
+			}
+			seen[claim.Name] = true
+
+			if claim.Source != "" {
+				if claim.Source != "user" {
+					failures = append(failures, &rpc.CheckFailure{
+						Property: joinPath(path, "source"),
+						Reason:   fmt.Sprintf("the source must be 'user' or not set but got '%s'", claim.Source),
+					})
+				}
+
+				continue
+			}
+
+			failures = append(failures, checkOptionalClaim(path, tokenType, claim)...)
+			if claim.Name == "groups" {
+				hasGroupsClaim = true
+			}
+		}
+	}
+
+	if hasGroupsClaim && !inputs["groupMembershipClaims"].ContainsUnknowns() &&
+		(args.GroupMembershipClaims == "" || args.GroupMembershipClaims == "None") {
+		failures = append(failures, &rpc.CheckFailure{
+			Property: "groupMembershipClaims",
+			Reason:   "the 'groups' optional claim needs 'groupMembershipClaims' to be set to a value other than 'None'",
+		})
+	}
+
+	return failures
+}
+
+// checkOptionalClaim validates a built-in optional claim.
+func checkOptionalClaim(path, tokenType string, claim optionalClaimArgs) []*rpc.CheckFailure {
+	var failures []*rpc.CheckFailure
+
+	allowedTokenTypes, ok := optionalClaimTokenTypes[claim.Name]
+	if !ok {
+		return []*rpc.CheckFailure{{
+			Property: joinPath(path, "name"),
+			Reason:   fmt.Sprintf("'%s' is not a built-in optional claim, set 'source' to 'user' for a directory extension", claim.Name),
+		}}
+	}
+
+	allowed := false
+	for _, t := range allowedTokenTypes {
+		allowed = allowed || t == tokenType
+	}
+	if !allowed {
+		failures = append(failures, &rpc.CheckFailure{
+			Property: joinPath(path, "name"),
+			Reason:   fmt.Sprintf("the claim '%s' can't be added to %s, only to %s", claim.Name, tokenType, strings.Join(allowedTokenTypes, ", ")),
+		})
+	}
+
+	properties := optionalClaimAdditionalProperties[claim.Name]
+	for j, p := range claim.AdditionalProperties {
+		valid := false
+		for _, v := range properties {
+			valid = valid || v == p
+		}
+
+		if !valid {
+			reason := fmt.Sprintf("the claim '%s' has no additional properties", claim.Name)
+			if len(properties) > 0 {
+				reason = fmt.Sprintf("'%s' is not an additional property of the claim '%s', the valid values are: %s",
+					p, claim.Name, strings.Join(properties, ", "))
+			}
+
+			failures = append(failures, &rpc.CheckFailure{
+				Property: fmt.Sprintf("%s[%d]", joinPath(path, "additionalProperties"), j),
+				Reason:   reason,
+			})
+		}
+	}
+
+	return failures
+}
+
+// diffTokenConfiguration compares the settings like diffApplication does. Changing the application replaces the
+// resource.
+func diffTokenConfiguration(olds, news resource.PropertyMap) ([]string, []string, map[string]*rpc.PropertyDiff) {
+	diffs, replaces, detailedDiff := diffInputs(olds, news, nil, []string{"objectId"})
+
+	settingDiffs, settingDetailedDiff := diffSettings(olds, news, tokenConfigurationFields, tokenConfigurationResets)
+	diffs = append(diffs, settingDiffs...)
+	for k, v := range settingDetailedDiff {
+		detailedDiff[k] = v
+	}
+
+	return diffs, replaces, detailedDiff
+}
+
+// applyTokenConfiguration writes the optional claims and group membership claims to the application. Settings that
+// are not set are reset.
+func applyTokenConfiguration(objectID string, values map[string]interface{}) error {
+	body := map[string]interface{}{}
+	for _, k := range tokenConfigurationFields {
+		body[k] = withResets(tokenConfigurationResets[k], values[k])
+	}
+
+	return graphRequest("PATCH", "applications/"+objectID, body, nil)
+}
+
+// createTokenConfiguration sets the token configuration once the application is available.
+func createTokenConfiguration(inputs resource.PropertyMap) (string, map[string]interface{}, error) {
+	var args tokenConfigurationArgs
+	err := decodeInputs(inputs, &args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = waitForApp(args.ObjectID, true)
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = applyTokenConfiguration(args.ObjectID, plainProperties(inputs))
+	if err != nil {
+		return "", nil, err
+	}
+
+	return args.ObjectID, inputs.Mappable(), nil
+}
+
+// updateTokenConfiguration applies the new token configuration.
+func updateTokenConfiguration(news resource.PropertyMap) (map[string]interface{}, error) {
+	var args tokenConfigurationArgs
+	err := decodeInputs(news, &args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = applyTokenConfiguration(args.ObjectID, plainProperties(news))
+	if err != nil {
+		return nil, err
+	}
+
+	return news.Mappable(), nil
+}
+
+// readTokenConfiguration refreshes the token configuration of the application with the given object ID.
+func readTokenConfiguration(spec *pschema.PackageSpec, id string, state, inputs resource.PropertyMap) (string, map[string]interface{}, map[string]interface{}, error) {
+	var live map[string]interface{}
+	found, err := graphGet(fmt.Sprintf("applications/%s?$select=%s", id, strings.Join(tokenConfigurationFields, ",")), &live)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	if !found {
+		return "", nil, nil, nil
+	}
+
+	settings := projectOntoSchema(spec, spec.Resources["knapcode:index:TokenConfiguration"].InputProperties, live)
+
+	outputs := state.Mappable()
+	readInputs := map[string]interface{}{"objectId": id}
+	for _, k := range tokenConfigurationFields {
+		v, ok := pruneEmpty(settings[k])
+		if !ok {
+			v = nil
+		}
+
+		outputs[k] = v
+		readInputs[k] = v
+	}
+	outputs["objectId"] = id
+
+	if len(inputs) > 0 {
+		readInputs = inputs.Mappable()
+	}
+
+	return id, outputs, readInputs, nil
+}
+
+// deleteTokenConfiguration removes the optional claims and group membership claims from the application. An
+// application that is already gone is not an error.
+func deleteTokenConfiguration(state resource.PropertyMap) error {
+	var args tokenConfigurationArgs
+	err := decodeInputs(state, &args)
+	if err != nil {
+		return err
+	}
+
+	err = applyTokenConfiguration(args.ObjectID, nil)
+	if err != nil && !isNotFoundError(err) {
+		return err
+	}
+
+	return nil
+}
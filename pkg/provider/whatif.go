@@ -0,0 +1,206 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	logger "github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+	rpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+// unknownPlaceholder stands in for input values that are not known yet (e.g. during preview). Any patch value
+// containing it is reported as changing since its final value cannot be compared.
+const unknownPlaceholder = "\x00unknown\x00"
+
+// diffAppUpdate compares the old and new inputs of an application update resource and, when the application can be
+// found, the live application with the patch that create() would send.
+func diffAppUpdate(olds, news resource.PropertyMap) ([]string, map[string]*rpc.PropertyDiff, error) {
+	diffs := []string{}
+	detailedDiff := map[string]*rpc.PropertyDiff{}
+
+	d := olds.Diff(news)
+	if d != nil {
+		for _, k := range []resource.PropertyKey{
+			"objectId",
+			"hostName",
+			"spaRedirectPaths",
+			"publicClientRedirectUris",
+			"isFallbackPublicClient",
+			"implicitGrantSettings",
+			"conflictPolicy",
+			"force",
+			"purgeOnDelete",
+		} {
+			if d.Changed(k) {
+				diffs = append(diffs, string(k))
+				detailedDiff[string(k)] = &rpc.PropertyDiff{Kind: rpc.PropertyDiff_UPDATE, InputDiff: true}
+			}
+		}
+	}
+
+	// Without an object ID there is no live application to compare with.
+	if !news["objectId"].IsString() {
+		return diffs, detailedDiff, nil
+	}
+
+	objectID := news["objectId"].StringValue()
+
+	var args prepareAppForWebSignInInputs
+	err := decodeInputs(news, &args)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !news["hostName"].IsString() {
+		args.HostName = unknownPlaceholder
+	}
+
+	if news["spaRedirectPaths"].ContainsUnknowns() {
+		args.SpaRedirectPaths = []string{unknownPlaceholder}
+	}
+
+	if news["publicClientRedirectUris"].ContainsUnknowns() {
+		args.PublicClientRedirectUris = []string{unknownPlaceholder}
+	}
+
+	var state prepareAppForWebSignInState
+	err = decodeInputs(olds, &state)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	patch, err := desiredAppUpdate(args, state.AppliedPatch)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	selected := map[string]interface{}{}
+	for k, v := range state.AppliedPatch {
+		selected[k] = v
+	}
+	for k, v := range patch {
+		selected[k] = v
+	}
+
+	var live map[string]interface{}
+	found, err := graphGet(fmt.Sprintf("applications/%s?$select=%s", objectID, strings.Join(sortedKeys(selected), ",")), &live)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !found {
+		// The application may not be replicated yet. create() waits for it, so compare with an empty application.
+		logger.V(9).Infof("application with object ID %s was not found, comparing with an empty application", objectID)
+		live = map[string]interface{}{}
+	}
+
+	// Changes made outside of Pulumi that update() keeps are not reported as changes.
+	if found && args.ConflictPolicy == conflictPolicyMerge {
+		patch, _, err = reconcileAppUpdate(args.ConflictPolicy, state.Fingerprint, state.AppliedPatch, live, patch)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	diffMergePatch("", live, patch, detailedDiff)
+
+	return diffs, detailedDiff, nil
+}
+
+// diffMergePatch records the effect of applying a JSON merge patch (RFC 7386) to a live object. Objects are merged
+// property by property while all other values, including arrays, replace the live value.
+func diffMergePatch(path string, live, patch map[string]interface{}, detailedDiff map[string]*rpc.PropertyDiff) {
+	for _, k := range sortedKeys(patch) {
+		p := joinPath(path, k)
+		patchValue := patch[k]
+		liveValue, has := live[k]
+		has = has && liveValue != nil
+
+		if patchValue == nil {
+			if has {
+				detailedDiff[p] = &rpc.PropertyDiff{Kind: rpc.PropertyDiff_DELETE}
+			}
+			continue
+		}
+
+		patchObject, isPatchObject := patchValue.(map[string]interface{})
+		liveObject, isLiveObject := liveValue.(map[string]interface{})
+		if isPatchObject && (isLiveObject || !has) {
+			if liveObject == nil {
+				liveObject = map[string]interface{}{}
+			}
+			diffMergePatch(p, liveObject, patchObject, detailedDiff)
+			continue
+		}
+
+		if !has {
+			detailedDiff[p] = &rpc.PropertyDiff{Kind: rpc.PropertyDiff_ADD}
+		} else if containsUnknown(patchValue) || !reflect.DeepEqual(liveValue, patchValue) {
+			detailedDiff[p] = &rpc.PropertyDiff{Kind: rpc.PropertyDiff_UPDATE}
+		}
+	}
+}
+
+func containsUnknown(v interface{}) bool {
+	switch v := v.(type) {
+	case string:
+		return strings.Contains(v, unknownPlaceholder)
+	case []interface{}:
+		for _, e := range v {
+			if containsUnknown(e) {
+				return true
+			}
+		}
+	case map[string]interface{}:
+		for _, e := range v {
+			if containsUnknown(e) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// toJSONObject converts a value to the generic form produced by decoding its JSON serialization.
+func toJSONObject(v interface{}) (map[string]interface{}, error) {
+	jsonBytes, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var obj map[string]interface{}
+	err = json.Unmarshal(jsonBytes, &obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+func sortedKeys(obj map[string]interface{}) []string {
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,127 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode
+{
+    /// <summary>
+    /// Grants application permissions of an API, like Microsoft Graph, to a service principal by permission name. The resource ID is the principal's object ID and the API's app ID separated by a slash, which is also the format used to import a grant.
+    /// </summary>
+    [KnapcodeResourceType("knapcode:index:ApiPermissionGrant")]
+    public partial class ApiPermissionGrant : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The IDs of the granted app roles, by permission name.
+        /// </summary>
+        [Output("appRoleIds")]
+        public Output<ImmutableDictionary<string, string>> AppRoleIds { get; private set; } = null!;
+
+        /// <summary>
+        /// The names of the application permissions to grant, e.g. 'User.Read.All'.
+        /// </summary>
+        [Output("permissions")]
+        public Output<ImmutableArray<string>> Permissions { get; private set; } = null!;
+
+        /// <summary>
+        /// The object ID of the service principal, e.g. a managed identity, that is granted the permissions. Changing this replaces the grant.
+        /// </summary>
+        [Output("principalId")]
+        public Output<string> PrincipalId { get; private set; } = null!;
+
+        /// <summary>
+        /// The API, either one of the well-known names 'MicrosoftGraph', 'AzureADGraph', 'AzureKeyVault', 'AzureServiceManagement', 'AzureStorage', 'Office365ExchangeOnline' and 'SharePointOnline', or an app ID. Changing this replaces the grant.
+        /// </summary>
+        [Output("resourceApp")]
+        public Output<string> ResourceApp { get; private set; } = null!;
+
+        /// <summary>
+        /// The app ID of the API.
+        /// </summary>
+        [Output("resourceAppId")]
+        public Output<string> ResourceAppId { get; private set; } = null!;
+
+        /// <summary>
+        /// The object ID of the API's service principal.
+        /// </summary>
+        [Output("resourceId")]
+        public Output<string> ResourceId { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a ApiPermissionGrant resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public ApiPermissionGrant(string name, ApiPermissionGrantArgs args, CustomResourceOptions? options = null)
+            : base("knapcode:index:ApiPermissionGrant", name, args ?? new ApiPermissionGrantArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private ApiPermissionGrant(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("knapcode:index:ApiPermissionGrant", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing ApiPermissionGrant resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static ApiPermissionGrant Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new ApiPermissionGrant(name, id, options);
+        }
+    }
+
+    public sealed class ApiPermissionGrantArgs : Pulumi.ResourceArgs
+    {
+        [Input("permissions", required: true)]
+        private InputList<string>? _permissions;
+
+        /// <summary>
+        /// The names of the application permissions to grant, e.g. 'User.Read.All'.
+        /// </summary>
+        public InputList<string> Permissions
+        {
+            get => _permissions ?? (_permissions = new InputList<string>());
+            set => _permissions = value;
+        }
+
+        /// <summary>
+        /// The object ID of the service principal, e.g. a managed identity, that is granted the permissions. Changing this replaces the grant.
+        /// </summary>
+        [Input("principalId", required: true)]
+        public Input<string> PrincipalId { get; set; } = null!;
+
+        /// <summary>
+        /// The API, either one of the well-known names 'MicrosoftGraph', 'AzureADGraph', 'AzureKeyVault', 'AzureServiceManagement', 'AzureStorage', 'Office365ExchangeOnline' and 'SharePointOnline', or an app ID. Changing this replaces the grant.
+        /// </summary>
+        [Input("resourceApp", required: true)]
+        public Input<string> ResourceApp { get; set; } = null!;
+
+        public ApiPermissionGrantArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,157 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode
+{
+    /// <summary>
+    /// A single app role of an application. The other app roles of the application are left untouched, so roles can be defined from several stacks. The resource ID is the application's object ID and the role ID separated by a slash, which is also the format used to import a role.
+    /// </summary>
+    [KnapcodeResourceType("knapcode:index:AppRole")]
+    public partial class AppRole : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// Who can be assigned the role: 'User' for users and groups, 'Application' for applications, or both.
+        /// </summary>
+        [Output("allowedMemberTypes")]
+        public Output<ImmutableArray<string>> AllowedMemberTypes { get; private set; } = null!;
+
+        /// <summary>
+        /// The description of the role.
+        /// </summary>
+        [Output("description")]
+        public Output<string> Description { get; private set; } = null!;
+
+        /// <summary>
+        /// The display name of the role.
+        /// </summary>
+        [Output("displayName")]
+        public Output<string> DisplayName { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether the role is enabled. Defaults to true.
+        /// </summary>
+        [Output("isEnabled")]
+        public Output<bool> IsEnabled { get; private set; } = null!;
+
+        /// <summary>
+        /// The object ID of the application. Changing this replaces the role.
+        /// </summary>
+        [Output("objectId")]
+        public Output<string> ObjectId { get; private set; } = null!;
+
+        /// <summary>
+        /// The ID of the role. Defaults to a GUID derived from the value. Changing this replaces the role.
+        /// </summary>
+        [Output("roleId")]
+        public Output<string> RoleId { get; private set; } = null!;
+
+        /// <summary>
+        /// The value of the role, which appears in the roles claim of tokens.
+        /// </summary>
+        [Output("value")]
+        public Output<string?> Value { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a AppRole resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public AppRole(string name, AppRoleArgs args, CustomResourceOptions? options = null)
+            : base("knapcode:index:AppRole", name, args ?? new AppRoleArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private AppRole(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("knapcode:index:AppRole", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing AppRole resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static AppRole Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new AppRole(name, id, options);
+        }
+    }
+
+    public sealed class AppRoleArgs : Pulumi.ResourceArgs
+    {
+        [Input("allowedMemberTypes", required: true)]
+        private InputList<string>? _allowedMemberTypes;
+
+        /// <summary>
+        /// Who can be assigned the role: 'User' for users and groups, 'Application' for applications, or both.
+        /// </summary>
+        public InputList<string> AllowedMemberTypes
+        {
+            get => _allowedMemberTypes ?? (_allowedMemberTypes = new InputList<string>());
+            set => _allowedMemberTypes = value;
+        }
+
+        /// <summary>
+        /// The description of the role.
+        /// </summary>
+        [Input("description", required: true)]
+        public Input<string> Description { get; set; } = null!;
+
+        /// <summary>
+        /// The display name of the role.
+        /// </summary>
+        [Input("displayName", required: true)]
+        public Input<string> DisplayName { get; set; } = null!;
+
+        /// <summary>
+        /// Whether the role is enabled. Defaults to true.
+        /// </summary>
+        [Input("isEnabled")]
+        public Input<bool>? IsEnabled { get; set; }
+
+        /// <summary>
+        /// The object ID of the application. Changing this replaces the role.
+        /// </summary>
+        [Input("objectId", required: true)]
+        public Input<string> ObjectId { get; set; } = null!;
+
+        /// <summary>
+        /// The ID of the role. Defaults to a GUID derived from the value. Changing this replaces the role.
+        /// </summary>
+        [Input("roleId")]
+        public Input<string>? RoleId { get; set; }
+
+        /// <summary>
+        /// The value of the role, which appears in the roles claim of tokens.
+        /// </summary>
+        [Input("value")]
+        public Input<string>? Value { get; set; }
+
+        public AppRoleArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,145 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode
+{
+    /// <summary>
+    /// Assigns an app role of an application to a user, group or service principal. The resource ID is the object ID of the resource service principal and the assignment ID separated by a slash, which is also the format used to import an assignment.
+    /// </summary>
+    [KnapcodeResourceType("knapcode:index:AppRoleAssignment")]
+    public partial class AppRoleAssignment : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The value of the app role to assign. Changing this replaces the assignment.
+        /// </summary>
+        [Output("appRole")]
+        public Output<string?> AppRole { get; private set; } = null!;
+
+        /// <summary>
+        /// The ID of the app role to assign, instead of its value. If neither this nor 'appRole' is set, the principal is assigned to the application without a specific role. Changing this replaces the assignment.
+        /// </summary>
+        [Output("appRoleId")]
+        public Output<string?> AppRoleId { get; private set; } = null!;
+
+        /// <summary>
+        /// The ID of the app role assignment.
+        /// </summary>
+        [Output("assignmentId")]
+        public Output<string> AssignmentId { get; private set; } = null!;
+
+        /// <summary>
+        /// The display name of the principal.
+        /// </summary>
+        [Output("principalDisplayName")]
+        public Output<string> PrincipalDisplayName { get; private set; } = null!;
+
+        /// <summary>
+        /// The object ID of the user, group or service principal (e.g. a managed identity) that is assigned the role. Changing this replaces the assignment.
+        /// </summary>
+        [Output("principalId")]
+        public Output<string> PrincipalId { get; private set; } = null!;
+
+        /// <summary>
+        /// The type of the principal: 'User', 'Group' or 'ServicePrincipal'.
+        /// </summary>
+        [Output("principalType")]
+        public Output<string> PrincipalType { get; private set; } = null!;
+
+        /// <summary>
+        /// The ID of the assigned app role.
+        /// </summary>
+        [Output("resolvedAppRoleId")]
+        public Output<string> ResolvedAppRoleId { get; private set; } = null!;
+
+        /// <summary>
+        /// The display name of the resource service principal.
+        /// </summary>
+        [Output("resourceDisplayName")]
+        public Output<string> ResourceDisplayName { get; private set; } = null!;
+
+        /// <summary>
+        /// The object ID of the service principal of the application that defines the app role. Changing this replaces the assignment.
+        /// </summary>
+        [Output("resourceId")]
+        public Output<string> ResourceId { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a AppRoleAssignment resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public AppRoleAssignment(string name, AppRoleAssignmentArgs args, CustomResourceOptions? options = null)
+            : base("knapcode:index:AppRoleAssignment", name, args ?? new AppRoleAssignmentArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private AppRoleAssignment(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("knapcode:index:AppRoleAssignment", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing AppRoleAssignment resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static AppRoleAssignment Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new AppRoleAssignment(name, id, options);
+        }
+    }
+
+    public sealed class AppRoleAssignmentArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The value of the app role to assign. Changing this replaces the assignment.
+        /// </summary>
+        [Input("appRole")]
+        public Input<string>? AppRole { get; set; }
+
+        /// <summary>
+        /// The ID of the app role to assign, instead of its value. If neither this nor 'appRole' is set, the principal is assigned to the application without a specific role. Changing this replaces the assignment.
+        /// </summary>
+        [Input("appRoleId")]
+        public Input<string>? AppRoleId { get; set; }
+
+        /// <summary>
+        /// The object ID of the user, group or service principal (e.g. a managed identity) that is assigned the role. Changing this replaces the assignment.
+        /// </summary>
+        [Input("principalId", required: true)]
+        public Input<string> PrincipalId { get; set; } = null!;
+
+        /// <summary>
+        /// The object ID of the service principal of the application that defines the app role. Changing this replaces the assignment.
+        /// </summary>
+        [Input("resourceId", required: true)]
+        public Input<string> ResourceId { get; set; } = null!;
+
+        public AppRoleAssignmentArgs()
+        {
+        }
+    }
+}
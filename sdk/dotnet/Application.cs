@@ -0,0 +1,259 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode
+{
+    /// <summary>
+    /// An application (app registration) managed entirely through Microsoft Graph. Settings that are not set are reset to their defaults. The resource ID is the object ID of the application, which is also used to import it.
+    /// </summary>
+    [KnapcodeResourceType("knapcode:index:Application")]
+    public partial class Application : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// Settings for an application that exposes an API.
+        /// </summary>
+        [Output("api")]
+        public Output<Outputs.ApplicationApi?> Api { get; private set; } = null!;
+
+        /// <summary>
+        /// The app ID (client ID) of the application.
+        /// </summary>
+        [Output("appId")]
+        public Output<string> AppId { get; private set; } = null!;
+
+        /// <summary>
+        /// The roles defined by the application.
+        /// </summary>
+        [Output("appRoles")]
+        public Output<ImmutableArray<Outputs.ApplicationAppRole>> AppRoles { get; private set; } = null!;
+
+        /// <summary>
+        /// The display name of the application.
+        /// </summary>
+        [Output("displayName")]
+        public Output<string> DisplayName { get; private set; } = null!;
+
+        /// <summary>
+        /// The URIs that identify the application within its tenant.
+        /// </summary>
+        [Output("identifierUris")]
+        public Output<ImmutableArray<string>> IdentifierUris { get; private set; } = null!;
+
+        /// <summary>
+        /// Free text notes about the application.
+        /// </summary>
+        [Output("notes")]
+        public Output<string?> Notes { get; private set; } = null!;
+
+        /// <summary>
+        /// The object ID of the application.
+        /// </summary>
+        [Output("objectId")]
+        public Output<string> ObjectId { get; private set; } = null!;
+
+        /// <summary>
+        /// Optional claims included in tokens.
+        /// </summary>
+        [Output("optionalClaims")]
+        public Output<Outputs.ApplicationOptionalClaims?> OptionalClaims { get; private set; } = null!;
+
+        /// <summary>
+        /// Settings for a public client.
+        /// </summary>
+        [Output("publicClient")]
+        public Output<Outputs.ApplicationPublicClient?> PublicClient { get; private set; } = null!;
+
+        /// <summary>
+        /// Permanently delete the application from the directory's deleted items when the resource is deleted, releasing its identifier URIs. Defaults to false.
+        /// </summary>
+        [Output("purgeOnDelete")]
+        public Output<bool?> PurgeOnDelete { get; private set; } = null!;
+
+        /// <summary>
+        /// The permissions the application requires on other applications.
+        /// </summary>
+        [Output("requiredResourceAccess")]
+        public Output<ImmutableArray<Outputs.ApplicationRequiredResourceAccess>> RequiredResourceAccess { get; private set; } = null!;
+
+        /// <summary>
+        /// The accounts that can sign in. Defaults to 'AzureADMyOrg'.
+        /// </summary>
+        [Output("signInAudience")]
+        public Output<Pulumi.Knapcode.SignInAudience?> SignInAudience { get; private set; } = null!;
+
+        /// <summary>
+        /// Settings for a single-page application.
+        /// </summary>
+        [Output("spa")]
+        public Output<Outputs.ApplicationSpa?> Spa { get; private set; } = null!;
+
+        /// <summary>
+        /// Tags on the application.
+        /// </summary>
+        [Output("tags")]
+        public Output<ImmutableArray<string>> Tags { get; private set; } = null!;
+
+        /// <summary>
+        /// Settings for a web application.
+        /// </summary>
+        [Output("web")]
+        public Output<Outputs.ApplicationWeb?> Web { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a Application resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public Application(string name, ApplicationArgs args, CustomResourceOptions? options = null)
+            : base("knapcode:index:Application", name, args ?? new ApplicationArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private Application(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("knapcode:index:Application", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing Application resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static Application Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new Application(name, id, options);
+        }
+    }
+
+    public sealed class ApplicationArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Settings for an application that exposes an API.
+        /// </summary>
+        [Input("api")]
+        public Input<Inputs.ApplicationApiArgs>? Api { get; set; }
+
+        [Input("appRoles")]
+        private InputList<Inputs.ApplicationAppRoleArgs>? _appRoles;
+
+        /// <summary>
+        /// The roles defined by the application.
+        /// </summary>
+        public InputList<Inputs.ApplicationAppRoleArgs> AppRoles
+        {
+            get => _appRoles ?? (_appRoles = new InputList<Inputs.ApplicationAppRoleArgs>());
+            set => _appRoles = value;
+        }
+
+        /// <summary>
+        /// The display name of the application.
+        /// </summary>
+        [Input("displayName", required: true)]
+        public Input<string> DisplayName { get; set; } = null!;
+
+        [Input("identifierUris")]
+        private InputList<string>? _identifierUris;
+
+        /// <summary>
+        /// The URIs that identify the application within its tenant.
+        /// </summary>
+        public InputList<string> IdentifierUris
+        {
+            get => _identifierUris ?? (_identifierUris = new InputList<string>());
+            set => _identifierUris = value;
+        }
+
+        /// <summary>
+        /// Free text notes about the application.
+        /// </summary>
+        [Input("notes")]
+        public Input<string>? Notes { get; set; }
+
+        /// <summary>
+        /// Optional claims included in tokens.
+        /// </summary>
+        [Input("optionalClaims")]
+        public Input<Inputs.ApplicationOptionalClaimsArgs>? OptionalClaims { get; set; }
+
+        /// <summary>
+        /// Settings for a public client.
+        /// </summary>
+        [Input("publicClient")]
+        public Input<Inputs.ApplicationPublicClientArgs>? PublicClient { get; set; }
+
+        /// <summary>
+        /// Permanently delete the application from the directory's deleted items when the resource is deleted, releasing its identifier URIs. Defaults to false.
+        /// </summary>
+        [Input("purgeOnDelete")]
+        public Input<bool>? PurgeOnDelete { get; set; }
+
+        [Input("requiredResourceAccess")]
+        private InputList<Inputs.ApplicationRequiredResourceAccessArgs>? _requiredResourceAccess;
+
+        /// <summary>
+        /// The permissions the application requires on other applications.
+        /// </summary>
+        public InputList<Inputs.ApplicationRequiredResourceAccessArgs> RequiredResourceAccess
+        {
+            get => _requiredResourceAccess ?? (_requiredResourceAccess = new InputList<Inputs.ApplicationRequiredResourceAccessArgs>());
+            set => _requiredResourceAccess = value;
+        }
+
+        /// <summary>
+        /// The accounts that can sign in. Defaults to 'AzureADMyOrg'.
+        /// </summary>
+        [Input("signInAudience")]
+        public Input<Pulumi.Knapcode.SignInAudience>? SignInAudience { get; set; }
+
+        /// <summary>
+        /// Settings for a single-page application.
+        /// </summary>
+        [Input("spa")]
+        public Input<Inputs.ApplicationSpaArgs>? Spa { get; set; }
+
+        [Input("tags")]
+        private InputList<string>? _tags;
+
+        /// <summary>
+        /// Tags on the application.
+        /// </summary>
+        public InputList<string> Tags
+        {
+            get => _tags ?? (_tags = new InputList<string>());
+            set => _tags = value;
+        }
+
+        /// <summary>
+        /// Settings for a web application.
+        /// </summary>
+        [Input("web")]
+        public Input<Inputs.ApplicationWebArgs>? Web { get; set; }
+
+        public ApplicationArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,151 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode
+{
+    /// <summary>
+    /// The marketing, support, terms of service and privacy statement URLs and the publisher domain of an application, shown on the consent prompt. Deleting the resource clears the URLs. The resource ID is the object ID of the application, which is also used to import it.
+    /// </summary>
+    [KnapcodeResourceType("knapcode:index:ApplicationBranding")]
+    public partial class ApplicationBranding : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The host name the URLs that are not set are derived from, e.g. 'example.com' gives 'https://example.com/privacy' for the privacy statement.
+        /// </summary>
+        [Output("hostName")]
+        public Output<string?> HostName { get; private set; } = null!;
+
+        /// <summary>
+        /// The URL of the application's marketing page. Defaults to 'https://{hostName}' when 'hostName' is set.
+        /// </summary>
+        [Output("marketingUrl")]
+        public Output<string?> MarketingUrl { get; private set; } = null!;
+
+        /// <summary>
+        /// The object ID of the application. Changing this replaces the resource.
+        /// </summary>
+        [Output("objectId")]
+        public Output<string> ObjectId { get; private set; } = null!;
+
+        /// <summary>
+        /// The URL of the application's privacy statement, shown on the consent prompt. Defaults to 'https://{hostName}/privacy' when 'hostName' is set.
+        /// </summary>
+        [Output("privacyStatementUrl")]
+        public Output<string?> PrivacyStatementUrl { get; private set; } = null!;
+
+        /// <summary>
+        /// The publisher domain shown on the consent prompt. Must be a verified domain of the tenant. It is left unchanged when not set.
+        /// </summary>
+        [Output("publisherDomain")]
+        public Output<string?> PublisherDomain { get; private set; } = null!;
+
+        /// <summary>
+        /// The URL of the application's support page. Defaults to 'https://{hostName}/support' when 'hostName' is set.
+        /// </summary>
+        [Output("supportUrl")]
+        public Output<string?> SupportUrl { get; private set; } = null!;
+
+        /// <summary>
+        /// The URL of the application's terms of service, shown on the consent prompt. Defaults to 'https://{hostName}/terms' when 'hostName' is set.
+        /// </summary>
+        [Output("termsOfServiceUrl")]
+        public Output<string?> TermsOfServiceUrl { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a ApplicationBranding resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public ApplicationBranding(string name, ApplicationBrandingArgs args, CustomResourceOptions? options = null)
+            : base("knapcode:index:ApplicationBranding", name, args ?? new ApplicationBrandingArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private ApplicationBranding(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("knapcode:index:ApplicationBranding", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing ApplicationBranding resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static ApplicationBranding Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new ApplicationBranding(name, id, options);
+        }
+    }
+
+    public sealed class ApplicationBrandingArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The host name the URLs that are not set are derived from, e.g. 'example.com' gives 'https://example.com/privacy' for the privacy statement.
+        /// </summary>
+        [Input("hostName")]
+        public Input<string>? HostName { get; set; }
+
+        /// <summary>
+        /// The URL of the application's marketing page. Defaults to 'https://{hostName}' when 'hostName' is set.
+        /// </summary>
+        [Input("marketingUrl")]
+        public Input<string>? MarketingUrl { get; set; }
+
+        /// <summary>
+        /// The object ID of the application. Changing this replaces the resource.
+        /// </summary>
+        [Input("objectId", required: true)]
+        public Input<string> ObjectId { get; set; } = null!;
+
+        /// <summary>
+        /// The URL of the application's privacy statement, shown on the consent prompt. Defaults to 'https://{hostName}/privacy' when 'hostName' is set.
+        /// </summary>
+        [Input("privacyStatementUrl")]
+        public Input<string>? PrivacyStatementUrl { get; set; }
+
+        /// <summary>
+        /// The publisher domain shown on the consent prompt. Must be a verified domain of the tenant. It is left unchanged when not set.
+        /// </summary>
+        [Input("publisherDomain")]
+        public Input<string>? PublisherDomain { get; set; }
+
+        /// <summary>
+        /// The URL of the application's support page. Defaults to 'https://{hostName}/support' when 'hostName' is set.
+        /// </summary>
+        [Input("supportUrl")]
+        public Input<string>? SupportUrl { get; set; }
+
+        /// <summary>
+        /// The URL of the application's terms of service, shown on the consent prompt. Defaults to 'https://{hostName}/terms' when 'hostName' is set.
+        /// </summary>
+        [Input("termsOfServiceUrl")]
+        public Input<string>? TermsOfServiceUrl { get; set; }
+
+        public ApplicationBrandingArgs()
+        {
+        }
+    }
+}
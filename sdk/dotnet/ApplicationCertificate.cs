@@ -0,0 +1,127 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode
+{
+    /// <summary>
+    /// A certificate in the key credentials of an application, used for certificate-based client authentication. Other key credentials on the application are left untouched.
+    /// </summary>
+    [KnapcodeResourceType("knapcode:index:ApplicationCertificate")]
+    public partial class ApplicationCertificate : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The certificate, either PEM encoded or as base64 encoded DER. Only the public certificate is needed.
+        /// </summary>
+        [Output("certificate")]
+        public Output<string> Certificate { get; private set; } = null!;
+
+        /// <summary>
+        /// A friendly name for the certificate. Defaults to the certificate subject.
+        /// </summary>
+        [Output("displayName")]
+        public Output<string?> DisplayName { get; private set; } = null!;
+
+        /// <summary>
+        /// When the certificate expires.
+        /// </summary>
+        [Output("endDateTime")]
+        public Output<string> EndDateTime { get; private set; } = null!;
+
+        /// <summary>
+        /// The key ID of the certificate, derived from the application and the certificate thumbprint.
+        /// </summary>
+        [Output("keyId")]
+        public Output<string> KeyId { get; private set; } = null!;
+
+        /// <summary>
+        /// The object ID of the application.
+        /// </summary>
+        [Output("objectId")]
+        public Output<string> ObjectId { get; private set; } = null!;
+
+        /// <summary>
+        /// When the certificate becomes valid.
+        /// </summary>
+        [Output("startDateTime")]
+        public Output<string> StartDateTime { get; private set; } = null!;
+
+        /// <summary>
+        /// The SHA-1 thumbprint of the certificate, as uppercase hex.
+        /// </summary>
+        [Output("thumbprint")]
+        public Output<string> Thumbprint { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a ApplicationCertificate resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public ApplicationCertificate(string name, ApplicationCertificateArgs args, CustomResourceOptions? options = null)
+            : base("knapcode:index:ApplicationCertificate", name, args ?? new ApplicationCertificateArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private ApplicationCertificate(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("knapcode:index:ApplicationCertificate", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing ApplicationCertificate resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static ApplicationCertificate Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new ApplicationCertificate(name, id, options);
+        }
+    }
+
+    public sealed class ApplicationCertificateArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The certificate, either PEM encoded or as base64 encoded DER. Only the public certificate is needed.
+        /// </summary>
+        [Input("certificate", required: true)]
+        public Input<string> Certificate { get; set; } = null!;
+
+        /// <summary>
+        /// A friendly name for the certificate. Defaults to the certificate subject.
+        /// </summary>
+        [Input("displayName")]
+        public Input<string>? DisplayName { get; set; }
+
+        /// <summary>
+        /// The object ID of the application.
+        /// </summary>
+        [Input("objectId", required: true)]
+        public Input<string> ObjectId { get; set; } = null!;
+
+        public ApplicationCertificateArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,115 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode
+{
+    /// <summary>
+    /// The logo of an application, shown on the consent prompt and in My Apps. The logo must be a PNG, JPEG, GIF or BMP image of at most 100 KB. Microsoft Graph can't remove a logo, so deleting the resource leaves the logo in place. The resource ID is the object ID of the application, which is also used to import it.
+    /// </summary>
+    [KnapcodeResourceType("knapcode:index:ApplicationLogo")]
+    public partial class ApplicationLogo : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The base64 encoded logo. Only one of 'source' and 'content' can be set.
+        /// </summary>
+        [Output("content")]
+        public Output<string?> Content { get; private set; } = null!;
+
+        /// <summary>
+        /// The hex encoded SHA-256 of the logo. The logo is only uploaded again when it changes.
+        /// </summary>
+        [Output("contentSha256")]
+        public Output<string> ContentSha256 { get; private set; } = null!;
+
+        /// <summary>
+        /// The detected image type of the logo, e.g. 'image/png'.
+        /// </summary>
+        [Output("contentType")]
+        public Output<string> ContentType { get; private set; } = null!;
+
+        /// <summary>
+        /// The object ID of the application. Changing this replaces the resource.
+        /// </summary>
+        [Output("objectId")]
+        public Output<string> ObjectId { get; private set; } = null!;
+
+        /// <summary>
+        /// The path of the logo file. Only one of 'source' and 'content' can be set.
+        /// </summary>
+        [Output("source")]
+        public Output<string?> Source { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a ApplicationLogo resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public ApplicationLogo(string name, ApplicationLogoArgs args, CustomResourceOptions? options = null)
+            : base("knapcode:index:ApplicationLogo", name, args ?? new ApplicationLogoArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private ApplicationLogo(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("knapcode:index:ApplicationLogo", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing ApplicationLogo resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static ApplicationLogo Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new ApplicationLogo(name, id, options);
+        }
+    }
+
+    public sealed class ApplicationLogoArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The base64 encoded logo. Only one of 'source' and 'content' can be set.
+        /// </summary>
+        [Input("content")]
+        public Input<string>? Content { get; set; }
+
+        /// <summary>
+        /// The object ID of the application. Changing this replaces the resource.
+        /// </summary>
+        [Input("objectId", required: true)]
+        public Input<string> ObjectId { get; set; } = null!;
+
+        /// <summary>
+        /// The path of the logo file. Only one of 'source' and 'content' can be set.
+        /// </summary>
+        [Input("source")]
+        public Input<string>? Source { get; set; }
+
+        public ApplicationLogoArgs()
+        {
+        }
+    }
+}
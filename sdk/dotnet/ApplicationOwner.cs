@@ -0,0 +1,103 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode
+{
+    /// <summary>
+    /// Adds an owner to an application. The resource ID is the object ID of the application and the object ID of the owner separated by a slash, which is also used to import it.
+    /// </summary>
+    [KnapcodeResourceType("knapcode:index:ApplicationOwner")]
+    public partial class ApplicationOwner : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The object ID of the application. Changing this replaces the resource.
+        /// </summary>
+        [Output("objectId")]
+        public Output<string> ObjectId { get; private set; } = null!;
+
+        /// <summary>
+        /// The display name of the owner.
+        /// </summary>
+        [Output("ownerDisplayName")]
+        public Output<string> OwnerDisplayName { get; private set; } = null!;
+
+        /// <summary>
+        /// The object ID of the user or service principal to add as an owner. Changing this replaces the resource.
+        /// </summary>
+        [Output("ownerId")]
+        public Output<string> OwnerId { get; private set; } = null!;
+
+        /// <summary>
+        /// The type of the owner, e.g. 'user' or 'servicePrincipal'.
+        /// </summary>
+        [Output("ownerType")]
+        public Output<string> OwnerType { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a ApplicationOwner resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public ApplicationOwner(string name, ApplicationOwnerArgs args, CustomResourceOptions? options = null)
+            : base("knapcode:index:ApplicationOwner", name, args ?? new ApplicationOwnerArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private ApplicationOwner(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("knapcode:index:ApplicationOwner", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing ApplicationOwner resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static ApplicationOwner Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new ApplicationOwner(name, id, options);
+        }
+    }
+
+    public sealed class ApplicationOwnerArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The object ID of the application. Changing this replaces the resource.
+        /// </summary>
+        [Input("objectId", required: true)]
+        public Input<string> ObjectId { get; set; } = null!;
+
+        /// <summary>
+        /// The object ID of the user or service principal to add as an owner. Changing this replaces the resource.
+        /// </summary>
+        [Input("ownerId", required: true)]
+        public Input<string> OwnerId { get; set; } = null!;
+
+        public ApplicationOwnerArgs()
+        {
+        }
+    }
+}
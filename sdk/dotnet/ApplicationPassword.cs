@@ -0,0 +1,155 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode
+{
+    /// <summary>
+    /// A client secret for an application, managed with the Microsoft Graph `addPassword` and `removePassword` actions. Every change replaces the client secret.
+    /// </summary>
+    [KnapcodeResourceType("knapcode:index:ApplicationPassword")]
+    public partial class ApplicationPassword : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// A friendly name for the client secret.
+        /// </summary>
+        [Output("displayName")]
+        public Output<string?> DisplayName { get; private set; } = null!;
+
+        /// <summary>
+        /// When the client secret expires, as an RFC 3339 date and time. Defaults to two years after the start.
+        /// </summary>
+        [Output("endDateTime")]
+        public Output<string?> EndDateTime { get; private set; } = null!;
+
+        /// <summary>
+        /// The first few characters of the client secret.
+        /// </summary>
+        [Output("hint")]
+        public Output<string> Hint { get; private set; } = null!;
+
+        /// <summary>
+        /// The key ID of the client secret.
+        /// </summary>
+        [Output("keyId")]
+        public Output<string> KeyId { get; private set; } = null!;
+
+        /// <summary>
+        /// The object ID of the application.
+        /// </summary>
+        [Output("objectId")]
+        public Output<string> ObjectId { get; private set; } = null!;
+
+        /// <summary>
+        /// Arbitrary values that replace the client secret with a new one whenever they change.
+        /// </summary>
+        [Output("rotateWhenChanged")]
+        public Output<ImmutableDictionary<string, string>?> RotateWhenChanged { get; private set; } = null!;
+
+        /// <summary>
+        /// The client secret.
+        /// </summary>
+        [Output("secretText")]
+        public Output<string> SecretText { get; private set; } = null!;
+
+        /// <summary>
+        /// When the client secret becomes valid, as an RFC 3339 date and time. Defaults to now.
+        /// </summary>
+        [Output("startDateTime")]
+        public Output<string?> StartDateTime { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a ApplicationPassword resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public ApplicationPassword(string name, ApplicationPasswordArgs args, CustomResourceOptions? options = null)
+            : base("knapcode:index:ApplicationPassword", name, args ?? new ApplicationPasswordArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private ApplicationPassword(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("knapcode:index:ApplicationPassword", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                AdditionalSecretOutputs =
+                {
+                    "secretText",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing ApplicationPassword resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static ApplicationPassword Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new ApplicationPassword(name, id, options);
+        }
+    }
+
+    public sealed class ApplicationPasswordArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// A friendly name for the client secret.
+        /// </summary>
+        [Input("displayName")]
+        public Input<string>? DisplayName { get; set; }
+
+        /// <summary>
+        /// When the client secret expires, as an RFC 3339 date and time. Defaults to two years after the start.
+        /// </summary>
+        [Input("endDateTime")]
+        public Input<string>? EndDateTime { get; set; }
+
+        /// <summary>
+        /// The object ID of the application.
+        /// </summary>
+        [Input("objectId", required: true)]
+        public Input<string> ObjectId { get; set; } = null!;
+
+        [Input("rotateWhenChanged")]
+        private InputMap<string>? _rotateWhenChanged;
+
+        /// <summary>
+        /// Arbitrary values that replace the client secret with a new one whenever they change.
+        /// </summary>
+        public InputMap<string> RotateWhenChanged
+        {
+            get => _rotateWhenChanged ?? (_rotateWhenChanged = new InputMap<string>());
+            set => _rotateWhenChanged = value;
+        }
+
+        /// <summary>
+        /// When the client secret becomes valid, as an RFC 3339 date and time. Defaults to now.
+        /// </summary>
+        [Input("startDateTime")]
+        public Input<string>? StartDateTime { get; set; }
+
+        public ApplicationPasswordArgs()
+        {
+        }
+    }
+}
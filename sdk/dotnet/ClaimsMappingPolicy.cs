@@ -0,0 +1,109 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode
+{
+    /// <summary>
+    /// A claims mapping policy that customizes the claims emitted in SAML tokens and JWTs. The resource ID is the ID of the policy, which is also used to import it.
+    /// </summary>
+    [KnapcodeResourceType("knapcode:index:ClaimsMappingPolicy")]
+    public partial class ClaimsMappingPolicy : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The claims emitted by the policy. Changing the definition updates the policy in place.
+        /// </summary>
+        [Output("definition")]
+        public Output<Outputs.ClaimsMappingPolicyDefinition> Definition { get; private set; } = null!;
+
+        /// <summary>
+        /// The display name of the policy.
+        /// </summary>
+        [Output("displayName")]
+        public Output<string> DisplayName { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether the policy applies to every application in the organization that has no policy assigned. Defaults to false.
+        /// </summary>
+        [Output("isOrganizationDefault")]
+        public Output<bool?> IsOrganizationDefault { get; private set; } = null!;
+
+        /// <summary>
+        /// The ID of the policy.
+        /// </summary>
+        [Output("policyId")]
+        public Output<string> PolicyId { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a ClaimsMappingPolicy resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public ClaimsMappingPolicy(string name, ClaimsMappingPolicyArgs args, CustomResourceOptions? options = null)
+            : base("knapcode:index:ClaimsMappingPolicy", name, args ?? new ClaimsMappingPolicyArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private ClaimsMappingPolicy(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("knapcode:index:ClaimsMappingPolicy", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing ClaimsMappingPolicy resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static ClaimsMappingPolicy Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new ClaimsMappingPolicy(name, id, options);
+        }
+    }
+
+    public sealed class ClaimsMappingPolicyArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The claims emitted by the policy. Changing the definition updates the policy in place.
+        /// </summary>
+        [Input("definition", required: true)]
+        public Input<Inputs.ClaimsMappingPolicyDefinitionArgs> Definition { get; set; } = null!;
+
+        /// <summary>
+        /// The display name of the policy.
+        /// </summary>
+        [Input("displayName", required: true)]
+        public Input<string> DisplayName { get; set; } = null!;
+
+        /// <summary>
+        /// Whether the policy applies to every application in the organization that has no policy assigned. Defaults to false.
+        /// </summary>
+        [Input("isOrganizationDefault")]
+        public Input<bool>? IsOrganizationDefault { get; set; }
+
+        public ClaimsMappingPolicyArgs()
+        {
+        }
+    }
+}
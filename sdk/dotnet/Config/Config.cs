@@ -0,0 +1,17 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System.Collections.Immutable;
+
+namespace Pulumi.Knapcode
+{
+    public static class Config
+    {
+        private static readonly Pulumi.Config __config = new Pulumi.Config("knapcode");
+        /// <summary>
+        /// The object IDs of users or service principals that are added as owners of every application and service principal created by this provider, so that they can be managed in the portal.
+        /// </summary>
+        public static ImmutableArray<string> DefaultOwners { get; set; } = __config.GetObject<ImmutableArray<string>>("defaultOwners");
+
+    }
+}
@@ -0,0 +1,139 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode
+{
+    /// <summary>
+    /// Assigns an Azure AD directory role to a principal, for the whole directory or scoped to an administrative unit or an application. Only one scope can be set. The resource ID is the ID of the role assignment, which is also used to import it.
+    /// </summary>
+    [KnapcodeResourceType("knapcode:index:DirectoryRoleAssignment")]
+    public partial class DirectoryRoleAssignment : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The object ID of an administrative unit to scope the assignment to. Changing this replaces the resource.
+        /// </summary>
+        [Output("administrativeUnitId")]
+        public Output<string?> AdministrativeUnitId { get; private set; } = null!;
+
+        /// <summary>
+        /// The object ID of an application to scope the assignment to. Changing this replaces the resource.
+        /// </summary>
+        [Output("applicationObjectId")]
+        public Output<string?> ApplicationObjectId { get; private set; } = null!;
+
+        /// <summary>
+        /// The ID of the role assignment.
+        /// </summary>
+        [Output("assignmentId")]
+        public Output<string> AssignmentId { get; private set; } = null!;
+
+        /// <summary>
+        /// The scope of the assignment: '/' for the whole directory, '/administrativeUnits/{id}' for an administrative unit or '/{id}' for an application.
+        /// </summary>
+        [Output("directoryScopeId")]
+        public Output<string> DirectoryScopeId { get; private set; } = null!;
+
+        /// <summary>
+        /// The object ID of the user, group or service principal to assign the role to. Changing this replaces the resource.
+        /// </summary>
+        [Output("principalId")]
+        public Output<string> PrincipalId { get; private set; } = null!;
+
+        /// <summary>
+        /// The directory role, either the display name of a role like 'Application Administrator' or the ID or template ID of a role definition. Changing this replaces the resource.
+        /// </summary>
+        [Output("role")]
+        public Output<string> Role { get; private set; } = null!;
+
+        /// <summary>
+        /// The template ID of the assigned role.
+        /// </summary>
+        [Output("roleDefinitionId")]
+        public Output<string> RoleDefinitionId { get; private set; } = null!;
+
+        /// <summary>
+        /// The display name of the assigned role.
+        /// </summary>
+        [Output("roleDisplayName")]
+        public Output<string> RoleDisplayName { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a DirectoryRoleAssignment resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public DirectoryRoleAssignment(string name, DirectoryRoleAssignmentArgs args, CustomResourceOptions? options = null)
+            : base("knapcode:index:DirectoryRoleAssignment", name, args ?? new DirectoryRoleAssignmentArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private DirectoryRoleAssignment(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("knapcode:index:DirectoryRoleAssignment", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing DirectoryRoleAssignment resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static DirectoryRoleAssignment Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new DirectoryRoleAssignment(name, id, options);
+        }
+    }
+
+    public sealed class DirectoryRoleAssignmentArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The object ID of an administrative unit to scope the assignment to. Changing this replaces the resource.
+        /// </summary>
+        [Input("administrativeUnitId")]
+        public Input<string>? AdministrativeUnitId { get; set; }
+
+        /// <summary>
+        /// The object ID of an application to scope the assignment to. Changing this replaces the resource.
+        /// </summary>
+        [Input("applicationObjectId")]
+        public Input<string>? ApplicationObjectId { get; set; }
+
+        /// <summary>
+        /// The object ID of the user, group or service principal to assign the role to. Changing this replaces the resource.
+        /// </summary>
+        [Input("principalId", required: true)]
+        public Input<string> PrincipalId { get; set; } = null!;
+
+        /// <summary>
+        /// The directory role, either the display name of a role like 'Application Administrator' or the ID or template ID of a role definition. Changing this replaces the resource.
+        /// </summary>
+        [Input("role", required: true)]
+        public Input<string> Role { get; set; } = null!;
+
+        public DirectoryRoleAssignmentArgs()
+        {
+        }
+    }
+}
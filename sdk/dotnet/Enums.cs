@@ -0,0 +1,234 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.ComponentModel;
+using Pulumi;
+
+namespace Pulumi.Knapcode
+{
+    /// <summary>
+    /// How to handle application settings that were changed outside of Pulumi.
+    /// </summary>
+    [EnumType]
+    public readonly struct ConflictPolicy : IEquatable<ConflictPolicy>
+    {
+        private readonly string _value;
+
+        private ConflictPolicy(string value)
+        {
+            _value = value ?? throw new ArgumentNullException(nameof(value));
+        }
+
+        /// <summary>
+        /// Overwrite the external changes and log a warning.
+        /// </summary>
+        public static ConflictPolicy Overwrite { get; } = new ConflictPolicy("overwrite");
+        /// <summary>
+        /// Fail the update and report the external changes.
+        /// </summary>
+        public static ConflictPolicy Fail { get; } = new ConflictPolicy("fail");
+        /// <summary>
+        /// Keep external changes to settings this resource is not changing.
+        /// </summary>
+        public static ConflictPolicy Merge { get; } = new ConflictPolicy("merge");
+
+        public static bool operator ==(ConflictPolicy left, ConflictPolicy right) => left.Equals(right);
+        public static bool operator !=(ConflictPolicy left, ConflictPolicy right) => !left.Equals(right);
+
+        public static explicit operator string(ConflictPolicy value) => value._value;
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override bool Equals(object? obj) => obj is ConflictPolicy other && Equals(other);
+        public bool Equals(ConflictPolicy other) => string.Equals(_value, other._value, StringComparison.Ordinal);
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override int GetHashCode() => _value?.GetHashCode() ?? 0;
+
+        public override string ToString() => _value;
+    }
+
+    /// <summary>
+    /// The data type of the values of an extension property.
+    /// </summary>
+    [EnumType]
+    public readonly struct ExtensionPropertyDataType : IEquatable<ExtensionPropertyDataType>
+    {
+        private readonly string _value;
+
+        private ExtensionPropertyDataType(string value)
+        {
+            _value = value ?? throw new ArgumentNullException(nameof(value));
+        }
+
+        /// <summary>
+        /// Binary data, at most 256 bytes.
+        /// </summary>
+        public static ExtensionPropertyDataType Binary { get; } = new ExtensionPropertyDataType("Binary");
+        /// <summary>
+        /// A boolean.
+        /// </summary>
+        public static ExtensionPropertyDataType Boolean { get; } = new ExtensionPropertyDataType("Boolean");
+        /// <summary>
+        /// A date and time in ISO 8601 format, always in UTC.
+        /// </summary>
+        public static ExtensionPropertyDataType DateTime { get; } = new ExtensionPropertyDataType("DateTime");
+        /// <summary>
+        /// A 32-bit integer.
+        /// </summary>
+        public static ExtensionPropertyDataType Integer { get; } = new ExtensionPropertyDataType("Integer");
+        /// <summary>
+        /// A 64-bit integer.
+        /// </summary>
+        public static ExtensionPropertyDataType LargeInteger { get; } = new ExtensionPropertyDataType("LargeInteger");
+        /// <summary>
+        /// A string, at most 256 characters.
+        /// </summary>
+        public static ExtensionPropertyDataType String { get; } = new ExtensionPropertyDataType("String");
+
+        public static bool operator ==(ExtensionPropertyDataType left, ExtensionPropertyDataType right) => left.Equals(right);
+        public static bool operator !=(ExtensionPropertyDataType left, ExtensionPropertyDataType right) => !left.Equals(right);
+
+        public static explicit operator string(ExtensionPropertyDataType value) => value._value;
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override bool Equals(object? obj) => obj is ExtensionPropertyDataType other && Equals(other);
+        public bool Equals(ExtensionPropertyDataType other) => string.Equals(_value, other._value, StringComparison.Ordinal);
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override int GetHashCode() => _value?.GetHashCode() ?? 0;
+
+        public override string ToString() => _value;
+    }
+
+    /// <summary>
+    /// The groups included in the groups claim of tokens issued for an application.
+    /// </summary>
+    [EnumType]
+    public readonly struct GroupMembershipClaims : IEquatable<GroupMembershipClaims>
+    {
+        private readonly string _value;
+
+        private GroupMembershipClaims(string value)
+        {
+            _value = value ?? throw new ArgumentNullException(nameof(value));
+        }
+
+        /// <summary>
+        /// No groups.
+        /// </summary>
+        public static GroupMembershipClaims None { get; } = new GroupMembershipClaims("None");
+        /// <summary>
+        /// Security groups and Azure AD roles the user is a member of.
+        /// </summary>
+        public static GroupMembershipClaims SecurityGroup { get; } = new GroupMembershipClaims("SecurityGroup");
+        /// <summary>
+        /// Azure AD roles the user is assigned to.
+        /// </summary>
+        public static GroupMembershipClaims DirectoryRole { get; } = new GroupMembershipClaims("DirectoryRole");
+        /// <summary>
+        /// Groups assigned to the application the user is a member of.
+        /// </summary>
+        public static GroupMembershipClaims ApplicationGroup { get; } = new GroupMembershipClaims("ApplicationGroup");
+        /// <summary>
+        /// Security groups, distribution lists and Azure AD roles the user is a member of.
+        /// </summary>
+        public static GroupMembershipClaims All { get; } = new GroupMembershipClaims("All");
+
+        public static bool operator ==(GroupMembershipClaims left, GroupMembershipClaims right) => left.Equals(right);
+        public static bool operator !=(GroupMembershipClaims left, GroupMembershipClaims right) => !left.Equals(right);
+
+        public static explicit operator string(GroupMembershipClaims value) => value._value;
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override bool Equals(object? obj) => obj is GroupMembershipClaims other && Equals(other);
+        public bool Equals(GroupMembershipClaims other) => string.Equals(_value, other._value, StringComparison.Ordinal);
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override int GetHashCode() => _value?.GetHashCode() ?? 0;
+
+        public override string ToString() => _value;
+    }
+
+    /// <summary>
+    /// The kind of a group.
+    /// </summary>
+    [EnumType]
+    public readonly struct GroupType : IEquatable<GroupType>
+    {
+        private readonly string _value;
+
+        private GroupType(string value)
+        {
+            _value = value ?? throw new ArgumentNullException(nameof(value));
+        }
+
+        /// <summary>
+        /// A security group.
+        /// </summary>
+        public static GroupType Security { get; } = new GroupType("Security");
+        /// <summary>
+        /// A Microsoft 365 group, with a mailbox and other collaboration features.
+        /// </summary>
+        public static GroupType Microsoft365 { get; } = new GroupType("Microsoft365");
+
+        public static bool operator ==(GroupType left, GroupType right) => left.Equals(right);
+        public static bool operator !=(GroupType left, GroupType right) => !left.Equals(right);
+
+        public static explicit operator string(GroupType value) => value._value;
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override bool Equals(object? obj) => obj is GroupType other && Equals(other);
+        public bool Equals(GroupType other) => string.Equals(_value, other._value, StringComparison.Ordinal);
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override int GetHashCode() => _value?.GetHashCode() ?? 0;
+
+        public override string ToString() => _value;
+    }
+
+    /// <summary>
+    /// The Microsoft accounts that can sign in to an application.
+    /// </summary>
+    [EnumType]
+    public readonly struct SignInAudience : IEquatable<SignInAudience>
+    {
+        private readonly string _value;
+
+        private SignInAudience(string value)
+        {
+            _value = value ?? throw new ArgumentNullException(nameof(value));
+        }
+
+        /// <summary>
+        /// Accounts in the application's tenant only.
+        /// </summary>
+        public static SignInAudience AzureADMyOrg { get; } = new SignInAudience("AzureADMyOrg");
+        /// <summary>
+        /// Accounts in any Azure AD tenant.
+        /// </summary>
+        public static SignInAudience AzureADMultipleOrgs { get; } = new SignInAudience("AzureADMultipleOrgs");
+        /// <summary>
+        /// Accounts in any Azure AD tenant and personal Microsoft accounts.
+        /// </summary>
+        public static SignInAudience AzureADandPersonalMicrosoftAccount { get; } = new SignInAudience("AzureADandPersonalMicrosoftAccount");
+        /// <summary>
+        /// Personal Microsoft accounts only.
+        /// </summary>
+        public static SignInAudience PersonalMicrosoftAccount { get; } = new SignInAudience("PersonalMicrosoftAccount");
+
+        public static bool operator ==(SignInAudience left, SignInAudience right) => left.Equals(right);
+        public static bool operator !=(SignInAudience left, SignInAudience right) => !left.Equals(right);
+
+        public static explicit operator string(SignInAudience value) => value._value;
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override bool Equals(object? obj) => obj is SignInAudience other && Equals(other);
+        public bool Equals(SignInAudience other) => string.Equals(_value, other._value, StringComparison.Ordinal);
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override int GetHashCode() => _value?.GetHashCode() ?? 0;
+
+        public override string ToString() => _value;
+    }
+}
@@ -0,0 +1,157 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode
+{
+    /// <summary>
+    /// Exposes an application as an API: sets its identifier URI and manages its scopes, pre-authorized client applications and known client applications. Other API settings are left untouched. The resource ID is the object ID of the application, which is also used to import it.
+    /// </summary>
+    [KnapcodeResourceType("knapcode:index:ExposeApi")]
+    public partial class ExposeApi : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The app ID of the application.
+        /// </summary>
+        [Output("appId")]
+        public Output<string> AppId { get; private set; } = null!;
+
+        /// <summary>
+        /// The identifier URI of the API. Defaults to 'api://{appId}'.
+        /// </summary>
+        [Output("identifierUri")]
+        public Output<string?> IdentifierUri { get; private set; } = null!;
+
+        /// <summary>
+        /// The identifier URIs of the application.
+        /// </summary>
+        [Output("identifierUris")]
+        public Output<ImmutableArray<string>> IdentifierUris { get; private set; } = null!;
+
+        /// <summary>
+        /// The app IDs of client applications that are bundled with the API for consent.
+        /// </summary>
+        [Output("knownClientApplications")]
+        public Output<ImmutableArray<string>> KnownClientApplications { get; private set; } = null!;
+
+        /// <summary>
+        /// The object ID of the application. Changing this replaces the resource.
+        /// </summary>
+        [Output("objectId")]
+        public Output<string> ObjectId { get; private set; } = null!;
+
+        /// <summary>
+        /// The client applications that can use the API's scopes without user consent.
+        /// </summary>
+        [Output("preAuthorizedApplications")]
+        public Output<ImmutableArray<Outputs.ExposeApiPreAuthorizedApplication>> PreAuthorizedApplications { get; private set; } = null!;
+
+        /// <summary>
+        /// The delegated permissions exposed by the API. IDs default to a GUID derived from the value.
+        /// </summary>
+        [Output("scopes")]
+        public Output<ImmutableArray<Outputs.ApplicationPermissionScope>> Scopes { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a ExposeApi resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public ExposeApi(string name, ExposeApiArgs args, CustomResourceOptions? options = null)
+            : base("knapcode:index:ExposeApi", name, args ?? new ExposeApiArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private ExposeApi(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("knapcode:index:ExposeApi", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing ExposeApi resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static ExposeApi Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new ExposeApi(name, id, options);
+        }
+    }
+
+    public sealed class ExposeApiArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The identifier URI of the API. Defaults to 'api://{appId}'.
+        /// </summary>
+        [Input("identifierUri")]
+        public Input<string>? IdentifierUri { get; set; }
+
+        [Input("knownClientApplications")]
+        private InputList<string>? _knownClientApplications;
+
+        /// <summary>
+        /// The app IDs of client applications that are bundled with the API for consent.
+        /// </summary>
+        public InputList<string> KnownClientApplications
+        {
+            get => _knownClientApplications ?? (_knownClientApplications = new InputList<string>());
+            set => _knownClientApplications = value;
+        }
+
+        /// <summary>
+        /// The object ID of the application. Changing this replaces the resource.
+        /// </summary>
+        [Input("objectId", required: true)]
+        public Input<string> ObjectId { get; set; } = null!;
+
+        [Input("preAuthorizedApplications")]
+        private InputList<Inputs.ExposeApiPreAuthorizedApplicationArgs>? _preAuthorizedApplications;
+
+        /// <summary>
+        /// The client applications that can use the API's scopes without user consent.
+        /// </summary>
+        public InputList<Inputs.ExposeApiPreAuthorizedApplicationArgs> PreAuthorizedApplications
+        {
+            get => _preAuthorizedApplications ?? (_preAuthorizedApplications = new InputList<Inputs.ExposeApiPreAuthorizedApplicationArgs>());
+            set => _preAuthorizedApplications = value;
+        }
+
+        [Input("scopes")]
+        private InputList<Inputs.ApplicationPermissionScopeArgs>? _scopes;
+
+        /// <summary>
+        /// The delegated permissions exposed by the API. IDs default to a GUID derived from the value.
+        /// </summary>
+        public InputList<Inputs.ApplicationPermissionScopeArgs> Scopes
+        {
+            get => _scopes ?? (_scopes = new InputList<Inputs.ApplicationPermissionScopeArgs>());
+            set => _scopes = value;
+        }
+
+        public ExposeApiArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,145 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode
+{
+    /// <summary>
+    /// A directory extension property owned by an application, which adds a custom attribute to directory objects like users and groups. The resource ID is the object ID of the application and the extension property ID separated by a slash, which is also used to import it.
+    /// </summary>
+    [KnapcodeResourceType("knapcode:index:ExtensionProperty")]
+    public partial class ExtensionProperty : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The full name of the extension property on the target objects, in the form 'extension_{appId}_{name}' where the application ID has no hyphens.
+        /// </summary>
+        [Output("attributeName")]
+        public Output<string> AttributeName { get; private set; } = null!;
+
+        /// <summary>
+        /// The data type of the values of the extension property. Changing this replaces the resource.
+        /// </summary>
+        [Output("dataType")]
+        public Output<Pulumi.Knapcode.ExtensionPropertyDataType> DataType { get; private set; } = null!;
+
+        /// <summary>
+        /// The ID of the extension property.
+        /// </summary>
+        [Output("extensionPropertyId")]
+        public Output<string> ExtensionPropertyId { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether the extension property holds a collection of values. Defaults to false. Changing this replaces the resource.
+        /// </summary>
+        [Output("isMultiValued")]
+        public Output<bool?> IsMultiValued { get; private set; } = null!;
+
+        /// <summary>
+        /// The name of the extension property, without the 'extension_{appId}_' prefix. Can only contain letters, digits and '_' and must start with a letter. Changing this replaces the resource.
+        /// </summary>
+        [Output("name")]
+        public Output<string> Name { get; private set; } = null!;
+
+        /// <summary>
+        /// The object ID of the application that owns the extension property. Changing this replaces the resource.
+        /// </summary>
+        [Output("objectId")]
+        public Output<string> ObjectId { get; private set; } = null!;
+
+        /// <summary>
+        /// The kinds of directory objects the extension property can be set on: 'User', 'Group', 'AdministrativeUnit', 'Application', 'Device' or 'Organization'. Changing this replaces the resource.
+        /// </summary>
+        [Output("targetObjects")]
+        public Output<ImmutableArray<string>> TargetObjects { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a ExtensionProperty resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public ExtensionProperty(string name, ExtensionPropertyArgs args, CustomResourceOptions? options = null)
+            : base("knapcode:index:ExtensionProperty", name, args ?? new ExtensionPropertyArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private ExtensionProperty(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("knapcode:index:ExtensionProperty", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing ExtensionProperty resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static ExtensionProperty Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new ExtensionProperty(name, id, options);
+        }
+    }
+
+    public sealed class ExtensionPropertyArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The data type of the values of the extension property. Changing this replaces the resource.
+        /// </summary>
+        [Input("dataType", required: true)]
+        public Input<Pulumi.Knapcode.ExtensionPropertyDataType> DataType { get; set; } = null!;
+
+        /// <summary>
+        /// Whether the extension property holds a collection of values. Defaults to false. Changing this replaces the resource.
+        /// </summary>
+        [Input("isMultiValued")]
+        public Input<bool>? IsMultiValued { get; set; }
+
+        /// <summary>
+        /// The name of the extension property, without the 'extension_{appId}_' prefix. Can only contain letters, digits and '_' and must start with a letter. Changing this replaces the resource.
+        /// </summary>
+        [Input("name", required: true)]
+        public Input<string> Name { get; set; } = null!;
+
+        /// <summary>
+        /// The object ID of the application that owns the extension property. Changing this replaces the resource.
+        /// </summary>
+        [Input("objectId", required: true)]
+        public Input<string> ObjectId { get; set; } = null!;
+
+        [Input("targetObjects", required: true)]
+        private InputList<string>? _targetObjects;
+
+        /// <summary>
+        /// The kinds of directory objects the extension property can be set on: 'User', 'Group', 'AdministrativeUnit', 'Application', 'Device' or 'Organization'. Changing this replaces the resource.
+        /// </summary>
+        public InputList<string> TargetObjects
+        {
+            get => _targetObjects ?? (_targetObjects = new InputList<string>());
+            set => _targetObjects = value;
+        }
+
+        public ExtensionPropertyArgs()
+        {
+        }
+    }
+}
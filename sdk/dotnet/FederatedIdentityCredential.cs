@@ -0,0 +1,175 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode
+{
+    /// <summary>
+    /// A federated identity credential on an application, letting an external workload like a GitHub Actions workflow or a Kubernetes service account get tokens for the application without a secret. The resource ID is the application's object ID and the credential ID separated by a slash, which is also the format used to import a credential.
+    /// </summary>
+    [KnapcodeResourceType("knapcode:index:FederatedIdentityCredential")]
+    public partial class FederatedIdentityCredential : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The audiences that can appear in the external token.
+        /// </summary>
+        [Output("audiences")]
+        public Output<ImmutableArray<string>> Audiences { get; private set; } = null!;
+
+        /// <summary>
+        /// The ID of the credential assigned by Microsoft Graph.
+        /// </summary>
+        [Output("credentialId")]
+        public Output<string> CredentialId { get; private set; } = null!;
+
+        /// <summary>
+        /// A description of the credential.
+        /// </summary>
+        [Output("description")]
+        public Output<string?> Description { get; private set; } = null!;
+
+        /// <summary>
+        /// Builds the subject for GitHub Actions.
+        /// </summary>
+        [Output("github")]
+        public Output<Outputs.GitHubFederatedSubject?> Github { get; private set; } = null!;
+
+        /// <summary>
+        /// The URL of the external identity provider.
+        /// </summary>
+        [Output("issuer")]
+        public Output<string> Issuer { get; private set; } = null!;
+
+        /// <summary>
+        /// Builds the subject for a Kubernetes service account.
+        /// </summary>
+        [Output("kubernetes")]
+        public Output<Outputs.KubernetesFederatedSubject?> Kubernetes { get; private set; } = null!;
+
+        /// <summary>
+        /// The name of the credential, unique within the application. Changing this replaces the credential.
+        /// </summary>
+        [Output("name")]
+        public Output<string> Name { get; private set; } = null!;
+
+        /// <summary>
+        /// The object ID of the application. Changing this replaces the credential.
+        /// </summary>
+        [Output("objectId")]
+        public Output<string> ObjectId { get; private set; } = null!;
+
+        /// <summary>
+        /// The identity of the external workload.
+        /// </summary>
+        [Output("subject")]
+        public Output<string> Subject { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a FederatedIdentityCredential resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public FederatedIdentityCredential(string name, FederatedIdentityCredentialArgs args, CustomResourceOptions? options = null)
+            : base("knapcode:index:FederatedIdentityCredential", name, args ?? new FederatedIdentityCredentialArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private FederatedIdentityCredential(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("knapcode:index:FederatedIdentityCredential", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing FederatedIdentityCredential resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static FederatedIdentityCredential Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new FederatedIdentityCredential(name, id, options);
+        }
+    }
+
+    public sealed class FederatedIdentityCredentialArgs : Pulumi.ResourceArgs
+    {
+        [Input("audiences")]
+        private InputList<string>? _audiences;
+
+        /// <summary>
+        /// The audiences that can appear in the external token. Defaults to 'api://AzureADTokenExchange'.
+        /// </summary>
+        public InputList<string> Audiences
+        {
+            get => _audiences ?? (_audiences = new InputList<string>());
+            set => _audiences = value;
+        }
+
+        /// <summary>
+        /// A description of the credential.
+        /// </summary>
+        [Input("description")]
+        public Input<string>? Description { get; set; }
+
+        /// <summary>
+        /// Builds the subject for GitHub Actions.
+        /// </summary>
+        [Input("github")]
+        public Input<Inputs.GitHubFederatedSubjectArgs>? Github { get; set; }
+
+        /// <summary>
+        /// The URL of the external identity provider. Defaults to the GitHub Actions issuer when 'github' is set.
+        /// </summary>
+        [Input("issuer")]
+        public Input<string>? Issuer { get; set; }
+
+        /// <summary>
+        /// Builds the subject for a Kubernetes service account.
+        /// </summary>
+        [Input("kubernetes")]
+        public Input<Inputs.KubernetesFederatedSubjectArgs>? Kubernetes { get; set; }
+
+        /// <summary>
+        /// The name of the credential, unique within the application. Changing this replaces the credential.
+        /// </summary>
+        [Input("name", required: true)]
+        public Input<string> Name { get; set; } = null!;
+
+        /// <summary>
+        /// The object ID of the application. Changing this replaces the credential.
+        /// </summary>
+        [Input("objectId", required: true)]
+        public Input<string> ObjectId { get; set; } = null!;
+
+        /// <summary>
+        /// The identity of the external workload. Set this, 'github' or 'kubernetes'.
+        /// </summary>
+        [Input("subject")]
+        public Input<string>? Subject { get; set; }
+
+        public FederatedIdentityCredentialArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,78 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode
+{
+    public static class GetDeletedApplication
+    {
+        /// <summary>
+        /// Looks up an application by object ID, either live or in the directory's deleted items, or a deleted application by display name, without restoring it.
+        /// </summary>
+        public static Task<GetDeletedApplicationResult> InvokeAsync(GetDeletedApplicationArgs? args = null, InvokeOptions? options = null)
+            => Pulumi.Deployment.Instance.InvokeAsync<GetDeletedApplicationResult>("knapcode:index:getDeletedApplication", args ?? new GetDeletedApplicationArgs(), options.WithVersion());
+    }
+
+
+    public sealed class GetDeletedApplicationArgs : Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The display name of the deleted application. Either this or `objectId` must be set.
+        /// </summary>
+        [Input("displayName")]
+        public string? DisplayName { get; set; }
+
+        /// <summary>
+        /// The object ID of the application. Either this or `displayName` must be set.
+        /// </summary>
+        [Input("objectId")]
+        public string? ObjectId { get; set; }
+
+        public GetDeletedApplicationArgs()
+        {
+        }
+    }
+
+
+    [OutputType]
+    public sealed class GetDeletedApplicationResult
+    {
+        /// <summary>
+        /// The application (client) ID of the application.
+        /// </summary>
+        public readonly string AppId;
+        /// <summary>
+        /// The display name of the application.
+        /// </summary>
+        public readonly string DisplayName;
+        /// <summary>
+        /// Whether the application is in the directory's deleted items.
+        /// </summary>
+        public readonly bool IsDeleted;
+        /// <summary>
+        /// The object ID of the application.
+        /// </summary>
+        public readonly string ObjectId;
+
+        [OutputConstructor]
+        private GetDeletedApplicationResult(
+            string appId,
+
+            string displayName,
+
+            bool isDeleted,
+
+            string objectId)
+        {
+            AppId = appId;
+            DisplayName = displayName;
+            IsDeleted = isDeleted;
+            ObjectId = objectId;
+        }
+    }
+}
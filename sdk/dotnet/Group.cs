@@ -0,0 +1,139 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode
+{
+    /// <summary>
+    /// A security or Microsoft 365 group managed through Microsoft Graph. The resource ID is the object ID of the group, which is also used to import it.
+    /// </summary>
+    [KnapcodeResourceType("knapcode:index:Group")]
+    public partial class Group : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The description of the group.
+        /// </summary>
+        [Output("description")]
+        public Output<string?> Description { get; private set; } = null!;
+
+        /// <summary>
+        /// The display name of the group.
+        /// </summary>
+        [Output("displayName")]
+        public Output<string> DisplayName { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether the group can be assigned Azure AD roles. Defaults to false. Changing this replaces the resource.
+        /// </summary>
+        [Output("isAssignableToRole")]
+        public Output<bool?> IsAssignableToRole { get; private set; } = null!;
+
+        /// <summary>
+        /// The email address of the group, for Microsoft 365 groups.
+        /// </summary>
+        [Output("mail")]
+        public Output<string?> Mail { get; private set; } = null!;
+
+        /// <summary>
+        /// The mail alias of the group. Can only contain letters, digits, '.', '-' and '_'. Defaults to the display name without the other characters.
+        /// </summary>
+        [Output("mailNickname")]
+        public Output<string> MailNickname { get; private set; } = null!;
+
+        /// <summary>
+        /// The object ID of the group.
+        /// </summary>
+        [Output("objectId")]
+        public Output<string> ObjectId { get; private set; } = null!;
+
+        /// <summary>
+        /// The kind of group. Both kinds are security enabled, so they can be assigned app roles. Defaults to 'Security'. Changing this replaces the resource.
+        /// </summary>
+        [Output("type")]
+        public Output<Pulumi.Knapcode.GroupType?> Type { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a Group resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public Group(string name, GroupArgs args, CustomResourceOptions? options = null)
+            : base("knapcode:index:Group", name, args ?? new GroupArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private Group(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("knapcode:index:Group", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing Group resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static Group Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new Group(name, id, options);
+        }
+    }
+
+    public sealed class GroupArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The description of the group.
+        /// </summary>
+        [Input("description")]
+        public Input<string>? Description { get; set; }
+
+        /// <summary>
+        /// The display name of the group.
+        /// </summary>
+        [Input("displayName", required: true)]
+        public Input<string> DisplayName { get; set; } = null!;
+
+        /// <summary>
+        /// Whether the group can be assigned Azure AD roles. Defaults to false. Changing this replaces the resource.
+        /// </summary>
+        [Input("isAssignableToRole")]
+        public Input<bool>? IsAssignableToRole { get; set; }
+
+        /// <summary>
+        /// The mail alias of the group. Can only contain letters, digits, '.', '-' and '_'. Defaults to the display name without the other characters.
+        /// </summary>
+        [Input("mailNickname")]
+        public Input<string>? MailNickname { get; set; }
+
+        /// <summary>
+        /// The kind of group. Both kinds are security enabled, so they can be assigned app roles. Defaults to 'Security'. Changing this replaces the resource.
+        /// </summary>
+        [Input("type")]
+        public Input<Pulumi.Knapcode.GroupType>? Type { get; set; }
+
+        public GroupArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,103 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode
+{
+    /// <summary>
+    /// Adds a member to a group. A member that is already in the group is not an error, and neither is a member that is already gone when the resource is deleted. The resource ID is the object ID of the group and the object ID of the member separated by a slash, which is also used to import it.
+    /// </summary>
+    [KnapcodeResourceType("knapcode:index:GroupMember")]
+    public partial class GroupMember : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The object ID of the group. Changing this replaces the resource.
+        /// </summary>
+        [Output("groupId")]
+        public Output<string> GroupId { get; private set; } = null!;
+
+        /// <summary>
+        /// The display name of the member.
+        /// </summary>
+        [Output("memberDisplayName")]
+        public Output<string> MemberDisplayName { get; private set; } = null!;
+
+        /// <summary>
+        /// The object ID of the user, group or service principal to add as a member. Changing this replaces the resource.
+        /// </summary>
+        [Output("memberId")]
+        public Output<string> MemberId { get; private set; } = null!;
+
+        /// <summary>
+        /// The type of the member, e.g. 'user', 'group' or 'servicePrincipal'.
+        /// </summary>
+        [Output("memberType")]
+        public Output<string> MemberType { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a GroupMember resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public GroupMember(string name, GroupMemberArgs args, CustomResourceOptions? options = null)
+            : base("knapcode:index:GroupMember", name, args ?? new GroupMemberArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private GroupMember(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("knapcode:index:GroupMember", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing GroupMember resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static GroupMember Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new GroupMember(name, id, options);
+        }
+    }
+
+    public sealed class GroupMemberArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The object ID of the group. Changing this replaces the resource.
+        /// </summary>
+        [Input("groupId", required: true)]
+        public Input<string> GroupId { get; set; } = null!;
+
+        /// <summary>
+        /// The object ID of the user, group or service principal to add as a member. Changing this replaces the resource.
+        /// </summary>
+        [Input("memberId", required: true)]
+        public Input<string> MemberId { get; set; } = null!;
+
+        public GroupMemberArgs()
+        {
+        }
+    }
+}
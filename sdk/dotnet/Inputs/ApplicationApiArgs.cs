@@ -0,0 +1,70 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Inputs
+{
+
+    /// <summary>
+    /// Settings for an application that exposes an API.
+    /// </summary>
+    public sealed class ApplicationApiArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Whether claims mapping can be used without a custom signing key.
+        /// </summary>
+        [Input("acceptMappedClaims")]
+        public Input<bool>? AcceptMappedClaims { get; set; }
+
+        [Input("knownClientApplications")]
+        private InputList<string>? _knownClientApplications;
+
+        /// <summary>
+        /// The app IDs of client applications that are bundled with this application for consent.
+        /// </summary>
+        public InputList<string> KnownClientApplications
+        {
+            get => _knownClientApplications ?? (_knownClientApplications = new InputList<string>());
+            set => _knownClientApplications = value;
+        }
+
+        [Input("oauth2PermissionScopes")]
+        private InputList<Inputs.ApplicationPermissionScopeArgs>? _oauth2PermissionScopes;
+
+        /// <summary>
+        /// The delegated permissions exposed by the API.
+        /// </summary>
+        public InputList<Inputs.ApplicationPermissionScopeArgs> Oauth2PermissionScopes
+        {
+            get => _oauth2PermissionScopes ?? (_oauth2PermissionScopes = new InputList<Inputs.ApplicationPermissionScopeArgs>());
+            set => _oauth2PermissionScopes = value;
+        }
+
+        [Input("preAuthorizedApplications")]
+        private InputList<Inputs.ApplicationPreAuthorizedApplicationArgs>? _preAuthorizedApplications;
+
+        /// <summary>
+        /// The client applications that are pre-authorized for the API's scopes.
+        /// </summary>
+        public InputList<Inputs.ApplicationPreAuthorizedApplicationArgs> PreAuthorizedApplications
+        {
+            get => _preAuthorizedApplications ?? (_preAuthorizedApplications = new InputList<Inputs.ApplicationPreAuthorizedApplicationArgs>());
+            set => _preAuthorizedApplications = value;
+        }
+
+        /// <summary>
+        /// The access token version expected by the API, 1 or 2.
+        /// </summary>
+        [Input("requestedAccessTokenVersion")]
+        public Input<int>? RequestedAccessTokenVersion { get; set; }
+
+        public ApplicationApiArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,64 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Inputs
+{
+
+    /// <summary>
+    /// A role that can be assigned to users, groups or applications.
+    /// </summary>
+    public sealed class ApplicationAppRoleArgs : Pulumi.ResourceArgs
+    {
+        [Input("allowedMemberTypes", required: true)]
+        private InputList<string>? _allowedMemberTypes;
+
+        /// <summary>
+        /// Who can be assigned the role: 'User' for users and groups, 'Application' for applications, or both.
+        /// </summary>
+        public InputList<string> AllowedMemberTypes
+        {
+            get => _allowedMemberTypes ?? (_allowedMemberTypes = new InputList<string>());
+            set => _allowedMemberTypes = value;
+        }
+
+        /// <summary>
+        /// The description of the role.
+        /// </summary>
+        [Input("description", required: true)]
+        public Input<string> Description { get; set; } = null!;
+
+        /// <summary>
+        /// The display name of the role.
+        /// </summary>
+        [Input("displayName", required: true)]
+        public Input<string> DisplayName { get; set; } = null!;
+
+        /// <summary>
+        /// The ID of the role. Defaults to a GUID derived from the value.
+        /// </summary>
+        [Input("id")]
+        public Input<string>? Id { get; set; }
+
+        /// <summary>
+        /// Whether the role is enabled. Defaults to true.
+        /// </summary>
+        [Input("isEnabled")]
+        public Input<bool>? IsEnabled { get; set; }
+
+        /// <summary>
+        /// The value of the role, which appears in the roles claim of tokens.
+        /// </summary>
+        [Input("value")]
+        public Input<string>? Value { get; set; }
+
+        public ApplicationAppRoleArgs()
+        {
+        }
+    }
+}
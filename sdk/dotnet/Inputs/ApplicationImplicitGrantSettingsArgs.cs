@@ -0,0 +1,34 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Inputs
+{
+
+    /// <summary>
+    /// Whether tokens can be requested with the OAuth 2.0 implicit flow.
+    /// </summary>
+    public sealed class ApplicationImplicitGrantSettingsArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Whether access tokens can be requested with the implicit flow.
+        /// </summary>
+        [Input("enableAccessTokenIssuance")]
+        public Input<bool>? EnableAccessTokenIssuance { get; set; }
+
+        /// <summary>
+        /// Whether ID tokens can be requested with the implicit flow.
+        /// </summary>
+        [Input("enableIdTokenIssuance")]
+        public Input<bool>? EnableIdTokenIssuance { get; set; }
+
+        public ApplicationImplicitGrantSettingsArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,52 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Inputs
+{
+
+    /// <summary>
+    /// An optional claim included in tokens.
+    /// </summary>
+    public sealed class ApplicationOptionalClaimArgs : Pulumi.ResourceArgs
+    {
+        [Input("additionalProperties")]
+        private InputList<string>? _additionalProperties;
+
+        /// <summary>
+        /// Additional properties of the claim.
+        /// </summary>
+        public InputList<string> AdditionalProperties
+        {
+            get => _additionalProperties ?? (_additionalProperties = new InputList<string>());
+            set => _additionalProperties = value;
+        }
+
+        /// <summary>
+        /// Whether the claim is essential for the application.
+        /// </summary>
+        [Input("essential")]
+        public Input<bool>? Essential { get; set; }
+
+        /// <summary>
+        /// The name of the claim.
+        /// </summary>
+        [Input("name", required: true)]
+        public Input<string> Name { get; set; } = null!;
+
+        /// <summary>
+        /// The source of the claim, e.g. 'user' for a directory extension. Not set for built-in claims.
+        /// </summary>
+        [Input("source")]
+        public Input<string>? Source { get; set; }
+
+        public ApplicationOptionalClaimArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,58 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Inputs
+{
+
+    /// <summary>
+    /// Optional claims included in the tokens issued for an application.
+    /// </summary>
+    public sealed class ApplicationOptionalClaimsArgs : Pulumi.ResourceArgs
+    {
+        [Input("accessToken")]
+        private InputList<Inputs.ApplicationOptionalClaimArgs>? _accessToken;
+
+        /// <summary>
+        /// The optional claims in access tokens.
+        /// </summary>
+        public InputList<Inputs.ApplicationOptionalClaimArgs> AccessToken
+        {
+            get => _accessToken ?? (_accessToken = new InputList<Inputs.ApplicationOptionalClaimArgs>());
+            set => _accessToken = value;
+        }
+
+        [Input("idToken")]
+        private InputList<Inputs.ApplicationOptionalClaimArgs>? _idToken;
+
+        /// <summary>
+        /// The optional claims in ID tokens.
+        /// </summary>
+        public InputList<Inputs.ApplicationOptionalClaimArgs> IdToken
+        {
+            get => _idToken ?? (_idToken = new InputList<Inputs.ApplicationOptionalClaimArgs>());
+            set => _idToken = value;
+        }
+
+        [Input("saml2Token")]
+        private InputList<Inputs.ApplicationOptionalClaimArgs>? _saml2Token;
+
+        /// <summary>
+        /// The optional claims in SAML tokens.
+        /// </summary>
+        public InputList<Inputs.ApplicationOptionalClaimArgs> Saml2Token
+        {
+            get => _saml2Token ?? (_saml2Token = new InputList<Inputs.ApplicationOptionalClaimArgs>());
+            set => _saml2Token = value;
+        }
+
+        public ApplicationOptionalClaimsArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,70 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Inputs
+{
+
+    /// <summary>
+    /// A delegated permission exposed by an application's API.
+    /// </summary>
+    public sealed class ApplicationPermissionScopeArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The description of the scope shown to admins.
+        /// </summary>
+        [Input("adminConsentDescription")]
+        public Input<string>? AdminConsentDescription { get; set; }
+
+        /// <summary>
+        /// The title of the scope shown to admins.
+        /// </summary>
+        [Input("adminConsentDisplayName")]
+        public Input<string>? AdminConsentDisplayName { get; set; }
+
+        /// <summary>
+        /// The ID of the scope. Defaults to a GUID derived from the value.
+        /// </summary>
+        [Input("id")]
+        public Input<string>? Id { get; set; }
+
+        /// <summary>
+        /// Whether the scope is enabled. Defaults to true.
+        /// </summary>
+        [Input("isEnabled")]
+        public Input<bool>? IsEnabled { get; set; }
+
+        /// <summary>
+        /// Whether users ('User') or only admins ('Admin') can consent to the scope. Defaults to 'User'.
+        /// </summary>
+        [Input("type")]
+        public Input<string>? Type { get; set; }
+
+        /// <summary>
+        /// The description of the scope shown to users.
+        /// </summary>
+        [Input("userConsentDescription")]
+        public Input<string>? UserConsentDescription { get; set; }
+
+        /// <summary>
+        /// The title of the scope shown to users.
+        /// </summary>
+        [Input("userConsentDisplayName")]
+        public Input<string>? UserConsentDisplayName { get; set; }
+
+        /// <summary>
+        /// The value of the scope, which appears in the scp claim of access tokens.
+        /// </summary>
+        [Input("value")]
+        public Input<string>? Value { get; set; }
+
+        public ApplicationPermissionScopeArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,40 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Inputs
+{
+
+    /// <summary>
+    /// A client application that can use an API's scopes without user consent.
+    /// </summary>
+    public sealed class ApplicationPreAuthorizedApplicationArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The app ID of the client application.
+        /// </summary>
+        [Input("appId", required: true)]
+        public Input<string> AppId { get; set; } = null!;
+
+        [Input("delegatedPermissionIds", required: true)]
+        private InputList<string>? _delegatedPermissionIds;
+
+        /// <summary>
+        /// The IDs of the scopes the client application is pre-authorized for.
+        /// </summary>
+        public InputList<string> DelegatedPermissionIds
+        {
+            get => _delegatedPermissionIds ?? (_delegatedPermissionIds = new InputList<string>());
+            set => _delegatedPermissionIds = value;
+        }
+
+        public ApplicationPreAuthorizedApplicationArgs()
+        {
+        }
+    }
+}
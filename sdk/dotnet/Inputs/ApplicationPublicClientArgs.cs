@@ -0,0 +1,34 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Inputs
+{
+
+    /// <summary>
+    /// Settings for a public client, like a desktop or mobile application.
+    /// </summary>
+    public sealed class ApplicationPublicClientArgs : Pulumi.ResourceArgs
+    {
+        [Input("redirectUris")]
+        private InputList<string>? _redirectUris;
+
+        /// <summary>
+        /// The URLs where tokens are sent for sign-in.
+        /// </summary>
+        public InputList<string> RedirectUris
+        {
+            get => _redirectUris ?? (_redirectUris = new InputList<string>());
+            set => _redirectUris = value;
+        }
+
+        public ApplicationPublicClientArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,40 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Inputs
+{
+
+    /// <summary>
+    /// The permissions an application requires on a resource application.
+    /// </summary>
+    public sealed class ApplicationRequiredResourceAccessArgs : Pulumi.ResourceArgs
+    {
+        [Input("resourceAccess", required: true)]
+        private InputList<Inputs.ApplicationResourceAccessArgs>? _resourceAccess;
+
+        /// <summary>
+        /// The permissions required on the resource.
+        /// </summary>
+        public InputList<Inputs.ApplicationResourceAccessArgs> ResourceAccess
+        {
+            get => _resourceAccess ?? (_resourceAccess = new InputList<Inputs.ApplicationResourceAccessArgs>());
+            set => _resourceAccess = value;
+        }
+
+        /// <summary>
+        /// The app ID of the resource application, e.g. '00000003-0000-0000-c000-000000000000' for Microsoft Graph.
+        /// </summary>
+        [Input("resourceAppId", required: true)]
+        public Input<string> ResourceAppId { get; set; } = null!;
+
+        public ApplicationRequiredResourceAccessArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,34 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Inputs
+{
+
+    /// <summary>
+    /// A permission an application requires on a resource.
+    /// </summary>
+    public sealed class ApplicationResourceAccessArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The ID of the scope or app role.
+        /// </summary>
+        [Input("id", required: true)]
+        public Input<string> Id { get; set; } = null!;
+
+        /// <summary>
+        /// 'Scope' for a delegated permission or 'Role' for an application permission.
+        /// </summary>
+        [Input("type", required: true)]
+        public Input<string> Type { get; set; } = null!;
+
+        public ApplicationResourceAccessArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,52 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Inputs
+{
+
+    /// <summary>
+    /// Settings for a web application.
+    /// </summary>
+    public sealed class ApplicationWebArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The home page of the application.
+        /// </summary>
+        [Input("homePageUrl")]
+        public Input<string>? HomePageUrl { get; set; }
+
+        /// <summary>
+        /// The implicit grant settings.
+        /// </summary>
+        [Input("implicitGrantSettings")]
+        public Input<Inputs.ApplicationImplicitGrantSettingsArgs>? ImplicitGrantSettings { get; set; }
+
+        /// <summary>
+        /// The URL used to sign out of the application.
+        /// </summary>
+        [Input("logoutUrl")]
+        public Input<string>? LogoutUrl { get; set; }
+
+        [Input("redirectUris")]
+        private InputList<string>? _redirectUris;
+
+        /// <summary>
+        /// The URLs where tokens are sent for sign-in.
+        /// </summary>
+        public InputList<string> RedirectUris
+        {
+            get => _redirectUris ?? (_redirectUris = new InputList<string>());
+            set => _redirectUris = value;
+        }
+
+        public ApplicationWebArgs()
+        {
+        }
+    }
+}
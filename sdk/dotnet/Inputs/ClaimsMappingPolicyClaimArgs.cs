@@ -0,0 +1,58 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Inputs
+{
+
+    /// <summary>
+    /// A claim emitted by a claims mapping policy, either from a source attribute or with a constant value.
+    /// </summary>
+    public sealed class ClaimsMappingPolicyClaimArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The attribute of the source the claim value comes from, e.g. 'employeeid'.
+        /// </summary>
+        [Input("id")]
+        public Input<string>? Id { get; set; }
+
+        /// <summary>
+        /// The name of the claim in JWTs.
+        /// </summary>
+        [Input("jwtClaimType")]
+        public Input<string>? JwtClaimType { get; set; }
+
+        /// <summary>
+        /// The URI of the claim in SAML tokens.
+        /// </summary>
+        [Input("samlClaimType")]
+        public Input<string>? SamlClaimType { get; set; }
+
+        /// <summary>
+        /// The name format of the claim in SAML tokens.
+        /// </summary>
+        [Input("samlNameFormat")]
+        public Input<string>? SamlNameFormat { get; set; }
+
+        /// <summary>
+        /// The source of the claim value: 'user', 'application', 'resource', 'audience', 'company' or 'transformation'.
+        /// </summary>
+        [Input("source")]
+        public Input<string>? Source { get; set; }
+
+        /// <summary>
+        /// A constant value for the claim, instead of a source and an ID.
+        /// </summary>
+        [Input("value")]
+        public Input<string>? Value { get; set; }
+
+        public ClaimsMappingPolicyClaimArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,40 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Inputs
+{
+
+    /// <summary>
+    /// The definition of a claims mapping policy.
+    /// </summary>
+    public sealed class ClaimsMappingPolicyDefinitionArgs : Pulumi.ResourceArgs
+    {
+        [Input("claimsSchema", required: true)]
+        private InputList<Inputs.ClaimsMappingPolicyClaimArgs>? _claimsSchema;
+
+        /// <summary>
+        /// The claims emitted in tokens.
+        /// </summary>
+        public InputList<Inputs.ClaimsMappingPolicyClaimArgs> ClaimsSchema
+        {
+            get => _claimsSchema ?? (_claimsSchema = new InputList<Inputs.ClaimsMappingPolicyClaimArgs>());
+            set => _claimsSchema = value;
+        }
+
+        /// <summary>
+        /// Whether the basic claim set is included in tokens in addition to the mapped claims. Defaults to false.
+        /// </summary>
+        [Input("includeBasicClaimSet")]
+        public Input<bool>? IncludeBasicClaimSet { get; set; }
+
+        public ClaimsMappingPolicyDefinitionArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,40 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Inputs
+{
+
+    /// <summary>
+    /// A client application that can use some of the API's scopes without user consent.
+    /// </summary>
+    public sealed class ExposeApiPreAuthorizedApplicationArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The app ID of the client application.
+        /// </summary>
+        [Input("appId", required: true)]
+        public Input<string> AppId { get; set; } = null!;
+
+        [Input("scopes", required: true)]
+        private InputList<string>? _scopes;
+
+        /// <summary>
+        /// The values of the scopes the client application is pre-authorized for.
+        /// </summary>
+        public InputList<string> Scopes
+        {
+            get => _scopes ?? (_scopes = new InputList<string>());
+            set => _scopes = value;
+        }
+
+        public ExposeApiPreAuthorizedApplicationArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,52 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Inputs
+{
+
+    /// <summary>
+    /// Builds the subject of a federated identity credential for GitHub Actions. Exactly one of branch, tag, environment and pullRequest must be set.
+    /// </summary>
+    public sealed class GitHubFederatedSubjectArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Trust workflows running on this branch.
+        /// </summary>
+        [Input("branch")]
+        public Input<string>? Branch { get; set; }
+
+        /// <summary>
+        /// Trust jobs that use this deployment environment.
+        /// </summary>
+        [Input("environment")]
+        public Input<string>? Environment { get; set; }
+
+        /// <summary>
+        /// Trust workflows triggered by pull requests.
+        /// </summary>
+        [Input("pullRequest")]
+        public Input<bool>? PullRequest { get; set; }
+
+        /// <summary>
+        /// The repository, in the form 'owner/repository'.
+        /// </summary>
+        [Input("repository", required: true)]
+        public Input<string> Repository { get; set; } = null!;
+
+        /// <summary>
+        /// Trust workflows running on this tag.
+        /// </summary>
+        [Input("tag")]
+        public Input<string>? Tag { get; set; }
+
+        public GitHubFederatedSubjectArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,34 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Inputs
+{
+
+    /// <summary>
+    /// Builds the subject of a federated identity credential for a Kubernetes service account.
+    /// </summary>
+    public sealed class KubernetesFederatedSubjectArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The namespace of the service account.
+        /// </summary>
+        [Input("namespace", required: true)]
+        public Input<string> Namespace { get; set; } = null!;
+
+        /// <summary>
+        /// The name of the service account.
+        /// </summary>
+        [Input("serviceAccount", required: true)]
+        public Input<string> ServiceAccount { get; set; } = null!;
+
+        public KubernetesFederatedSubjectArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,52 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Inputs
+{
+
+    /// <summary>
+    /// An API the application requires permissions on, with the permissions given by name.
+    /// </summary>
+    public sealed class RequiredResourceAccessResourceArgs : Pulumi.ResourceArgs
+    {
+        [Input("applicationPermissions")]
+        private InputList<string>? _applicationPermissions;
+
+        /// <summary>
+        /// The values of the application permissions (app roles) to require, like 'User.Read.All'.
+        /// </summary>
+        public InputList<string> ApplicationPermissions
+        {
+            get => _applicationPermissions ?? (_applicationPermissions = new InputList<string>());
+            set => _applicationPermissions = value;
+        }
+
+        [Input("delegatedPermissions")]
+        private InputList<string>? _delegatedPermissions;
+
+        /// <summary>
+        /// The values of the delegated permissions (scopes) to require, like 'User.Read' or 'openid'.
+        /// </summary>
+        public InputList<string> DelegatedPermissions
+        {
+            get => _delegatedPermissions ?? (_delegatedPermissions = new InputList<string>());
+            set => _delegatedPermissions = value;
+        }
+
+        /// <summary>
+        /// The API, either as the app ID of its application or as one of the well-known names: 'MicrosoftGraph', 'AzureADGraph', 'AzureKeyVault', 'AzureServiceManagement', 'AzureStorage', 'Office365ExchangeOnline' or 'SharePointOnline'.
+        /// </summary>
+        [Input("resourceApp", required: true)]
+        public Input<string> ResourceApp { get; set; } = null!;
+
+        public RequiredResourceAccessResourceArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,28 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Inputs
+{
+
+    /// <summary>
+    /// The definition of a token lifetime policy.
+    /// </summary>
+    public sealed class TokenLifetimePolicyDefinitionArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The lifetime of access and ID tokens as a time span between '00:10:00' and '1.00:00:00', e.g. '01:00:00' for an hour.
+        /// </summary>
+        [Input("accessTokenLifetime", required: true)]
+        public Input<string> AccessTokenLifetime { get; set; } = null!;
+
+        public TokenLifetimePolicyDefinitionArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,56 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Outputs
+{
+
+    [OutputType]
+    public sealed class ApplicationApi
+    {
+        /// <summary>
+        /// Whether claims mapping can be used without a custom signing key.
+        /// </summary>
+        public readonly bool? AcceptMappedClaims;
+        /// <summary>
+        /// The app IDs of client applications that are bundled with this application for consent.
+        /// </summary>
+        public readonly ImmutableArray<string> KnownClientApplications;
+        /// <summary>
+        /// The delegated permissions exposed by the API.
+        /// </summary>
+        public readonly ImmutableArray<Outputs.ApplicationPermissionScope> Oauth2PermissionScopes;
+        /// <summary>
+        /// The client applications that are pre-authorized for the API's scopes.
+        /// </summary>
+        public readonly ImmutableArray<Outputs.ApplicationPreAuthorizedApplication> PreAuthorizedApplications;
+        /// <summary>
+        /// The access token version expected by the API, 1 or 2.
+        /// </summary>
+        public readonly int? RequestedAccessTokenVersion;
+
+        [OutputConstructor]
+        private ApplicationApi(
+            bool? acceptMappedClaims,
+
+            ImmutableArray<string> knownClientApplications,
+
+            ImmutableArray<Outputs.ApplicationPermissionScope> oauth2PermissionScopes,
+
+            ImmutableArray<Outputs.ApplicationPreAuthorizedApplication> preAuthorizedApplications,
+
+            int? requestedAccessTokenVersion)
+        {
+            AcceptMappedClaims = acceptMappedClaims;
+            KnownClientApplications = knownClientApplications;
+            Oauth2PermissionScopes = oauth2PermissionScopes;
+            PreAuthorizedApplications = preAuthorizedApplications;
+            RequestedAccessTokenVersion = requestedAccessTokenVersion;
+        }
+    }
+}
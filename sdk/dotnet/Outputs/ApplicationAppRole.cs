@@ -0,0 +1,63 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Outputs
+{
+
+    [OutputType]
+    public sealed class ApplicationAppRole
+    {
+        /// <summary>
+        /// Who can be assigned the role: 'User' for users and groups, 'Application' for applications, or both.
+        /// </summary>
+        public readonly ImmutableArray<string> AllowedMemberTypes;
+        /// <summary>
+        /// The description of the role.
+        /// </summary>
+        public readonly string Description;
+        /// <summary>
+        /// The display name of the role.
+        /// </summary>
+        public readonly string DisplayName;
+        /// <summary>
+        /// The ID of the role. Defaults to a GUID derived from the value.
+        /// </summary>
+        public readonly string? Id;
+        /// <summary>
+        /// Whether the role is enabled. Defaults to true.
+        /// </summary>
+        public readonly bool? IsEnabled;
+        /// <summary>
+        /// The value of the role, which appears in the roles claim of tokens.
+        /// </summary>
+        public readonly string? Value;
+
+        [OutputConstructor]
+        private ApplicationAppRole(
+            ImmutableArray<string> allowedMemberTypes,
+
+            string description,
+
+            string displayName,
+
+            string? id,
+
+            bool? isEnabled,
+
+            string? value)
+        {
+            AllowedMemberTypes = allowedMemberTypes;
+            Description = description;
+            DisplayName = displayName;
+            Id = id;
+            IsEnabled = isEnabled;
+            Value = value;
+        }
+    }
+}
@@ -0,0 +1,35 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Outputs
+{
+
+    [OutputType]
+    public sealed class ApplicationImplicitGrantSettings
+    {
+        /// <summary>
+        /// Whether access tokens can be requested with the implicit flow.
+        /// </summary>
+        public readonly bool? EnableAccessTokenIssuance;
+        /// <summary>
+        /// Whether ID tokens can be requested with the implicit flow.
+        /// </summary>
+        public readonly bool? EnableIdTokenIssuance;
+
+        [OutputConstructor]
+        private ApplicationImplicitGrantSettings(
+            bool? enableAccessTokenIssuance,
+
+            bool? enableIdTokenIssuance)
+        {
+            EnableAccessTokenIssuance = enableAccessTokenIssuance;
+            EnableIdTokenIssuance = enableIdTokenIssuance;
+        }
+    }
+}
@@ -0,0 +1,49 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Outputs
+{
+
+    [OutputType]
+    public sealed class ApplicationOptionalClaim
+    {
+        /// <summary>
+        /// Additional properties of the claim.
+        /// </summary>
+        public readonly ImmutableArray<string> AdditionalProperties;
+        /// <summary>
+        /// Whether the claim is essential for the application.
+        /// </summary>
+        public readonly bool? Essential;
+        /// <summary>
+        /// The name of the claim.
+        /// </summary>
+        public readonly string Name;
+        /// <summary>
+        /// The source of the claim, e.g. 'user' for a directory extension. Not set for built-in claims.
+        /// </summary>
+        public readonly string? Source;
+
+        [OutputConstructor]
+        private ApplicationOptionalClaim(
+            ImmutableArray<string> additionalProperties,
+
+            bool? essential,
+
+            string name,
+
+            string? source)
+        {
+            AdditionalProperties = additionalProperties;
+            Essential = essential;
+            Name = name;
+            Source = source;
+        }
+    }
+}
@@ -0,0 +1,42 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Outputs
+{
+
+    [OutputType]
+    public sealed class ApplicationOptionalClaims
+    {
+        /// <summary>
+        /// The optional claims in access tokens.
+        /// </summary>
+        public readonly ImmutableArray<Outputs.ApplicationOptionalClaim> AccessToken;
+        /// <summary>
+        /// The optional claims in ID tokens.
+        /// </summary>
+        public readonly ImmutableArray<Outputs.ApplicationOptionalClaim> IdToken;
+        /// <summary>
+        /// The optional claims in SAML tokens.
+        /// </summary>
+        public readonly ImmutableArray<Outputs.ApplicationOptionalClaim> Saml2Token;
+
+        [OutputConstructor]
+        private ApplicationOptionalClaims(
+            ImmutableArray<Outputs.ApplicationOptionalClaim> accessToken,
+
+            ImmutableArray<Outputs.ApplicationOptionalClaim> idToken,
+
+            ImmutableArray<Outputs.ApplicationOptionalClaim> saml2Token)
+        {
+            AccessToken = accessToken;
+            IdToken = idToken;
+            Saml2Token = saml2Token;
+        }
+    }
+}
@@ -0,0 +1,77 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Outputs
+{
+
+    [OutputType]
+    public sealed class ApplicationPermissionScope
+    {
+        /// <summary>
+        /// The description of the scope shown to admins.
+        /// </summary>
+        public readonly string? AdminConsentDescription;
+        /// <summary>
+        /// The title of the scope shown to admins.
+        /// </summary>
+        public readonly string? AdminConsentDisplayName;
+        /// <summary>
+        /// The ID of the scope. Defaults to a GUID derived from the value.
+        /// </summary>
+        public readonly string? Id;
+        /// <summary>
+        /// Whether the scope is enabled. Defaults to true.
+        /// </summary>
+        public readonly bool? IsEnabled;
+        /// <summary>
+        /// Whether users ('User') or only admins ('Admin') can consent to the scope. Defaults to 'User'.
+        /// </summary>
+        public readonly string? Type;
+        /// <summary>
+        /// The description of the scope shown to users.
+        /// </summary>
+        public readonly string? UserConsentDescription;
+        /// <summary>
+        /// The title of the scope shown to users.
+        /// </summary>
+        public readonly string? UserConsentDisplayName;
+        /// <summary>
+        /// The value of the scope, which appears in the scp claim of access tokens.
+        /// </summary>
+        public readonly string? Value;
+
+        [OutputConstructor]
+        private ApplicationPermissionScope(
+            string? adminConsentDescription,
+
+            string? adminConsentDisplayName,
+
+            string? id,
+
+            bool? isEnabled,
+
+            string? type,
+
+            string? userConsentDescription,
+
+            string? userConsentDisplayName,
+
+            string? value)
+        {
+            AdminConsentDescription = adminConsentDescription;
+            AdminConsentDisplayName = adminConsentDisplayName;
+            Id = id;
+            IsEnabled = isEnabled;
+            Type = type;
+            UserConsentDescription = userConsentDescription;
+            UserConsentDisplayName = userConsentDisplayName;
+            Value = value;
+        }
+    }
+}
@@ -0,0 +1,35 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Outputs
+{
+
+    [OutputType]
+    public sealed class ApplicationPreAuthorizedApplication
+    {
+        /// <summary>
+        /// The app ID of the client application.
+        /// </summary>
+        public readonly string AppId;
+        /// <summary>
+        /// The IDs of the scopes the client application is pre-authorized for.
+        /// </summary>
+        public readonly ImmutableArray<string> DelegatedPermissionIds;
+
+        [OutputConstructor]
+        private ApplicationPreAuthorizedApplication(
+            string appId,
+
+            ImmutableArray<string> delegatedPermissionIds)
+        {
+            AppId = appId;
+            DelegatedPermissionIds = delegatedPermissionIds;
+        }
+    }
+}
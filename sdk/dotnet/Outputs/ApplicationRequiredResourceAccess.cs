@@ -0,0 +1,35 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Outputs
+{
+
+    [OutputType]
+    public sealed class ApplicationRequiredResourceAccess
+    {
+        /// <summary>
+        /// The permissions required on the resource.
+        /// </summary>
+        public readonly ImmutableArray<Outputs.ApplicationResourceAccess> ResourceAccess;
+        /// <summary>
+        /// The app ID of the resource application, e.g. '00000003-0000-0000-c000-000000000000' for Microsoft Graph.
+        /// </summary>
+        public readonly string ResourceAppId;
+
+        [OutputConstructor]
+        private ApplicationRequiredResourceAccess(
+            ImmutableArray<Outputs.ApplicationResourceAccess> resourceAccess,
+
+            string resourceAppId)
+        {
+            ResourceAccess = resourceAccess;
+            ResourceAppId = resourceAppId;
+        }
+    }
+}
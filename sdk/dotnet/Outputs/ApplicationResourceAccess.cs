@@ -0,0 +1,35 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Outputs
+{
+
+    [OutputType]
+    public sealed class ApplicationResourceAccess
+    {
+        /// <summary>
+        /// The ID of the scope or app role.
+        /// </summary>
+        public readonly string Id;
+        /// <summary>
+        /// 'Scope' for a delegated permission or 'Role' for an application permission.
+        /// </summary>
+        public readonly string Type;
+
+        [OutputConstructor]
+        private ApplicationResourceAccess(
+            string id,
+
+            string type)
+        {
+            Id = id;
+            Type = type;
+        }
+    }
+}
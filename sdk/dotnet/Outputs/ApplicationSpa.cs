@@ -0,0 +1,27 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Outputs
+{
+
+    [OutputType]
+    public sealed class ApplicationSpa
+    {
+        /// <summary>
+        /// The URLs where tokens are sent for sign-in.
+        /// </summary>
+        public readonly ImmutableArray<string> RedirectUris;
+
+        [OutputConstructor]
+        private ApplicationSpa(ImmutableArray<string> redirectUris)
+        {
+            RedirectUris = redirectUris;
+        }
+    }
+}
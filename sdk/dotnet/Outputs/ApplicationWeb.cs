@@ -0,0 +1,49 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Outputs
+{
+
+    [OutputType]
+    public sealed class ApplicationWeb
+    {
+        /// <summary>
+        /// The home page of the application.
+        /// </summary>
+        public readonly string? HomePageUrl;
+        /// <summary>
+        /// The implicit grant settings.
+        /// </summary>
+        public readonly Outputs.ApplicationImplicitGrantSettings? ImplicitGrantSettings;
+        /// <summary>
+        /// The URL used to sign out of the application.
+        /// </summary>
+        public readonly string? LogoutUrl;
+        /// <summary>
+        /// The URLs where tokens are sent for sign-in.
+        /// </summary>
+        public readonly ImmutableArray<string> RedirectUris;
+
+        [OutputConstructor]
+        private ApplicationWeb(
+            string? homePageUrl,
+
+            Outputs.ApplicationImplicitGrantSettings? implicitGrantSettings,
+
+            string? logoutUrl,
+
+            ImmutableArray<string> redirectUris)
+        {
+            HomePageUrl = homePageUrl;
+            ImplicitGrantSettings = implicitGrantSettings;
+            LogoutUrl = logoutUrl;
+            RedirectUris = redirectUris;
+        }
+    }
+}
@@ -0,0 +1,63 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Outputs
+{
+
+    [OutputType]
+    public sealed class ClaimsMappingPolicyClaim
+    {
+        /// <summary>
+        /// The attribute of the source the claim value comes from, e.g. 'employeeid'.
+        /// </summary>
+        public readonly string? Id;
+        /// <summary>
+        /// The name of the claim in JWTs.
+        /// </summary>
+        public readonly string? JwtClaimType;
+        /// <summary>
+        /// The URI of the claim in SAML tokens.
+        /// </summary>
+        public readonly string? SamlClaimType;
+        /// <summary>
+        /// The name format of the claim in SAML tokens.
+        /// </summary>
+        public readonly string? SamlNameFormat;
+        /// <summary>
+        /// The source of the claim value: 'user', 'application', 'resource', 'audience', 'company' or 'transformation'.
+        /// </summary>
+        public readonly string? Source;
+        /// <summary>
+        /// A constant value for the claim, instead of a source and an ID.
+        /// </summary>
+        public readonly string? Value;
+
+        [OutputConstructor]
+        private ClaimsMappingPolicyClaim(
+            string? id,
+
+            string? jwtClaimType,
+
+            string? samlClaimType,
+
+            string? samlNameFormat,
+
+            string? source,
+
+            string? value)
+        {
+            Id = id;
+            JwtClaimType = jwtClaimType;
+            SamlClaimType = samlClaimType;
+            SamlNameFormat = samlNameFormat;
+            Source = source;
+            Value = value;
+        }
+    }
+}
@@ -0,0 +1,35 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Outputs
+{
+
+    [OutputType]
+    public sealed class ClaimsMappingPolicyDefinition
+    {
+        /// <summary>
+        /// The claims emitted in tokens.
+        /// </summary>
+        public readonly ImmutableArray<Outputs.ClaimsMappingPolicyClaim> ClaimsSchema;
+        /// <summary>
+        /// Whether the basic claim set is included in tokens in addition to the mapped claims. Defaults to false.
+        /// </summary>
+        public readonly bool? IncludeBasicClaimSet;
+
+        [OutputConstructor]
+        private ClaimsMappingPolicyDefinition(
+            ImmutableArray<Outputs.ClaimsMappingPolicyClaim> claimsSchema,
+
+            bool? includeBasicClaimSet)
+        {
+            ClaimsSchema = claimsSchema;
+            IncludeBasicClaimSet = includeBasicClaimSet;
+        }
+    }
+}
@@ -0,0 +1,56 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Outputs
+{
+
+    [OutputType]
+    public sealed class GitHubFederatedSubject
+    {
+        /// <summary>
+        /// Trust workflows running on this branch.
+        /// </summary>
+        public readonly string? Branch;
+        /// <summary>
+        /// Trust jobs that use this deployment environment.
+        /// </summary>
+        public readonly string? Environment;
+        /// <summary>
+        /// Trust workflows triggered by pull requests.
+        /// </summary>
+        public readonly bool? PullRequest;
+        /// <summary>
+        /// The repository, in the form 'owner/repository'.
+        /// </summary>
+        public readonly string Repository;
+        /// <summary>
+        /// Trust workflows running on this tag.
+        /// </summary>
+        public readonly string? Tag;
+
+        [OutputConstructor]
+        private GitHubFederatedSubject(
+            string? branch,
+
+            string? environment,
+
+            bool? pullRequest,
+
+            string repository,
+
+            string? tag)
+        {
+            Branch = branch;
+            Environment = environment;
+            PullRequest = pullRequest;
+            Repository = repository;
+            Tag = tag;
+        }
+    }
+}
@@ -0,0 +1,35 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Outputs
+{
+
+    [OutputType]
+    public sealed class KubernetesFederatedSubject
+    {
+        /// <summary>
+        /// The namespace of the service account.
+        /// </summary>
+        public readonly string Namespace;
+        /// <summary>
+        /// The name of the service account.
+        /// </summary>
+        public readonly string ServiceAccount;
+
+        [OutputConstructor]
+        private KubernetesFederatedSubject(
+            string @namespace,
+
+            string serviceAccount)
+        {
+            Namespace = @namespace;
+            ServiceAccount = serviceAccount;
+        }
+    }
+}
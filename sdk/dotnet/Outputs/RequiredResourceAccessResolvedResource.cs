@@ -0,0 +1,49 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Outputs
+{
+
+    [OutputType]
+    public sealed class RequiredResourceAccessResolvedResource
+    {
+        /// <summary>
+        /// The IDs of the application permissions, by value.
+        /// </summary>
+        public readonly ImmutableDictionary<string, string> AppRoleIds;
+        /// <summary>
+        /// The app ID of the API.
+        /// </summary>
+        public readonly string ResourceAppId;
+        /// <summary>
+        /// The object ID of the API's service principal.
+        /// </summary>
+        public readonly string ResourceId;
+        /// <summary>
+        /// The IDs of the delegated permissions, by value.
+        /// </summary>
+        public readonly ImmutableDictionary<string, string> ScopeIds;
+
+        [OutputConstructor]
+        private RequiredResourceAccessResolvedResource(
+            ImmutableDictionary<string, string> appRoleIds,
+
+            string resourceAppId,
+
+            string resourceId,
+
+            ImmutableDictionary<string, string> scopeIds)
+        {
+            AppRoleIds = appRoleIds;
+            ResourceAppId = resourceAppId;
+            ResourceId = resourceId;
+            ScopeIds = scopeIds;
+        }
+    }
+}
@@ -0,0 +1,42 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Outputs
+{
+
+    [OutputType]
+    public sealed class RequiredResourceAccessResource
+    {
+        /// <summary>
+        /// The values of the application permissions (app roles) to require, like 'User.Read.All'.
+        /// </summary>
+        public readonly ImmutableArray<string> ApplicationPermissions;
+        /// <summary>
+        /// The values of the delegated permissions (scopes) to require, like 'User.Read' or 'openid'.
+        /// </summary>
+        public readonly ImmutableArray<string> DelegatedPermissions;
+        /// <summary>
+        /// The API, either as the app ID of its application or as one of the well-known names: 'MicrosoftGraph', 'AzureADGraph', 'AzureKeyVault', 'AzureServiceManagement', 'AzureStorage', 'Office365ExchangeOnline' or 'SharePointOnline'.
+        /// </summary>
+        public readonly string ResourceApp;
+
+        [OutputConstructor]
+        private RequiredResourceAccessResource(
+            ImmutableArray<string> applicationPermissions,
+
+            ImmutableArray<string> delegatedPermissions,
+
+            string resourceApp)
+        {
+            ApplicationPermissions = applicationPermissions;
+            DelegatedPermissions = delegatedPermissions;
+            ResourceApp = resourceApp;
+        }
+    }
+}
@@ -0,0 +1,27 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode.Outputs
+{
+
+    [OutputType]
+    public sealed class TokenLifetimePolicyDefinition
+    {
+        /// <summary>
+        /// The lifetime of access and ID tokens as a time span between '00:10:00' and '1.00:00:00', e.g. '01:00:00' for an hour.
+        /// </summary>
+        public readonly string AccessTokenLifetime;
+
+        [OutputConstructor]
+        private TokenLifetimePolicyDefinition(string accessTokenLifetime)
+        {
+            AccessTokenLifetime = accessTokenLifetime;
+        }
+    }
+}
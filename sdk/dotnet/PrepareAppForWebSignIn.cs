@@ -9,9 +9,64 @@ using Pulumi.Serialization;
 
 namespace Pulumi.Knapcode
 {
+    /// <summary>
+    /// Prepares an existing app registration for web sign-in on the provided host name using Microsoft Graph.
+    /// </summary>
     [KnapcodeResourceType("knapcode:index:PrepareAppForWebSignIn")]
     public partial class PrepareAppForWebSignIn : Pulumi.CustomResource
     {
+        /// <summary>
+        /// The application settings last written by this resource.
+        /// </summary>
+        [Output("appliedPatch")]
+        public Output<object> AppliedPatch { get; private set; } = null!;
+
+        [Output("conflictPolicy")]
+        public Output<Pulumi.Knapcode.ConflictPolicy?> ConflictPolicy { get; private set; } = null!;
+
+        /// <summary>
+        /// SHA-256 hash of the application settings last written by this resource.
+        /// </summary>
+        [Output("fingerprint")]
+        public Output<string> Fingerprint { get; private set; } = null!;
+
+        [Output("force")]
+        public Output<bool?> Force { get; private set; } = null!;
+
+        [Output("hostName")]
+        public Output<string> HostName { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether ID tokens and access tokens can be requested with the OAuth 2.0 implicit flow, for legacy single-page applications.
+        /// </summary>
+        [Output("implicitGrantSettings")]
+        public Output<Outputs.ApplicationImplicitGrantSettings?> ImplicitGrantSettings { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether the application is a public client, e.g. for the device code flow.
+        /// </summary>
+        [Output("isFallbackPublicClient")]
+        public Output<bool?> IsFallbackPublicClient { get; private set; } = null!;
+
+        [Output("objectId")]
+        public Output<string> ObjectId { get; private set; } = null!;
+
+        /// <summary>
+        /// The redirect URIs of the public client (mobile and desktop) platform, e.g. 'http://localhost' for command-line tools.
+        /// </summary>
+        [Output("publicClientRedirectUris")]
+        public Output<ImmutableArray<string>> PublicClientRedirectUris { get; private set; } = null!;
+
+        [Output("purgeOnDelete")]
+        public Output<bool?> PurgeOnDelete { get; private set; } = null!;
+
+        /// <summary>
+        /// Paths on the host name that are registered as single-page application redirect URIs, e.g. '/' for 'https://{hostName}/'. Each path must start with '/'.
+        /// </summary>
+        [Output("spaRedirectPaths")]
+        public Output<ImmutableArray<string>> SpaRedirectPaths { get; private set; } = null!;
+
+
         /// <summary>
         /// Create a PrepareAppForWebSignIn resource with the given unique name, arguments, and options.
         /// </summary>
@@ -56,12 +111,66 @@ namespace Pulumi.Knapcode
 
     public sealed class PrepareAppForWebSignInArgs : Pulumi.ResourceArgs
     {
+        /// <summary>
+        /// What to do when the application was changed outside of Pulumi since it was last written. Defaults to `overwrite`.
+        /// </summary>
+        [Input("conflictPolicy")]
+        public Input<Pulumi.Knapcode.ConflictPolicy>? ConflictPolicy { get; set; }
+
+        /// <summary>
+        /// Delete the application even if it does not have this resource's ownership tag. The tag is added to the application's `tags` when the resource is created or updated.
+        /// </summary>
+        [Input("force")]
+        public Input<bool>? Force { get; set; }
+
         [Input("hostName", required: true)]
         public Input<string> HostName { get; set; } = null!;
 
+        /// <summary>
+        /// Whether ID tokens and access tokens can be requested with the OAuth 2.0 implicit flow, for legacy single-page applications.
+        /// </summary>
+        [Input("implicitGrantSettings")]
+        public Input<Inputs.ApplicationImplicitGrantSettingsArgs>? ImplicitGrantSettings { get; set; }
+
+        /// <summary>
+        /// Whether the application is a public client, e.g. for the device code flow.
+        /// </summary>
+        [Input("isFallbackPublicClient")]
+        public Input<bool>? IsFallbackPublicClient { get; set; }
+
         [Input("objectId", required: true)]
         public Input<string> ObjectId { get; set; } = null!;
 
+        [Input("publicClientRedirectUris")]
+        private InputList<string>? _publicClientRedirectUris;
+
+        /// <summary>
+        /// The redirect URIs of the public client (mobile and desktop) platform, e.g. 'http://localhost' for command-line tools.
+        /// </summary>
+        public InputList<string> PublicClientRedirectUris
+        {
+            get => _publicClientRedirectUris ?? (_publicClientRedirectUris = new InputList<string>());
+            set => _publicClientRedirectUris = value;
+        }
+
+        /// <summary>
+        /// Permanently delete the application from the directory's deleted items when the resource is deleted, releasing its identifier URIs.
+        /// </summary>
+        [Input("purgeOnDelete")]
+        public Input<bool>? PurgeOnDelete { get; set; }
+
+        [Input("spaRedirectPaths")]
+        private InputList<string>? _spaRedirectPaths;
+
+        /// <summary>
+        /// Paths on the host name that are registered as single-page application redirect URIs, e.g. '/' for 'https://{hostName}/'. Each path must start with '/'.
+        /// </summary>
+        public InputList<string> SpaRedirectPaths
+        {
+            get => _spaRedirectPaths ?? (_spaRedirectPaths = new InputList<string>());
+            set => _spaRedirectPaths = value;
+        }
+
         public PrepareAppForWebSignInArgs()
         {
         }
@@ -9,6 +9,9 @@ using Pulumi.Serialization;
 
 namespace Pulumi.Knapcode
 {
+    /// <summary>
+    /// The provider type for the knapcode package.
+    /// </summary>
     [KnapcodeResourceType("pulumi:providers:knapcode")]
     public partial class Provider : Pulumi.ProviderResource
     {
@@ -39,6 +42,18 @@ namespace Pulumi.Knapcode
 
     public sealed class ProviderArgs : Pulumi.ResourceArgs
     {
+        [Input("defaultOwners", json: true)]
+        private InputList<string>? _defaultOwners;
+
+        /// <summary>
+        /// The object IDs of users or service principals that are added as owners of every application and service principal created by this provider, so that they can be managed in the portal.
+        /// </summary>
+        public InputList<string> DefaultOwners
+        {
+            get => _defaultOwners ?? (_defaultOwners = new InputList<string>());
+            set => _defaultOwners = value;
+        }
+
         public ProviderArgs()
         {
         }
@@ -0,0 +1,127 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode
+{
+    /// <summary>
+    /// Manages the API permissions an application requires, by permission name, and optionally grants admin consent for them. Entries of requiredResourceAccess for other APIs are left untouched. The resource ID is the object ID of the application.
+    /// </summary>
+    [KnapcodeResourceType("knapcode:index:RequiredResourceAccess")]
+    public partial class RequiredResourceAccess : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The app ID of the application.
+        /// </summary>
+        [Output("appId")]
+        public Output<string> AppId { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether to grant the permissions for the whole tenant, like the 'Grant admin consent' button in the portal does. This needs a service principal for the application. Defaults to false.
+        /// </summary>
+        [Output("grantAdminConsent")]
+        public Output<bool?> GrantAdminConsent { get; private set; } = null!;
+
+        /// <summary>
+        /// The object ID of the application. Changing this replaces the resource.
+        /// </summary>
+        [Output("objectId")]
+        public Output<string> ObjectId { get; private set; } = null!;
+
+        /// <summary>
+        /// The APIs with the permission names resolved to IDs.
+        /// </summary>
+        [Output("resolvedResources")]
+        public Output<ImmutableArray<Outputs.RequiredResourceAccessResolvedResource>> ResolvedResources { get; private set; } = null!;
+
+        /// <summary>
+        /// The APIs the application requires permissions on. Each API can only be listed once.
+        /// </summary>
+        [Output("resources")]
+        public Output<ImmutableArray<Outputs.RequiredResourceAccessResource>> Resources { get; private set; } = null!;
+
+        /// <summary>
+        /// The object ID of the application's service principal the permissions are granted to, if admin consent is granted.
+        /// </summary>
+        [Output("servicePrincipalId")]
+        public Output<string?> ServicePrincipalId { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a RequiredResourceAccess resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public RequiredResourceAccess(string name, RequiredResourceAccessArgs args, CustomResourceOptions? options = null)
+            : base("knapcode:index:RequiredResourceAccess", name, args ?? new RequiredResourceAccessArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private RequiredResourceAccess(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("knapcode:index:RequiredResourceAccess", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing RequiredResourceAccess resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static RequiredResourceAccess Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new RequiredResourceAccess(name, id, options);
+        }
+    }
+
+    public sealed class RequiredResourceAccessArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Whether to grant the permissions for the whole tenant, like the 'Grant admin consent' button in the portal does. This needs a service principal for the application. Defaults to false.
+        /// </summary>
+        [Input("grantAdminConsent")]
+        public Input<bool>? GrantAdminConsent { get; set; }
+
+        /// <summary>
+        /// The object ID of the application. Changing this replaces the resource.
+        /// </summary>
+        [Input("objectId", required: true)]
+        public Input<string> ObjectId { get; set; } = null!;
+
+        [Input("resources", required: true)]
+        private InputList<Inputs.RequiredResourceAccessResourceArgs>? _resources;
+
+        /// <summary>
+        /// The APIs the application requires permissions on. Each API can only be listed once.
+        /// </summary>
+        public InputList<Inputs.RequiredResourceAccessResourceArgs> Resources
+        {
+            get => _resources ?? (_resources = new InputList<Inputs.RequiredResourceAccessResourceArgs>());
+            set => _resources = value;
+        }
+
+        public RequiredResourceAccessArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,71 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode
+{
+    public static class RestoreDeletedApplication
+    {
+        /// <summary>
+        /// Restores a soft-deleted application from the directory's deleted items and waits for it to be available. An application that is not deleted is left as-is. Functions also run during previews, so use the RestoredApplication resource to only restore the application when the stack is updated.
+        /// </summary>
+        public static Task<RestoreDeletedApplicationResult> InvokeAsync(RestoreDeletedApplicationArgs? args = null, InvokeOptions? options = null)
+            => Pulumi.Deployment.Instance.InvokeAsync<RestoreDeletedApplicationResult>("knapcode:index:restoreDeletedApplication", args ?? new RestoreDeletedApplicationArgs(), options.WithVersion());
+    }
+
+
+    public sealed class RestoreDeletedApplicationArgs : Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The display name of the deleted application. Either this or `objectId` must be set.
+        /// </summary>
+        [Input("displayName")]
+        public string? DisplayName { get; set; }
+
+        /// <summary>
+        /// The object ID of the deleted application. Either this or `displayName` must be set.
+        /// </summary>
+        [Input("objectId")]
+        public string? ObjectId { get; set; }
+
+        public RestoreDeletedApplicationArgs()
+        {
+        }
+    }
+
+
+    [OutputType]
+    public sealed class RestoreDeletedApplicationResult
+    {
+        /// <summary>
+        /// The application (client) ID of the restored application.
+        /// </summary>
+        public readonly string AppId;
+        /// <summary>
+        /// The display name of the restored application.
+        /// </summary>
+        public readonly string DisplayName;
+        /// <summary>
+        /// The object ID of the restored application.
+        /// </summary>
+        public readonly string ObjectId;
+
+        [OutputConstructor]
+        private RestoreDeletedApplicationResult(
+            string appId,
+
+            string displayName,
+
+            string objectId)
+        {
+            AppId = appId;
+            DisplayName = displayName;
+            ObjectId = objectId;
+        }
+    }
+}
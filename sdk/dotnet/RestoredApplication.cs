@@ -0,0 +1,97 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode
+{
+    /// <summary>
+    /// Restores a soft-deleted application from the directory's deleted items, keeping its object ID and application ID. Deleting this resource leaves the application in place.
+    /// </summary>
+    [KnapcodeResourceType("knapcode:index:RestoredApplication")]
+    public partial class RestoredApplication : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The application (client) ID of the restored application.
+        /// </summary>
+        [Output("appId")]
+        public Output<string> AppId { get; private set; } = null!;
+
+        /// <summary>
+        /// The display name of the restored application.
+        /// </summary>
+        [Output("displayName")]
+        public Output<string> DisplayName { get; private set; } = null!;
+
+        /// <summary>
+        /// The object ID of the restored application.
+        /// </summary>
+        [Output("objectId")]
+        public Output<string> ObjectId { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a RestoredApplication resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public RestoredApplication(string name, RestoredApplicationArgs? args = null, CustomResourceOptions? options = null)
+            : base("knapcode:index:RestoredApplication", name, args ?? new RestoredApplicationArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private RestoredApplication(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("knapcode:index:RestoredApplication", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing RestoredApplication resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static RestoredApplication Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new RestoredApplication(name, id, options);
+        }
+    }
+
+    public sealed class RestoredApplicationArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The display name of the deleted application. Either this or `objectId` must be set.
+        /// </summary>
+        [Input("displayName")]
+        public Input<string>? DisplayName { get; set; }
+
+        /// <summary>
+        /// The object ID of the deleted application. Either this or `displayName` must be set.
+        /// </summary>
+        [Input("objectId")]
+        public Input<string>? ObjectId { get; set; }
+
+        public RestoredApplicationArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,145 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode
+{
+    /// <summary>
+    /// The service principal (enterprise application) of an application, managed through Microsoft Graph. The resource ID is the object ID of the service principal, which is also used to import it.
+    /// </summary>
+    [KnapcodeResourceType("knapcode:index:ServicePrincipal")]
+    public partial class ServicePrincipal : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// Whether users can sign in to the application. Defaults to true.
+        /// </summary>
+        [Output("accountEnabled")]
+        public Output<bool?> AccountEnabled { get; private set; } = null!;
+
+        /// <summary>
+        /// The app ID (client ID) of the application. Changing this replaces the service principal.
+        /// </summary>
+        [Output("appId")]
+        public Output<string> AppId { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether users and other apps must be assigned an app role before they can get tokens for the application. Defaults to false.
+        /// </summary>
+        [Output("appRoleAssignmentRequired")]
+        public Output<bool?> AppRoleAssignmentRequired { get; private set; } = null!;
+
+        /// <summary>
+        /// The display name of the service principal, copied from the application.
+        /// </summary>
+        [Output("displayName")]
+        public Output<string> DisplayName { get; private set; } = null!;
+
+        /// <summary>
+        /// Free text notes about the service principal.
+        /// </summary>
+        [Output("notes")]
+        public Output<string?> Notes { get; private set; } = null!;
+
+        /// <summary>
+        /// The object ID of the service principal.
+        /// </summary>
+        [Output("objectId")]
+        public Output<string> ObjectId { get; private set; } = null!;
+
+        /// <summary>
+        /// Tags on the service principal.
+        /// </summary>
+        [Output("tags")]
+        public Output<ImmutableArray<string>> Tags { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a ServicePrincipal resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public ServicePrincipal(string name, ServicePrincipalArgs args, CustomResourceOptions? options = null)
+            : base("knapcode:index:ServicePrincipal", name, args ?? new ServicePrincipalArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private ServicePrincipal(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("knapcode:index:ServicePrincipal", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing ServicePrincipal resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static ServicePrincipal Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new ServicePrincipal(name, id, options);
+        }
+    }
+
+    public sealed class ServicePrincipalArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Whether users can sign in to the application. Defaults to true.
+        /// </summary>
+        [Input("accountEnabled")]
+        public Input<bool>? AccountEnabled { get; set; }
+
+        /// <summary>
+        /// The app ID (client ID) of the application. Changing this replaces the service principal.
+        /// </summary>
+        [Input("appId", required: true)]
+        public Input<string> AppId { get; set; } = null!;
+
+        /// <summary>
+        /// Whether users and other apps must be assigned an app role before they can get tokens for the application. Defaults to false.
+        /// </summary>
+        [Input("appRoleAssignmentRequired")]
+        public Input<bool>? AppRoleAssignmentRequired { get; set; }
+
+        /// <summary>
+        /// Free text notes about the service principal.
+        /// </summary>
+        [Input("notes")]
+        public Input<string>? Notes { get; set; }
+
+        [Input("tags")]
+        private InputList<string>? _tags;
+
+        /// <summary>
+        /// Tags on the service principal.
+        /// </summary>
+        public InputList<string> Tags
+        {
+            get => _tags ?? (_tags = new InputList<string>());
+            set => _tags = value;
+        }
+
+        public ServicePrincipalArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,103 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode
+{
+    /// <summary>
+    /// Manages the optional claims and group membership claims of an application. Other settings are left untouched, so don't set 'optionalClaims' on an Application resource for the same application. The resource ID is the object ID of the application, which is also used to import it.
+    /// </summary>
+    [KnapcodeResourceType("knapcode:index:TokenConfiguration")]
+    public partial class TokenConfiguration : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The groups included in the groups claim. Must be set when the 'groups' optional claim is used. Defaults to 'None'.
+        /// </summary>
+        [Output("groupMembershipClaims")]
+        public Output<Pulumi.Knapcode.GroupMembershipClaims?> GroupMembershipClaims { get; private set; } = null!;
+
+        /// <summary>
+        /// The object ID of the application. Changing this replaces the resource.
+        /// </summary>
+        [Output("objectId")]
+        public Output<string> ObjectId { get; private set; } = null!;
+
+        /// <summary>
+        /// The optional claims included in the tokens issued for the application. Built-in claims and their additional properties are validated.
+        /// </summary>
+        [Output("optionalClaims")]
+        public Output<Outputs.ApplicationOptionalClaims?> OptionalClaims { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a TokenConfiguration resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public TokenConfiguration(string name, TokenConfigurationArgs args, CustomResourceOptions? options = null)
+            : base("knapcode:index:TokenConfiguration", name, args ?? new TokenConfigurationArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private TokenConfiguration(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("knapcode:index:TokenConfiguration", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing TokenConfiguration resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static TokenConfiguration Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new TokenConfiguration(name, id, options);
+        }
+    }
+
+    public sealed class TokenConfigurationArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The groups included in the groups claim. Must be set when the 'groups' optional claim is used. Defaults to 'None'.
+        /// </summary>
+        [Input("groupMembershipClaims")]
+        public Input<Pulumi.Knapcode.GroupMembershipClaims>? GroupMembershipClaims { get; set; }
+
+        /// <summary>
+        /// The object ID of the application. Changing this replaces the resource.
+        /// </summary>
+        [Input("objectId", required: true)]
+        public Input<string> ObjectId { get; set; } = null!;
+
+        /// <summary>
+        /// The optional claims included in the tokens issued for the application. Built-in claims and their additional properties are validated.
+        /// </summary>
+        [Input("optionalClaims")]
+        public Input<Inputs.ApplicationOptionalClaimsArgs>? OptionalClaims { get; set; }
+
+        public TokenConfigurationArgs()
+        {
+        }
+    }
+}
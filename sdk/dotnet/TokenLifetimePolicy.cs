@@ -0,0 +1,109 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode
+{
+    /// <summary>
+    /// A token lifetime policy that sets the lifetime of access and ID tokens. The resource ID is the ID of the policy, which is also used to import it.
+    /// </summary>
+    [KnapcodeResourceType("knapcode:index:TokenLifetimePolicy")]
+    public partial class TokenLifetimePolicy : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The token lifetimes set by the policy. Changing the definition updates the policy in place.
+        /// </summary>
+        [Output("definition")]
+        public Output<Outputs.TokenLifetimePolicyDefinition> Definition { get; private set; } = null!;
+
+        /// <summary>
+        /// The display name of the policy.
+        /// </summary>
+        [Output("displayName")]
+        public Output<string> DisplayName { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether the policy applies to every application in the organization that has no policy assigned. Defaults to false.
+        /// </summary>
+        [Output("isOrganizationDefault")]
+        public Output<bool?> IsOrganizationDefault { get; private set; } = null!;
+
+        /// <summary>
+        /// The ID of the policy.
+        /// </summary>
+        [Output("policyId")]
+        public Output<string> PolicyId { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a TokenLifetimePolicy resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public TokenLifetimePolicy(string name, TokenLifetimePolicyArgs args, CustomResourceOptions? options = null)
+            : base("knapcode:index:TokenLifetimePolicy", name, args ?? new TokenLifetimePolicyArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private TokenLifetimePolicy(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("knapcode:index:TokenLifetimePolicy", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing TokenLifetimePolicy resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static TokenLifetimePolicy Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new TokenLifetimePolicy(name, id, options);
+        }
+    }
+
+    public sealed class TokenLifetimePolicyArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The token lifetimes set by the policy. Changing the definition updates the policy in place.
+        /// </summary>
+        [Input("definition", required: true)]
+        public Input<Inputs.TokenLifetimePolicyDefinitionArgs> Definition { get; set; } = null!;
+
+        /// <summary>
+        /// The display name of the policy.
+        /// </summary>
+        [Input("displayName", required: true)]
+        public Input<string> DisplayName { get; set; } = null!;
+
+        /// <summary>
+        /// Whether the policy applies to every application in the organization that has no policy assigned. Defaults to false.
+        /// </summary>
+        [Input("isOrganizationDefault")]
+        public Input<bool>? IsOrganizationDefault { get; set; }
+
+        public TokenLifetimePolicyArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,91 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Knapcode
+{
+    /// <summary>
+    /// Assigns a token lifetime policy to the application of a service principal, since Microsoft Graph only assigns token lifetime policies to applications. The application must be in this tenant. The resource ID is the object ID of the service principal and the policy ID separated by a slash, which is also used to import it.
+    /// </summary>
+    [KnapcodeResourceType("knapcode:index:TokenLifetimePolicyAssignment")]
+    public partial class TokenLifetimePolicyAssignment : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The ID of the policy. Changing this replaces the resource.
+        /// </summary>
+        [Output("policyId")]
+        public Output<string> PolicyId { get; private set; } = null!;
+
+        /// <summary>
+        /// The object ID of the service principal. Changing this replaces the resource.
+        /// </summary>
+        [Output("servicePrincipalId")]
+        public Output<string> ServicePrincipalId { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a TokenLifetimePolicyAssignment resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public TokenLifetimePolicyAssignment(string name, TokenLifetimePolicyAssignmentArgs args, CustomResourceOptions? options = null)
+            : base("knapcode:index:TokenLifetimePolicyAssignment", name, args ?? new TokenLifetimePolicyAssignmentArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private TokenLifetimePolicyAssignment(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("knapcode:index:TokenLifetimePolicyAssignment", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing TokenLifetimePolicyAssignment resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static TokenLifetimePolicyAssignment Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new TokenLifetimePolicyAssignment(name, id, options);
+        }
+    }
+
+    public sealed class TokenLifetimePolicyAssignmentArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The ID of the policy. Changing this replaces the resource.
+        /// </summary>
+        [Input("policyId", required: true)]
+        public Input<string> PolicyId { get; set; } = null!;
+
+        /// <summary>
+        /// The object ID of the service principal. Changing this replaces the resource.
+        /// </summary>
+        [Input("servicePrincipalId", required: true)]
+        public Input<string> ServicePrincipalId { get; set; } = null!;
+
+        public TokenLifetimePolicyAssignmentArgs()
+        {
+        }
+    }
+}
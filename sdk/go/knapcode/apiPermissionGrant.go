@@ -0,0 +1,163 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package knapcode
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// Grants application permissions of an API, like Microsoft Graph, to a service principal by permission name. The resource ID is the principal's object ID and the API's app ID separated by a slash, which is also the format used to import a grant.
+type ApiPermissionGrant struct {
+	pulumi.CustomResourceState
+
+	// The IDs of the granted app roles, by permission name.
+	AppRoleIds pulumi.StringMapOutput `pulumi:"appRoleIds"`
+	// The names of the application permissions to grant, e.g. 'User.Read.All'.
+	Permissions pulumi.StringArrayOutput `pulumi:"permissions"`
+	// The object ID of the service principal, e.g. a managed identity, that is granted the permissions. Changing this replaces the grant.
+	PrincipalId pulumi.StringOutput `pulumi:"principalId"`
+	// The API, either one of the well-known names 'MicrosoftGraph', 'AzureADGraph', 'AzureKeyVault', 'AzureServiceManagement', 'AzureStorage', 'Office365ExchangeOnline' and 'SharePointOnline', or an app ID. Changing this replaces the grant.
+	ResourceApp pulumi.StringOutput `pulumi:"resourceApp"`
+	// The app ID of the API.
+	ResourceAppId pulumi.StringOutput `pulumi:"resourceAppId"`
+	// The object ID of the API's service principal.
+	ResourceId pulumi.StringOutput `pulumi:"resourceId"`
+}
+
+// NewApiPermissionGrant registers a new resource with the given unique name, arguments, and options.
+func NewApiPermissionGrant(ctx *pulumi.Context,
+	name string, args *ApiPermissionGrantArgs, opts ...pulumi.ResourceOption) (*ApiPermissionGrant, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Permissions == nil {
+		return nil, errors.New("invalid value for required argument 'Permissions'")
+	}
+	if args.PrincipalId == nil {
+		return nil, errors.New("invalid value for required argument 'PrincipalId'")
+	}
+	if args.ResourceApp == nil {
+		return nil, errors.New("invalid value for required argument 'ResourceApp'")
+	}
+	var resource ApiPermissionGrant
+	err := ctx.RegisterResource("knapcode:index:ApiPermissionGrant", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetApiPermissionGrant gets an existing ApiPermissionGrant resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetApiPermissionGrant(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *ApiPermissionGrantState, opts ...pulumi.ResourceOption) (*ApiPermissionGrant, error) {
+	var resource ApiPermissionGrant
+	err := ctx.ReadResource("knapcode:index:ApiPermissionGrant", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering ApiPermissionGrant resources.
+type apiPermissionGrantState struct {
+	// The IDs of the granted app roles, by permission name.
+	AppRoleIds map[string]string `pulumi:"appRoleIds"`
+	// The names of the application permissions to grant, e.g. 'User.Read.All'.
+	Permissions []string `pulumi:"permissions"`
+	// The object ID of the service principal, e.g. a managed identity, that is granted the permissions. Changing this replaces the grant.
+	PrincipalId *string `pulumi:"principalId"`
+	// The API, either one of the well-known names 'MicrosoftGraph', 'AzureADGraph', 'AzureKeyVault', 'AzureServiceManagement', 'AzureStorage', 'Office365ExchangeOnline' and 'SharePointOnline', or an app ID. Changing this replaces the grant.
+	ResourceApp *string `pulumi:"resourceApp"`
+	// The app ID of the API.
+	ResourceAppId *string `pulumi:"resourceAppId"`
+	// The object ID of the API's service principal.
+	ResourceId *string `pulumi:"resourceId"`
+}
+
+type ApiPermissionGrantState struct {
+	// The IDs of the granted app roles, by permission name.
+	AppRoleIds pulumi.StringMapInput
+	// The names of the application permissions to grant, e.g. 'User.Read.All'.
+	Permissions pulumi.StringArrayInput
+	// The object ID of the service principal, e.g. a managed identity, that is granted the permissions. Changing this replaces the grant.
+	PrincipalId pulumi.StringPtrInput
+	// The API, either one of the well-known names 'MicrosoftGraph', 'AzureADGraph', 'AzureKeyVault', 'AzureServiceManagement', 'AzureStorage', 'Office365ExchangeOnline' and 'SharePointOnline', or an app ID. Changing this replaces the grant.
+	ResourceApp pulumi.StringPtrInput
+	// The app ID of the API.
+	ResourceAppId pulumi.StringPtrInput
+	// The object ID of the API's service principal.
+	ResourceId pulumi.StringPtrInput
+}
+
+func (ApiPermissionGrantState) ElementType() reflect.Type {
+	return reflect.TypeOf((*apiPermissionGrantState)(nil)).Elem()
+}
+
+type apiPermissionGrantArgs struct {
+	// The names of the application permissions to grant, e.g. 'User.Read.All'.
+	Permissions []string `pulumi:"permissions"`
+	// The object ID of the service principal, e.g. a managed identity, that is granted the permissions. Changing this replaces the grant.
+	PrincipalId string `pulumi:"principalId"`
+	// The API, either one of the well-known names 'MicrosoftGraph', 'AzureADGraph', 'AzureKeyVault', 'AzureServiceManagement', 'AzureStorage', 'Office365ExchangeOnline' and 'SharePointOnline', or an app ID. Changing this replaces the grant.
+	ResourceApp string `pulumi:"resourceApp"`
+}
+
+// The set of arguments for constructing a ApiPermissionGrant resource.
+type ApiPermissionGrantArgs struct {
+	// The names of the application permissions to grant, e.g. 'User.Read.All'.
+	Permissions pulumi.StringArrayInput
+	// The object ID of the service principal, e.g. a managed identity, that is granted the permissions. Changing this replaces the grant.
+	PrincipalId pulumi.StringInput
+	// The API, either one of the well-known names 'MicrosoftGraph', 'AzureADGraph', 'AzureKeyVault', 'AzureServiceManagement', 'AzureStorage', 'Office365ExchangeOnline' and 'SharePointOnline', or an app ID. Changing this replaces the grant.
+	ResourceApp pulumi.StringInput
+}
+
+func (ApiPermissionGrantArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*apiPermissionGrantArgs)(nil)).Elem()
+}
+
+type ApiPermissionGrantInput interface {
+	pulumi.Input
+
+	ToApiPermissionGrantOutput() ApiPermissionGrantOutput
+	ToApiPermissionGrantOutputWithContext(ctx context.Context) ApiPermissionGrantOutput
+}
+
+func (*ApiPermissionGrant) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApiPermissionGrant)(nil))
+}
+
+func (i *ApiPermissionGrant) ToApiPermissionGrantOutput() ApiPermissionGrantOutput {
+	return i.ToApiPermissionGrantOutputWithContext(context.Background())
+}
+
+func (i *ApiPermissionGrant) ToApiPermissionGrantOutputWithContext(ctx context.Context) ApiPermissionGrantOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApiPermissionGrantOutput)
+}
+
+type ApiPermissionGrantOutput struct {
+	*pulumi.OutputState
+}
+
+func (ApiPermissionGrantOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApiPermissionGrant)(nil))
+}
+
+func (o ApiPermissionGrantOutput) ToApiPermissionGrantOutput() ApiPermissionGrantOutput {
+	return o
+}
+
+func (o ApiPermissionGrantOutput) ToApiPermissionGrantOutputWithContext(ctx context.Context) ApiPermissionGrantOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(ApiPermissionGrantOutput{})
+}
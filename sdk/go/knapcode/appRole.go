@@ -0,0 +1,188 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package knapcode
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// A single app role of an application. The other app roles of the application are left untouched, so roles can be defined from several stacks. The resource ID is the application's object ID and the role ID separated by a slash, which is also the format used to import a role.
+type AppRole struct {
+	pulumi.CustomResourceState
+
+	// Who can be assigned the role: 'User' for users and groups, 'Application' for applications, or both.
+	AllowedMemberTypes pulumi.StringArrayOutput `pulumi:"allowedMemberTypes"`
+	// The description of the role.
+	Description pulumi.StringOutput `pulumi:"description"`
+	// The display name of the role.
+	DisplayName pulumi.StringOutput `pulumi:"displayName"`
+	// Whether the role is enabled. Defaults to true.
+	IsEnabled pulumi.BoolOutput `pulumi:"isEnabled"`
+	// The object ID of the application. Changing this replaces the role.
+	ObjectId pulumi.StringOutput `pulumi:"objectId"`
+	// The ID of the role. Defaults to a GUID derived from the value. Changing this replaces the role.
+	RoleId pulumi.StringOutput `pulumi:"roleId"`
+	// The value of the role, which appears in the roles claim of tokens.
+	Value pulumi.StringPtrOutput `pulumi:"value"`
+}
+
+// NewAppRole registers a new resource with the given unique name, arguments, and options.
+func NewAppRole(ctx *pulumi.Context,
+	name string, args *AppRoleArgs, opts ...pulumi.ResourceOption) (*AppRole, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.AllowedMemberTypes == nil {
+		return nil, errors.New("invalid value for required argument 'AllowedMemberTypes'")
+	}
+	if args.Description == nil {
+		return nil, errors.New("invalid value for required argument 'Description'")
+	}
+	if args.DisplayName == nil {
+		return nil, errors.New("invalid value for required argument 'DisplayName'")
+	}
+	if args.ObjectId == nil {
+		return nil, errors.New("invalid value for required argument 'ObjectId'")
+	}
+	var resource AppRole
+	err := ctx.RegisterResource("knapcode:index:AppRole", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetAppRole gets an existing AppRole resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetAppRole(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *AppRoleState, opts ...pulumi.ResourceOption) (*AppRole, error) {
+	var resource AppRole
+	err := ctx.ReadResource("knapcode:index:AppRole", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering AppRole resources.
+type appRoleState struct {
+	// Who can be assigned the role: 'User' for users and groups, 'Application' for applications, or both.
+	AllowedMemberTypes []string `pulumi:"allowedMemberTypes"`
+	// The description of the role.
+	Description *string `pulumi:"description"`
+	// The display name of the role.
+	DisplayName *string `pulumi:"displayName"`
+	// Whether the role is enabled. Defaults to true.
+	IsEnabled *bool `pulumi:"isEnabled"`
+	// The object ID of the application. Changing this replaces the role.
+	ObjectId *string `pulumi:"objectId"`
+	// The ID of the role. Defaults to a GUID derived from the value. Changing this replaces the role.
+	RoleId *string `pulumi:"roleId"`
+	// The value of the role, which appears in the roles claim of tokens.
+	Value *string `pulumi:"value"`
+}
+
+type AppRoleState struct {
+	// Who can be assigned the role: 'User' for users and groups, 'Application' for applications, or both.
+	AllowedMemberTypes pulumi.StringArrayInput
+	// The description of the role.
+	Description pulumi.StringPtrInput
+	// The display name of the role.
+	DisplayName pulumi.StringPtrInput
+	// Whether the role is enabled. Defaults to true.
+	IsEnabled pulumi.BoolPtrInput
+	// The object ID of the application. Changing this replaces the role.
+	ObjectId pulumi.StringPtrInput
+	// The ID of the role. Defaults to a GUID derived from the value. Changing this replaces the role.
+	RoleId pulumi.StringPtrInput
+	// The value of the role, which appears in the roles claim of tokens.
+	Value pulumi.StringPtrInput
+}
+
+func (AppRoleState) ElementType() reflect.Type {
+	return reflect.TypeOf((*appRoleState)(nil)).Elem()
+}
+
+type appRoleArgs struct {
+	// Who can be assigned the role: 'User' for users and groups, 'Application' for applications, or both.
+	AllowedMemberTypes []string `pulumi:"allowedMemberTypes"`
+	// The description of the role.
+	Description string `pulumi:"description"`
+	// The display name of the role.
+	DisplayName string `pulumi:"displayName"`
+	// Whether the role is enabled. Defaults to true.
+	IsEnabled *bool `pulumi:"isEnabled"`
+	// The object ID of the application. Changing this replaces the role.
+	ObjectId string `pulumi:"objectId"`
+	// The ID of the role. Defaults to a GUID derived from the value. Changing this replaces the role.
+	RoleId *string `pulumi:"roleId"`
+	// The value of the role, which appears in the roles claim of tokens.
+	Value *string `pulumi:"value"`
+}
+
+// The set of arguments for constructing a AppRole resource.
+type AppRoleArgs struct {
+	// Who can be assigned the role: 'User' for users and groups, 'Application' for applications, or both.
+	AllowedMemberTypes pulumi.StringArrayInput
+	// The description of the role.
+	Description pulumi.StringInput
+	// The display name of the role.
+	DisplayName pulumi.StringInput
+	// Whether the role is enabled. Defaults to true.
+	IsEnabled pulumi.BoolPtrInput
+	// The object ID of the application. Changing this replaces the role.
+	ObjectId pulumi.StringInput
+	// The ID of the role. Defaults to a GUID derived from the value. Changing this replaces the role.
+	RoleId pulumi.StringPtrInput
+	// The value of the role, which appears in the roles claim of tokens.
+	Value pulumi.StringPtrInput
+}
+
+func (AppRoleArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*appRoleArgs)(nil)).Elem()
+}
+
+type AppRoleInput interface {
+	pulumi.Input
+
+	ToAppRoleOutput() AppRoleOutput
+	ToAppRoleOutputWithContext(ctx context.Context) AppRoleOutput
+}
+
+func (*AppRole) ElementType() reflect.Type {
+	return reflect.TypeOf((*AppRole)(nil))
+}
+
+func (i *AppRole) ToAppRoleOutput() AppRoleOutput {
+	return i.ToAppRoleOutputWithContext(context.Background())
+}
+
+func (i *AppRole) ToAppRoleOutputWithContext(ctx context.Context) AppRoleOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AppRoleOutput)
+}
+
+type AppRoleOutput struct {
+	*pulumi.OutputState
+}
+
+func (AppRoleOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*AppRole)(nil))
+}
+
+func (o AppRoleOutput) ToAppRoleOutput() AppRoleOutput {
+	return o
+}
+
+func (o AppRoleOutput) ToAppRoleOutputWithContext(ctx context.Context) AppRoleOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(AppRoleOutput{})
+}
@@ -0,0 +1,182 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package knapcode
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// Assigns an app role of an application to a user, group or service principal. The resource ID is the object ID of the resource service principal and the assignment ID separated by a slash, which is also the format used to import an assignment.
+type AppRoleAssignment struct {
+	pulumi.CustomResourceState
+
+	// The value of the app role to assign. Changing this replaces the assignment.
+	AppRole pulumi.StringPtrOutput `pulumi:"appRole"`
+	// The ID of the app role to assign, instead of its value. If neither this nor 'appRole' is set, the principal is assigned to the application without a specific role. Changing this replaces the assignment.
+	AppRoleId pulumi.StringPtrOutput `pulumi:"appRoleId"`
+	// The ID of the app role assignment.
+	AssignmentId pulumi.StringOutput `pulumi:"assignmentId"`
+	// The display name of the principal.
+	PrincipalDisplayName pulumi.StringOutput `pulumi:"principalDisplayName"`
+	// The object ID of the user, group or service principal (e.g. a managed identity) that is assigned the role. Changing this replaces the assignment.
+	PrincipalId pulumi.StringOutput `pulumi:"principalId"`
+	// The type of the principal: 'User', 'Group' or 'ServicePrincipal'.
+	PrincipalType pulumi.StringOutput `pulumi:"principalType"`
+	// The ID of the assigned app role.
+	ResolvedAppRoleId pulumi.StringOutput `pulumi:"resolvedAppRoleId"`
+	// The display name of the resource service principal.
+	ResourceDisplayName pulumi.StringOutput `pulumi:"resourceDisplayName"`
+	// The object ID of the service principal of the application that defines the app role. Changing this replaces the assignment.
+	ResourceId pulumi.StringOutput `pulumi:"resourceId"`
+}
+
+// NewAppRoleAssignment registers a new resource with the given unique name, arguments, and options.
+func NewAppRoleAssignment(ctx *pulumi.Context,
+	name string, args *AppRoleAssignmentArgs, opts ...pulumi.ResourceOption) (*AppRoleAssignment, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.PrincipalId == nil {
+		return nil, errors.New("invalid value for required argument 'PrincipalId'")
+	}
+	if args.ResourceId == nil {
+		return nil, errors.New("invalid value for required argument 'ResourceId'")
+	}
+	var resource AppRoleAssignment
+	err := ctx.RegisterResource("knapcode:index:AppRoleAssignment", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetAppRoleAssignment gets an existing AppRoleAssignment resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetAppRoleAssignment(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *AppRoleAssignmentState, opts ...pulumi.ResourceOption) (*AppRoleAssignment, error) {
+	var resource AppRoleAssignment
+	err := ctx.ReadResource("knapcode:index:AppRoleAssignment", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering AppRoleAssignment resources.
+type appRoleAssignmentState struct {
+	// The value of the app role to assign. Changing this replaces the assignment.
+	AppRole *string `pulumi:"appRole"`
+	// The ID of the app role to assign, instead of its value. If neither this nor 'appRole' is set, the principal is assigned to the application without a specific role. Changing this replaces the assignment.
+	AppRoleId *string `pulumi:"appRoleId"`
+	// The ID of the app role assignment.
+	AssignmentId *string `pulumi:"assignmentId"`
+	// The display name of the principal.
+	PrincipalDisplayName *string `pulumi:"principalDisplayName"`
+	// The object ID of the user, group or service principal (e.g. a managed identity) that is assigned the role. Changing this replaces the assignment.
+	PrincipalId *string `pulumi:"principalId"`
+	// The type of the principal: 'User', 'Group' or 'ServicePrincipal'.
+	PrincipalType *string `pulumi:"principalType"`
+	// The ID of the assigned app role.
+	ResolvedAppRoleId *string `pulumi:"resolvedAppRoleId"`
+	// The display name of the resource service principal.
+	ResourceDisplayName *string `pulumi:"resourceDisplayName"`
+	// The object ID of the service principal of the application that defines the app role. Changing this replaces the assignment.
+	ResourceId *string `pulumi:"resourceId"`
+}
+
+type AppRoleAssignmentState struct {
+	// The value of the app role to assign. Changing this replaces the assignment.
+	AppRole pulumi.StringPtrInput
+	// The ID of the app role to assign, instead of its value. If neither this nor 'appRole' is set, the principal is assigned to the application without a specific role. Changing this replaces the assignment.
+	AppRoleId pulumi.StringPtrInput
+	// The ID of the app role assignment.
+	AssignmentId pulumi.StringPtrInput
+	// The display name of the principal.
+	PrincipalDisplayName pulumi.StringPtrInput
+	// The object ID of the user, group or service principal (e.g. a managed identity) that is assigned the role. Changing this replaces the assignment.
+	PrincipalId pulumi.StringPtrInput
+	// The type of the principal: 'User', 'Group' or 'ServicePrincipal'.
+	PrincipalType pulumi.StringPtrInput
+	// The ID of the assigned app role.
+	ResolvedAppRoleId pulumi.StringPtrInput
+	// The display name of the resource service principal.
+	ResourceDisplayName pulumi.StringPtrInput
+	// The object ID of the service principal of the application that defines the app role. Changing this replaces the assignment.
+	ResourceId pulumi.StringPtrInput
+}
+
+func (AppRoleAssignmentState) ElementType() reflect.Type {
+	return reflect.TypeOf((*appRoleAssignmentState)(nil)).Elem()
+}
+
+type appRoleAssignmentArgs struct {
+	// The value of the app role to assign. Changing this replaces the assignment.
+	AppRole *string `pulumi:"appRole"`
+	// The ID of the app role to assign, instead of its value. If neither this nor 'appRole' is set, the principal is assigned to the application without a specific role. Changing this replaces the assignment.
+	AppRoleId *string `pulumi:"appRoleId"`
+	// The object ID of the user, group or service principal (e.g. a managed identity) that is assigned the role. Changing this replaces the assignment.
+	PrincipalId string `pulumi:"principalId"`
+	// The object ID of the service principal of the application that defines the app role. Changing this replaces the assignment.
+	ResourceId string `pulumi:"resourceId"`
+}
+
+// The set of arguments for constructing a AppRoleAssignment resource.
+type AppRoleAssignmentArgs struct {
+	// The value of the app role to assign. Changing this replaces the assignment.
+	AppRole pulumi.StringPtrInput
+	// The ID of the app role to assign, instead of its value. If neither this nor 'appRole' is set, the principal is assigned to the application without a specific role. Changing this replaces the assignment.
+	AppRoleId pulumi.StringPtrInput
+	// The object ID of the user, group or service principal (e.g. a managed identity) that is assigned the role. Changing this replaces the assignment.
+	PrincipalId pulumi.StringInput
+	// The object ID of the service principal of the application that defines the app role. Changing this replaces the assignment.
+	ResourceId pulumi.StringInput
+}
+
+func (AppRoleAssignmentArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*appRoleAssignmentArgs)(nil)).Elem()
+}
+
+type AppRoleAssignmentInput interface {
+	pulumi.Input
+
+	ToAppRoleAssignmentOutput() AppRoleAssignmentOutput
+	ToAppRoleAssignmentOutputWithContext(ctx context.Context) AppRoleAssignmentOutput
+}
+
+func (*AppRoleAssignment) ElementType() reflect.Type {
+	return reflect.TypeOf((*AppRoleAssignment)(nil))
+}
+
+func (i *AppRoleAssignment) ToAppRoleAssignmentOutput() AppRoleAssignmentOutput {
+	return i.ToAppRoleAssignmentOutputWithContext(context.Background())
+}
+
+func (i *AppRoleAssignment) ToAppRoleAssignmentOutputWithContext(ctx context.Context) AppRoleAssignmentOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AppRoleAssignmentOutput)
+}
+
+type AppRoleAssignmentOutput struct {
+	*pulumi.OutputState
+}
+
+func (AppRoleAssignmentOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*AppRoleAssignment)(nil))
+}
+
+func (o AppRoleAssignmentOutput) ToAppRoleAssignmentOutput() AppRoleAssignmentOutput {
+	return o
+}
+
+func (o AppRoleAssignmentOutput) ToAppRoleAssignmentOutputWithContext(ctx context.Context) AppRoleAssignmentOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(AppRoleAssignmentOutput{})
+}
@@ -0,0 +1,251 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package knapcode
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// An application (app registration) managed entirely through Microsoft Graph. Settings that are not set are reset to their defaults. The resource ID is the object ID of the application, which is also used to import it.
+type Application struct {
+	pulumi.CustomResourceState
+
+	// Settings for an application that exposes an API.
+	Api ApplicationApiPtrOutput `pulumi:"api"`
+	// The app ID (client ID) of the application.
+	AppId pulumi.StringOutput `pulumi:"appId"`
+	// The roles defined by the application.
+	AppRoles ApplicationAppRoleArrayOutput `pulumi:"appRoles"`
+	// The display name of the application.
+	DisplayName pulumi.StringOutput `pulumi:"displayName"`
+	// The URIs that identify the application within its tenant.
+	IdentifierUris pulumi.StringArrayOutput `pulumi:"identifierUris"`
+	// Free text notes about the application.
+	Notes pulumi.StringPtrOutput `pulumi:"notes"`
+	// The object ID of the application.
+	ObjectId pulumi.StringOutput `pulumi:"objectId"`
+	// Optional claims included in tokens.
+	OptionalClaims ApplicationOptionalClaimsPtrOutput `pulumi:"optionalClaims"`
+	// Settings for a public client.
+	PublicClient ApplicationPublicClientPtrOutput `pulumi:"publicClient"`
+	// Permanently delete the application from the directory's deleted items when the resource is deleted, releasing its identifier URIs. Defaults to false.
+	PurgeOnDelete pulumi.BoolPtrOutput `pulumi:"purgeOnDelete"`
+	// The permissions the application requires on other applications.
+	RequiredResourceAccess ApplicationRequiredResourceAccessArrayOutput `pulumi:"requiredResourceAccess"`
+	// The accounts that can sign in. Defaults to 'AzureADMyOrg'.
+	SignInAudience pulumi.StringPtrOutput `pulumi:"signInAudience"`
+	// Settings for a single-page application.
+	Spa ApplicationSpaPtrOutput `pulumi:"spa"`
+	// Tags on the application.
+	Tags pulumi.StringArrayOutput `pulumi:"tags"`
+	// Settings for a web application.
+	Web ApplicationWebPtrOutput `pulumi:"web"`
+}
+
+// NewApplication registers a new resource with the given unique name, arguments, and options.
+func NewApplication(ctx *pulumi.Context,
+	name string, args *ApplicationArgs, opts ...pulumi.ResourceOption) (*Application, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.DisplayName == nil {
+		return nil, errors.New("invalid value for required argument 'DisplayName'")
+	}
+	var resource Application
+	err := ctx.RegisterResource("knapcode:index:Application", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetApplication gets an existing Application resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetApplication(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *ApplicationState, opts ...pulumi.ResourceOption) (*Application, error) {
+	var resource Application
+	err := ctx.ReadResource("knapcode:index:Application", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering Application resources.
+type applicationState struct {
+	// Settings for an application that exposes an API.
+	Api *ApplicationApi `pulumi:"api"`
+	// The app ID (client ID) of the application.
+	AppId *string `pulumi:"appId"`
+	// The roles defined by the application.
+	AppRoles []ApplicationAppRole `pulumi:"appRoles"`
+	// The display name of the application.
+	DisplayName *string `pulumi:"displayName"`
+	// The URIs that identify the application within its tenant.
+	IdentifierUris []string `pulumi:"identifierUris"`
+	// Free text notes about the application.
+	Notes *string `pulumi:"notes"`
+	// The object ID of the application.
+	ObjectId *string `pulumi:"objectId"`
+	// Optional claims included in tokens.
+	OptionalClaims *ApplicationOptionalClaims `pulumi:"optionalClaims"`
+	// Settings for a public client.
+	PublicClient *ApplicationPublicClient `pulumi:"publicClient"`
+	// Permanently delete the application from the directory's deleted items when the resource is deleted, releasing its identifier URIs. Defaults to false.
+	PurgeOnDelete *bool `pulumi:"purgeOnDelete"`
+	// The permissions the application requires on other applications.
+	RequiredResourceAccess []ApplicationRequiredResourceAccess `pulumi:"requiredResourceAccess"`
+	// The accounts that can sign in. Defaults to 'AzureADMyOrg'.
+	SignInAudience *string `pulumi:"signInAudience"`
+	// Settings for a single-page application.
+	Spa *ApplicationSpa `pulumi:"spa"`
+	// Tags on the application.
+	Tags []string `pulumi:"tags"`
+	// Settings for a web application.
+	Web *ApplicationWeb `pulumi:"web"`
+}
+
+type ApplicationState struct {
+	// Settings for an application that exposes an API.
+	Api ApplicationApiPtrInput
+	// The app ID (client ID) of the application.
+	AppId pulumi.StringPtrInput
+	// The roles defined by the application.
+	AppRoles ApplicationAppRoleArrayInput
+	// The display name of the application.
+	DisplayName pulumi.StringPtrInput
+	// The URIs that identify the application within its tenant.
+	IdentifierUris pulumi.StringArrayInput
+	// Free text notes about the application.
+	Notes pulumi.StringPtrInput
+	// The object ID of the application.
+	ObjectId pulumi.StringPtrInput
+	// Optional claims included in tokens.
+	OptionalClaims ApplicationOptionalClaimsPtrInput
+	// Settings for a public client.
+	PublicClient ApplicationPublicClientPtrInput
+	// Permanently delete the application from the directory's deleted items when the resource is deleted, releasing its identifier URIs. Defaults to false.
+	PurgeOnDelete pulumi.BoolPtrInput
+	// The permissions the application requires on other applications.
+	RequiredResourceAccess ApplicationRequiredResourceAccessArrayInput
+	// The accounts that can sign in. Defaults to 'AzureADMyOrg'.
+	SignInAudience *SignInAudience
+	// Settings for a single-page application.
+	Spa ApplicationSpaPtrInput
+	// Tags on the application.
+	Tags pulumi.StringArrayInput
+	// Settings for a web application.
+	Web ApplicationWebPtrInput
+}
+
+func (ApplicationState) ElementType() reflect.Type {
+	return reflect.TypeOf((*applicationState)(nil)).Elem()
+}
+
+type applicationArgs struct {
+	// Settings for an application that exposes an API.
+	Api *ApplicationApi `pulumi:"api"`
+	// The roles defined by the application.
+	AppRoles []ApplicationAppRole `pulumi:"appRoles"`
+	// The display name of the application.
+	DisplayName string `pulumi:"displayName"`
+	// The URIs that identify the application within its tenant.
+	IdentifierUris []string `pulumi:"identifierUris"`
+	// Free text notes about the application.
+	Notes *string `pulumi:"notes"`
+	// Optional claims included in tokens.
+	OptionalClaims *ApplicationOptionalClaims `pulumi:"optionalClaims"`
+	// Settings for a public client.
+	PublicClient *ApplicationPublicClient `pulumi:"publicClient"`
+	// Permanently delete the application from the directory's deleted items when the resource is deleted, releasing its identifier URIs. Defaults to false.
+	PurgeOnDelete *bool `pulumi:"purgeOnDelete"`
+	// The permissions the application requires on other applications.
+	RequiredResourceAccess []ApplicationRequiredResourceAccess `pulumi:"requiredResourceAccess"`
+	// The accounts that can sign in. Defaults to 'AzureADMyOrg'.
+	SignInAudience *string `pulumi:"signInAudience"`
+	// Settings for a single-page application.
+	Spa *ApplicationSpa `pulumi:"spa"`
+	// Tags on the application.
+	Tags []string `pulumi:"tags"`
+	// Settings for a web application.
+	Web *ApplicationWeb `pulumi:"web"`
+}
+
+// The set of arguments for constructing a Application resource.
+type ApplicationArgs struct {
+	// Settings for an application that exposes an API.
+	Api ApplicationApiPtrInput
+	// The roles defined by the application.
+	AppRoles ApplicationAppRoleArrayInput
+	// The display name of the application.
+	DisplayName pulumi.StringInput
+	// The URIs that identify the application within its tenant.
+	IdentifierUris pulumi.StringArrayInput
+	// Free text notes about the application.
+	Notes pulumi.StringPtrInput
+	// Optional claims included in tokens.
+	OptionalClaims ApplicationOptionalClaimsPtrInput
+	// Settings for a public client.
+	PublicClient ApplicationPublicClientPtrInput
+	// Permanently delete the application from the directory's deleted items when the resource is deleted, releasing its identifier URIs. Defaults to false.
+	PurgeOnDelete pulumi.BoolPtrInput
+	// The permissions the application requires on other applications.
+	RequiredResourceAccess ApplicationRequiredResourceAccessArrayInput
+	// The accounts that can sign in. Defaults to 'AzureADMyOrg'.
+	SignInAudience *SignInAudience
+	// Settings for a single-page application.
+	Spa ApplicationSpaPtrInput
+	// Tags on the application.
+	Tags pulumi.StringArrayInput
+	// Settings for a web application.
+	Web ApplicationWebPtrInput
+}
+
+func (ApplicationArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*applicationArgs)(nil)).Elem()
+}
+
+type ApplicationInput interface {
+	pulumi.Input
+
+	ToApplicationOutput() ApplicationOutput
+	ToApplicationOutputWithContext(ctx context.Context) ApplicationOutput
+}
+
+func (*Application) ElementType() reflect.Type {
+	return reflect.TypeOf((*Application)(nil))
+}
+
+func (i *Application) ToApplicationOutput() ApplicationOutput {
+	return i.ToApplicationOutputWithContext(context.Background())
+}
+
+func (i *Application) ToApplicationOutputWithContext(ctx context.Context) ApplicationOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationOutput)
+}
+
+type ApplicationOutput struct {
+	*pulumi.OutputState
+}
+
+func (ApplicationOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Application)(nil))
+}
+
+func (o ApplicationOutput) ToApplicationOutput() ApplicationOutput {
+	return o
+}
+
+func (o ApplicationOutput) ToApplicationOutputWithContext(ctx context.Context) ApplicationOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(ApplicationOutput{})
+}
@@ -0,0 +1,179 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package knapcode
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// The marketing, support, terms of service and privacy statement URLs and the publisher domain of an application, shown on the consent prompt. Deleting the resource clears the URLs. The resource ID is the object ID of the application, which is also used to import it.
+type ApplicationBranding struct {
+	pulumi.CustomResourceState
+
+	// The host name the URLs that are not set are derived from, e.g. 'example.com' gives 'https://example.com/privacy' for the privacy statement.
+	HostName pulumi.StringPtrOutput `pulumi:"hostName"`
+	// The URL of the application's marketing page. Defaults to 'https://{hostName}' when 'hostName' is set.
+	MarketingUrl pulumi.StringPtrOutput `pulumi:"marketingUrl"`
+	// The object ID of the application. Changing this replaces the resource.
+	ObjectId pulumi.StringOutput `pulumi:"objectId"`
+	// The URL of the application's privacy statement, shown on the consent prompt. Defaults to 'https://{hostName}/privacy' when 'hostName' is set.
+	PrivacyStatementUrl pulumi.StringPtrOutput `pulumi:"privacyStatementUrl"`
+	// The publisher domain shown on the consent prompt. Must be a verified domain of the tenant. It is left unchanged when not set.
+	PublisherDomain pulumi.StringPtrOutput `pulumi:"publisherDomain"`
+	// The URL of the application's support page. Defaults to 'https://{hostName}/support' when 'hostName' is set.
+	SupportUrl pulumi.StringPtrOutput `pulumi:"supportUrl"`
+	// The URL of the application's terms of service, shown on the consent prompt. Defaults to 'https://{hostName}/terms' when 'hostName' is set.
+	TermsOfServiceUrl pulumi.StringPtrOutput `pulumi:"termsOfServiceUrl"`
+}
+
+// NewApplicationBranding registers a new resource with the given unique name, arguments, and options.
+func NewApplicationBranding(ctx *pulumi.Context,
+	name string, args *ApplicationBrandingArgs, opts ...pulumi.ResourceOption) (*ApplicationBranding, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.ObjectId == nil {
+		return nil, errors.New("invalid value for required argument 'ObjectId'")
+	}
+	var resource ApplicationBranding
+	err := ctx.RegisterResource("knapcode:index:ApplicationBranding", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetApplicationBranding gets an existing ApplicationBranding resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetApplicationBranding(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *ApplicationBrandingState, opts ...pulumi.ResourceOption) (*ApplicationBranding, error) {
+	var resource ApplicationBranding
+	err := ctx.ReadResource("knapcode:index:ApplicationBranding", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering ApplicationBranding resources.
+type applicationBrandingState struct {
+	// The host name the URLs that are not set are derived from, e.g. 'example.com' gives 'https://example.com/privacy' for the privacy statement.
+	HostName *string `pulumi:"hostName"`
+	// The URL of the application's marketing page. Defaults to 'https://{hostName}' when 'hostName' is set.
+	MarketingUrl *string `pulumi:"marketingUrl"`
+	// The object ID of the application. Changing this replaces the resource.
+	ObjectId *string `pulumi:"objectId"`
+	// The URL of the application's privacy statement, shown on the consent prompt. Defaults to 'https://{hostName}/privacy' when 'hostName' is set.
+	PrivacyStatementUrl *string `pulumi:"privacyStatementUrl"`
+	// The publisher domain shown on the consent prompt. Must be a verified domain of the tenant. It is left unchanged when not set.
+	PublisherDomain *string `pulumi:"publisherDomain"`
+	// The URL of the application's support page. Defaults to 'https://{hostName}/support' when 'hostName' is set.
+	SupportUrl *string `pulumi:"supportUrl"`
+	// The URL of the application's terms of service, shown on the consent prompt. Defaults to 'https://{hostName}/terms' when 'hostName' is set.
+	TermsOfServiceUrl *string `pulumi:"termsOfServiceUrl"`
+}
+
+type ApplicationBrandingState struct {
+	// The host name the URLs that are not set are derived from, e.g. 'example.com' gives 'https://example.com/privacy' for the privacy statement.
+	HostName pulumi.StringPtrInput
+	// The URL of the application's marketing page. Defaults to 'https://{hostName}' when 'hostName' is set.
+	MarketingUrl pulumi.StringPtrInput
+	// The object ID of the application. Changing this replaces the resource.
+	ObjectId pulumi.StringPtrInput
+	// The URL of the application's privacy statement, shown on the consent prompt. Defaults to 'https://{hostName}/privacy' when 'hostName' is set.
+	PrivacyStatementUrl pulumi.StringPtrInput
+	// The publisher domain shown on the consent prompt. Must be a verified domain of the tenant. It is left unchanged when not set.
+	PublisherDomain pulumi.StringPtrInput
+	// The URL of the application's support page. Defaults to 'https://{hostName}/support' when 'hostName' is set.
+	SupportUrl pulumi.StringPtrInput
+	// The URL of the application's terms of service, shown on the consent prompt. Defaults to 'https://{hostName}/terms' when 'hostName' is set.
+	TermsOfServiceUrl pulumi.StringPtrInput
+}
+
+func (ApplicationBrandingState) ElementType() reflect.Type {
+	return reflect.TypeOf((*applicationBrandingState)(nil)).Elem()
+}
+
+type applicationBrandingArgs struct {
+	// The host name the URLs that are not set are derived from, e.g. 'example.com' gives 'https://example.com/privacy' for the privacy statement.
+	HostName *string `pulumi:"hostName"`
+	// The URL of the application's marketing page. Defaults to 'https://{hostName}' when 'hostName' is set.
+	MarketingUrl *string `pulumi:"marketingUrl"`
+	// The object ID of the application. Changing this replaces the resource.
+	ObjectId string `pulumi:"objectId"`
+	// The URL of the application's privacy statement, shown on the consent prompt. Defaults to 'https://{hostName}/privacy' when 'hostName' is set.
+	PrivacyStatementUrl *string `pulumi:"privacyStatementUrl"`
+	// The publisher domain shown on the consent prompt. Must be a verified domain of the tenant. It is left unchanged when not set.
+	PublisherDomain *string `pulumi:"publisherDomain"`
+	// The URL of the application's support page. Defaults to 'https://{hostName}/support' when 'hostName' is set.
+	SupportUrl *string `pulumi:"supportUrl"`
+	// The URL of the application's terms of service, shown on the consent prompt. Defaults to 'https://{hostName}/terms' when 'hostName' is set.
+	TermsOfServiceUrl *string `pulumi:"termsOfServiceUrl"`
+}
+
+// The set of arguments for constructing a ApplicationBranding resource.
+type ApplicationBrandingArgs struct {
+	// The host name the URLs that are not set are derived from, e.g. 'example.com' gives 'https://example.com/privacy' for the privacy statement.
+	HostName pulumi.StringPtrInput
+	// The URL of the application's marketing page. Defaults to 'https://{hostName}' when 'hostName' is set.
+	MarketingUrl pulumi.StringPtrInput
+	// The object ID of the application. Changing this replaces the resource.
+	ObjectId pulumi.StringInput
+	// The URL of the application's privacy statement, shown on the consent prompt. Defaults to 'https://{hostName}/privacy' when 'hostName' is set.
+	PrivacyStatementUrl pulumi.StringPtrInput
+	// The publisher domain shown on the consent prompt. Must be a verified domain of the tenant. It is left unchanged when not set.
+	PublisherDomain pulumi.StringPtrInput
+	// The URL of the application's support page. Defaults to 'https://{hostName}/support' when 'hostName' is set.
+	SupportUrl pulumi.StringPtrInput
+	// The URL of the application's terms of service, shown on the consent prompt. Defaults to 'https://{hostName}/terms' when 'hostName' is set.
+	TermsOfServiceUrl pulumi.StringPtrInput
+}
+
+func (ApplicationBrandingArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*applicationBrandingArgs)(nil)).Elem()
+}
+
+type ApplicationBrandingInput interface {
+	pulumi.Input
+
+	ToApplicationBrandingOutput() ApplicationBrandingOutput
+	ToApplicationBrandingOutputWithContext(ctx context.Context) ApplicationBrandingOutput
+}
+
+func (*ApplicationBranding) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationBranding)(nil))
+}
+
+func (i *ApplicationBranding) ToApplicationBrandingOutput() ApplicationBrandingOutput {
+	return i.ToApplicationBrandingOutputWithContext(context.Background())
+}
+
+func (i *ApplicationBranding) ToApplicationBrandingOutputWithContext(ctx context.Context) ApplicationBrandingOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationBrandingOutput)
+}
+
+type ApplicationBrandingOutput struct {
+	*pulumi.OutputState
+}
+
+func (ApplicationBrandingOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationBranding)(nil))
+}
+
+func (o ApplicationBrandingOutput) ToApplicationBrandingOutput() ApplicationBrandingOutput {
+	return o
+}
+
+func (o ApplicationBrandingOutput) ToApplicationBrandingOutputWithContext(ctx context.Context) ApplicationBrandingOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(ApplicationBrandingOutput{})
+}
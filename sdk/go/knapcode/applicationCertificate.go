@@ -0,0 +1,166 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package knapcode
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// A certificate in the key credentials of an application, used for certificate-based client authentication. Other key credentials on the application are left untouched.
+type ApplicationCertificate struct {
+	pulumi.CustomResourceState
+
+	// The certificate, either PEM encoded or as base64 encoded DER. Only the public certificate is needed.
+	Certificate pulumi.StringOutput `pulumi:"certificate"`
+	// A friendly name for the certificate. Defaults to the certificate subject.
+	DisplayName pulumi.StringPtrOutput `pulumi:"displayName"`
+	// When the certificate expires.
+	EndDateTime pulumi.StringOutput `pulumi:"endDateTime"`
+	// The key ID of the certificate, derived from the application and the certificate thumbprint.
+	KeyId pulumi.StringOutput `pulumi:"keyId"`
+	// The object ID of the application.
+	ObjectId pulumi.StringOutput `pulumi:"objectId"`
+	// When the certificate becomes valid.
+	StartDateTime pulumi.StringOutput `pulumi:"startDateTime"`
+	// The SHA-1 thumbprint of the certificate, as uppercase hex.
+	Thumbprint pulumi.StringOutput `pulumi:"thumbprint"`
+}
+
+// NewApplicationCertificate registers a new resource with the given unique name, arguments, and options.
+func NewApplicationCertificate(ctx *pulumi.Context,
+	name string, args *ApplicationCertificateArgs, opts ...pulumi.ResourceOption) (*ApplicationCertificate, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Certificate == nil {
+		return nil, errors.New("invalid value for required argument 'Certificate'")
+	}
+	if args.ObjectId == nil {
+		return nil, errors.New("invalid value for required argument 'ObjectId'")
+	}
+	var resource ApplicationCertificate
+	err := ctx.RegisterResource("knapcode:index:ApplicationCertificate", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetApplicationCertificate gets an existing ApplicationCertificate resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetApplicationCertificate(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *ApplicationCertificateState, opts ...pulumi.ResourceOption) (*ApplicationCertificate, error) {
+	var resource ApplicationCertificate
+	err := ctx.ReadResource("knapcode:index:ApplicationCertificate", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering ApplicationCertificate resources.
+type applicationCertificateState struct {
+	// The certificate, either PEM encoded or as base64 encoded DER. Only the public certificate is needed.
+	Certificate *string `pulumi:"certificate"`
+	// A friendly name for the certificate. Defaults to the certificate subject.
+	DisplayName *string `pulumi:"displayName"`
+	// When the certificate expires.
+	EndDateTime *string `pulumi:"endDateTime"`
+	// The key ID of the certificate, derived from the application and the certificate thumbprint.
+	KeyId *string `pulumi:"keyId"`
+	// The object ID of the application.
+	ObjectId *string `pulumi:"objectId"`
+	// When the certificate becomes valid.
+	StartDateTime *string `pulumi:"startDateTime"`
+	// The SHA-1 thumbprint of the certificate, as uppercase hex.
+	Thumbprint *string `pulumi:"thumbprint"`
+}
+
+type ApplicationCertificateState struct {
+	// The certificate, either PEM encoded or as base64 encoded DER. Only the public certificate is needed.
+	Certificate pulumi.StringPtrInput
+	// A friendly name for the certificate. Defaults to the certificate subject.
+	DisplayName pulumi.StringPtrInput
+	// When the certificate expires.
+	EndDateTime pulumi.StringPtrInput
+	// The key ID of the certificate, derived from the application and the certificate thumbprint.
+	KeyId pulumi.StringPtrInput
+	// The object ID of the application.
+	ObjectId pulumi.StringPtrInput
+	// When the certificate becomes valid.
+	StartDateTime pulumi.StringPtrInput
+	// The SHA-1 thumbprint of the certificate, as uppercase hex.
+	Thumbprint pulumi.StringPtrInput
+}
+
+func (ApplicationCertificateState) ElementType() reflect.Type {
+	return reflect.TypeOf((*applicationCertificateState)(nil)).Elem()
+}
+
+type applicationCertificateArgs struct {
+	// The certificate, either PEM encoded or as base64 encoded DER. Only the public certificate is needed.
+	Certificate string `pulumi:"certificate"`
+	// A friendly name for the certificate. Defaults to the certificate subject.
+	DisplayName *string `pulumi:"displayName"`
+	// The object ID of the application.
+	ObjectId string `pulumi:"objectId"`
+}
+
+// The set of arguments for constructing a ApplicationCertificate resource.
+type ApplicationCertificateArgs struct {
+	// The certificate, either PEM encoded or as base64 encoded DER. Only the public certificate is needed.
+	Certificate pulumi.StringInput
+	// A friendly name for the certificate. Defaults to the certificate subject.
+	DisplayName pulumi.StringPtrInput
+	// The object ID of the application.
+	ObjectId pulumi.StringInput
+}
+
+func (ApplicationCertificateArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*applicationCertificateArgs)(nil)).Elem()
+}
+
+type ApplicationCertificateInput interface {
+	pulumi.Input
+
+	ToApplicationCertificateOutput() ApplicationCertificateOutput
+	ToApplicationCertificateOutputWithContext(ctx context.Context) ApplicationCertificateOutput
+}
+
+func (*ApplicationCertificate) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationCertificate)(nil))
+}
+
+func (i *ApplicationCertificate) ToApplicationCertificateOutput() ApplicationCertificateOutput {
+	return i.ToApplicationCertificateOutputWithContext(context.Background())
+}
+
+func (i *ApplicationCertificate) ToApplicationCertificateOutputWithContext(ctx context.Context) ApplicationCertificateOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationCertificateOutput)
+}
+
+type ApplicationCertificateOutput struct {
+	*pulumi.OutputState
+}
+
+func (ApplicationCertificateOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationCertificate)(nil))
+}
+
+func (o ApplicationCertificateOutput) ToApplicationCertificateOutput() ApplicationCertificateOutput {
+	return o
+}
+
+func (o ApplicationCertificateOutput) ToApplicationCertificateOutputWithContext(ctx context.Context) ApplicationCertificateOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(ApplicationCertificateOutput{})
+}
@@ -0,0 +1,151 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package knapcode
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// The logo of an application, shown on the consent prompt and in My Apps. The logo must be a PNG, JPEG, GIF or BMP image of at most 100 KB. Microsoft Graph can't remove a logo, so deleting the resource leaves the logo in place. The resource ID is the object ID of the application, which is also used to import it.
+type ApplicationLogo struct {
+	pulumi.CustomResourceState
+
+	// The base64 encoded logo. Only one of 'source' and 'content' can be set.
+	Content pulumi.StringPtrOutput `pulumi:"content"`
+	// The hex encoded SHA-256 of the logo. The logo is only uploaded again when it changes.
+	ContentSha256 pulumi.StringOutput `pulumi:"contentSha256"`
+	// The detected image type of the logo, e.g. 'image/png'.
+	ContentType pulumi.StringOutput `pulumi:"contentType"`
+	// The object ID of the application. Changing this replaces the resource.
+	ObjectId pulumi.StringOutput `pulumi:"objectId"`
+	// The path of the logo file. Only one of 'source' and 'content' can be set.
+	Source pulumi.StringPtrOutput `pulumi:"source"`
+}
+
+// NewApplicationLogo registers a new resource with the given unique name, arguments, and options.
+func NewApplicationLogo(ctx *pulumi.Context,
+	name string, args *ApplicationLogoArgs, opts ...pulumi.ResourceOption) (*ApplicationLogo, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.ObjectId == nil {
+		return nil, errors.New("invalid value for required argument 'ObjectId'")
+	}
+	var resource ApplicationLogo
+	err := ctx.RegisterResource("knapcode:index:ApplicationLogo", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetApplicationLogo gets an existing ApplicationLogo resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetApplicationLogo(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *ApplicationLogoState, opts ...pulumi.ResourceOption) (*ApplicationLogo, error) {
+	var resource ApplicationLogo
+	err := ctx.ReadResource("knapcode:index:ApplicationLogo", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering ApplicationLogo resources.
+type applicationLogoState struct {
+	// The base64 encoded logo. Only one of 'source' and 'content' can be set.
+	Content *string `pulumi:"content"`
+	// The hex encoded SHA-256 of the logo. The logo is only uploaded again when it changes.
+	ContentSha256 *string `pulumi:"contentSha256"`
+	// The detected image type of the logo, e.g. 'image/png'.
+	ContentType *string `pulumi:"contentType"`
+	// The object ID of the application. Changing this replaces the resource.
+	ObjectId *string `pulumi:"objectId"`
+	// The path of the logo file. Only one of 'source' and 'content' can be set.
+	Source *string `pulumi:"source"`
+}
+
+type ApplicationLogoState struct {
+	// The base64 encoded logo. Only one of 'source' and 'content' can be set.
+	Content pulumi.StringPtrInput
+	// The hex encoded SHA-256 of the logo. The logo is only uploaded again when it changes.
+	ContentSha256 pulumi.StringPtrInput
+	// The detected image type of the logo, e.g. 'image/png'.
+	ContentType pulumi.StringPtrInput
+	// The object ID of the application. Changing this replaces the resource.
+	ObjectId pulumi.StringPtrInput
+	// The path of the logo file. Only one of 'source' and 'content' can be set.
+	Source pulumi.StringPtrInput
+}
+
+func (ApplicationLogoState) ElementType() reflect.Type {
+	return reflect.TypeOf((*applicationLogoState)(nil)).Elem()
+}
+
+type applicationLogoArgs struct {
+	// The base64 encoded logo. Only one of 'source' and 'content' can be set.
+	Content *string `pulumi:"content"`
+	// The object ID of the application. Changing this replaces the resource.
+	ObjectId string `pulumi:"objectId"`
+	// The path of the logo file. Only one of 'source' and 'content' can be set.
+	Source *string `pulumi:"source"`
+}
+
+// The set of arguments for constructing a ApplicationLogo resource.
+type ApplicationLogoArgs struct {
+	// The base64 encoded logo. Only one of 'source' and 'content' can be set.
+	Content pulumi.StringPtrInput
+	// The object ID of the application. Changing this replaces the resource.
+	ObjectId pulumi.StringInput
+	// The path of the logo file. Only one of 'source' and 'content' can be set.
+	Source pulumi.StringPtrInput
+}
+
+func (ApplicationLogoArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*applicationLogoArgs)(nil)).Elem()
+}
+
+type ApplicationLogoInput interface {
+	pulumi.Input
+
+	ToApplicationLogoOutput() ApplicationLogoOutput
+	ToApplicationLogoOutputWithContext(ctx context.Context) ApplicationLogoOutput
+}
+
+func (*ApplicationLogo) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationLogo)(nil))
+}
+
+func (i *ApplicationLogo) ToApplicationLogoOutput() ApplicationLogoOutput {
+	return i.ToApplicationLogoOutputWithContext(context.Background())
+}
+
+func (i *ApplicationLogo) ToApplicationLogoOutputWithContext(ctx context.Context) ApplicationLogoOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationLogoOutput)
+}
+
+type ApplicationLogoOutput struct {
+	*pulumi.OutputState
+}
+
+func (ApplicationLogoOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationLogo)(nil))
+}
+
+func (o ApplicationLogoOutput) ToApplicationLogoOutput() ApplicationLogoOutput {
+	return o
+}
+
+func (o ApplicationLogoOutput) ToApplicationLogoOutputWithContext(ctx context.Context) ApplicationLogoOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(ApplicationLogoOutput{})
+}
@@ -0,0 +1,144 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package knapcode
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// Adds an owner to an application. The resource ID is the object ID of the application and the object ID of the owner separated by a slash, which is also used to import it.
+type ApplicationOwner struct {
+	pulumi.CustomResourceState
+
+	// The object ID of the application. Changing this replaces the resource.
+	ObjectId pulumi.StringOutput `pulumi:"objectId"`
+	// The display name of the owner.
+	OwnerDisplayName pulumi.StringOutput `pulumi:"ownerDisplayName"`
+	// The object ID of the user or service principal to add as an owner. Changing this replaces the resource.
+	OwnerId pulumi.StringOutput `pulumi:"ownerId"`
+	// The type of the owner, e.g. 'user' or 'servicePrincipal'.
+	OwnerType pulumi.StringOutput `pulumi:"ownerType"`
+}
+
+// NewApplicationOwner registers a new resource with the given unique name, arguments, and options.
+func NewApplicationOwner(ctx *pulumi.Context,
+	name string, args *ApplicationOwnerArgs, opts ...pulumi.ResourceOption) (*ApplicationOwner, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.ObjectId == nil {
+		return nil, errors.New("invalid value for required argument 'ObjectId'")
+	}
+	if args.OwnerId == nil {
+		return nil, errors.New("invalid value for required argument 'OwnerId'")
+	}
+	var resource ApplicationOwner
+	err := ctx.RegisterResource("knapcode:index:ApplicationOwner", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetApplicationOwner gets an existing ApplicationOwner resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetApplicationOwner(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *ApplicationOwnerState, opts ...pulumi.ResourceOption) (*ApplicationOwner, error) {
+	var resource ApplicationOwner
+	err := ctx.ReadResource("knapcode:index:ApplicationOwner", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering ApplicationOwner resources.
+type applicationOwnerState struct {
+	// The object ID of the application. Changing this replaces the resource.
+	ObjectId *string `pulumi:"objectId"`
+	// The display name of the owner.
+	OwnerDisplayName *string `pulumi:"ownerDisplayName"`
+	// The object ID of the user or service principal to add as an owner. Changing this replaces the resource.
+	OwnerId *string `pulumi:"ownerId"`
+	// The type of the owner, e.g. 'user' or 'servicePrincipal'.
+	OwnerType *string `pulumi:"ownerType"`
+}
+
+type ApplicationOwnerState struct {
+	// The object ID of the application. Changing this replaces the resource.
+	ObjectId pulumi.StringPtrInput
+	// The display name of the owner.
+	OwnerDisplayName pulumi.StringPtrInput
+	// The object ID of the user or service principal to add as an owner. Changing this replaces the resource.
+	OwnerId pulumi.StringPtrInput
+	// The type of the owner, e.g. 'user' or 'servicePrincipal'.
+	OwnerType pulumi.StringPtrInput
+}
+
+func (ApplicationOwnerState) ElementType() reflect.Type {
+	return reflect.TypeOf((*applicationOwnerState)(nil)).Elem()
+}
+
+type applicationOwnerArgs struct {
+	// The object ID of the application. Changing this replaces the resource.
+	ObjectId string `pulumi:"objectId"`
+	// The object ID of the user or service principal to add as an owner. Changing this replaces the resource.
+	OwnerId string `pulumi:"ownerId"`
+}
+
+// The set of arguments for constructing a ApplicationOwner resource.
+type ApplicationOwnerArgs struct {
+	// The object ID of the application. Changing this replaces the resource.
+	ObjectId pulumi.StringInput
+	// The object ID of the user or service principal to add as an owner. Changing this replaces the resource.
+	OwnerId pulumi.StringInput
+}
+
+func (ApplicationOwnerArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*applicationOwnerArgs)(nil)).Elem()
+}
+
+type ApplicationOwnerInput interface {
+	pulumi.Input
+
+	ToApplicationOwnerOutput() ApplicationOwnerOutput
+	ToApplicationOwnerOutputWithContext(ctx context.Context) ApplicationOwnerOutput
+}
+
+func (*ApplicationOwner) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationOwner)(nil))
+}
+
+func (i *ApplicationOwner) ToApplicationOwnerOutput() ApplicationOwnerOutput {
+	return i.ToApplicationOwnerOutputWithContext(context.Background())
+}
+
+func (i *ApplicationOwner) ToApplicationOwnerOutputWithContext(ctx context.Context) ApplicationOwnerOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationOwnerOutput)
+}
+
+type ApplicationOwnerOutput struct {
+	*pulumi.OutputState
+}
+
+func (ApplicationOwnerOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationOwner)(nil))
+}
+
+func (o ApplicationOwnerOutput) ToApplicationOwnerOutput() ApplicationOwnerOutput {
+	return o
+}
+
+func (o ApplicationOwnerOutput) ToApplicationOwnerOutputWithContext(ctx context.Context) ApplicationOwnerOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(ApplicationOwnerOutput{})
+}
@@ -0,0 +1,181 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package knapcode
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// A client secret for an application, managed with the Microsoft Graph `addPassword` and `removePassword` actions. Every change replaces the client secret.
+type ApplicationPassword struct {
+	pulumi.CustomResourceState
+
+	// A friendly name for the client secret.
+	DisplayName pulumi.StringPtrOutput `pulumi:"displayName"`
+	// When the client secret expires, as an RFC 3339 date and time. Defaults to two years after the start.
+	EndDateTime pulumi.StringPtrOutput `pulumi:"endDateTime"`
+	// The first few characters of the client secret.
+	Hint pulumi.StringOutput `pulumi:"hint"`
+	// The key ID of the client secret.
+	KeyId pulumi.StringOutput `pulumi:"keyId"`
+	// The object ID of the application.
+	ObjectId pulumi.StringOutput `pulumi:"objectId"`
+	// Arbitrary values that replace the client secret with a new one whenever they change.
+	RotateWhenChanged pulumi.StringMapOutput `pulumi:"rotateWhenChanged"`
+	// The client secret.
+	SecretText pulumi.StringOutput `pulumi:"secretText"`
+	// When the client secret becomes valid, as an RFC 3339 date and time. Defaults to now.
+	StartDateTime pulumi.StringPtrOutput `pulumi:"startDateTime"`
+}
+
+// NewApplicationPassword registers a new resource with the given unique name, arguments, and options.
+func NewApplicationPassword(ctx *pulumi.Context,
+	name string, args *ApplicationPasswordArgs, opts ...pulumi.ResourceOption) (*ApplicationPassword, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.ObjectId == nil {
+		return nil, errors.New("invalid value for required argument 'ObjectId'")
+	}
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"secretText",
+	})
+	opts = append(opts, secrets)
+	var resource ApplicationPassword
+	err := ctx.RegisterResource("knapcode:index:ApplicationPassword", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetApplicationPassword gets an existing ApplicationPassword resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetApplicationPassword(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *ApplicationPasswordState, opts ...pulumi.ResourceOption) (*ApplicationPassword, error) {
+	var resource ApplicationPassword
+	err := ctx.ReadResource("knapcode:index:ApplicationPassword", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering ApplicationPassword resources.
+type applicationPasswordState struct {
+	// A friendly name for the client secret.
+	DisplayName *string `pulumi:"displayName"`
+	// When the client secret expires, as an RFC 3339 date and time. Defaults to two years after the start.
+	EndDateTime *string `pulumi:"endDateTime"`
+	// The first few characters of the client secret.
+	Hint *string `pulumi:"hint"`
+	// The key ID of the client secret.
+	KeyId *string `pulumi:"keyId"`
+	// The object ID of the application.
+	ObjectId *string `pulumi:"objectId"`
+	// Arbitrary values that replace the client secret with a new one whenever they change.
+	RotateWhenChanged map[string]string `pulumi:"rotateWhenChanged"`
+	// The client secret.
+	SecretText *string `pulumi:"secretText"`
+	// When the client secret becomes valid, as an RFC 3339 date and time. Defaults to now.
+	StartDateTime *string `pulumi:"startDateTime"`
+}
+
+type ApplicationPasswordState struct {
+	// A friendly name for the client secret.
+	DisplayName pulumi.StringPtrInput
+	// When the client secret expires, as an RFC 3339 date and time. Defaults to two years after the start.
+	EndDateTime pulumi.StringPtrInput
+	// The first few characters of the client secret.
+	Hint pulumi.StringPtrInput
+	// The key ID of the client secret.
+	KeyId pulumi.StringPtrInput
+	// The object ID of the application.
+	ObjectId pulumi.StringPtrInput
+	// Arbitrary values that replace the client secret with a new one whenever they change.
+	RotateWhenChanged pulumi.StringMapInput
+	// The client secret.
+	SecretText pulumi.StringPtrInput
+	// When the client secret becomes valid, as an RFC 3339 date and time. Defaults to now.
+	StartDateTime pulumi.StringPtrInput
+}
+
+func (ApplicationPasswordState) ElementType() reflect.Type {
+	return reflect.TypeOf((*applicationPasswordState)(nil)).Elem()
+}
+
+type applicationPasswordArgs struct {
+	// A friendly name for the client secret.
+	DisplayName *string `pulumi:"displayName"`
+	// When the client secret expires, as an RFC 3339 date and time. Defaults to two years after the start.
+	EndDateTime *string `pulumi:"endDateTime"`
+	// The object ID of the application.
+	ObjectId string `pulumi:"objectId"`
+	// Arbitrary values that replace the client secret with a new one whenever they change.
+	RotateWhenChanged map[string]string `pulumi:"rotateWhenChanged"`
+	// When the client secret becomes valid, as an RFC 3339 date and time. Defaults to now.
+	StartDateTime *string `pulumi:"startDateTime"`
+}
+
+// The set of arguments for constructing a ApplicationPassword resource.
+type ApplicationPasswordArgs struct {
+	// A friendly name for the client secret.
+	DisplayName pulumi.StringPtrInput
+	// When the client secret expires, as an RFC 3339 date and time. Defaults to two years after the start.
+	EndDateTime pulumi.StringPtrInput
+	// The object ID of the application.
+	ObjectId pulumi.StringInput
+	// Arbitrary values that replace the client secret with a new one whenever they change.
+	RotateWhenChanged pulumi.StringMapInput
+	// When the client secret becomes valid, as an RFC 3339 date and time. Defaults to now.
+	StartDateTime pulumi.StringPtrInput
+}
+
+func (ApplicationPasswordArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*applicationPasswordArgs)(nil)).Elem()
+}
+
+type ApplicationPasswordInput interface {
+	pulumi.Input
+
+	ToApplicationPasswordOutput() ApplicationPasswordOutput
+	ToApplicationPasswordOutputWithContext(ctx context.Context) ApplicationPasswordOutput
+}
+
+func (*ApplicationPassword) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationPassword)(nil))
+}
+
+func (i *ApplicationPassword) ToApplicationPasswordOutput() ApplicationPasswordOutput {
+	return i.ToApplicationPasswordOutputWithContext(context.Background())
+}
+
+func (i *ApplicationPassword) ToApplicationPasswordOutputWithContext(ctx context.Context) ApplicationPasswordOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationPasswordOutput)
+}
+
+type ApplicationPasswordOutput struct {
+	*pulumi.OutputState
+}
+
+func (ApplicationPasswordOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationPassword)(nil))
+}
+
+func (o ApplicationPasswordOutput) ToApplicationPasswordOutput() ApplicationPasswordOutput {
+	return o
+}
+
+func (o ApplicationPasswordOutput) ToApplicationPasswordOutputWithContext(ctx context.Context) ApplicationPasswordOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(ApplicationPasswordOutput{})
+}
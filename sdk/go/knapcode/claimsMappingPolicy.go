@@ -0,0 +1,148 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package knapcode
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// A claims mapping policy that customizes the claims emitted in SAML tokens and JWTs. The resource ID is the ID of the policy, which is also used to import it.
+type ClaimsMappingPolicy struct {
+	pulumi.CustomResourceState
+
+	// The claims emitted by the policy. Changing the definition updates the policy in place.
+	Definition ClaimsMappingPolicyDefinitionOutput `pulumi:"definition"`
+	// The display name of the policy.
+	DisplayName pulumi.StringOutput `pulumi:"displayName"`
+	// Whether the policy applies to every application in the organization that has no policy assigned. Defaults to false.
+	IsOrganizationDefault pulumi.BoolPtrOutput `pulumi:"isOrganizationDefault"`
+	// The ID of the policy.
+	PolicyId pulumi.StringOutput `pulumi:"policyId"`
+}
+
+// NewClaimsMappingPolicy registers a new resource with the given unique name, arguments, and options.
+func NewClaimsMappingPolicy(ctx *pulumi.Context,
+	name string, args *ClaimsMappingPolicyArgs, opts ...pulumi.ResourceOption) (*ClaimsMappingPolicy, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Definition == nil {
+		return nil, errors.New("invalid value for required argument 'Definition'")
+	}
+	if args.DisplayName == nil {
+		return nil, errors.New("invalid value for required argument 'DisplayName'")
+	}
+	var resource ClaimsMappingPolicy
+	err := ctx.RegisterResource("knapcode:index:ClaimsMappingPolicy", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetClaimsMappingPolicy gets an existing ClaimsMappingPolicy resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetClaimsMappingPolicy(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *ClaimsMappingPolicyState, opts ...pulumi.ResourceOption) (*ClaimsMappingPolicy, error) {
+	var resource ClaimsMappingPolicy
+	err := ctx.ReadResource("knapcode:index:ClaimsMappingPolicy", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering ClaimsMappingPolicy resources.
+type claimsMappingPolicyState struct {
+	// The claims emitted by the policy. Changing the definition updates the policy in place.
+	Definition *ClaimsMappingPolicyDefinition `pulumi:"definition"`
+	// The display name of the policy.
+	DisplayName *string `pulumi:"displayName"`
+	// Whether the policy applies to every application in the organization that has no policy assigned. Defaults to false.
+	IsOrganizationDefault *bool `pulumi:"isOrganizationDefault"`
+	// The ID of the policy.
+	PolicyId *string `pulumi:"policyId"`
+}
+
+type ClaimsMappingPolicyState struct {
+	// The claims emitted by the policy. Changing the definition updates the policy in place.
+	Definition ClaimsMappingPolicyDefinitionPtrInput
+	// The display name of the policy.
+	DisplayName pulumi.StringPtrInput
+	// Whether the policy applies to every application in the organization that has no policy assigned. Defaults to false.
+	IsOrganizationDefault pulumi.BoolPtrInput
+	// The ID of the policy.
+	PolicyId pulumi.StringPtrInput
+}
+
+func (ClaimsMappingPolicyState) ElementType() reflect.Type {
+	return reflect.TypeOf((*claimsMappingPolicyState)(nil)).Elem()
+}
+
+type claimsMappingPolicyArgs struct {
+	// The claims emitted by the policy. Changing the definition updates the policy in place.
+	Definition ClaimsMappingPolicyDefinition `pulumi:"definition"`
+	// The display name of the policy.
+	DisplayName string `pulumi:"displayName"`
+	// Whether the policy applies to every application in the organization that has no policy assigned. Defaults to false.
+	IsOrganizationDefault *bool `pulumi:"isOrganizationDefault"`
+}
+
+// The set of arguments for constructing a ClaimsMappingPolicy resource.
+type ClaimsMappingPolicyArgs struct {
+	// The claims emitted by the policy. Changing the definition updates the policy in place.
+	Definition ClaimsMappingPolicyDefinitionInput
+	// The display name of the policy.
+	DisplayName pulumi.StringInput
+	// Whether the policy applies to every application in the organization that has no policy assigned. Defaults to false.
+	IsOrganizationDefault pulumi.BoolPtrInput
+}
+
+func (ClaimsMappingPolicyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*claimsMappingPolicyArgs)(nil)).Elem()
+}
+
+type ClaimsMappingPolicyInput interface {
+	pulumi.Input
+
+	ToClaimsMappingPolicyOutput() ClaimsMappingPolicyOutput
+	ToClaimsMappingPolicyOutputWithContext(ctx context.Context) ClaimsMappingPolicyOutput
+}
+
+func (*ClaimsMappingPolicy) ElementType() reflect.Type {
+	return reflect.TypeOf((*ClaimsMappingPolicy)(nil))
+}
+
+func (i *ClaimsMappingPolicy) ToClaimsMappingPolicyOutput() ClaimsMappingPolicyOutput {
+	return i.ToClaimsMappingPolicyOutputWithContext(context.Background())
+}
+
+func (i *ClaimsMappingPolicy) ToClaimsMappingPolicyOutputWithContext(ctx context.Context) ClaimsMappingPolicyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ClaimsMappingPolicyOutput)
+}
+
+type ClaimsMappingPolicyOutput struct {
+	*pulumi.OutputState
+}
+
+func (ClaimsMappingPolicyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ClaimsMappingPolicy)(nil))
+}
+
+func (o ClaimsMappingPolicyOutput) ToClaimsMappingPolicyOutput() ClaimsMappingPolicyOutput {
+	return o
+}
+
+func (o ClaimsMappingPolicyOutput) ToClaimsMappingPolicyOutputWithContext(ctx context.Context) ClaimsMappingPolicyOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(ClaimsMappingPolicyOutput{})
+}
@@ -0,0 +1,132 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package knapcode
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// Assigns a claims mapping policy to a service principal. The resource ID is the object ID of the service principal and the policy ID separated by a slash, which is also used to import it.
+type ClaimsMappingPolicyAssignment struct {
+	pulumi.CustomResourceState
+
+	// The ID of the policy. Changing this replaces the resource.
+	PolicyId pulumi.StringOutput `pulumi:"policyId"`
+	// The object ID of the service principal. Changing this replaces the resource.
+	ServicePrincipalId pulumi.StringOutput `pulumi:"servicePrincipalId"`
+}
+
+// NewClaimsMappingPolicyAssignment registers a new resource with the given unique name, arguments, and options.
+func NewClaimsMappingPolicyAssignment(ctx *pulumi.Context,
+	name string, args *ClaimsMappingPolicyAssignmentArgs, opts ...pulumi.ResourceOption) (*ClaimsMappingPolicyAssignment, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.PolicyId == nil {
+		return nil, errors.New("invalid value for required argument 'PolicyId'")
+	}
+	if args.ServicePrincipalId == nil {
+		return nil, errors.New("invalid value for required argument 'ServicePrincipalId'")
+	}
+	var resource ClaimsMappingPolicyAssignment
+	err := ctx.RegisterResource("knapcode:index:ClaimsMappingPolicyAssignment", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetClaimsMappingPolicyAssignment gets an existing ClaimsMappingPolicyAssignment resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetClaimsMappingPolicyAssignment(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *ClaimsMappingPolicyAssignmentState, opts ...pulumi.ResourceOption) (*ClaimsMappingPolicyAssignment, error) {
+	var resource ClaimsMappingPolicyAssignment
+	err := ctx.ReadResource("knapcode:index:ClaimsMappingPolicyAssignment", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering ClaimsMappingPolicyAssignment resources.
+type claimsMappingPolicyAssignmentState struct {
+	// The ID of the policy. Changing this replaces the resource.
+	PolicyId *string `pulumi:"policyId"`
+	// The object ID of the service principal. Changing this replaces the resource.
+	ServicePrincipalId *string `pulumi:"servicePrincipalId"`
+}
+
+type ClaimsMappingPolicyAssignmentState struct {
+	// The ID of the policy. Changing this replaces the resource.
+	PolicyId pulumi.StringPtrInput
+	// The object ID of the service principal. Changing this replaces the resource.
+	ServicePrincipalId pulumi.StringPtrInput
+}
+
+func (ClaimsMappingPolicyAssignmentState) ElementType() reflect.Type {
+	return reflect.TypeOf((*claimsMappingPolicyAssignmentState)(nil)).Elem()
+}
+
+type claimsMappingPolicyAssignmentArgs struct {
+	// The ID of the policy. Changing this replaces the resource.
+	PolicyId string `pulumi:"policyId"`
+	// The object ID of the service principal. Changing this replaces the resource.
+	ServicePrincipalId string `pulumi:"servicePrincipalId"`
+}
+
+// The set of arguments for constructing a ClaimsMappingPolicyAssignment resource.
+type ClaimsMappingPolicyAssignmentArgs struct {
+	// The ID of the policy. Changing this replaces the resource.
+	PolicyId pulumi.StringInput
+	// The object ID of the service principal. Changing this replaces the resource.
+	ServicePrincipalId pulumi.StringInput
+}
+
+func (ClaimsMappingPolicyAssignmentArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*claimsMappingPolicyAssignmentArgs)(nil)).Elem()
+}
+
+type ClaimsMappingPolicyAssignmentInput interface {
+	pulumi.Input
+
+	ToClaimsMappingPolicyAssignmentOutput() ClaimsMappingPolicyAssignmentOutput
+	ToClaimsMappingPolicyAssignmentOutputWithContext(ctx context.Context) ClaimsMappingPolicyAssignmentOutput
+}
+
+func (*ClaimsMappingPolicyAssignment) ElementType() reflect.Type {
+	return reflect.TypeOf((*ClaimsMappingPolicyAssignment)(nil))
+}
+
+func (i *ClaimsMappingPolicyAssignment) ToClaimsMappingPolicyAssignmentOutput() ClaimsMappingPolicyAssignmentOutput {
+	return i.ToClaimsMappingPolicyAssignmentOutputWithContext(context.Background())
+}
+
+func (i *ClaimsMappingPolicyAssignment) ToClaimsMappingPolicyAssignmentOutputWithContext(ctx context.Context) ClaimsMappingPolicyAssignmentOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ClaimsMappingPolicyAssignmentOutput)
+}
+
+type ClaimsMappingPolicyAssignmentOutput struct {
+	*pulumi.OutputState
+}
+
+func (ClaimsMappingPolicyAssignmentOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ClaimsMappingPolicyAssignment)(nil))
+}
+
+func (o ClaimsMappingPolicyAssignmentOutput) ToClaimsMappingPolicyAssignmentOutput() ClaimsMappingPolicyAssignmentOutput {
+	return o
+}
+
+func (o ClaimsMappingPolicyAssignmentOutput) ToClaimsMappingPolicyAssignmentOutputWithContext(ctx context.Context) ClaimsMappingPolicyAssignmentOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(ClaimsMappingPolicyAssignmentOutput{})
+}
@@ -0,0 +1,14 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package config
+
+import (
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi/config"
+)
+
+// The object IDs of users or service principals that are added as owners of every application and service principal created by this provider, so that they can be managed in the portal.
+func GetDefaultOwners(ctx *pulumi.Context) string {
+	return config.Get(ctx, "knapcode:defaultOwners")
+}
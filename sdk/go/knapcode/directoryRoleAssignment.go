@@ -0,0 +1,176 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package knapcode
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// Assigns an Azure AD directory role to a principal, for the whole directory or scoped to an administrative unit or an application. Only one scope can be set. The resource ID is the ID of the role assignment, which is also used to import it.
+type DirectoryRoleAssignment struct {
+	pulumi.CustomResourceState
+
+	// The object ID of an administrative unit to scope the assignment to. Changing this replaces the resource.
+	AdministrativeUnitId pulumi.StringPtrOutput `pulumi:"administrativeUnitId"`
+	// The object ID of an application to scope the assignment to. Changing this replaces the resource.
+	ApplicationObjectId pulumi.StringPtrOutput `pulumi:"applicationObjectId"`
+	// The ID of the role assignment.
+	AssignmentId pulumi.StringOutput `pulumi:"assignmentId"`
+	// The scope of the assignment: '/' for the whole directory, '/administrativeUnits/{id}' for an administrative unit or '/{id}' for an application.
+	DirectoryScopeId pulumi.StringOutput `pulumi:"directoryScopeId"`
+	// The object ID of the user, group or service principal to assign the role to. Changing this replaces the resource.
+	PrincipalId pulumi.StringOutput `pulumi:"principalId"`
+	// The directory role, either the display name of a role like 'Application Administrator' or the ID or template ID of a role definition. Changing this replaces the resource.
+	Role pulumi.StringOutput `pulumi:"role"`
+	// The template ID of the assigned role.
+	RoleDefinitionId pulumi.StringOutput `pulumi:"roleDefinitionId"`
+	// The display name of the assigned role.
+	RoleDisplayName pulumi.StringOutput `pulumi:"roleDisplayName"`
+}
+
+// NewDirectoryRoleAssignment registers a new resource with the given unique name, arguments, and options.
+func NewDirectoryRoleAssignment(ctx *pulumi.Context,
+	name string, args *DirectoryRoleAssignmentArgs, opts ...pulumi.ResourceOption) (*DirectoryRoleAssignment, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.PrincipalId == nil {
+		return nil, errors.New("invalid value for required argument 'PrincipalId'")
+	}
+	if args.Role == nil {
+		return nil, errors.New("invalid value for required argument 'Role'")
+	}
+	var resource DirectoryRoleAssignment
+	err := ctx.RegisterResource("knapcode:index:DirectoryRoleAssignment", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetDirectoryRoleAssignment gets an existing DirectoryRoleAssignment resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetDirectoryRoleAssignment(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *DirectoryRoleAssignmentState, opts ...pulumi.ResourceOption) (*DirectoryRoleAssignment, error) {
+	var resource DirectoryRoleAssignment
+	err := ctx.ReadResource("knapcode:index:DirectoryRoleAssignment", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering DirectoryRoleAssignment resources.
+type directoryRoleAssignmentState struct {
+	// The object ID of an administrative unit to scope the assignment to. Changing this replaces the resource.
+	AdministrativeUnitId *string `pulumi:"administrativeUnitId"`
+	// The object ID of an application to scope the assignment to. Changing this replaces the resource.
+	ApplicationObjectId *string `pulumi:"applicationObjectId"`
+	// The ID of the role assignment.
+	AssignmentId *string `pulumi:"assignmentId"`
+	// The scope of the assignment: '/' for the whole directory, '/administrativeUnits/{id}' for an administrative unit or '/{id}' for an application.
+	DirectoryScopeId *string `pulumi:"directoryScopeId"`
+	// The object ID of the user, group or service principal to assign the role to. Changing this replaces the resource.
+	PrincipalId *string `pulumi:"principalId"`
+	// The directory role, either the display name of a role like 'Application Administrator' or the ID or template ID of a role definition. Changing this replaces the resource.
+	Role *string `pulumi:"role"`
+	// The template ID of the assigned role.
+	RoleDefinitionId *string `pulumi:"roleDefinitionId"`
+	// The display name of the assigned role.
+	RoleDisplayName *string `pulumi:"roleDisplayName"`
+}
+
+type DirectoryRoleAssignmentState struct {
+	// The object ID of an administrative unit to scope the assignment to. Changing this replaces the resource.
+	AdministrativeUnitId pulumi.StringPtrInput
+	// The object ID of an application to scope the assignment to. Changing this replaces the resource.
+	ApplicationObjectId pulumi.StringPtrInput
+	// The ID of the role assignment.
+	AssignmentId pulumi.StringPtrInput
+	// The scope of the assignment: '/' for the whole directory, '/administrativeUnits/{id}' for an administrative unit or '/{id}' for an application.
+	DirectoryScopeId pulumi.StringPtrInput
+	// The object ID of the user, group or service principal to assign the role to. Changing this replaces the resource.
+	PrincipalId pulumi.StringPtrInput
+	// The directory role, either the display name of a role like 'Application Administrator' or the ID or template ID of a role definition. Changing this replaces the resource.
+	Role pulumi.StringPtrInput
+	// The template ID of the assigned role.
+	RoleDefinitionId pulumi.StringPtrInput
+	// The display name of the assigned role.
+	RoleDisplayName pulumi.StringPtrInput
+}
+
+func (DirectoryRoleAssignmentState) ElementType() reflect.Type {
+	return reflect.TypeOf((*directoryRoleAssignmentState)(nil)).Elem()
+}
+
+type directoryRoleAssignmentArgs struct {
+	// The object ID of an administrative unit to scope the assignment to. Changing this replaces the resource.
+	AdministrativeUnitId *string `pulumi:"administrativeUnitId"`
+	// The object ID of an application to scope the assignment to. Changing this replaces the resource.
+	ApplicationObjectId *string `pulumi:"applicationObjectId"`
+	// The object ID of the user, group or service principal to assign the role to. Changing this replaces the resource.
+	PrincipalId string `pulumi:"principalId"`
+	// The directory role, either the display name of a role like 'Application Administrator' or the ID or template ID of a role definition. Changing this replaces the resource.
+	Role string `pulumi:"role"`
+}
+
+// The set of arguments for constructing a DirectoryRoleAssignment resource.
+type DirectoryRoleAssignmentArgs struct {
+	// The object ID of an administrative unit to scope the assignment to. Changing this replaces the resource.
+	AdministrativeUnitId pulumi.StringPtrInput
+	// The object ID of an application to scope the assignment to. Changing this replaces the resource.
+	ApplicationObjectId pulumi.StringPtrInput
+	// The object ID of the user, group or service principal to assign the role to. Changing this replaces the resource.
+	PrincipalId pulumi.StringInput
+	// The directory role, either the display name of a role like 'Application Administrator' or the ID or template ID of a role definition. Changing this replaces the resource.
+	Role pulumi.StringInput
+}
+
+func (DirectoryRoleAssignmentArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*directoryRoleAssignmentArgs)(nil)).Elem()
+}
+
+type DirectoryRoleAssignmentInput interface {
+	pulumi.Input
+
+	ToDirectoryRoleAssignmentOutput() DirectoryRoleAssignmentOutput
+	ToDirectoryRoleAssignmentOutputWithContext(ctx context.Context) DirectoryRoleAssignmentOutput
+}
+
+func (*DirectoryRoleAssignment) ElementType() reflect.Type {
+	return reflect.TypeOf((*DirectoryRoleAssignment)(nil))
+}
+
+func (i *DirectoryRoleAssignment) ToDirectoryRoleAssignmentOutput() DirectoryRoleAssignmentOutput {
+	return i.ToDirectoryRoleAssignmentOutputWithContext(context.Background())
+}
+
+func (i *DirectoryRoleAssignment) ToDirectoryRoleAssignmentOutputWithContext(ctx context.Context) DirectoryRoleAssignmentOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DirectoryRoleAssignmentOutput)
+}
+
+type DirectoryRoleAssignmentOutput struct {
+	*pulumi.OutputState
+}
+
+func (DirectoryRoleAssignmentOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*DirectoryRoleAssignment)(nil))
+}
+
+func (o DirectoryRoleAssignmentOutput) ToDirectoryRoleAssignmentOutput() DirectoryRoleAssignmentOutput {
+	return o
+}
+
+func (o DirectoryRoleAssignmentOutput) ToDirectoryRoleAssignmentOutputWithContext(ctx context.Context) DirectoryRoleAssignmentOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(DirectoryRoleAssignmentOutput{})
+}
@@ -0,0 +1,171 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package knapcode
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// Exposes an application as an API: sets its identifier URI and manages its scopes, pre-authorized client applications and known client applications. Other API settings are left untouched. The resource ID is the object ID of the application, which is also used to import it.
+type ExposeApi struct {
+	pulumi.CustomResourceState
+
+	// The app ID of the application.
+	AppId pulumi.StringOutput `pulumi:"appId"`
+	// The identifier URI of the API. Defaults to 'api://{appId}'.
+	IdentifierUri pulumi.StringPtrOutput `pulumi:"identifierUri"`
+	// The identifier URIs of the application.
+	IdentifierUris pulumi.StringArrayOutput `pulumi:"identifierUris"`
+	// The app IDs of client applications that are bundled with the API for consent.
+	KnownClientApplications pulumi.StringArrayOutput `pulumi:"knownClientApplications"`
+	// The object ID of the application. Changing this replaces the resource.
+	ObjectId pulumi.StringOutput `pulumi:"objectId"`
+	// The client applications that can use the API's scopes without user consent.
+	PreAuthorizedApplications ExposeApiPreAuthorizedApplicationArrayOutput `pulumi:"preAuthorizedApplications"`
+	// The delegated permissions exposed by the API. IDs default to a GUID derived from the value.
+	Scopes ApplicationPermissionScopeArrayOutput `pulumi:"scopes"`
+}
+
+// NewExposeApi registers a new resource with the given unique name, arguments, and options.
+func NewExposeApi(ctx *pulumi.Context,
+	name string, args *ExposeApiArgs, opts ...pulumi.ResourceOption) (*ExposeApi, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.ObjectId == nil {
+		return nil, errors.New("invalid value for required argument 'ObjectId'")
+	}
+	var resource ExposeApi
+	err := ctx.RegisterResource("knapcode:index:ExposeApi", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetExposeApi gets an existing ExposeApi resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetExposeApi(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *ExposeApiState, opts ...pulumi.ResourceOption) (*ExposeApi, error) {
+	var resource ExposeApi
+	err := ctx.ReadResource("knapcode:index:ExposeApi", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering ExposeApi resources.
+type exposeApiState struct {
+	// The app ID of the application.
+	AppId *string `pulumi:"appId"`
+	// The identifier URI of the API. Defaults to 'api://{appId}'.
+	IdentifierUri *string `pulumi:"identifierUri"`
+	// The identifier URIs of the application.
+	IdentifierUris []string `pulumi:"identifierUris"`
+	// The app IDs of client applications that are bundled with the API for consent.
+	KnownClientApplications []string `pulumi:"knownClientApplications"`
+	// The object ID of the application. Changing this replaces the resource.
+	ObjectId *string `pulumi:"objectId"`
+	// The client applications that can use the API's scopes without user consent.
+	PreAuthorizedApplications []ExposeApiPreAuthorizedApplication `pulumi:"preAuthorizedApplications"`
+	// The delegated permissions exposed by the API. IDs default to a GUID derived from the value.
+	Scopes []ApplicationPermissionScope `pulumi:"scopes"`
+}
+
+type ExposeApiState struct {
+	// The app ID of the application.
+	AppId pulumi.StringPtrInput
+	// The identifier URI of the API. Defaults to 'api://{appId}'.
+	IdentifierUri pulumi.StringPtrInput
+	// The identifier URIs of the application.
+	IdentifierUris pulumi.StringArrayInput
+	// The app IDs of client applications that are bundled with the API for consent.
+	KnownClientApplications pulumi.StringArrayInput
+	// The object ID of the application. Changing this replaces the resource.
+	ObjectId pulumi.StringPtrInput
+	// The client applications that can use the API's scopes without user consent.
+	PreAuthorizedApplications ExposeApiPreAuthorizedApplicationArrayInput
+	// The delegated permissions exposed by the API. IDs default to a GUID derived from the value.
+	Scopes ApplicationPermissionScopeArrayInput
+}
+
+func (ExposeApiState) ElementType() reflect.Type {
+	return reflect.TypeOf((*exposeApiState)(nil)).Elem()
+}
+
+type exposeApiArgs struct {
+	// The identifier URI of the API. Defaults to 'api://{appId}'.
+	IdentifierUri *string `pulumi:"identifierUri"`
+	// The app IDs of client applications that are bundled with the API for consent.
+	KnownClientApplications []string `pulumi:"knownClientApplications"`
+	// The object ID of the application. Changing this replaces the resource.
+	ObjectId string `pulumi:"objectId"`
+	// The client applications that can use the API's scopes without user consent.
+	PreAuthorizedApplications []ExposeApiPreAuthorizedApplication `pulumi:"preAuthorizedApplications"`
+	// The delegated permissions exposed by the API. IDs default to a GUID derived from the value.
+	Scopes []ApplicationPermissionScope `pulumi:"scopes"`
+}
+
+// The set of arguments for constructing a ExposeApi resource.
+type ExposeApiArgs struct {
+	// The identifier URI of the API. Defaults to 'api://{appId}'.
+	IdentifierUri pulumi.StringPtrInput
+	// The app IDs of client applications that are bundled with the API for consent.
+	KnownClientApplications pulumi.StringArrayInput
+	// The object ID of the application. Changing this replaces the resource.
+	ObjectId pulumi.StringInput
+	// The client applications that can use the API's scopes without user consent.
+	PreAuthorizedApplications ExposeApiPreAuthorizedApplicationArrayInput
+	// The delegated permissions exposed by the API. IDs default to a GUID derived from the value.
+	Scopes ApplicationPermissionScopeArrayInput
+}
+
+func (ExposeApiArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*exposeApiArgs)(nil)).Elem()
+}
+
+type ExposeApiInput interface {
+	pulumi.Input
+
+	ToExposeApiOutput() ExposeApiOutput
+	ToExposeApiOutputWithContext(ctx context.Context) ExposeApiOutput
+}
+
+func (*ExposeApi) ElementType() reflect.Type {
+	return reflect.TypeOf((*ExposeApi)(nil))
+}
+
+func (i *ExposeApi) ToExposeApiOutput() ExposeApiOutput {
+	return i.ToExposeApiOutputWithContext(context.Background())
+}
+
+func (i *ExposeApi) ToExposeApiOutputWithContext(ctx context.Context) ExposeApiOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ExposeApiOutput)
+}
+
+type ExposeApiOutput struct {
+	*pulumi.OutputState
+}
+
+func (ExposeApiOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ExposeApi)(nil))
+}
+
+func (o ExposeApiOutput) ToExposeApiOutput() ExposeApiOutput {
+	return o
+}
+
+func (o ExposeApiOutput) ToExposeApiOutputWithContext(ctx context.Context) ExposeApiOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(ExposeApiOutput{})
+}
@@ -0,0 +1,177 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package knapcode
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// A directory extension property owned by an application, which adds a custom attribute to directory objects like users and groups. The resource ID is the object ID of the application and the extension property ID separated by a slash, which is also used to import it.
+type ExtensionProperty struct {
+	pulumi.CustomResourceState
+
+	// The full name of the extension property on the target objects, in the form 'extension_{appId}_{name}' where the application ID has no hyphens.
+	AttributeName pulumi.StringOutput `pulumi:"attributeName"`
+	// The data type of the values of the extension property. Changing this replaces the resource.
+	DataType pulumi.StringOutput `pulumi:"dataType"`
+	// The ID of the extension property.
+	ExtensionPropertyId pulumi.StringOutput `pulumi:"extensionPropertyId"`
+	// Whether the extension property holds a collection of values. Defaults to false. Changing this replaces the resource.
+	IsMultiValued pulumi.BoolPtrOutput `pulumi:"isMultiValued"`
+	// The name of the extension property, without the 'extension_{appId}_' prefix. Can only contain letters, digits and '_' and must start with a letter. Changing this replaces the resource.
+	Name pulumi.StringOutput `pulumi:"name"`
+	// The object ID of the application that owns the extension property. Changing this replaces the resource.
+	ObjectId pulumi.StringOutput `pulumi:"objectId"`
+	// The kinds of directory objects the extension property can be set on: 'User', 'Group', 'AdministrativeUnit', 'Application', 'Device' or 'Organization'. Changing this replaces the resource.
+	TargetObjects pulumi.StringArrayOutput `pulumi:"targetObjects"`
+}
+
+// NewExtensionProperty registers a new resource with the given unique name, arguments, and options.
+func NewExtensionProperty(ctx *pulumi.Context,
+	name string, args *ExtensionPropertyArgs, opts ...pulumi.ResourceOption) (*ExtensionProperty, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Name == nil {
+		return nil, errors.New("invalid value for required argument 'Name'")
+	}
+	if args.ObjectId == nil {
+		return nil, errors.New("invalid value for required argument 'ObjectId'")
+	}
+	if args.TargetObjects == nil {
+		return nil, errors.New("invalid value for required argument 'TargetObjects'")
+	}
+	var resource ExtensionProperty
+	err := ctx.RegisterResource("knapcode:index:ExtensionProperty", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetExtensionProperty gets an existing ExtensionProperty resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetExtensionProperty(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *ExtensionPropertyState, opts ...pulumi.ResourceOption) (*ExtensionProperty, error) {
+	var resource ExtensionProperty
+	err := ctx.ReadResource("knapcode:index:ExtensionProperty", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering ExtensionProperty resources.
+type extensionPropertyState struct {
+	// The full name of the extension property on the target objects, in the form 'extension_{appId}_{name}' where the application ID has no hyphens.
+	AttributeName *string `pulumi:"attributeName"`
+	// The data type of the values of the extension property. Changing this replaces the resource.
+	DataType *string `pulumi:"dataType"`
+	// The ID of the extension property.
+	ExtensionPropertyId *string `pulumi:"extensionPropertyId"`
+	// Whether the extension property holds a collection of values. Defaults to false. Changing this replaces the resource.
+	IsMultiValued *bool `pulumi:"isMultiValued"`
+	// The name of the extension property, without the 'extension_{appId}_' prefix. Can only contain letters, digits and '_' and must start with a letter. Changing this replaces the resource.
+	Name *string `pulumi:"name"`
+	// The object ID of the application that owns the extension property. Changing this replaces the resource.
+	ObjectId *string `pulumi:"objectId"`
+	// The kinds of directory objects the extension property can be set on: 'User', 'Group', 'AdministrativeUnit', 'Application', 'Device' or 'Organization'. Changing this replaces the resource.
+	TargetObjects []string `pulumi:"targetObjects"`
+}
+
+type ExtensionPropertyState struct {
+	// The full name of the extension property on the target objects, in the form 'extension_{appId}_{name}' where the application ID has no hyphens.
+	AttributeName pulumi.StringPtrInput
+	// The data type of the values of the extension property. Changing this replaces the resource.
+	DataType *ExtensionPropertyDataType
+	// The ID of the extension property.
+	ExtensionPropertyId pulumi.StringPtrInput
+	// Whether the extension property holds a collection of values. Defaults to false. Changing this replaces the resource.
+	IsMultiValued pulumi.BoolPtrInput
+	// The name of the extension property, without the 'extension_{appId}_' prefix. Can only contain letters, digits and '_' and must start with a letter. Changing this replaces the resource.
+	Name pulumi.StringPtrInput
+	// The object ID of the application that owns the extension property. Changing this replaces the resource.
+	ObjectId pulumi.StringPtrInput
+	// The kinds of directory objects the extension property can be set on: 'User', 'Group', 'AdministrativeUnit', 'Application', 'Device' or 'Organization'. Changing this replaces the resource.
+	TargetObjects pulumi.StringArrayInput
+}
+
+func (ExtensionPropertyState) ElementType() reflect.Type {
+	return reflect.TypeOf((*extensionPropertyState)(nil)).Elem()
+}
+
+type extensionPropertyArgs struct {
+	// The data type of the values of the extension property. Changing this replaces the resource.
+	DataType string `pulumi:"dataType"`
+	// Whether the extension property holds a collection of values. Defaults to false. Changing this replaces the resource.
+	IsMultiValued *bool `pulumi:"isMultiValued"`
+	// The name of the extension property, without the 'extension_{appId}_' prefix. Can only contain letters, digits and '_' and must start with a letter. Changing this replaces the resource.
+	Name string `pulumi:"name"`
+	// The object ID of the application that owns the extension property. Changing this replaces the resource.
+	ObjectId string `pulumi:"objectId"`
+	// The kinds of directory objects the extension property can be set on: 'User', 'Group', 'AdministrativeUnit', 'Application', 'Device' or 'Organization'. Changing this replaces the resource.
+	TargetObjects []string `pulumi:"targetObjects"`
+}
+
+// The set of arguments for constructing a ExtensionProperty resource.
+type ExtensionPropertyArgs struct {
+	// The data type of the values of the extension property. Changing this replaces the resource.
+	DataType ExtensionPropertyDataType
+	// Whether the extension property holds a collection of values. Defaults to false. Changing this replaces the resource.
+	IsMultiValued pulumi.BoolPtrInput
+	// The name of the extension property, without the 'extension_{appId}_' prefix. Can only contain letters, digits and '_' and must start with a letter. Changing this replaces the resource.
+	Name pulumi.StringInput
+	// The object ID of the application that owns the extension property. Changing this replaces the resource.
+	ObjectId pulumi.StringInput
+	// The kinds of directory objects the extension property can be set on: 'User', 'Group', 'AdministrativeUnit', 'Application', 'Device' or 'Organization'. Changing this replaces the resource.
+	TargetObjects pulumi.StringArrayInput
+}
+
+func (ExtensionPropertyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*extensionPropertyArgs)(nil)).Elem()
+}
+
+type ExtensionPropertyInput interface {
+	pulumi.Input
+
+	ToExtensionPropertyOutput() ExtensionPropertyOutput
+	ToExtensionPropertyOutputWithContext(ctx context.Context) ExtensionPropertyOutput
+}
+
+func (*ExtensionProperty) ElementType() reflect.Type {
+	return reflect.TypeOf((*ExtensionProperty)(nil))
+}
+
+func (i *ExtensionProperty) ToExtensionPropertyOutput() ExtensionPropertyOutput {
+	return i.ToExtensionPropertyOutputWithContext(context.Background())
+}
+
+func (i *ExtensionProperty) ToExtensionPropertyOutputWithContext(ctx context.Context) ExtensionPropertyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ExtensionPropertyOutput)
+}
+
+type ExtensionPropertyOutput struct {
+	*pulumi.OutputState
+}
+
+func (ExtensionPropertyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ExtensionProperty)(nil))
+}
+
+func (o ExtensionPropertyOutput) ToExtensionPropertyOutput() ExtensionPropertyOutput {
+	return o
+}
+
+func (o ExtensionPropertyOutput) ToExtensionPropertyOutputWithContext(ctx context.Context) ExtensionPropertyOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(ExtensionPropertyOutput{})
+}
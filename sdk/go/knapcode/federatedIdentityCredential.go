@@ -0,0 +1,198 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package knapcode
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// A federated identity credential on an application, letting an external workload like a GitHub Actions workflow or a Kubernetes service account get tokens for the application without a secret. The resource ID is the application's object ID and the credential ID separated by a slash, which is also the format used to import a credential.
+type FederatedIdentityCredential struct {
+	pulumi.CustomResourceState
+
+	// The audiences that can appear in the external token.
+	Audiences pulumi.StringArrayOutput `pulumi:"audiences"`
+	// The ID of the credential assigned by Microsoft Graph.
+	CredentialId pulumi.StringOutput `pulumi:"credentialId"`
+	// A description of the credential.
+	Description pulumi.StringPtrOutput `pulumi:"description"`
+	// Builds the subject for GitHub Actions.
+	Github GitHubFederatedSubjectPtrOutput `pulumi:"github"`
+	// The URL of the external identity provider.
+	Issuer pulumi.StringOutput `pulumi:"issuer"`
+	// Builds the subject for a Kubernetes service account.
+	Kubernetes KubernetesFederatedSubjectPtrOutput `pulumi:"kubernetes"`
+	// The name of the credential, unique within the application. Changing this replaces the credential.
+	Name pulumi.StringOutput `pulumi:"name"`
+	// The object ID of the application. Changing this replaces the credential.
+	ObjectId pulumi.StringOutput `pulumi:"objectId"`
+	// The identity of the external workload.
+	Subject pulumi.StringOutput `pulumi:"subject"`
+}
+
+// NewFederatedIdentityCredential registers a new resource with the given unique name, arguments, and options.
+func NewFederatedIdentityCredential(ctx *pulumi.Context,
+	name string, args *FederatedIdentityCredentialArgs, opts ...pulumi.ResourceOption) (*FederatedIdentityCredential, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Name == nil {
+		return nil, errors.New("invalid value for required argument 'Name'")
+	}
+	if args.ObjectId == nil {
+		return nil, errors.New("invalid value for required argument 'ObjectId'")
+	}
+	var resource FederatedIdentityCredential
+	err := ctx.RegisterResource("knapcode:index:FederatedIdentityCredential", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetFederatedIdentityCredential gets an existing FederatedIdentityCredential resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetFederatedIdentityCredential(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *FederatedIdentityCredentialState, opts ...pulumi.ResourceOption) (*FederatedIdentityCredential, error) {
+	var resource FederatedIdentityCredential
+	err := ctx.ReadResource("knapcode:index:FederatedIdentityCredential", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering FederatedIdentityCredential resources.
+type federatedIdentityCredentialState struct {
+	// The audiences that can appear in the external token.
+	Audiences []string `pulumi:"audiences"`
+	// The ID of the credential assigned by Microsoft Graph.
+	CredentialId *string `pulumi:"credentialId"`
+	// A description of the credential.
+	Description *string `pulumi:"description"`
+	// Builds the subject for GitHub Actions.
+	Github *GitHubFederatedSubject `pulumi:"github"`
+	// The URL of the external identity provider.
+	Issuer *string `pulumi:"issuer"`
+	// Builds the subject for a Kubernetes service account.
+	Kubernetes *KubernetesFederatedSubject `pulumi:"kubernetes"`
+	// The name of the credential, unique within the application. Changing this replaces the credential.
+	Name *string `pulumi:"name"`
+	// The object ID of the application. Changing this replaces the credential.
+	ObjectId *string `pulumi:"objectId"`
+	// The identity of the external workload.
+	Subject *string `pulumi:"subject"`
+}
+
+type FederatedIdentityCredentialState struct {
+	// The audiences that can appear in the external token.
+	Audiences pulumi.StringArrayInput
+	// The ID of the credential assigned by Microsoft Graph.
+	CredentialId pulumi.StringPtrInput
+	// A description of the credential.
+	Description pulumi.StringPtrInput
+	// Builds the subject for GitHub Actions.
+	Github GitHubFederatedSubjectPtrInput
+	// The URL of the external identity provider.
+	Issuer pulumi.StringPtrInput
+	// Builds the subject for a Kubernetes service account.
+	Kubernetes KubernetesFederatedSubjectPtrInput
+	// The name of the credential, unique within the application. Changing this replaces the credential.
+	Name pulumi.StringPtrInput
+	// The object ID of the application. Changing this replaces the credential.
+	ObjectId pulumi.StringPtrInput
+	// The identity of the external workload.
+	Subject pulumi.StringPtrInput
+}
+
+func (FederatedIdentityCredentialState) ElementType() reflect.Type {
+	return reflect.TypeOf((*federatedIdentityCredentialState)(nil)).Elem()
+}
+
+type federatedIdentityCredentialArgs struct {
+	// The audiences that can appear in the external token. Defaults to 'api://AzureADTokenExchange'.
+	Audiences []string `pulumi:"audiences"`
+	// A description of the credential.
+	Description *string `pulumi:"description"`
+	// Builds the subject for GitHub Actions.
+	Github *GitHubFederatedSubject `pulumi:"github"`
+	// The URL of the external identity provider. Defaults to the GitHub Actions issuer when 'github' is set.
+	Issuer *string `pulumi:"issuer"`
+	// Builds the subject for a Kubernetes service account.
+	Kubernetes *KubernetesFederatedSubject `pulumi:"kubernetes"`
+	// The name of the credential, unique within the application. Changing this replaces the credential.
+	Name string `pulumi:"name"`
+	// The object ID of the application. Changing this replaces the credential.
+	ObjectId string `pulumi:"objectId"`
+	// The identity of the external workload. Set this, 'github' or 'kubernetes'.
+	Subject *string `pulumi:"subject"`
+}
+
+// The set of arguments for constructing a FederatedIdentityCredential resource.
+type FederatedIdentityCredentialArgs struct {
+	// The audiences that can appear in the external token. Defaults to 'api://AzureADTokenExchange'.
+	Audiences pulumi.StringArrayInput
+	// A description of the credential.
+	Description pulumi.StringPtrInput
+	// Builds the subject for GitHub Actions.
+	Github GitHubFederatedSubjectPtrInput
+	// The URL of the external identity provider. Defaults to the GitHub Actions issuer when 'github' is set.
+	Issuer pulumi.StringPtrInput
+	// Builds the subject for a Kubernetes service account.
+	Kubernetes KubernetesFederatedSubjectPtrInput
+	// The name of the credential, unique within the application. Changing this replaces the credential.
+	Name pulumi.StringInput
+	// The object ID of the application. Changing this replaces the credential.
+	ObjectId pulumi.StringInput
+	// The identity of the external workload. Set this, 'github' or 'kubernetes'.
+	Subject pulumi.StringPtrInput
+}
+
+func (FederatedIdentityCredentialArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*federatedIdentityCredentialArgs)(nil)).Elem()
+}
+
+type FederatedIdentityCredentialInput interface {
+	pulumi.Input
+
+	ToFederatedIdentityCredentialOutput() FederatedIdentityCredentialOutput
+	ToFederatedIdentityCredentialOutputWithContext(ctx context.Context) FederatedIdentityCredentialOutput
+}
+
+func (*FederatedIdentityCredential) ElementType() reflect.Type {
+	return reflect.TypeOf((*FederatedIdentityCredential)(nil))
+}
+
+func (i *FederatedIdentityCredential) ToFederatedIdentityCredentialOutput() FederatedIdentityCredentialOutput {
+	return i.ToFederatedIdentityCredentialOutputWithContext(context.Background())
+}
+
+func (i *FederatedIdentityCredential) ToFederatedIdentityCredentialOutputWithContext(ctx context.Context) FederatedIdentityCredentialOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FederatedIdentityCredentialOutput)
+}
+
+type FederatedIdentityCredentialOutput struct {
+	*pulumi.OutputState
+}
+
+func (FederatedIdentityCredentialOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*FederatedIdentityCredential)(nil))
+}
+
+func (o FederatedIdentityCredentialOutput) ToFederatedIdentityCredentialOutput() FederatedIdentityCredentialOutput {
+	return o
+}
+
+func (o FederatedIdentityCredentialOutput) ToFederatedIdentityCredentialOutputWithContext(ctx context.Context) FederatedIdentityCredentialOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(FederatedIdentityCredentialOutput{})
+}
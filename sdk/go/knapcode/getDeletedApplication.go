@@ -0,0 +1,36 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package knapcode
+
+import (
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// Looks up an application by object ID, either live or in the directory's deleted items, or a deleted application by display name, without restoring it.
+func GetDeletedApplication(ctx *pulumi.Context, args *GetDeletedApplicationArgs, opts ...pulumi.InvokeOption) (*GetDeletedApplicationResult, error) {
+	var rv GetDeletedApplicationResult
+	err := ctx.Invoke("knapcode:index:getDeletedApplication", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type GetDeletedApplicationArgs struct {
+	// The display name of the deleted application. Either this or `objectId` must be set.
+	DisplayName *string `pulumi:"displayName"`
+	// The object ID of the application. Either this or `displayName` must be set.
+	ObjectId *string `pulumi:"objectId"`
+}
+
+type GetDeletedApplicationResult struct {
+	// The application (client) ID of the application.
+	AppId string `pulumi:"appId"`
+	// The display name of the application.
+	DisplayName string `pulumi:"displayName"`
+	// Whether the application is in the directory's deleted items.
+	IsDeleted bool `pulumi:"isDeleted"`
+	// The object ID of the application.
+	ObjectId string `pulumi:"objectId"`
+}
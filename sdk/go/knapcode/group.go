@@ -0,0 +1,171 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package knapcode
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// A security or Microsoft 365 group managed through Microsoft Graph. The resource ID is the object ID of the group, which is also used to import it.
+type Group struct {
+	pulumi.CustomResourceState
+
+	// The description of the group.
+	Description pulumi.StringPtrOutput `pulumi:"description"`
+	// The display name of the group.
+	DisplayName pulumi.StringOutput `pulumi:"displayName"`
+	// Whether the group can be assigned Azure AD roles. Defaults to false. Changing this replaces the resource.
+	IsAssignableToRole pulumi.BoolPtrOutput `pulumi:"isAssignableToRole"`
+	// The email address of the group, for Microsoft 365 groups.
+	Mail pulumi.StringPtrOutput `pulumi:"mail"`
+	// The mail alias of the group. Can only contain letters, digits, '.', '-' and '_'. Defaults to the display name without the other characters.
+	MailNickname pulumi.StringOutput `pulumi:"mailNickname"`
+	// The object ID of the group.
+	ObjectId pulumi.StringOutput `pulumi:"objectId"`
+	// The kind of group. Both kinds are security enabled, so they can be assigned app roles. Defaults to 'Security'. Changing this replaces the resource.
+	Type pulumi.StringPtrOutput `pulumi:"type"`
+}
+
+// NewGroup registers a new resource with the given unique name, arguments, and options.
+func NewGroup(ctx *pulumi.Context,
+	name string, args *GroupArgs, opts ...pulumi.ResourceOption) (*Group, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.DisplayName == nil {
+		return nil, errors.New("invalid value for required argument 'DisplayName'")
+	}
+	var resource Group
+	err := ctx.RegisterResource("knapcode:index:Group", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetGroup gets an existing Group resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetGroup(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *GroupState, opts ...pulumi.ResourceOption) (*Group, error) {
+	var resource Group
+	err := ctx.ReadResource("knapcode:index:Group", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering Group resources.
+type groupState struct {
+	// The description of the group.
+	Description *string `pulumi:"description"`
+	// The display name of the group.
+	DisplayName *string `pulumi:"displayName"`
+	// Whether the group can be assigned Azure AD roles. Defaults to false. Changing this replaces the resource.
+	IsAssignableToRole *bool `pulumi:"isAssignableToRole"`
+	// The email address of the group, for Microsoft 365 groups.
+	Mail *string `pulumi:"mail"`
+	// The mail alias of the group. Can only contain letters, digits, '.', '-' and '_'. Defaults to the display name without the other characters.
+	MailNickname *string `pulumi:"mailNickname"`
+	// The object ID of the group.
+	ObjectId *string `pulumi:"objectId"`
+	// The kind of group. Both kinds are security enabled, so they can be assigned app roles. Defaults to 'Security'. Changing this replaces the resource.
+	Type *string `pulumi:"type"`
+}
+
+type GroupState struct {
+	// The description of the group.
+	Description pulumi.StringPtrInput
+	// The display name of the group.
+	DisplayName pulumi.StringPtrInput
+	// Whether the group can be assigned Azure AD roles. Defaults to false. Changing this replaces the resource.
+	IsAssignableToRole pulumi.BoolPtrInput
+	// The email address of the group, for Microsoft 365 groups.
+	Mail pulumi.StringPtrInput
+	// The mail alias of the group. Can only contain letters, digits, '.', '-' and '_'. Defaults to the display name without the other characters.
+	MailNickname pulumi.StringPtrInput
+	// The object ID of the group.
+	ObjectId pulumi.StringPtrInput
+	// The kind of group. Both kinds are security enabled, so they can be assigned app roles. Defaults to 'Security'. Changing this replaces the resource.
+	Type *GroupType
+}
+
+func (GroupState) ElementType() reflect.Type {
+	return reflect.TypeOf((*groupState)(nil)).Elem()
+}
+
+type groupArgs struct {
+	// The description of the group.
+	Description *string `pulumi:"description"`
+	// The display name of the group.
+	DisplayName string `pulumi:"displayName"`
+	// Whether the group can be assigned Azure AD roles. Defaults to false. Changing this replaces the resource.
+	IsAssignableToRole *bool `pulumi:"isAssignableToRole"`
+	// The mail alias of the group. Can only contain letters, digits, '.', '-' and '_'. Defaults to the display name without the other characters.
+	MailNickname *string `pulumi:"mailNickname"`
+	// The kind of group. Both kinds are security enabled, so they can be assigned app roles. Defaults to 'Security'. Changing this replaces the resource.
+	Type *string `pulumi:"type"`
+}
+
+// The set of arguments for constructing a Group resource.
+type GroupArgs struct {
+	// The description of the group.
+	Description pulumi.StringPtrInput
+	// The display name of the group.
+	DisplayName pulumi.StringInput
+	// Whether the group can be assigned Azure AD roles. Defaults to false. Changing this replaces the resource.
+	IsAssignableToRole pulumi.BoolPtrInput
+	// The mail alias of the group. Can only contain letters, digits, '.', '-' and '_'. Defaults to the display name without the other characters.
+	MailNickname pulumi.StringPtrInput
+	// The kind of group. Both kinds are security enabled, so they can be assigned app roles. Defaults to 'Security'. Changing this replaces the resource.
+	Type *GroupType
+}
+
+func (GroupArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*groupArgs)(nil)).Elem()
+}
+
+type GroupInput interface {
+	pulumi.Input
+
+	ToGroupOutput() GroupOutput
+	ToGroupOutputWithContext(ctx context.Context) GroupOutput
+}
+
+func (*Group) ElementType() reflect.Type {
+	return reflect.TypeOf((*Group)(nil))
+}
+
+func (i *Group) ToGroupOutput() GroupOutput {
+	return i.ToGroupOutputWithContext(context.Background())
+}
+
+func (i *Group) ToGroupOutputWithContext(ctx context.Context) GroupOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(GroupOutput)
+}
+
+type GroupOutput struct {
+	*pulumi.OutputState
+}
+
+func (GroupOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Group)(nil))
+}
+
+func (o GroupOutput) ToGroupOutput() GroupOutput {
+	return o
+}
+
+func (o GroupOutput) ToGroupOutputWithContext(ctx context.Context) GroupOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(GroupOutput{})
+}
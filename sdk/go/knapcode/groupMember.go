@@ -0,0 +1,144 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package knapcode
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// Adds a member to a group. A member that is already in the group is not an error, and neither is a member that is already gone when the resource is deleted. The resource ID is the object ID of the group and the object ID of the member separated by a slash, which is also used to import it.
+type GroupMember struct {
+	pulumi.CustomResourceState
+
+	// The object ID of the group. Changing this replaces the resource.
+	GroupId pulumi.StringOutput `pulumi:"groupId"`
+	// The display name of the member.
+	MemberDisplayName pulumi.StringOutput `pulumi:"memberDisplayName"`
+	// The object ID of the user, group or service principal to add as a member. Changing this replaces the resource.
+	MemberId pulumi.StringOutput `pulumi:"memberId"`
+	// The type of the member, e.g. 'user', 'group' or 'servicePrincipal'.
+	MemberType pulumi.StringOutput `pulumi:"memberType"`
+}
+
+// NewGroupMember registers a new resource with the given unique name, arguments, and options.
+func NewGroupMember(ctx *pulumi.Context,
+	name string, args *GroupMemberArgs, opts ...pulumi.ResourceOption) (*GroupMember, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.GroupId == nil {
+		return nil, errors.New("invalid value for required argument 'GroupId'")
+	}
+	if args.MemberId == nil {
+		return nil, errors.New("invalid value for required argument 'MemberId'")
+	}
+	var resource GroupMember
+	err := ctx.RegisterResource("knapcode:index:GroupMember", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetGroupMember gets an existing GroupMember resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetGroupMember(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *GroupMemberState, opts ...pulumi.ResourceOption) (*GroupMember, error) {
+	var resource GroupMember
+	err := ctx.ReadResource("knapcode:index:GroupMember", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering GroupMember resources.
+type groupMemberState struct {
+	// The object ID of the group. Changing this replaces the resource.
+	GroupId *string `pulumi:"groupId"`
+	// The display name of the member.
+	MemberDisplayName *string `pulumi:"memberDisplayName"`
+	// The object ID of the user, group or service principal to add as a member. Changing this replaces the resource.
+	MemberId *string `pulumi:"memberId"`
+	// The type of the member, e.g. 'user', 'group' or 'servicePrincipal'.
+	MemberType *string `pulumi:"memberType"`
+}
+
+type GroupMemberState struct {
+	// The object ID of the group. Changing this replaces the resource.
+	GroupId pulumi.StringPtrInput
+	// The display name of the member.
+	MemberDisplayName pulumi.StringPtrInput
+	// The object ID of the user, group or service principal to add as a member. Changing this replaces the resource.
+	MemberId pulumi.StringPtrInput
+	// The type of the member, e.g. 'user', 'group' or 'servicePrincipal'.
+	MemberType pulumi.StringPtrInput
+}
+
+func (GroupMemberState) ElementType() reflect.Type {
+	return reflect.TypeOf((*groupMemberState)(nil)).Elem()
+}
+
+type groupMemberArgs struct {
+	// The object ID of the group. Changing this replaces the resource.
+	GroupId string `pulumi:"groupId"`
+	// The object ID of the user, group or service principal to add as a member. Changing this replaces the resource.
+	MemberId string `pulumi:"memberId"`
+}
+
+// The set of arguments for constructing a GroupMember resource.
+type GroupMemberArgs struct {
+	// The object ID of the group. Changing this replaces the resource.
+	GroupId pulumi.StringInput
+	// The object ID of the user, group or service principal to add as a member. Changing this replaces the resource.
+	MemberId pulumi.StringInput
+}
+
+func (GroupMemberArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*groupMemberArgs)(nil)).Elem()
+}
+
+type GroupMemberInput interface {
+	pulumi.Input
+
+	ToGroupMemberOutput() GroupMemberOutput
+	ToGroupMemberOutputWithContext(ctx context.Context) GroupMemberOutput
+}
+
+func (*GroupMember) ElementType() reflect.Type {
+	return reflect.TypeOf((*GroupMember)(nil))
+}
+
+func (i *GroupMember) ToGroupMemberOutput() GroupMemberOutput {
+	return i.ToGroupMemberOutputWithContext(context.Background())
+}
+
+func (i *GroupMember) ToGroupMemberOutputWithContext(ctx context.Context) GroupMemberOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(GroupMemberOutput)
+}
+
+type GroupMemberOutput struct {
+	*pulumi.OutputState
+}
+
+func (GroupMemberOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GroupMember)(nil))
+}
+
+func (o GroupMemberOutput) ToGroupMemberOutput() GroupMemberOutput {
+	return o
+}
+
+func (o GroupMemberOutput) ToGroupMemberOutputWithContext(ctx context.Context) GroupMemberOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(GroupMemberOutput{})
+}
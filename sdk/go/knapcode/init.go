@@ -20,8 +20,54 @@ func (m *module) Version() semver.Version {
 
 func (m *module) Construct(ctx *pulumi.Context, name, typ, urn string) (r pulumi.Resource, err error) {
 	switch typ {
+	case "knapcode:index:ApiPermissionGrant":
+		r, err = NewApiPermissionGrant(ctx, name, nil, pulumi.URN_(urn))
+	case "knapcode:index:AppRole":
+		r, err = NewAppRole(ctx, name, nil, pulumi.URN_(urn))
+	case "knapcode:index:AppRoleAssignment":
+		r, err = NewAppRoleAssignment(ctx, name, nil, pulumi.URN_(urn))
+	case "knapcode:index:Application":
+		r, err = NewApplication(ctx, name, nil, pulumi.URN_(urn))
+	case "knapcode:index:ApplicationBranding":
+		r, err = NewApplicationBranding(ctx, name, nil, pulumi.URN_(urn))
+	case "knapcode:index:ApplicationCertificate":
+		r, err = NewApplicationCertificate(ctx, name, nil, pulumi.URN_(urn))
+	case "knapcode:index:ApplicationLogo":
+		r, err = NewApplicationLogo(ctx, name, nil, pulumi.URN_(urn))
+	case "knapcode:index:ApplicationOwner":
+		r, err = NewApplicationOwner(ctx, name, nil, pulumi.URN_(urn))
+	case "knapcode:index:ApplicationPassword":
+		r, err = NewApplicationPassword(ctx, name, nil, pulumi.URN_(urn))
+	case "knapcode:index:ClaimsMappingPolicy":
+		r, err = NewClaimsMappingPolicy(ctx, name, nil, pulumi.URN_(urn))
+	case "knapcode:index:ClaimsMappingPolicyAssignment":
+		r, err = NewClaimsMappingPolicyAssignment(ctx, name, nil, pulumi.URN_(urn))
+	case "knapcode:index:DirectoryRoleAssignment":
+		r, err = NewDirectoryRoleAssignment(ctx, name, nil, pulumi.URN_(urn))
+	case "knapcode:index:ExposeApi":
+		r, err = NewExposeApi(ctx, name, nil, pulumi.URN_(urn))
+	case "knapcode:index:ExtensionProperty":
+		r, err = NewExtensionProperty(ctx, name, nil, pulumi.URN_(urn))
+	case "knapcode:index:FederatedIdentityCredential":
+		r, err = NewFederatedIdentityCredential(ctx, name, nil, pulumi.URN_(urn))
+	case "knapcode:index:Group":
+		r, err = NewGroup(ctx, name, nil, pulumi.URN_(urn))
+	case "knapcode:index:GroupMember":
+		r, err = NewGroupMember(ctx, name, nil, pulumi.URN_(urn))
 	case "knapcode:index:PrepareAppForWebSignIn":
 		r, err = NewPrepareAppForWebSignIn(ctx, name, nil, pulumi.URN_(urn))
+	case "knapcode:index:RequiredResourceAccess":
+		r, err = NewRequiredResourceAccess(ctx, name, nil, pulumi.URN_(urn))
+	case "knapcode:index:RestoredApplication":
+		r, err = NewRestoredApplication(ctx, name, nil, pulumi.URN_(urn))
+	case "knapcode:index:ServicePrincipal":
+		r, err = NewServicePrincipal(ctx, name, nil, pulumi.URN_(urn))
+	case "knapcode:index:TokenConfiguration":
+		r, err = NewTokenConfiguration(ctx, name, nil, pulumi.URN_(urn))
+	case "knapcode:index:TokenLifetimePolicy":
+		r, err = NewTokenLifetimePolicy(ctx, name, nil, pulumi.URN_(urn))
+	case "knapcode:index:TokenLifetimePolicyAssignment":
+		r, err = NewTokenLifetimePolicyAssignment(ctx, name, nil, pulumi.URN_(urn))
 	default:
 		return nil, fmt.Errorf("unknown resource type: %s", typ)
 	}
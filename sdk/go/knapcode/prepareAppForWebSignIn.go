@@ -11,8 +11,27 @@ import (
 	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
 )
 
+// Prepares an existing app registration for web sign-in on the provided host name using Microsoft Graph.
 type PrepareAppForWebSignIn struct {
 	pulumi.CustomResourceState
+
+	// The application settings last written by this resource.
+	AppliedPatch   pulumi.AnyOutput       `pulumi:"appliedPatch"`
+	ConflictPolicy pulumi.StringPtrOutput `pulumi:"conflictPolicy"`
+	// SHA-256 hash of the application settings last written by this resource.
+	Fingerprint pulumi.StringOutput  `pulumi:"fingerprint"`
+	Force       pulumi.BoolPtrOutput `pulumi:"force"`
+	HostName    pulumi.StringOutput  `pulumi:"hostName"`
+	// Whether ID tokens and access tokens can be requested with the OAuth 2.0 implicit flow, for legacy single-page applications.
+	ImplicitGrantSettings ApplicationImplicitGrantSettingsPtrOutput `pulumi:"implicitGrantSettings"`
+	// Whether the application is a public client, e.g. for the device code flow.
+	IsFallbackPublicClient pulumi.BoolPtrOutput `pulumi:"isFallbackPublicClient"`
+	ObjectId               pulumi.StringOutput  `pulumi:"objectId"`
+	// The redirect URIs of the public client (mobile and desktop) platform, e.g. 'http://localhost' for command-line tools.
+	PublicClientRedirectUris pulumi.StringArrayOutput `pulumi:"publicClientRedirectUris"`
+	PurgeOnDelete            pulumi.BoolPtrOutput     `pulumi:"purgeOnDelete"`
+	// Paths on the host name that are registered as single-page application redirect URIs, e.g. '/' for 'https://{hostName}/'. Each path must start with '/'.
+	SpaRedirectPaths pulumi.StringArrayOutput `pulumi:"spaRedirectPaths"`
 }
 
 // NewPrepareAppForWebSignIn registers a new resource with the given unique name, arguments, and options.
@@ -50,9 +69,43 @@ func GetPrepareAppForWebSignIn(ctx *pulumi.Context,
 
 // Input properties used for looking up and filtering PrepareAppForWebSignIn resources.
 type prepareAppForWebSignInState struct {
+	// The application settings last written by this resource.
+	AppliedPatch   interface{} `pulumi:"appliedPatch"`
+	ConflictPolicy *string     `pulumi:"conflictPolicy"`
+	// SHA-256 hash of the application settings last written by this resource.
+	Fingerprint *string `pulumi:"fingerprint"`
+	Force       *bool   `pulumi:"force"`
+	HostName    *string `pulumi:"hostName"`
+	// Whether ID tokens and access tokens can be requested with the OAuth 2.0 implicit flow, for legacy single-page applications.
+	ImplicitGrantSettings *ApplicationImplicitGrantSettings `pulumi:"implicitGrantSettings"`
+	// Whether the application is a public client, e.g. for the device code flow.
+	IsFallbackPublicClient *bool   `pulumi:"isFallbackPublicClient"`
+	ObjectId               *string `pulumi:"objectId"`
+	// The redirect URIs of the public client (mobile and desktop) platform, e.g. 'http://localhost' for command-line tools.
+	PublicClientRedirectUris []string `pulumi:"publicClientRedirectUris"`
+	PurgeOnDelete            *bool    `pulumi:"purgeOnDelete"`
+	// Paths on the host name that are registered as single-page application redirect URIs, e.g. '/' for 'https://{hostName}/'. Each path must start with '/'.
+	SpaRedirectPaths []string `pulumi:"spaRedirectPaths"`
 }
 
 type PrepareAppForWebSignInState struct {
+	// The application settings last written by this resource.
+	AppliedPatch   pulumi.Input
+	ConflictPolicy *ConflictPolicy
+	// SHA-256 hash of the application settings last written by this resource.
+	Fingerprint pulumi.StringPtrInput
+	Force       pulumi.BoolPtrInput
+	HostName    pulumi.StringPtrInput
+	// Whether ID tokens and access tokens can be requested with the OAuth 2.0 implicit flow, for legacy single-page applications.
+	ImplicitGrantSettings ApplicationImplicitGrantSettingsPtrInput
+	// Whether the application is a public client, e.g. for the device code flow.
+	IsFallbackPublicClient pulumi.BoolPtrInput
+	ObjectId               pulumi.StringPtrInput
+	// The redirect URIs of the public client (mobile and desktop) platform, e.g. 'http://localhost' for command-line tools.
+	PublicClientRedirectUris pulumi.StringArrayInput
+	PurgeOnDelete            pulumi.BoolPtrInput
+	// Paths on the host name that are registered as single-page application redirect URIs, e.g. '/' for 'https://{hostName}/'. Each path must start with '/'.
+	SpaRedirectPaths pulumi.StringArrayInput
 }
 
 func (PrepareAppForWebSignInState) ElementType() reflect.Type {
@@ -60,14 +113,42 @@ func (PrepareAppForWebSignInState) ElementType() reflect.Type {
 }
 
 type prepareAppForWebSignInArgs struct {
+	// What to do when the application was changed outside of Pulumi since it was last written. Defaults to `overwrite`.
+	ConflictPolicy *string `pulumi:"conflictPolicy"`
+	// Delete the application even if it does not have this resource's ownership tag. The tag is added to the application's `tags` when the resource is created or updated.
+	Force    *bool  `pulumi:"force"`
 	HostName string `pulumi:"hostName"`
-	ObjectId string `pulumi:"objectId"`
+	// Whether ID tokens and access tokens can be requested with the OAuth 2.0 implicit flow, for legacy single-page applications.
+	ImplicitGrantSettings *ApplicationImplicitGrantSettings `pulumi:"implicitGrantSettings"`
+	// Whether the application is a public client, e.g. for the device code flow.
+	IsFallbackPublicClient *bool  `pulumi:"isFallbackPublicClient"`
+	ObjectId               string `pulumi:"objectId"`
+	// The redirect URIs of the public client (mobile and desktop) platform, e.g. 'http://localhost' for command-line tools.
+	PublicClientRedirectUris []string `pulumi:"publicClientRedirectUris"`
+	// Permanently delete the application from the directory's deleted items when the resource is deleted, releasing its identifier URIs.
+	PurgeOnDelete *bool `pulumi:"purgeOnDelete"`
+	// Paths on the host name that are registered as single-page application redirect URIs, e.g. '/' for 'https://{hostName}/'. Each path must start with '/'.
+	SpaRedirectPaths []string `pulumi:"spaRedirectPaths"`
 }
 
 // The set of arguments for constructing a PrepareAppForWebSignIn resource.
 type PrepareAppForWebSignInArgs struct {
+	// What to do when the application was changed outside of Pulumi since it was last written. Defaults to `overwrite`.
+	ConflictPolicy *ConflictPolicy
+	// Delete the application even if it does not have this resource's ownership tag. The tag is added to the application's `tags` when the resource is created or updated.
+	Force    pulumi.BoolPtrInput
 	HostName pulumi.StringInput
-	ObjectId pulumi.StringInput
+	// Whether ID tokens and access tokens can be requested with the OAuth 2.0 implicit flow, for legacy single-page applications.
+	ImplicitGrantSettings ApplicationImplicitGrantSettingsPtrInput
+	// Whether the application is a public client, e.g. for the device code flow.
+	IsFallbackPublicClient pulumi.BoolPtrInput
+	ObjectId               pulumi.StringInput
+	// The redirect URIs of the public client (mobile and desktop) platform, e.g. 'http://localhost' for command-line tools.
+	PublicClientRedirectUris pulumi.StringArrayInput
+	// Permanently delete the application from the directory's deleted items when the resource is deleted, releasing its identifier URIs.
+	PurgeOnDelete pulumi.BoolPtrInput
+	// Paths on the host name that are registered as single-page application redirect URIs, e.g. '/' for 'https://{hostName}/'. Each path must start with '/'.
+	SpaRedirectPaths pulumi.StringArrayInput
 }
 
 func (PrepareAppForWebSignInArgs) ElementType() reflect.Type {
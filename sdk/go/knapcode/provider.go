@@ -10,6 +10,7 @@ import (
 	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
 )
 
+// The provider type for the knapcode package.
 type Provider struct {
 	pulumi.ProviderResourceState
 }
@@ -30,10 +31,14 @@ func NewProvider(ctx *pulumi.Context,
 }
 
 type providerArgs struct {
+	// The object IDs of users or service principals that are added as owners of every application and service principal created by this provider, so that they can be managed in the portal.
+	DefaultOwners []string `pulumi:"defaultOwners"`
 }
 
 // The set of arguments for constructing a Provider resource.
 type ProviderArgs struct {
+	// The object IDs of users or service principals that are added as owners of every application and service principal created by this provider, so that they can be managed in the portal.
+	DefaultOwners pulumi.StringArrayInput
 }
 
 func (ProviderArgs) ElementType() reflect.Type {
@@ -0,0 +1,181 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package knapcode
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// How to handle application settings that were changed outside of Pulumi.
+type ConflictPolicy pulumi.String
+
+const (
+	// Overwrite the external changes and log a warning.
+	ConflictPolicyOverwrite = ConflictPolicy("overwrite")
+	// Fail the update and report the external changes.
+	ConflictPolicyFail = ConflictPolicy("fail")
+	// Keep external changes to settings this resource is not changing.
+	ConflictPolicyMerge = ConflictPolicy("merge")
+)
+
+func (ConflictPolicy) ElementType() reflect.Type {
+	return reflect.TypeOf((*pulumi.String)(nil)).Elem()
+}
+
+func (e ConflictPolicy) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e ConflictPolicy) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e ConflictPolicy) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e ConflictPolicy) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+// The data type of the values of an extension property.
+type ExtensionPropertyDataType pulumi.String
+
+const (
+	// Binary data, at most 256 bytes.
+	ExtensionPropertyDataTypeBinary = ExtensionPropertyDataType("Binary")
+	// A boolean.
+	ExtensionPropertyDataTypeBoolean = ExtensionPropertyDataType("Boolean")
+	// A date and time in ISO 8601 format, always in UTC.
+	ExtensionPropertyDataTypeDateTime = ExtensionPropertyDataType("DateTime")
+	// A 32-bit integer.
+	ExtensionPropertyDataTypeInteger = ExtensionPropertyDataType("Integer")
+	// A 64-bit integer.
+	ExtensionPropertyDataTypeLargeInteger = ExtensionPropertyDataType("LargeInteger")
+	// A string, at most 256 characters.
+	ExtensionPropertyDataTypeString = ExtensionPropertyDataType("String")
+)
+
+func (ExtensionPropertyDataType) ElementType() reflect.Type {
+	return reflect.TypeOf((*pulumi.String)(nil)).Elem()
+}
+
+func (e ExtensionPropertyDataType) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e ExtensionPropertyDataType) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e ExtensionPropertyDataType) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e ExtensionPropertyDataType) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+// The groups included in the groups claim of tokens issued for an application.
+type GroupMembershipClaims pulumi.String
+
+const (
+	// No groups.
+	GroupMembershipClaimsNone = GroupMembershipClaims("None")
+	// Security groups and Azure AD roles the user is a member of.
+	GroupMembershipClaimsSecurityGroup = GroupMembershipClaims("SecurityGroup")
+	// Azure AD roles the user is assigned to.
+	GroupMembershipClaimsDirectoryRole = GroupMembershipClaims("DirectoryRole")
+	// Groups assigned to the application the user is a member of.
+	GroupMembershipClaimsApplicationGroup = GroupMembershipClaims("ApplicationGroup")
+	// Security groups, distribution lists and Azure AD roles the user is a member of.
+	GroupMembershipClaimsAll = GroupMembershipClaims("All")
+)
+
+func (GroupMembershipClaims) ElementType() reflect.Type {
+	return reflect.TypeOf((*pulumi.String)(nil)).Elem()
+}
+
+func (e GroupMembershipClaims) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e GroupMembershipClaims) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e GroupMembershipClaims) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e GroupMembershipClaims) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+// The kind of a group.
+type GroupType pulumi.String
+
+const (
+	// A security group.
+	GroupTypeSecurity = GroupType("Security")
+	// A Microsoft 365 group, with a mailbox and other collaboration features.
+	GroupTypeMicrosoft365 = GroupType("Microsoft365")
+)
+
+func (GroupType) ElementType() reflect.Type {
+	return reflect.TypeOf((*pulumi.String)(nil)).Elem()
+}
+
+func (e GroupType) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e GroupType) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e GroupType) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e GroupType) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+// The Microsoft accounts that can sign in to an application.
+type SignInAudience pulumi.String
+
+const (
+	// Accounts in the application's tenant only.
+	SignInAudienceAzureADMyOrg = SignInAudience("AzureADMyOrg")
+	// Accounts in any Azure AD tenant.
+	SignInAudienceAzureADMultipleOrgs = SignInAudience("AzureADMultipleOrgs")
+	// Accounts in any Azure AD tenant and personal Microsoft accounts.
+	SignInAudienceAzureADandPersonalMicrosoftAccount = SignInAudience("AzureADandPersonalMicrosoftAccount")
+	// Personal Microsoft accounts only.
+	SignInAudiencePersonalMicrosoftAccount = SignInAudience("PersonalMicrosoftAccount")
+)
+
+func (SignInAudience) ElementType() reflect.Type {
+	return reflect.TypeOf((*pulumi.String)(nil)).Elem()
+}
+
+func (e SignInAudience) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e SignInAudience) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e SignInAudience) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e SignInAudience) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
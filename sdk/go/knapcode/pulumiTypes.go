@@ -0,0 +1,2959 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package knapcode
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// Settings for an application that exposes an API.
+type ApplicationApi struct {
+	// Whether claims mapping can be used without a custom signing key.
+	AcceptMappedClaims *bool `pulumi:"acceptMappedClaims"`
+	// The app IDs of client applications that are bundled with this application for consent.
+	KnownClientApplications []string `pulumi:"knownClientApplications"`
+	// The delegated permissions exposed by the API.
+	Oauth2PermissionScopes []ApplicationPermissionScope `pulumi:"oauth2PermissionScopes"`
+	// The client applications that are pre-authorized for the API's scopes.
+	PreAuthorizedApplications []ApplicationPreAuthorizedApplication `pulumi:"preAuthorizedApplications"`
+	// The access token version expected by the API, 1 or 2.
+	RequestedAccessTokenVersion *int `pulumi:"requestedAccessTokenVersion"`
+}
+
+// ApplicationApiInput is an input type that accepts ApplicationApiArgs and ApplicationApiOutput values.
+// You can construct a concrete instance of `ApplicationApiInput` via:
+//
+//	ApplicationApiArgs{...}
+type ApplicationApiInput interface {
+	pulumi.Input
+
+	ToApplicationApiOutput() ApplicationApiOutput
+	ToApplicationApiOutputWithContext(context.Context) ApplicationApiOutput
+}
+
+// Settings for an application that exposes an API.
+type ApplicationApiArgs struct {
+	// Whether claims mapping can be used without a custom signing key.
+	AcceptMappedClaims pulumi.BoolPtrInput `pulumi:"acceptMappedClaims"`
+	// The app IDs of client applications that are bundled with this application for consent.
+	KnownClientApplications pulumi.StringArrayInput `pulumi:"knownClientApplications"`
+	// The delegated permissions exposed by the API.
+	Oauth2PermissionScopes ApplicationPermissionScopeArrayInput `pulumi:"oauth2PermissionScopes"`
+	// The client applications that are pre-authorized for the API's scopes.
+	PreAuthorizedApplications ApplicationPreAuthorizedApplicationArrayInput `pulumi:"preAuthorizedApplications"`
+	// The access token version expected by the API, 1 or 2.
+	RequestedAccessTokenVersion pulumi.IntPtrInput `pulumi:"requestedAccessTokenVersion"`
+}
+
+func (ApplicationApiArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationApi)(nil)).Elem()
+}
+
+func (i ApplicationApiArgs) ToApplicationApiOutput() ApplicationApiOutput {
+	return i.ToApplicationApiOutputWithContext(context.Background())
+}
+
+func (i ApplicationApiArgs) ToApplicationApiOutputWithContext(ctx context.Context) ApplicationApiOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationApiOutput)
+}
+
+func (i ApplicationApiArgs) ToApplicationApiPtrOutput() ApplicationApiPtrOutput {
+	return i.ToApplicationApiPtrOutputWithContext(context.Background())
+}
+
+func (i ApplicationApiArgs) ToApplicationApiPtrOutputWithContext(ctx context.Context) ApplicationApiPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationApiOutput).ToApplicationApiPtrOutputWithContext(ctx)
+}
+
+// ApplicationApiPtrInput is an input type that accepts ApplicationApiArgs, ApplicationApiPtr and ApplicationApiPtrOutput values.
+// You can construct a concrete instance of `ApplicationApiPtrInput` via:
+//
+//	        ApplicationApiArgs{...}
+//
+//	or:
+//
+//	        nil
+type ApplicationApiPtrInput interface {
+	pulumi.Input
+
+	ToApplicationApiPtrOutput() ApplicationApiPtrOutput
+	ToApplicationApiPtrOutputWithContext(context.Context) ApplicationApiPtrOutput
+}
+
+type applicationApiPtrType ApplicationApiArgs
+
+func ApplicationApiPtr(v *ApplicationApiArgs) ApplicationApiPtrInput {
+	return (*applicationApiPtrType)(v)
+}
+
+func (*applicationApiPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**ApplicationApi)(nil)).Elem()
+}
+
+func (i *applicationApiPtrType) ToApplicationApiPtrOutput() ApplicationApiPtrOutput {
+	return i.ToApplicationApiPtrOutputWithContext(context.Background())
+}
+
+func (i *applicationApiPtrType) ToApplicationApiPtrOutputWithContext(ctx context.Context) ApplicationApiPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationApiPtrOutput)
+}
+
+// Settings for an application that exposes an API.
+type ApplicationApiOutput struct{ *pulumi.OutputState }
+
+func (ApplicationApiOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationApi)(nil)).Elem()
+}
+
+func (o ApplicationApiOutput) ToApplicationApiOutput() ApplicationApiOutput {
+	return o
+}
+
+func (o ApplicationApiOutput) ToApplicationApiOutputWithContext(ctx context.Context) ApplicationApiOutput {
+	return o
+}
+
+func (o ApplicationApiOutput) ToApplicationApiPtrOutput() ApplicationApiPtrOutput {
+	return o.ToApplicationApiPtrOutputWithContext(context.Background())
+}
+
+func (o ApplicationApiOutput) ToApplicationApiPtrOutputWithContext(ctx context.Context) ApplicationApiPtrOutput {
+	return o.ApplyT(func(v ApplicationApi) *ApplicationApi {
+		return &v
+	}).(ApplicationApiPtrOutput)
+}
+
+// Whether claims mapping can be used without a custom signing key.
+func (o ApplicationApiOutput) AcceptMappedClaims() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ApplicationApi) *bool { return v.AcceptMappedClaims }).(pulumi.BoolPtrOutput)
+}
+
+// The app IDs of client applications that are bundled with this application for consent.
+func (o ApplicationApiOutput) KnownClientApplications() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v ApplicationApi) []string { return v.KnownClientApplications }).(pulumi.StringArrayOutput)
+}
+
+// The delegated permissions exposed by the API.
+func (o ApplicationApiOutput) Oauth2PermissionScopes() ApplicationPermissionScopeArrayOutput {
+	return o.ApplyT(func(v ApplicationApi) []ApplicationPermissionScope { return v.Oauth2PermissionScopes }).(ApplicationPermissionScopeArrayOutput)
+}
+
+// The client applications that are pre-authorized for the API's scopes.
+func (o ApplicationApiOutput) PreAuthorizedApplications() ApplicationPreAuthorizedApplicationArrayOutput {
+	return o.ApplyT(func(v ApplicationApi) []ApplicationPreAuthorizedApplication { return v.PreAuthorizedApplications }).(ApplicationPreAuthorizedApplicationArrayOutput)
+}
+
+// The access token version expected by the API, 1 or 2.
+func (o ApplicationApiOutput) RequestedAccessTokenVersion() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v ApplicationApi) *int { return v.RequestedAccessTokenVersion }).(pulumi.IntPtrOutput)
+}
+
+type ApplicationApiPtrOutput struct{ *pulumi.OutputState }
+
+func (ApplicationApiPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ApplicationApi)(nil)).Elem()
+}
+
+func (o ApplicationApiPtrOutput) ToApplicationApiPtrOutput() ApplicationApiPtrOutput {
+	return o
+}
+
+func (o ApplicationApiPtrOutput) ToApplicationApiPtrOutputWithContext(ctx context.Context) ApplicationApiPtrOutput {
+	return o
+}
+
+func (o ApplicationApiPtrOutput) Elem() ApplicationApiOutput {
+	return o.ApplyT(func(v *ApplicationApi) ApplicationApi { return *v }).(ApplicationApiOutput)
+}
+
+// Whether claims mapping can be used without a custom signing key.
+func (o ApplicationApiPtrOutput) AcceptMappedClaims() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *ApplicationApi) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.AcceptMappedClaims
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The app IDs of client applications that are bundled with this application for consent.
+func (o ApplicationApiPtrOutput) KnownClientApplications() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *ApplicationApi) []string {
+		if v == nil {
+			return nil
+		}
+		return v.KnownClientApplications
+	}).(pulumi.StringArrayOutput)
+}
+
+// The delegated permissions exposed by the API.
+func (o ApplicationApiPtrOutput) Oauth2PermissionScopes() ApplicationPermissionScopeArrayOutput {
+	return o.ApplyT(func(v *ApplicationApi) []ApplicationPermissionScope {
+		if v == nil {
+			return nil
+		}
+		return v.Oauth2PermissionScopes
+	}).(ApplicationPermissionScopeArrayOutput)
+}
+
+// The client applications that are pre-authorized for the API's scopes.
+func (o ApplicationApiPtrOutput) PreAuthorizedApplications() ApplicationPreAuthorizedApplicationArrayOutput {
+	return o.ApplyT(func(v *ApplicationApi) []ApplicationPreAuthorizedApplication {
+		if v == nil {
+			return nil
+		}
+		return v.PreAuthorizedApplications
+	}).(ApplicationPreAuthorizedApplicationArrayOutput)
+}
+
+// The access token version expected by the API, 1 or 2.
+func (o ApplicationApiPtrOutput) RequestedAccessTokenVersion() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *ApplicationApi) *int {
+		if v == nil {
+			return nil
+		}
+		return v.RequestedAccessTokenVersion
+	}).(pulumi.IntPtrOutput)
+}
+
+// A role that can be assigned to users, groups or applications.
+type ApplicationAppRole struct {
+	// Who can be assigned the role: 'User' for users and groups, 'Application' for applications, or both.
+	AllowedMemberTypes []string `pulumi:"allowedMemberTypes"`
+	// The description of the role.
+	Description string `pulumi:"description"`
+	// The display name of the role.
+	DisplayName string `pulumi:"displayName"`
+	// The ID of the role. Defaults to a GUID derived from the value.
+	Id *string `pulumi:"id"`
+	// Whether the role is enabled. Defaults to true.
+	IsEnabled *bool `pulumi:"isEnabled"`
+	// The value of the role, which appears in the roles claim of tokens.
+	Value *string `pulumi:"value"`
+}
+
+// ApplicationAppRoleInput is an input type that accepts ApplicationAppRoleArgs and ApplicationAppRoleOutput values.
+// You can construct a concrete instance of `ApplicationAppRoleInput` via:
+//
+//	ApplicationAppRoleArgs{...}
+type ApplicationAppRoleInput interface {
+	pulumi.Input
+
+	ToApplicationAppRoleOutput() ApplicationAppRoleOutput
+	ToApplicationAppRoleOutputWithContext(context.Context) ApplicationAppRoleOutput
+}
+
+// A role that can be assigned to users, groups or applications.
+type ApplicationAppRoleArgs struct {
+	// Who can be assigned the role: 'User' for users and groups, 'Application' for applications, or both.
+	AllowedMemberTypes pulumi.StringArrayInput `pulumi:"allowedMemberTypes"`
+	// The description of the role.
+	Description pulumi.StringInput `pulumi:"description"`
+	// The display name of the role.
+	DisplayName pulumi.StringInput `pulumi:"displayName"`
+	// The ID of the role. Defaults to a GUID derived from the value.
+	Id pulumi.StringPtrInput `pulumi:"id"`
+	// Whether the role is enabled. Defaults to true.
+	IsEnabled pulumi.BoolPtrInput `pulumi:"isEnabled"`
+	// The value of the role, which appears in the roles claim of tokens.
+	Value pulumi.StringPtrInput `pulumi:"value"`
+}
+
+func (ApplicationAppRoleArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationAppRole)(nil)).Elem()
+}
+
+func (i ApplicationAppRoleArgs) ToApplicationAppRoleOutput() ApplicationAppRoleOutput {
+	return i.ToApplicationAppRoleOutputWithContext(context.Background())
+}
+
+func (i ApplicationAppRoleArgs) ToApplicationAppRoleOutputWithContext(ctx context.Context) ApplicationAppRoleOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationAppRoleOutput)
+}
+
+// ApplicationAppRoleArrayInput is an input type that accepts ApplicationAppRoleArray and ApplicationAppRoleArrayOutput values.
+// You can construct a concrete instance of `ApplicationAppRoleArrayInput` via:
+//
+//	ApplicationAppRoleArray{ ApplicationAppRoleArgs{...} }
+type ApplicationAppRoleArrayInput interface {
+	pulumi.Input
+
+	ToApplicationAppRoleArrayOutput() ApplicationAppRoleArrayOutput
+	ToApplicationAppRoleArrayOutputWithContext(context.Context) ApplicationAppRoleArrayOutput
+}
+
+type ApplicationAppRoleArray []ApplicationAppRoleInput
+
+func (ApplicationAppRoleArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ApplicationAppRole)(nil)).Elem()
+}
+
+func (i ApplicationAppRoleArray) ToApplicationAppRoleArrayOutput() ApplicationAppRoleArrayOutput {
+	return i.ToApplicationAppRoleArrayOutputWithContext(context.Background())
+}
+
+func (i ApplicationAppRoleArray) ToApplicationAppRoleArrayOutputWithContext(ctx context.Context) ApplicationAppRoleArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationAppRoleArrayOutput)
+}
+
+// A role that can be assigned to users, groups or applications.
+type ApplicationAppRoleOutput struct{ *pulumi.OutputState }
+
+func (ApplicationAppRoleOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationAppRole)(nil)).Elem()
+}
+
+func (o ApplicationAppRoleOutput) ToApplicationAppRoleOutput() ApplicationAppRoleOutput {
+	return o
+}
+
+func (o ApplicationAppRoleOutput) ToApplicationAppRoleOutputWithContext(ctx context.Context) ApplicationAppRoleOutput {
+	return o
+}
+
+// Who can be assigned the role: 'User' for users and groups, 'Application' for applications, or both.
+func (o ApplicationAppRoleOutput) AllowedMemberTypes() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v ApplicationAppRole) []string { return v.AllowedMemberTypes }).(pulumi.StringArrayOutput)
+}
+
+// The description of the role.
+func (o ApplicationAppRoleOutput) Description() pulumi.StringOutput {
+	return o.ApplyT(func(v ApplicationAppRole) string { return v.Description }).(pulumi.StringOutput)
+}
+
+// The display name of the role.
+func (o ApplicationAppRoleOutput) DisplayName() pulumi.StringOutput {
+	return o.ApplyT(func(v ApplicationAppRole) string { return v.DisplayName }).(pulumi.StringOutput)
+}
+
+// The ID of the role. Defaults to a GUID derived from the value.
+func (o ApplicationAppRoleOutput) Id() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ApplicationAppRole) *string { return v.Id }).(pulumi.StringPtrOutput)
+}
+
+// Whether the role is enabled. Defaults to true.
+func (o ApplicationAppRoleOutput) IsEnabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ApplicationAppRole) *bool { return v.IsEnabled }).(pulumi.BoolPtrOutput)
+}
+
+// The value of the role, which appears in the roles claim of tokens.
+func (o ApplicationAppRoleOutput) Value() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ApplicationAppRole) *string { return v.Value }).(pulumi.StringPtrOutput)
+}
+
+type ApplicationAppRoleArrayOutput struct{ *pulumi.OutputState }
+
+func (ApplicationAppRoleArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ApplicationAppRole)(nil)).Elem()
+}
+
+func (o ApplicationAppRoleArrayOutput) ToApplicationAppRoleArrayOutput() ApplicationAppRoleArrayOutput {
+	return o
+}
+
+func (o ApplicationAppRoleArrayOutput) ToApplicationAppRoleArrayOutputWithContext(ctx context.Context) ApplicationAppRoleArrayOutput {
+	return o
+}
+
+func (o ApplicationAppRoleArrayOutput) Index(i pulumi.IntInput) ApplicationAppRoleOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ApplicationAppRole {
+		return vs[0].([]ApplicationAppRole)[vs[1].(int)]
+	}).(ApplicationAppRoleOutput)
+}
+
+// Whether tokens can be requested with the OAuth 2.0 implicit flow.
+type ApplicationImplicitGrantSettings struct {
+	// Whether access tokens can be requested with the implicit flow.
+	EnableAccessTokenIssuance *bool `pulumi:"enableAccessTokenIssuance"`
+	// Whether ID tokens can be requested with the implicit flow.
+	EnableIdTokenIssuance *bool `pulumi:"enableIdTokenIssuance"`
+}
+
+// ApplicationImplicitGrantSettingsInput is an input type that accepts ApplicationImplicitGrantSettingsArgs and ApplicationImplicitGrantSettingsOutput values.
+// You can construct a concrete instance of `ApplicationImplicitGrantSettingsInput` via:
+//
+//	ApplicationImplicitGrantSettingsArgs{...}
+type ApplicationImplicitGrantSettingsInput interface {
+	pulumi.Input
+
+	ToApplicationImplicitGrantSettingsOutput() ApplicationImplicitGrantSettingsOutput
+	ToApplicationImplicitGrantSettingsOutputWithContext(context.Context) ApplicationImplicitGrantSettingsOutput
+}
+
+// Whether tokens can be requested with the OAuth 2.0 implicit flow.
+type ApplicationImplicitGrantSettingsArgs struct {
+	// Whether access tokens can be requested with the implicit flow.
+	EnableAccessTokenIssuance pulumi.BoolPtrInput `pulumi:"enableAccessTokenIssuance"`
+	// Whether ID tokens can be requested with the implicit flow.
+	EnableIdTokenIssuance pulumi.BoolPtrInput `pulumi:"enableIdTokenIssuance"`
+}
+
+func (ApplicationImplicitGrantSettingsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationImplicitGrantSettings)(nil)).Elem()
+}
+
+func (i ApplicationImplicitGrantSettingsArgs) ToApplicationImplicitGrantSettingsOutput() ApplicationImplicitGrantSettingsOutput {
+	return i.ToApplicationImplicitGrantSettingsOutputWithContext(context.Background())
+}
+
+func (i ApplicationImplicitGrantSettingsArgs) ToApplicationImplicitGrantSettingsOutputWithContext(ctx context.Context) ApplicationImplicitGrantSettingsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationImplicitGrantSettingsOutput)
+}
+
+func (i ApplicationImplicitGrantSettingsArgs) ToApplicationImplicitGrantSettingsPtrOutput() ApplicationImplicitGrantSettingsPtrOutput {
+	return i.ToApplicationImplicitGrantSettingsPtrOutputWithContext(context.Background())
+}
+
+func (i ApplicationImplicitGrantSettingsArgs) ToApplicationImplicitGrantSettingsPtrOutputWithContext(ctx context.Context) ApplicationImplicitGrantSettingsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationImplicitGrantSettingsOutput).ToApplicationImplicitGrantSettingsPtrOutputWithContext(ctx)
+}
+
+// ApplicationImplicitGrantSettingsPtrInput is an input type that accepts ApplicationImplicitGrantSettingsArgs, ApplicationImplicitGrantSettingsPtr and ApplicationImplicitGrantSettingsPtrOutput values.
+// You can construct a concrete instance of `ApplicationImplicitGrantSettingsPtrInput` via:
+//
+//	        ApplicationImplicitGrantSettingsArgs{...}
+//
+//	or:
+//
+//	        nil
+type ApplicationImplicitGrantSettingsPtrInput interface {
+	pulumi.Input
+
+	ToApplicationImplicitGrantSettingsPtrOutput() ApplicationImplicitGrantSettingsPtrOutput
+	ToApplicationImplicitGrantSettingsPtrOutputWithContext(context.Context) ApplicationImplicitGrantSettingsPtrOutput
+}
+
+type applicationImplicitGrantSettingsPtrType ApplicationImplicitGrantSettingsArgs
+
+func ApplicationImplicitGrantSettingsPtr(v *ApplicationImplicitGrantSettingsArgs) ApplicationImplicitGrantSettingsPtrInput {
+	return (*applicationImplicitGrantSettingsPtrType)(v)
+}
+
+func (*applicationImplicitGrantSettingsPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**ApplicationImplicitGrantSettings)(nil)).Elem()
+}
+
+func (i *applicationImplicitGrantSettingsPtrType) ToApplicationImplicitGrantSettingsPtrOutput() ApplicationImplicitGrantSettingsPtrOutput {
+	return i.ToApplicationImplicitGrantSettingsPtrOutputWithContext(context.Background())
+}
+
+func (i *applicationImplicitGrantSettingsPtrType) ToApplicationImplicitGrantSettingsPtrOutputWithContext(ctx context.Context) ApplicationImplicitGrantSettingsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationImplicitGrantSettingsPtrOutput)
+}
+
+// Whether tokens can be requested with the OAuth 2.0 implicit flow.
+type ApplicationImplicitGrantSettingsOutput struct{ *pulumi.OutputState }
+
+func (ApplicationImplicitGrantSettingsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationImplicitGrantSettings)(nil)).Elem()
+}
+
+func (o ApplicationImplicitGrantSettingsOutput) ToApplicationImplicitGrantSettingsOutput() ApplicationImplicitGrantSettingsOutput {
+	return o
+}
+
+func (o ApplicationImplicitGrantSettingsOutput) ToApplicationImplicitGrantSettingsOutputWithContext(ctx context.Context) ApplicationImplicitGrantSettingsOutput {
+	return o
+}
+
+func (o ApplicationImplicitGrantSettingsOutput) ToApplicationImplicitGrantSettingsPtrOutput() ApplicationImplicitGrantSettingsPtrOutput {
+	return o.ToApplicationImplicitGrantSettingsPtrOutputWithContext(context.Background())
+}
+
+func (o ApplicationImplicitGrantSettingsOutput) ToApplicationImplicitGrantSettingsPtrOutputWithContext(ctx context.Context) ApplicationImplicitGrantSettingsPtrOutput {
+	return o.ApplyT(func(v ApplicationImplicitGrantSettings) *ApplicationImplicitGrantSettings {
+		return &v
+	}).(ApplicationImplicitGrantSettingsPtrOutput)
+}
+
+// Whether access tokens can be requested with the implicit flow.
+func (o ApplicationImplicitGrantSettingsOutput) EnableAccessTokenIssuance() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ApplicationImplicitGrantSettings) *bool { return v.EnableAccessTokenIssuance }).(pulumi.BoolPtrOutput)
+}
+
+// Whether ID tokens can be requested with the implicit flow.
+func (o ApplicationImplicitGrantSettingsOutput) EnableIdTokenIssuance() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ApplicationImplicitGrantSettings) *bool { return v.EnableIdTokenIssuance }).(pulumi.BoolPtrOutput)
+}
+
+type ApplicationImplicitGrantSettingsPtrOutput struct{ *pulumi.OutputState }
+
+func (ApplicationImplicitGrantSettingsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ApplicationImplicitGrantSettings)(nil)).Elem()
+}
+
+func (o ApplicationImplicitGrantSettingsPtrOutput) ToApplicationImplicitGrantSettingsPtrOutput() ApplicationImplicitGrantSettingsPtrOutput {
+	return o
+}
+
+func (o ApplicationImplicitGrantSettingsPtrOutput) ToApplicationImplicitGrantSettingsPtrOutputWithContext(ctx context.Context) ApplicationImplicitGrantSettingsPtrOutput {
+	return o
+}
+
+func (o ApplicationImplicitGrantSettingsPtrOutput) Elem() ApplicationImplicitGrantSettingsOutput {
+	return o.ApplyT(func(v *ApplicationImplicitGrantSettings) ApplicationImplicitGrantSettings { return *v }).(ApplicationImplicitGrantSettingsOutput)
+}
+
+// Whether access tokens can be requested with the implicit flow.
+func (o ApplicationImplicitGrantSettingsPtrOutput) EnableAccessTokenIssuance() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *ApplicationImplicitGrantSettings) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.EnableAccessTokenIssuance
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Whether ID tokens can be requested with the implicit flow.
+func (o ApplicationImplicitGrantSettingsPtrOutput) EnableIdTokenIssuance() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *ApplicationImplicitGrantSettings) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.EnableIdTokenIssuance
+	}).(pulumi.BoolPtrOutput)
+}
+
+// An optional claim included in tokens.
+type ApplicationOptionalClaim struct {
+	// Additional properties of the claim.
+	AdditionalProperties []string `pulumi:"additionalProperties"`
+	// Whether the claim is essential for the application.
+	Essential *bool `pulumi:"essential"`
+	// The name of the claim.
+	Name string `pulumi:"name"`
+	// The source of the claim, e.g. 'user' for a directory extension. Not set for built-in claims.
+	Source *string `pulumi:"source"`
+}
+
+// ApplicationOptionalClaimInput is an input type that accepts ApplicationOptionalClaimArgs and ApplicationOptionalClaimOutput values.
+// You can construct a concrete instance of `ApplicationOptionalClaimInput` via:
+//
+//	ApplicationOptionalClaimArgs{...}
+type ApplicationOptionalClaimInput interface {
+	pulumi.Input
+
+	ToApplicationOptionalClaimOutput() ApplicationOptionalClaimOutput
+	ToApplicationOptionalClaimOutputWithContext(context.Context) ApplicationOptionalClaimOutput
+}
+
+// An optional claim included in tokens.
+type ApplicationOptionalClaimArgs struct {
+	// Additional properties of the claim.
+	AdditionalProperties pulumi.StringArrayInput `pulumi:"additionalProperties"`
+	// Whether the claim is essential for the application.
+	Essential pulumi.BoolPtrInput `pulumi:"essential"`
+	// The name of the claim.
+	Name pulumi.StringInput `pulumi:"name"`
+	// The source of the claim, e.g. 'user' for a directory extension. Not set for built-in claims.
+	Source pulumi.StringPtrInput `pulumi:"source"`
+}
+
+func (ApplicationOptionalClaimArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationOptionalClaim)(nil)).Elem()
+}
+
+func (i ApplicationOptionalClaimArgs) ToApplicationOptionalClaimOutput() ApplicationOptionalClaimOutput {
+	return i.ToApplicationOptionalClaimOutputWithContext(context.Background())
+}
+
+func (i ApplicationOptionalClaimArgs) ToApplicationOptionalClaimOutputWithContext(ctx context.Context) ApplicationOptionalClaimOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationOptionalClaimOutput)
+}
+
+// ApplicationOptionalClaimArrayInput is an input type that accepts ApplicationOptionalClaimArray and ApplicationOptionalClaimArrayOutput values.
+// You can construct a concrete instance of `ApplicationOptionalClaimArrayInput` via:
+//
+//	ApplicationOptionalClaimArray{ ApplicationOptionalClaimArgs{...} }
+type ApplicationOptionalClaimArrayInput interface {
+	pulumi.Input
+
+	ToApplicationOptionalClaimArrayOutput() ApplicationOptionalClaimArrayOutput
+	ToApplicationOptionalClaimArrayOutputWithContext(context.Context) ApplicationOptionalClaimArrayOutput
+}
+
+type ApplicationOptionalClaimArray []ApplicationOptionalClaimInput
+
+func (ApplicationOptionalClaimArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ApplicationOptionalClaim)(nil)).Elem()
+}
+
+func (i ApplicationOptionalClaimArray) ToApplicationOptionalClaimArrayOutput() ApplicationOptionalClaimArrayOutput {
+	return i.ToApplicationOptionalClaimArrayOutputWithContext(context.Background())
+}
+
+func (i ApplicationOptionalClaimArray) ToApplicationOptionalClaimArrayOutputWithContext(ctx context.Context) ApplicationOptionalClaimArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationOptionalClaimArrayOutput)
+}
+
+// An optional claim included in tokens.
+type ApplicationOptionalClaimOutput struct{ *pulumi.OutputState }
+
+func (ApplicationOptionalClaimOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationOptionalClaim)(nil)).Elem()
+}
+
+func (o ApplicationOptionalClaimOutput) ToApplicationOptionalClaimOutput() ApplicationOptionalClaimOutput {
+	return o
+}
+
+func (o ApplicationOptionalClaimOutput) ToApplicationOptionalClaimOutputWithContext(ctx context.Context) ApplicationOptionalClaimOutput {
+	return o
+}
+
+// Additional properties of the claim.
+func (o ApplicationOptionalClaimOutput) AdditionalProperties() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v ApplicationOptionalClaim) []string { return v.AdditionalProperties }).(pulumi.StringArrayOutput)
+}
+
+// Whether the claim is essential for the application.
+func (o ApplicationOptionalClaimOutput) Essential() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ApplicationOptionalClaim) *bool { return v.Essential }).(pulumi.BoolPtrOutput)
+}
+
+// The name of the claim.
+func (o ApplicationOptionalClaimOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v ApplicationOptionalClaim) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// The source of the claim, e.g. 'user' for a directory extension. Not set for built-in claims.
+func (o ApplicationOptionalClaimOutput) Source() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ApplicationOptionalClaim) *string { return v.Source }).(pulumi.StringPtrOutput)
+}
+
+type ApplicationOptionalClaimArrayOutput struct{ *pulumi.OutputState }
+
+func (ApplicationOptionalClaimArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ApplicationOptionalClaim)(nil)).Elem()
+}
+
+func (o ApplicationOptionalClaimArrayOutput) ToApplicationOptionalClaimArrayOutput() ApplicationOptionalClaimArrayOutput {
+	return o
+}
+
+func (o ApplicationOptionalClaimArrayOutput) ToApplicationOptionalClaimArrayOutputWithContext(ctx context.Context) ApplicationOptionalClaimArrayOutput {
+	return o
+}
+
+func (o ApplicationOptionalClaimArrayOutput) Index(i pulumi.IntInput) ApplicationOptionalClaimOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ApplicationOptionalClaim {
+		return vs[0].([]ApplicationOptionalClaim)[vs[1].(int)]
+	}).(ApplicationOptionalClaimOutput)
+}
+
+// Optional claims included in the tokens issued for an application.
+type ApplicationOptionalClaims struct {
+	// The optional claims in access tokens.
+	AccessToken []ApplicationOptionalClaim `pulumi:"accessToken"`
+	// The optional claims in ID tokens.
+	IdToken []ApplicationOptionalClaim `pulumi:"idToken"`
+	// The optional claims in SAML tokens.
+	Saml2Token []ApplicationOptionalClaim `pulumi:"saml2Token"`
+}
+
+// ApplicationOptionalClaimsInput is an input type that accepts ApplicationOptionalClaimsArgs and ApplicationOptionalClaimsOutput values.
+// You can construct a concrete instance of `ApplicationOptionalClaimsInput` via:
+//
+//	ApplicationOptionalClaimsArgs{...}
+type ApplicationOptionalClaimsInput interface {
+	pulumi.Input
+
+	ToApplicationOptionalClaimsOutput() ApplicationOptionalClaimsOutput
+	ToApplicationOptionalClaimsOutputWithContext(context.Context) ApplicationOptionalClaimsOutput
+}
+
+// Optional claims included in the tokens issued for an application.
+type ApplicationOptionalClaimsArgs struct {
+	// The optional claims in access tokens.
+	AccessToken ApplicationOptionalClaimArrayInput `pulumi:"accessToken"`
+	// The optional claims in ID tokens.
+	IdToken ApplicationOptionalClaimArrayInput `pulumi:"idToken"`
+	// The optional claims in SAML tokens.
+	Saml2Token ApplicationOptionalClaimArrayInput `pulumi:"saml2Token"`
+}
+
+func (ApplicationOptionalClaimsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationOptionalClaims)(nil)).Elem()
+}
+
+func (i ApplicationOptionalClaimsArgs) ToApplicationOptionalClaimsOutput() ApplicationOptionalClaimsOutput {
+	return i.ToApplicationOptionalClaimsOutputWithContext(context.Background())
+}
+
+func (i ApplicationOptionalClaimsArgs) ToApplicationOptionalClaimsOutputWithContext(ctx context.Context) ApplicationOptionalClaimsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationOptionalClaimsOutput)
+}
+
+func (i ApplicationOptionalClaimsArgs) ToApplicationOptionalClaimsPtrOutput() ApplicationOptionalClaimsPtrOutput {
+	return i.ToApplicationOptionalClaimsPtrOutputWithContext(context.Background())
+}
+
+func (i ApplicationOptionalClaimsArgs) ToApplicationOptionalClaimsPtrOutputWithContext(ctx context.Context) ApplicationOptionalClaimsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationOptionalClaimsOutput).ToApplicationOptionalClaimsPtrOutputWithContext(ctx)
+}
+
+// ApplicationOptionalClaimsPtrInput is an input type that accepts ApplicationOptionalClaimsArgs, ApplicationOptionalClaimsPtr and ApplicationOptionalClaimsPtrOutput values.
+// You can construct a concrete instance of `ApplicationOptionalClaimsPtrInput` via:
+//
+//	        ApplicationOptionalClaimsArgs{...}
+//
+//	or:
+//
+//	        nil
+type ApplicationOptionalClaimsPtrInput interface {
+	pulumi.Input
+
+	ToApplicationOptionalClaimsPtrOutput() ApplicationOptionalClaimsPtrOutput
+	ToApplicationOptionalClaimsPtrOutputWithContext(context.Context) ApplicationOptionalClaimsPtrOutput
+}
+
+type applicationOptionalClaimsPtrType ApplicationOptionalClaimsArgs
+
+func ApplicationOptionalClaimsPtr(v *ApplicationOptionalClaimsArgs) ApplicationOptionalClaimsPtrInput {
+	return (*applicationOptionalClaimsPtrType)(v)
+}
+
+func (*applicationOptionalClaimsPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**ApplicationOptionalClaims)(nil)).Elem()
+}
+
+func (i *applicationOptionalClaimsPtrType) ToApplicationOptionalClaimsPtrOutput() ApplicationOptionalClaimsPtrOutput {
+	return i.ToApplicationOptionalClaimsPtrOutputWithContext(context.Background())
+}
+
+func (i *applicationOptionalClaimsPtrType) ToApplicationOptionalClaimsPtrOutputWithContext(ctx context.Context) ApplicationOptionalClaimsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationOptionalClaimsPtrOutput)
+}
+
+// Optional claims included in the tokens issued for an application.
+type ApplicationOptionalClaimsOutput struct{ *pulumi.OutputState }
+
+func (ApplicationOptionalClaimsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationOptionalClaims)(nil)).Elem()
+}
+
+func (o ApplicationOptionalClaimsOutput) ToApplicationOptionalClaimsOutput() ApplicationOptionalClaimsOutput {
+	return o
+}
+
+func (o ApplicationOptionalClaimsOutput) ToApplicationOptionalClaimsOutputWithContext(ctx context.Context) ApplicationOptionalClaimsOutput {
+	return o
+}
+
+func (o ApplicationOptionalClaimsOutput) ToApplicationOptionalClaimsPtrOutput() ApplicationOptionalClaimsPtrOutput {
+	return o.ToApplicationOptionalClaimsPtrOutputWithContext(context.Background())
+}
+
+func (o ApplicationOptionalClaimsOutput) ToApplicationOptionalClaimsPtrOutputWithContext(ctx context.Context) ApplicationOptionalClaimsPtrOutput {
+	return o.ApplyT(func(v ApplicationOptionalClaims) *ApplicationOptionalClaims {
+		return &v
+	}).(ApplicationOptionalClaimsPtrOutput)
+}
+
+// The optional claims in access tokens.
+func (o ApplicationOptionalClaimsOutput) AccessToken() ApplicationOptionalClaimArrayOutput {
+	return o.ApplyT(func(v ApplicationOptionalClaims) []ApplicationOptionalClaim { return v.AccessToken }).(ApplicationOptionalClaimArrayOutput)
+}
+
+// The optional claims in ID tokens.
+func (o ApplicationOptionalClaimsOutput) IdToken() ApplicationOptionalClaimArrayOutput {
+	return o.ApplyT(func(v ApplicationOptionalClaims) []ApplicationOptionalClaim { return v.IdToken }).(ApplicationOptionalClaimArrayOutput)
+}
+
+// The optional claims in SAML tokens.
+func (o ApplicationOptionalClaimsOutput) Saml2Token() ApplicationOptionalClaimArrayOutput {
+	return o.ApplyT(func(v ApplicationOptionalClaims) []ApplicationOptionalClaim { return v.Saml2Token }).(ApplicationOptionalClaimArrayOutput)
+}
+
+type ApplicationOptionalClaimsPtrOutput struct{ *pulumi.OutputState }
+
+func (ApplicationOptionalClaimsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ApplicationOptionalClaims)(nil)).Elem()
+}
+
+func (o ApplicationOptionalClaimsPtrOutput) ToApplicationOptionalClaimsPtrOutput() ApplicationOptionalClaimsPtrOutput {
+	return o
+}
+
+func (o ApplicationOptionalClaimsPtrOutput) ToApplicationOptionalClaimsPtrOutputWithContext(ctx context.Context) ApplicationOptionalClaimsPtrOutput {
+	return o
+}
+
+func (o ApplicationOptionalClaimsPtrOutput) Elem() ApplicationOptionalClaimsOutput {
+	return o.ApplyT(func(v *ApplicationOptionalClaims) ApplicationOptionalClaims { return *v }).(ApplicationOptionalClaimsOutput)
+}
+
+// The optional claims in access tokens.
+func (o ApplicationOptionalClaimsPtrOutput) AccessToken() ApplicationOptionalClaimArrayOutput {
+	return o.ApplyT(func(v *ApplicationOptionalClaims) []ApplicationOptionalClaim {
+		if v == nil {
+			return nil
+		}
+		return v.AccessToken
+	}).(ApplicationOptionalClaimArrayOutput)
+}
+
+// The optional claims in ID tokens.
+func (o ApplicationOptionalClaimsPtrOutput) IdToken() ApplicationOptionalClaimArrayOutput {
+	return o.ApplyT(func(v *ApplicationOptionalClaims) []ApplicationOptionalClaim {
+		if v == nil {
+			return nil
+		}
+		return v.IdToken
+	}).(ApplicationOptionalClaimArrayOutput)
+}
+
+// The optional claims in SAML tokens.
+func (o ApplicationOptionalClaimsPtrOutput) Saml2Token() ApplicationOptionalClaimArrayOutput {
+	return o.ApplyT(func(v *ApplicationOptionalClaims) []ApplicationOptionalClaim {
+		if v == nil {
+			return nil
+		}
+		return v.Saml2Token
+	}).(ApplicationOptionalClaimArrayOutput)
+}
+
+// A delegated permission exposed by an application's API.
+type ApplicationPermissionScope struct {
+	// The description of the scope shown to admins.
+	AdminConsentDescription *string `pulumi:"adminConsentDescription"`
+	// The title of the scope shown to admins.
+	AdminConsentDisplayName *string `pulumi:"adminConsentDisplayName"`
+	// The ID of the scope. Defaults to a GUID derived from the value.
+	Id *string `pulumi:"id"`
+	// Whether the scope is enabled. Defaults to true.
+	IsEnabled *bool `pulumi:"isEnabled"`
+	// Whether users ('User') or only admins ('Admin') can consent to the scope. Defaults to 'User'.
+	Type *string `pulumi:"type"`
+	// The description of the scope shown to users.
+	UserConsentDescription *string `pulumi:"userConsentDescription"`
+	// The title of the scope shown to users.
+	UserConsentDisplayName *string `pulumi:"userConsentDisplayName"`
+	// The value of the scope, which appears in the scp claim of access tokens.
+	Value *string `pulumi:"value"`
+}
+
+// ApplicationPermissionScopeInput is an input type that accepts ApplicationPermissionScopeArgs and ApplicationPermissionScopeOutput values.
+// You can construct a concrete instance of `ApplicationPermissionScopeInput` via:
+//
+//	ApplicationPermissionScopeArgs{...}
+type ApplicationPermissionScopeInput interface {
+	pulumi.Input
+
+	ToApplicationPermissionScopeOutput() ApplicationPermissionScopeOutput
+	ToApplicationPermissionScopeOutputWithContext(context.Context) ApplicationPermissionScopeOutput
+}
+
+// A delegated permission exposed by an application's API.
+type ApplicationPermissionScopeArgs struct {
+	// The description of the scope shown to admins.
+	AdminConsentDescription pulumi.StringPtrInput `pulumi:"adminConsentDescription"`
+	// The title of the scope shown to admins.
+	AdminConsentDisplayName pulumi.StringPtrInput `pulumi:"adminConsentDisplayName"`
+	// The ID of the scope. Defaults to a GUID derived from the value.
+	Id pulumi.StringPtrInput `pulumi:"id"`
+	// Whether the scope is enabled. Defaults to true.
+	IsEnabled pulumi.BoolPtrInput `pulumi:"isEnabled"`
+	// Whether users ('User') or only admins ('Admin') can consent to the scope. Defaults to 'User'.
+	Type pulumi.StringPtrInput `pulumi:"type"`
+	// The description of the scope shown to users.
+	UserConsentDescription pulumi.StringPtrInput `pulumi:"userConsentDescription"`
+	// The title of the scope shown to users.
+	UserConsentDisplayName pulumi.StringPtrInput `pulumi:"userConsentDisplayName"`
+	// The value of the scope, which appears in the scp claim of access tokens.
+	Value pulumi.StringPtrInput `pulumi:"value"`
+}
+
+func (ApplicationPermissionScopeArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationPermissionScope)(nil)).Elem()
+}
+
+func (i ApplicationPermissionScopeArgs) ToApplicationPermissionScopeOutput() ApplicationPermissionScopeOutput {
+	return i.ToApplicationPermissionScopeOutputWithContext(context.Background())
+}
+
+func (i ApplicationPermissionScopeArgs) ToApplicationPermissionScopeOutputWithContext(ctx context.Context) ApplicationPermissionScopeOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationPermissionScopeOutput)
+}
+
+// ApplicationPermissionScopeArrayInput is an input type that accepts ApplicationPermissionScopeArray and ApplicationPermissionScopeArrayOutput values.
+// You can construct a concrete instance of `ApplicationPermissionScopeArrayInput` via:
+//
+//	ApplicationPermissionScopeArray{ ApplicationPermissionScopeArgs{...} }
+type ApplicationPermissionScopeArrayInput interface {
+	pulumi.Input
+
+	ToApplicationPermissionScopeArrayOutput() ApplicationPermissionScopeArrayOutput
+	ToApplicationPermissionScopeArrayOutputWithContext(context.Context) ApplicationPermissionScopeArrayOutput
+}
+
+type ApplicationPermissionScopeArray []ApplicationPermissionScopeInput
+
+func (ApplicationPermissionScopeArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ApplicationPermissionScope)(nil)).Elem()
+}
+
+func (i ApplicationPermissionScopeArray) ToApplicationPermissionScopeArrayOutput() ApplicationPermissionScopeArrayOutput {
+	return i.ToApplicationPermissionScopeArrayOutputWithContext(context.Background())
+}
+
+func (i ApplicationPermissionScopeArray) ToApplicationPermissionScopeArrayOutputWithContext(ctx context.Context) ApplicationPermissionScopeArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationPermissionScopeArrayOutput)
+}
+
+// A delegated permission exposed by an application's API.
+type ApplicationPermissionScopeOutput struct{ *pulumi.OutputState }
+
+func (ApplicationPermissionScopeOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationPermissionScope)(nil)).Elem()
+}
+
+func (o ApplicationPermissionScopeOutput) ToApplicationPermissionScopeOutput() ApplicationPermissionScopeOutput {
+	return o
+}
+
+func (o ApplicationPermissionScopeOutput) ToApplicationPermissionScopeOutputWithContext(ctx context.Context) ApplicationPermissionScopeOutput {
+	return o
+}
+
+// The description of the scope shown to admins.
+func (o ApplicationPermissionScopeOutput) AdminConsentDescription() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ApplicationPermissionScope) *string { return v.AdminConsentDescription }).(pulumi.StringPtrOutput)
+}
+
+// The title of the scope shown to admins.
+func (o ApplicationPermissionScopeOutput) AdminConsentDisplayName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ApplicationPermissionScope) *string { return v.AdminConsentDisplayName }).(pulumi.StringPtrOutput)
+}
+
+// The ID of the scope. Defaults to a GUID derived from the value.
+func (o ApplicationPermissionScopeOutput) Id() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ApplicationPermissionScope) *string { return v.Id }).(pulumi.StringPtrOutput)
+}
+
+// Whether the scope is enabled. Defaults to true.
+func (o ApplicationPermissionScopeOutput) IsEnabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ApplicationPermissionScope) *bool { return v.IsEnabled }).(pulumi.BoolPtrOutput)
+}
+
+// Whether users ('User') or only admins ('Admin') can consent to the scope. Defaults to 'User'.
+func (o ApplicationPermissionScopeOutput) Type() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ApplicationPermissionScope) *string { return v.Type }).(pulumi.StringPtrOutput)
+}
+
+// The description of the scope shown to users.
+func (o ApplicationPermissionScopeOutput) UserConsentDescription() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ApplicationPermissionScope) *string { return v.UserConsentDescription }).(pulumi.StringPtrOutput)
+}
+
+// The title of the scope shown to users.
+func (o ApplicationPermissionScopeOutput) UserConsentDisplayName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ApplicationPermissionScope) *string { return v.UserConsentDisplayName }).(pulumi.StringPtrOutput)
+}
+
+// The value of the scope, which appears in the scp claim of access tokens.
+func (o ApplicationPermissionScopeOutput) Value() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ApplicationPermissionScope) *string { return v.Value }).(pulumi.StringPtrOutput)
+}
+
+type ApplicationPermissionScopeArrayOutput struct{ *pulumi.OutputState }
+
+func (ApplicationPermissionScopeArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ApplicationPermissionScope)(nil)).Elem()
+}
+
+func (o ApplicationPermissionScopeArrayOutput) ToApplicationPermissionScopeArrayOutput() ApplicationPermissionScopeArrayOutput {
+	return o
+}
+
+func (o ApplicationPermissionScopeArrayOutput) ToApplicationPermissionScopeArrayOutputWithContext(ctx context.Context) ApplicationPermissionScopeArrayOutput {
+	return o
+}
+
+func (o ApplicationPermissionScopeArrayOutput) Index(i pulumi.IntInput) ApplicationPermissionScopeOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ApplicationPermissionScope {
+		return vs[0].([]ApplicationPermissionScope)[vs[1].(int)]
+	}).(ApplicationPermissionScopeOutput)
+}
+
+// A client application that can use an API's scopes without user consent.
+type ApplicationPreAuthorizedApplication struct {
+	// The app ID of the client application.
+	AppId string `pulumi:"appId"`
+	// The IDs of the scopes the client application is pre-authorized for.
+	DelegatedPermissionIds []string `pulumi:"delegatedPermissionIds"`
+}
+
+// ApplicationPreAuthorizedApplicationInput is an input type that accepts ApplicationPreAuthorizedApplicationArgs and ApplicationPreAuthorizedApplicationOutput values.
+// You can construct a concrete instance of `ApplicationPreAuthorizedApplicationInput` via:
+//
+//	ApplicationPreAuthorizedApplicationArgs{...}
+type ApplicationPreAuthorizedApplicationInput interface {
+	pulumi.Input
+
+	ToApplicationPreAuthorizedApplicationOutput() ApplicationPreAuthorizedApplicationOutput
+	ToApplicationPreAuthorizedApplicationOutputWithContext(context.Context) ApplicationPreAuthorizedApplicationOutput
+}
+
+// A client application that can use an API's scopes without user consent.
+type ApplicationPreAuthorizedApplicationArgs struct {
+	// The app ID of the client application.
+	AppId pulumi.StringInput `pulumi:"appId"`
+	// The IDs of the scopes the client application is pre-authorized for.
+	DelegatedPermissionIds pulumi.StringArrayInput `pulumi:"delegatedPermissionIds"`
+}
+
+func (ApplicationPreAuthorizedApplicationArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationPreAuthorizedApplication)(nil)).Elem()
+}
+
+func (i ApplicationPreAuthorizedApplicationArgs) ToApplicationPreAuthorizedApplicationOutput() ApplicationPreAuthorizedApplicationOutput {
+	return i.ToApplicationPreAuthorizedApplicationOutputWithContext(context.Background())
+}
+
+func (i ApplicationPreAuthorizedApplicationArgs) ToApplicationPreAuthorizedApplicationOutputWithContext(ctx context.Context) ApplicationPreAuthorizedApplicationOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationPreAuthorizedApplicationOutput)
+}
+
+// ApplicationPreAuthorizedApplicationArrayInput is an input type that accepts ApplicationPreAuthorizedApplicationArray and ApplicationPreAuthorizedApplicationArrayOutput values.
+// You can construct a concrete instance of `ApplicationPreAuthorizedApplicationArrayInput` via:
+//
+//	ApplicationPreAuthorizedApplicationArray{ ApplicationPreAuthorizedApplicationArgs{...} }
+type ApplicationPreAuthorizedApplicationArrayInput interface {
+	pulumi.Input
+
+	ToApplicationPreAuthorizedApplicationArrayOutput() ApplicationPreAuthorizedApplicationArrayOutput
+	ToApplicationPreAuthorizedApplicationArrayOutputWithContext(context.Context) ApplicationPreAuthorizedApplicationArrayOutput
+}
+
+type ApplicationPreAuthorizedApplicationArray []ApplicationPreAuthorizedApplicationInput
+
+func (ApplicationPreAuthorizedApplicationArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ApplicationPreAuthorizedApplication)(nil)).Elem()
+}
+
+func (i ApplicationPreAuthorizedApplicationArray) ToApplicationPreAuthorizedApplicationArrayOutput() ApplicationPreAuthorizedApplicationArrayOutput {
+	return i.ToApplicationPreAuthorizedApplicationArrayOutputWithContext(context.Background())
+}
+
+func (i ApplicationPreAuthorizedApplicationArray) ToApplicationPreAuthorizedApplicationArrayOutputWithContext(ctx context.Context) ApplicationPreAuthorizedApplicationArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationPreAuthorizedApplicationArrayOutput)
+}
+
+// A client application that can use an API's scopes without user consent.
+type ApplicationPreAuthorizedApplicationOutput struct{ *pulumi.OutputState }
+
+func (ApplicationPreAuthorizedApplicationOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationPreAuthorizedApplication)(nil)).Elem()
+}
+
+func (o ApplicationPreAuthorizedApplicationOutput) ToApplicationPreAuthorizedApplicationOutput() ApplicationPreAuthorizedApplicationOutput {
+	return o
+}
+
+func (o ApplicationPreAuthorizedApplicationOutput) ToApplicationPreAuthorizedApplicationOutputWithContext(ctx context.Context) ApplicationPreAuthorizedApplicationOutput {
+	return o
+}
+
+// The app ID of the client application.
+func (o ApplicationPreAuthorizedApplicationOutput) AppId() pulumi.StringOutput {
+	return o.ApplyT(func(v ApplicationPreAuthorizedApplication) string { return v.AppId }).(pulumi.StringOutput)
+}
+
+// The IDs of the scopes the client application is pre-authorized for.
+func (o ApplicationPreAuthorizedApplicationOutput) DelegatedPermissionIds() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v ApplicationPreAuthorizedApplication) []string { return v.DelegatedPermissionIds }).(pulumi.StringArrayOutput)
+}
+
+type ApplicationPreAuthorizedApplicationArrayOutput struct{ *pulumi.OutputState }
+
+func (ApplicationPreAuthorizedApplicationArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ApplicationPreAuthorizedApplication)(nil)).Elem()
+}
+
+func (o ApplicationPreAuthorizedApplicationArrayOutput) ToApplicationPreAuthorizedApplicationArrayOutput() ApplicationPreAuthorizedApplicationArrayOutput {
+	return o
+}
+
+func (o ApplicationPreAuthorizedApplicationArrayOutput) ToApplicationPreAuthorizedApplicationArrayOutputWithContext(ctx context.Context) ApplicationPreAuthorizedApplicationArrayOutput {
+	return o
+}
+
+func (o ApplicationPreAuthorizedApplicationArrayOutput) Index(i pulumi.IntInput) ApplicationPreAuthorizedApplicationOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ApplicationPreAuthorizedApplication {
+		return vs[0].([]ApplicationPreAuthorizedApplication)[vs[1].(int)]
+	}).(ApplicationPreAuthorizedApplicationOutput)
+}
+
+// Settings for a public client, like a desktop or mobile application.
+type ApplicationPublicClient struct {
+	// The URLs where tokens are sent for sign-in.
+	RedirectUris []string `pulumi:"redirectUris"`
+}
+
+// ApplicationPublicClientInput is an input type that accepts ApplicationPublicClientArgs and ApplicationPublicClientOutput values.
+// You can construct a concrete instance of `ApplicationPublicClientInput` via:
+//
+//	ApplicationPublicClientArgs{...}
+type ApplicationPublicClientInput interface {
+	pulumi.Input
+
+	ToApplicationPublicClientOutput() ApplicationPublicClientOutput
+	ToApplicationPublicClientOutputWithContext(context.Context) ApplicationPublicClientOutput
+}
+
+// Settings for a public client, like a desktop or mobile application.
+type ApplicationPublicClientArgs struct {
+	// The URLs where tokens are sent for sign-in.
+	RedirectUris pulumi.StringArrayInput `pulumi:"redirectUris"`
+}
+
+func (ApplicationPublicClientArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationPublicClient)(nil)).Elem()
+}
+
+func (i ApplicationPublicClientArgs) ToApplicationPublicClientOutput() ApplicationPublicClientOutput {
+	return i.ToApplicationPublicClientOutputWithContext(context.Background())
+}
+
+func (i ApplicationPublicClientArgs) ToApplicationPublicClientOutputWithContext(ctx context.Context) ApplicationPublicClientOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationPublicClientOutput)
+}
+
+func (i ApplicationPublicClientArgs) ToApplicationPublicClientPtrOutput() ApplicationPublicClientPtrOutput {
+	return i.ToApplicationPublicClientPtrOutputWithContext(context.Background())
+}
+
+func (i ApplicationPublicClientArgs) ToApplicationPublicClientPtrOutputWithContext(ctx context.Context) ApplicationPublicClientPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationPublicClientOutput).ToApplicationPublicClientPtrOutputWithContext(ctx)
+}
+
+// ApplicationPublicClientPtrInput is an input type that accepts ApplicationPublicClientArgs, ApplicationPublicClientPtr and ApplicationPublicClientPtrOutput values.
+// You can construct a concrete instance of `ApplicationPublicClientPtrInput` via:
+//
+//	        ApplicationPublicClientArgs{...}
+//
+//	or:
+//
+//	        nil
+type ApplicationPublicClientPtrInput interface {
+	pulumi.Input
+
+	ToApplicationPublicClientPtrOutput() ApplicationPublicClientPtrOutput
+	ToApplicationPublicClientPtrOutputWithContext(context.Context) ApplicationPublicClientPtrOutput
+}
+
+type applicationPublicClientPtrType ApplicationPublicClientArgs
+
+func ApplicationPublicClientPtr(v *ApplicationPublicClientArgs) ApplicationPublicClientPtrInput {
+	return (*applicationPublicClientPtrType)(v)
+}
+
+func (*applicationPublicClientPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**ApplicationPublicClient)(nil)).Elem()
+}
+
+func (i *applicationPublicClientPtrType) ToApplicationPublicClientPtrOutput() ApplicationPublicClientPtrOutput {
+	return i.ToApplicationPublicClientPtrOutputWithContext(context.Background())
+}
+
+func (i *applicationPublicClientPtrType) ToApplicationPublicClientPtrOutputWithContext(ctx context.Context) ApplicationPublicClientPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationPublicClientPtrOutput)
+}
+
+// Settings for a public client, like a desktop or mobile application.
+type ApplicationPublicClientOutput struct{ *pulumi.OutputState }
+
+func (ApplicationPublicClientOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationPublicClient)(nil)).Elem()
+}
+
+func (o ApplicationPublicClientOutput) ToApplicationPublicClientOutput() ApplicationPublicClientOutput {
+	return o
+}
+
+func (o ApplicationPublicClientOutput) ToApplicationPublicClientOutputWithContext(ctx context.Context) ApplicationPublicClientOutput {
+	return o
+}
+
+func (o ApplicationPublicClientOutput) ToApplicationPublicClientPtrOutput() ApplicationPublicClientPtrOutput {
+	return o.ToApplicationPublicClientPtrOutputWithContext(context.Background())
+}
+
+func (o ApplicationPublicClientOutput) ToApplicationPublicClientPtrOutputWithContext(ctx context.Context) ApplicationPublicClientPtrOutput {
+	return o.ApplyT(func(v ApplicationPublicClient) *ApplicationPublicClient {
+		return &v
+	}).(ApplicationPublicClientPtrOutput)
+}
+
+// The URLs where tokens are sent for sign-in.
+func (o ApplicationPublicClientOutput) RedirectUris() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v ApplicationPublicClient) []string { return v.RedirectUris }).(pulumi.StringArrayOutput)
+}
+
+type ApplicationPublicClientPtrOutput struct{ *pulumi.OutputState }
+
+func (ApplicationPublicClientPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ApplicationPublicClient)(nil)).Elem()
+}
+
+func (o ApplicationPublicClientPtrOutput) ToApplicationPublicClientPtrOutput() ApplicationPublicClientPtrOutput {
+	return o
+}
+
+func (o ApplicationPublicClientPtrOutput) ToApplicationPublicClientPtrOutputWithContext(ctx context.Context) ApplicationPublicClientPtrOutput {
+	return o
+}
+
+func (o ApplicationPublicClientPtrOutput) Elem() ApplicationPublicClientOutput {
+	return o.ApplyT(func(v *ApplicationPublicClient) ApplicationPublicClient { return *v }).(ApplicationPublicClientOutput)
+}
+
+// The URLs where tokens are sent for sign-in.
+func (o ApplicationPublicClientPtrOutput) RedirectUris() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *ApplicationPublicClient) []string {
+		if v == nil {
+			return nil
+		}
+		return v.RedirectUris
+	}).(pulumi.StringArrayOutput)
+}
+
+// The permissions an application requires on a resource application.
+type ApplicationRequiredResourceAccess struct {
+	// The permissions required on the resource.
+	ResourceAccess []ApplicationResourceAccess `pulumi:"resourceAccess"`
+	// The app ID of the resource application, e.g. '00000003-0000-0000-c000-000000000000' for Microsoft Graph.
+	ResourceAppId string `pulumi:"resourceAppId"`
+}
+
+// ApplicationRequiredResourceAccessInput is an input type that accepts ApplicationRequiredResourceAccessArgs and ApplicationRequiredResourceAccessOutput values.
+// You can construct a concrete instance of `ApplicationRequiredResourceAccessInput` via:
+//
+//	ApplicationRequiredResourceAccessArgs{...}
+type ApplicationRequiredResourceAccessInput interface {
+	pulumi.Input
+
+	ToApplicationRequiredResourceAccessOutput() ApplicationRequiredResourceAccessOutput
+	ToApplicationRequiredResourceAccessOutputWithContext(context.Context) ApplicationRequiredResourceAccessOutput
+}
+
+// The permissions an application requires on a resource application.
+type ApplicationRequiredResourceAccessArgs struct {
+	// The permissions required on the resource.
+	ResourceAccess ApplicationResourceAccessArrayInput `pulumi:"resourceAccess"`
+	// The app ID of the resource application, e.g. '00000003-0000-0000-c000-000000000000' for Microsoft Graph.
+	ResourceAppId pulumi.StringInput `pulumi:"resourceAppId"`
+}
+
+func (ApplicationRequiredResourceAccessArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationRequiredResourceAccess)(nil)).Elem()
+}
+
+func (i ApplicationRequiredResourceAccessArgs) ToApplicationRequiredResourceAccessOutput() ApplicationRequiredResourceAccessOutput {
+	return i.ToApplicationRequiredResourceAccessOutputWithContext(context.Background())
+}
+
+func (i ApplicationRequiredResourceAccessArgs) ToApplicationRequiredResourceAccessOutputWithContext(ctx context.Context) ApplicationRequiredResourceAccessOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationRequiredResourceAccessOutput)
+}
+
+// ApplicationRequiredResourceAccessArrayInput is an input type that accepts ApplicationRequiredResourceAccessArray and ApplicationRequiredResourceAccessArrayOutput values.
+// You can construct a concrete instance of `ApplicationRequiredResourceAccessArrayInput` via:
+//
+//	ApplicationRequiredResourceAccessArray{ ApplicationRequiredResourceAccessArgs{...} }
+type ApplicationRequiredResourceAccessArrayInput interface {
+	pulumi.Input
+
+	ToApplicationRequiredResourceAccessArrayOutput() ApplicationRequiredResourceAccessArrayOutput
+	ToApplicationRequiredResourceAccessArrayOutputWithContext(context.Context) ApplicationRequiredResourceAccessArrayOutput
+}
+
+type ApplicationRequiredResourceAccessArray []ApplicationRequiredResourceAccessInput
+
+func (ApplicationRequiredResourceAccessArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ApplicationRequiredResourceAccess)(nil)).Elem()
+}
+
+func (i ApplicationRequiredResourceAccessArray) ToApplicationRequiredResourceAccessArrayOutput() ApplicationRequiredResourceAccessArrayOutput {
+	return i.ToApplicationRequiredResourceAccessArrayOutputWithContext(context.Background())
+}
+
+func (i ApplicationRequiredResourceAccessArray) ToApplicationRequiredResourceAccessArrayOutputWithContext(ctx context.Context) ApplicationRequiredResourceAccessArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationRequiredResourceAccessArrayOutput)
+}
+
+// The permissions an application requires on a resource application.
+type ApplicationRequiredResourceAccessOutput struct{ *pulumi.OutputState }
+
+func (ApplicationRequiredResourceAccessOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationRequiredResourceAccess)(nil)).Elem()
+}
+
+func (o ApplicationRequiredResourceAccessOutput) ToApplicationRequiredResourceAccessOutput() ApplicationRequiredResourceAccessOutput {
+	return o
+}
+
+func (o ApplicationRequiredResourceAccessOutput) ToApplicationRequiredResourceAccessOutputWithContext(ctx context.Context) ApplicationRequiredResourceAccessOutput {
+	return o
+}
+
+// The permissions required on the resource.
+func (o ApplicationRequiredResourceAccessOutput) ResourceAccess() ApplicationResourceAccessArrayOutput {
+	return o.ApplyT(func(v ApplicationRequiredResourceAccess) []ApplicationResourceAccess { return v.ResourceAccess }).(ApplicationResourceAccessArrayOutput)
+}
+
+// The app ID of the resource application, e.g. '00000003-0000-0000-c000-000000000000' for Microsoft Graph.
+func (o ApplicationRequiredResourceAccessOutput) ResourceAppId() pulumi.StringOutput {
+	return o.ApplyT(func(v ApplicationRequiredResourceAccess) string { return v.ResourceAppId }).(pulumi.StringOutput)
+}
+
+type ApplicationRequiredResourceAccessArrayOutput struct{ *pulumi.OutputState }
+
+func (ApplicationRequiredResourceAccessArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ApplicationRequiredResourceAccess)(nil)).Elem()
+}
+
+func (o ApplicationRequiredResourceAccessArrayOutput) ToApplicationRequiredResourceAccessArrayOutput() ApplicationRequiredResourceAccessArrayOutput {
+	return o
+}
+
+func (o ApplicationRequiredResourceAccessArrayOutput) ToApplicationRequiredResourceAccessArrayOutputWithContext(ctx context.Context) ApplicationRequiredResourceAccessArrayOutput {
+	return o
+}
+
+func (o ApplicationRequiredResourceAccessArrayOutput) Index(i pulumi.IntInput) ApplicationRequiredResourceAccessOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ApplicationRequiredResourceAccess {
+		return vs[0].([]ApplicationRequiredResourceAccess)[vs[1].(int)]
+	}).(ApplicationRequiredResourceAccessOutput)
+}
+
+// A permission an application requires on a resource.
+type ApplicationResourceAccess struct {
+	// The ID of the scope or app role.
+	Id string `pulumi:"id"`
+	// 'Scope' for a delegated permission or 'Role' for an application permission.
+	Type string `pulumi:"type"`
+}
+
+// ApplicationResourceAccessInput is an input type that accepts ApplicationResourceAccessArgs and ApplicationResourceAccessOutput values.
+// You can construct a concrete instance of `ApplicationResourceAccessInput` via:
+//
+//	ApplicationResourceAccessArgs{...}
+type ApplicationResourceAccessInput interface {
+	pulumi.Input
+
+	ToApplicationResourceAccessOutput() ApplicationResourceAccessOutput
+	ToApplicationResourceAccessOutputWithContext(context.Context) ApplicationResourceAccessOutput
+}
+
+// A permission an application requires on a resource.
+type ApplicationResourceAccessArgs struct {
+	// The ID of the scope or app role.
+	Id pulumi.StringInput `pulumi:"id"`
+	// 'Scope' for a delegated permission or 'Role' for an application permission.
+	Type pulumi.StringInput `pulumi:"type"`
+}
+
+func (ApplicationResourceAccessArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationResourceAccess)(nil)).Elem()
+}
+
+func (i ApplicationResourceAccessArgs) ToApplicationResourceAccessOutput() ApplicationResourceAccessOutput {
+	return i.ToApplicationResourceAccessOutputWithContext(context.Background())
+}
+
+func (i ApplicationResourceAccessArgs) ToApplicationResourceAccessOutputWithContext(ctx context.Context) ApplicationResourceAccessOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationResourceAccessOutput)
+}
+
+// ApplicationResourceAccessArrayInput is an input type that accepts ApplicationResourceAccessArray and ApplicationResourceAccessArrayOutput values.
+// You can construct a concrete instance of `ApplicationResourceAccessArrayInput` via:
+//
+//	ApplicationResourceAccessArray{ ApplicationResourceAccessArgs{...} }
+type ApplicationResourceAccessArrayInput interface {
+	pulumi.Input
+
+	ToApplicationResourceAccessArrayOutput() ApplicationResourceAccessArrayOutput
+	ToApplicationResourceAccessArrayOutputWithContext(context.Context) ApplicationResourceAccessArrayOutput
+}
+
+type ApplicationResourceAccessArray []ApplicationResourceAccessInput
+
+func (ApplicationResourceAccessArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ApplicationResourceAccess)(nil)).Elem()
+}
+
+func (i ApplicationResourceAccessArray) ToApplicationResourceAccessArrayOutput() ApplicationResourceAccessArrayOutput {
+	return i.ToApplicationResourceAccessArrayOutputWithContext(context.Background())
+}
+
+func (i ApplicationResourceAccessArray) ToApplicationResourceAccessArrayOutputWithContext(ctx context.Context) ApplicationResourceAccessArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationResourceAccessArrayOutput)
+}
+
+// A permission an application requires on a resource.
+type ApplicationResourceAccessOutput struct{ *pulumi.OutputState }
+
+func (ApplicationResourceAccessOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationResourceAccess)(nil)).Elem()
+}
+
+func (o ApplicationResourceAccessOutput) ToApplicationResourceAccessOutput() ApplicationResourceAccessOutput {
+	return o
+}
+
+func (o ApplicationResourceAccessOutput) ToApplicationResourceAccessOutputWithContext(ctx context.Context) ApplicationResourceAccessOutput {
+	return o
+}
+
+// The ID of the scope or app role.
+func (o ApplicationResourceAccessOutput) Id() pulumi.StringOutput {
+	return o.ApplyT(func(v ApplicationResourceAccess) string { return v.Id }).(pulumi.StringOutput)
+}
+
+// 'Scope' for a delegated permission or 'Role' for an application permission.
+func (o ApplicationResourceAccessOutput) Type() pulumi.StringOutput {
+	return o.ApplyT(func(v ApplicationResourceAccess) string { return v.Type }).(pulumi.StringOutput)
+}
+
+type ApplicationResourceAccessArrayOutput struct{ *pulumi.OutputState }
+
+func (ApplicationResourceAccessArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ApplicationResourceAccess)(nil)).Elem()
+}
+
+func (o ApplicationResourceAccessArrayOutput) ToApplicationResourceAccessArrayOutput() ApplicationResourceAccessArrayOutput {
+	return o
+}
+
+func (o ApplicationResourceAccessArrayOutput) ToApplicationResourceAccessArrayOutputWithContext(ctx context.Context) ApplicationResourceAccessArrayOutput {
+	return o
+}
+
+func (o ApplicationResourceAccessArrayOutput) Index(i pulumi.IntInput) ApplicationResourceAccessOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ApplicationResourceAccess {
+		return vs[0].([]ApplicationResourceAccess)[vs[1].(int)]
+	}).(ApplicationResourceAccessOutput)
+}
+
+// Settings for a single-page application.
+type ApplicationSpa struct {
+	// The URLs where tokens are sent for sign-in.
+	RedirectUris []string `pulumi:"redirectUris"`
+}
+
+// ApplicationSpaInput is an input type that accepts ApplicationSpaArgs and ApplicationSpaOutput values.
+// You can construct a concrete instance of `ApplicationSpaInput` via:
+//
+//	ApplicationSpaArgs{...}
+type ApplicationSpaInput interface {
+	pulumi.Input
+
+	ToApplicationSpaOutput() ApplicationSpaOutput
+	ToApplicationSpaOutputWithContext(context.Context) ApplicationSpaOutput
+}
+
+// Settings for a single-page application.
+type ApplicationSpaArgs struct {
+	// The URLs where tokens are sent for sign-in.
+	RedirectUris pulumi.StringArrayInput `pulumi:"redirectUris"`
+}
+
+func (ApplicationSpaArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationSpa)(nil)).Elem()
+}
+
+func (i ApplicationSpaArgs) ToApplicationSpaOutput() ApplicationSpaOutput {
+	return i.ToApplicationSpaOutputWithContext(context.Background())
+}
+
+func (i ApplicationSpaArgs) ToApplicationSpaOutputWithContext(ctx context.Context) ApplicationSpaOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationSpaOutput)
+}
+
+func (i ApplicationSpaArgs) ToApplicationSpaPtrOutput() ApplicationSpaPtrOutput {
+	return i.ToApplicationSpaPtrOutputWithContext(context.Background())
+}
+
+func (i ApplicationSpaArgs) ToApplicationSpaPtrOutputWithContext(ctx context.Context) ApplicationSpaPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationSpaOutput).ToApplicationSpaPtrOutputWithContext(ctx)
+}
+
+// ApplicationSpaPtrInput is an input type that accepts ApplicationSpaArgs, ApplicationSpaPtr and ApplicationSpaPtrOutput values.
+// You can construct a concrete instance of `ApplicationSpaPtrInput` via:
+//
+//	        ApplicationSpaArgs{...}
+//
+//	or:
+//
+//	        nil
+type ApplicationSpaPtrInput interface {
+	pulumi.Input
+
+	ToApplicationSpaPtrOutput() ApplicationSpaPtrOutput
+	ToApplicationSpaPtrOutputWithContext(context.Context) ApplicationSpaPtrOutput
+}
+
+type applicationSpaPtrType ApplicationSpaArgs
+
+func ApplicationSpaPtr(v *ApplicationSpaArgs) ApplicationSpaPtrInput {
+	return (*applicationSpaPtrType)(v)
+}
+
+func (*applicationSpaPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**ApplicationSpa)(nil)).Elem()
+}
+
+func (i *applicationSpaPtrType) ToApplicationSpaPtrOutput() ApplicationSpaPtrOutput {
+	return i.ToApplicationSpaPtrOutputWithContext(context.Background())
+}
+
+func (i *applicationSpaPtrType) ToApplicationSpaPtrOutputWithContext(ctx context.Context) ApplicationSpaPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationSpaPtrOutput)
+}
+
+// Settings for a single-page application.
+type ApplicationSpaOutput struct{ *pulumi.OutputState }
+
+func (ApplicationSpaOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationSpa)(nil)).Elem()
+}
+
+func (o ApplicationSpaOutput) ToApplicationSpaOutput() ApplicationSpaOutput {
+	return o
+}
+
+func (o ApplicationSpaOutput) ToApplicationSpaOutputWithContext(ctx context.Context) ApplicationSpaOutput {
+	return o
+}
+
+func (o ApplicationSpaOutput) ToApplicationSpaPtrOutput() ApplicationSpaPtrOutput {
+	return o.ToApplicationSpaPtrOutputWithContext(context.Background())
+}
+
+func (o ApplicationSpaOutput) ToApplicationSpaPtrOutputWithContext(ctx context.Context) ApplicationSpaPtrOutput {
+	return o.ApplyT(func(v ApplicationSpa) *ApplicationSpa {
+		return &v
+	}).(ApplicationSpaPtrOutput)
+}
+
+// The URLs where tokens are sent for sign-in.
+func (o ApplicationSpaOutput) RedirectUris() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v ApplicationSpa) []string { return v.RedirectUris }).(pulumi.StringArrayOutput)
+}
+
+type ApplicationSpaPtrOutput struct{ *pulumi.OutputState }
+
+func (ApplicationSpaPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ApplicationSpa)(nil)).Elem()
+}
+
+func (o ApplicationSpaPtrOutput) ToApplicationSpaPtrOutput() ApplicationSpaPtrOutput {
+	return o
+}
+
+func (o ApplicationSpaPtrOutput) ToApplicationSpaPtrOutputWithContext(ctx context.Context) ApplicationSpaPtrOutput {
+	return o
+}
+
+func (o ApplicationSpaPtrOutput) Elem() ApplicationSpaOutput {
+	return o.ApplyT(func(v *ApplicationSpa) ApplicationSpa { return *v }).(ApplicationSpaOutput)
+}
+
+// The URLs where tokens are sent for sign-in.
+func (o ApplicationSpaPtrOutput) RedirectUris() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *ApplicationSpa) []string {
+		if v == nil {
+			return nil
+		}
+		return v.RedirectUris
+	}).(pulumi.StringArrayOutput)
+}
+
+// Settings for a web application.
+type ApplicationWeb struct {
+	// The home page of the application.
+	HomePageUrl *string `pulumi:"homePageUrl"`
+	// The implicit grant settings.
+	ImplicitGrantSettings *ApplicationImplicitGrantSettings `pulumi:"implicitGrantSettings"`
+	// The URL used to sign out of the application.
+	LogoutUrl *string `pulumi:"logoutUrl"`
+	// The URLs where tokens are sent for sign-in.
+	RedirectUris []string `pulumi:"redirectUris"`
+}
+
+// ApplicationWebInput is an input type that accepts ApplicationWebArgs and ApplicationWebOutput values.
+// You can construct a concrete instance of `ApplicationWebInput` via:
+//
+//	ApplicationWebArgs{...}
+type ApplicationWebInput interface {
+	pulumi.Input
+
+	ToApplicationWebOutput() ApplicationWebOutput
+	ToApplicationWebOutputWithContext(context.Context) ApplicationWebOutput
+}
+
+// Settings for a web application.
+type ApplicationWebArgs struct {
+	// The home page of the application.
+	HomePageUrl pulumi.StringPtrInput `pulumi:"homePageUrl"`
+	// The implicit grant settings.
+	ImplicitGrantSettings ApplicationImplicitGrantSettingsPtrInput `pulumi:"implicitGrantSettings"`
+	// The URL used to sign out of the application.
+	LogoutUrl pulumi.StringPtrInput `pulumi:"logoutUrl"`
+	// The URLs where tokens are sent for sign-in.
+	RedirectUris pulumi.StringArrayInput `pulumi:"redirectUris"`
+}
+
+func (ApplicationWebArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationWeb)(nil)).Elem()
+}
+
+func (i ApplicationWebArgs) ToApplicationWebOutput() ApplicationWebOutput {
+	return i.ToApplicationWebOutputWithContext(context.Background())
+}
+
+func (i ApplicationWebArgs) ToApplicationWebOutputWithContext(ctx context.Context) ApplicationWebOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationWebOutput)
+}
+
+func (i ApplicationWebArgs) ToApplicationWebPtrOutput() ApplicationWebPtrOutput {
+	return i.ToApplicationWebPtrOutputWithContext(context.Background())
+}
+
+func (i ApplicationWebArgs) ToApplicationWebPtrOutputWithContext(ctx context.Context) ApplicationWebPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationWebOutput).ToApplicationWebPtrOutputWithContext(ctx)
+}
+
+// ApplicationWebPtrInput is an input type that accepts ApplicationWebArgs, ApplicationWebPtr and ApplicationWebPtrOutput values.
+// You can construct a concrete instance of `ApplicationWebPtrInput` via:
+//
+//	        ApplicationWebArgs{...}
+//
+//	or:
+//
+//	        nil
+type ApplicationWebPtrInput interface {
+	pulumi.Input
+
+	ToApplicationWebPtrOutput() ApplicationWebPtrOutput
+	ToApplicationWebPtrOutputWithContext(context.Context) ApplicationWebPtrOutput
+}
+
+type applicationWebPtrType ApplicationWebArgs
+
+func ApplicationWebPtr(v *ApplicationWebArgs) ApplicationWebPtrInput {
+	return (*applicationWebPtrType)(v)
+}
+
+func (*applicationWebPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**ApplicationWeb)(nil)).Elem()
+}
+
+func (i *applicationWebPtrType) ToApplicationWebPtrOutput() ApplicationWebPtrOutput {
+	return i.ToApplicationWebPtrOutputWithContext(context.Background())
+}
+
+func (i *applicationWebPtrType) ToApplicationWebPtrOutputWithContext(ctx context.Context) ApplicationWebPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ApplicationWebPtrOutput)
+}
+
+// Settings for a web application.
+type ApplicationWebOutput struct{ *pulumi.OutputState }
+
+func (ApplicationWebOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ApplicationWeb)(nil)).Elem()
+}
+
+func (o ApplicationWebOutput) ToApplicationWebOutput() ApplicationWebOutput {
+	return o
+}
+
+func (o ApplicationWebOutput) ToApplicationWebOutputWithContext(ctx context.Context) ApplicationWebOutput {
+	return o
+}
+
+func (o ApplicationWebOutput) ToApplicationWebPtrOutput() ApplicationWebPtrOutput {
+	return o.ToApplicationWebPtrOutputWithContext(context.Background())
+}
+
+func (o ApplicationWebOutput) ToApplicationWebPtrOutputWithContext(ctx context.Context) ApplicationWebPtrOutput {
+	return o.ApplyT(func(v ApplicationWeb) *ApplicationWeb {
+		return &v
+	}).(ApplicationWebPtrOutput)
+}
+
+// The home page of the application.
+func (o ApplicationWebOutput) HomePageUrl() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ApplicationWeb) *string { return v.HomePageUrl }).(pulumi.StringPtrOutput)
+}
+
+// The implicit grant settings.
+func (o ApplicationWebOutput) ImplicitGrantSettings() ApplicationImplicitGrantSettingsPtrOutput {
+	return o.ApplyT(func(v ApplicationWeb) *ApplicationImplicitGrantSettings { return v.ImplicitGrantSettings }).(ApplicationImplicitGrantSettingsPtrOutput)
+}
+
+// The URL used to sign out of the application.
+func (o ApplicationWebOutput) LogoutUrl() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ApplicationWeb) *string { return v.LogoutUrl }).(pulumi.StringPtrOutput)
+}
+
+// The URLs where tokens are sent for sign-in.
+func (o ApplicationWebOutput) RedirectUris() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v ApplicationWeb) []string { return v.RedirectUris }).(pulumi.StringArrayOutput)
+}
+
+type ApplicationWebPtrOutput struct{ *pulumi.OutputState }
+
+func (ApplicationWebPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ApplicationWeb)(nil)).Elem()
+}
+
+func (o ApplicationWebPtrOutput) ToApplicationWebPtrOutput() ApplicationWebPtrOutput {
+	return o
+}
+
+func (o ApplicationWebPtrOutput) ToApplicationWebPtrOutputWithContext(ctx context.Context) ApplicationWebPtrOutput {
+	return o
+}
+
+func (o ApplicationWebPtrOutput) Elem() ApplicationWebOutput {
+	return o.ApplyT(func(v *ApplicationWeb) ApplicationWeb { return *v }).(ApplicationWebOutput)
+}
+
+// The home page of the application.
+func (o ApplicationWebPtrOutput) HomePageUrl() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ApplicationWeb) *string {
+		if v == nil {
+			return nil
+		}
+		return v.HomePageUrl
+	}).(pulumi.StringPtrOutput)
+}
+
+// The implicit grant settings.
+func (o ApplicationWebPtrOutput) ImplicitGrantSettings() ApplicationImplicitGrantSettingsPtrOutput {
+	return o.ApplyT(func(v *ApplicationWeb) *ApplicationImplicitGrantSettings {
+		if v == nil {
+			return nil
+		}
+		return v.ImplicitGrantSettings
+	}).(ApplicationImplicitGrantSettingsPtrOutput)
+}
+
+// The URL used to sign out of the application.
+func (o ApplicationWebPtrOutput) LogoutUrl() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ApplicationWeb) *string {
+		if v == nil {
+			return nil
+		}
+		return v.LogoutUrl
+	}).(pulumi.StringPtrOutput)
+}
+
+// The URLs where tokens are sent for sign-in.
+func (o ApplicationWebPtrOutput) RedirectUris() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *ApplicationWeb) []string {
+		if v == nil {
+			return nil
+		}
+		return v.RedirectUris
+	}).(pulumi.StringArrayOutput)
+}
+
+// A claim emitted by a claims mapping policy, either from a source attribute or with a constant value.
+type ClaimsMappingPolicyClaim struct {
+	// The attribute of the source the claim value comes from, e.g. 'employeeid'.
+	Id *string `pulumi:"id"`
+	// The name of the claim in JWTs.
+	JwtClaimType *string `pulumi:"jwtClaimType"`
+	// The URI of the claim in SAML tokens.
+	SamlClaimType *string `pulumi:"samlClaimType"`
+	// The name format of the claim in SAML tokens.
+	SamlNameFormat *string `pulumi:"samlNameFormat"`
+	// The source of the claim value: 'user', 'application', 'resource', 'audience', 'company' or 'transformation'.
+	Source *string `pulumi:"source"`
+	// A constant value for the claim, instead of a source and an ID.
+	Value *string `pulumi:"value"`
+}
+
+// ClaimsMappingPolicyClaimInput is an input type that accepts ClaimsMappingPolicyClaimArgs and ClaimsMappingPolicyClaimOutput values.
+// You can construct a concrete instance of `ClaimsMappingPolicyClaimInput` via:
+//
+//	ClaimsMappingPolicyClaimArgs{...}
+type ClaimsMappingPolicyClaimInput interface {
+	pulumi.Input
+
+	ToClaimsMappingPolicyClaimOutput() ClaimsMappingPolicyClaimOutput
+	ToClaimsMappingPolicyClaimOutputWithContext(context.Context) ClaimsMappingPolicyClaimOutput
+}
+
+// A claim emitted by a claims mapping policy, either from a source attribute or with a constant value.
+type ClaimsMappingPolicyClaimArgs struct {
+	// The attribute of the source the claim value comes from, e.g. 'employeeid'.
+	Id pulumi.StringPtrInput `pulumi:"id"`
+	// The name of the claim in JWTs.
+	JwtClaimType pulumi.StringPtrInput `pulumi:"jwtClaimType"`
+	// The URI of the claim in SAML tokens.
+	SamlClaimType pulumi.StringPtrInput `pulumi:"samlClaimType"`
+	// The name format of the claim in SAML tokens.
+	SamlNameFormat pulumi.StringPtrInput `pulumi:"samlNameFormat"`
+	// The source of the claim value: 'user', 'application', 'resource', 'audience', 'company' or 'transformation'.
+	Source pulumi.StringPtrInput `pulumi:"source"`
+	// A constant value for the claim, instead of a source and an ID.
+	Value pulumi.StringPtrInput `pulumi:"value"`
+}
+
+func (ClaimsMappingPolicyClaimArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ClaimsMappingPolicyClaim)(nil)).Elem()
+}
+
+func (i ClaimsMappingPolicyClaimArgs) ToClaimsMappingPolicyClaimOutput() ClaimsMappingPolicyClaimOutput {
+	return i.ToClaimsMappingPolicyClaimOutputWithContext(context.Background())
+}
+
+func (i ClaimsMappingPolicyClaimArgs) ToClaimsMappingPolicyClaimOutputWithContext(ctx context.Context) ClaimsMappingPolicyClaimOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ClaimsMappingPolicyClaimOutput)
+}
+
+// ClaimsMappingPolicyClaimArrayInput is an input type that accepts ClaimsMappingPolicyClaimArray and ClaimsMappingPolicyClaimArrayOutput values.
+// You can construct a concrete instance of `ClaimsMappingPolicyClaimArrayInput` via:
+//
+//	ClaimsMappingPolicyClaimArray{ ClaimsMappingPolicyClaimArgs{...} }
+type ClaimsMappingPolicyClaimArrayInput interface {
+	pulumi.Input
+
+	ToClaimsMappingPolicyClaimArrayOutput() ClaimsMappingPolicyClaimArrayOutput
+	ToClaimsMappingPolicyClaimArrayOutputWithContext(context.Context) ClaimsMappingPolicyClaimArrayOutput
+}
+
+type ClaimsMappingPolicyClaimArray []ClaimsMappingPolicyClaimInput
+
+func (ClaimsMappingPolicyClaimArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ClaimsMappingPolicyClaim)(nil)).Elem()
+}
+
+func (i ClaimsMappingPolicyClaimArray) ToClaimsMappingPolicyClaimArrayOutput() ClaimsMappingPolicyClaimArrayOutput {
+	return i.ToClaimsMappingPolicyClaimArrayOutputWithContext(context.Background())
+}
+
+func (i ClaimsMappingPolicyClaimArray) ToClaimsMappingPolicyClaimArrayOutputWithContext(ctx context.Context) ClaimsMappingPolicyClaimArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ClaimsMappingPolicyClaimArrayOutput)
+}
+
+// A claim emitted by a claims mapping policy, either from a source attribute or with a constant value.
+type ClaimsMappingPolicyClaimOutput struct{ *pulumi.OutputState }
+
+func (ClaimsMappingPolicyClaimOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ClaimsMappingPolicyClaim)(nil)).Elem()
+}
+
+func (o ClaimsMappingPolicyClaimOutput) ToClaimsMappingPolicyClaimOutput() ClaimsMappingPolicyClaimOutput {
+	return o
+}
+
+func (o ClaimsMappingPolicyClaimOutput) ToClaimsMappingPolicyClaimOutputWithContext(ctx context.Context) ClaimsMappingPolicyClaimOutput {
+	return o
+}
+
+// The attribute of the source the claim value comes from, e.g. 'employeeid'.
+func (o ClaimsMappingPolicyClaimOutput) Id() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ClaimsMappingPolicyClaim) *string { return v.Id }).(pulumi.StringPtrOutput)
+}
+
+// The name of the claim in JWTs.
+func (o ClaimsMappingPolicyClaimOutput) JwtClaimType() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ClaimsMappingPolicyClaim) *string { return v.JwtClaimType }).(pulumi.StringPtrOutput)
+}
+
+// The URI of the claim in SAML tokens.
+func (o ClaimsMappingPolicyClaimOutput) SamlClaimType() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ClaimsMappingPolicyClaim) *string { return v.SamlClaimType }).(pulumi.StringPtrOutput)
+}
+
+// The name format of the claim in SAML tokens.
+func (o ClaimsMappingPolicyClaimOutput) SamlNameFormat() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ClaimsMappingPolicyClaim) *string { return v.SamlNameFormat }).(pulumi.StringPtrOutput)
+}
+
+// The source of the claim value: 'user', 'application', 'resource', 'audience', 'company' or 'transformation'.
+func (o ClaimsMappingPolicyClaimOutput) Source() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ClaimsMappingPolicyClaim) *string { return v.Source }).(pulumi.StringPtrOutput)
+}
+
+// A constant value for the claim, instead of a source and an ID.
+func (o ClaimsMappingPolicyClaimOutput) Value() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ClaimsMappingPolicyClaim) *string { return v.Value }).(pulumi.StringPtrOutput)
+}
+
+type ClaimsMappingPolicyClaimArrayOutput struct{ *pulumi.OutputState }
+
+func (ClaimsMappingPolicyClaimArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ClaimsMappingPolicyClaim)(nil)).Elem()
+}
+
+func (o ClaimsMappingPolicyClaimArrayOutput) ToClaimsMappingPolicyClaimArrayOutput() ClaimsMappingPolicyClaimArrayOutput {
+	return o
+}
+
+func (o ClaimsMappingPolicyClaimArrayOutput) ToClaimsMappingPolicyClaimArrayOutputWithContext(ctx context.Context) ClaimsMappingPolicyClaimArrayOutput {
+	return o
+}
+
+func (o ClaimsMappingPolicyClaimArrayOutput) Index(i pulumi.IntInput) ClaimsMappingPolicyClaimOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ClaimsMappingPolicyClaim {
+		return vs[0].([]ClaimsMappingPolicyClaim)[vs[1].(int)]
+	}).(ClaimsMappingPolicyClaimOutput)
+}
+
+// The definition of a claims mapping policy.
+type ClaimsMappingPolicyDefinition struct {
+	// The claims emitted in tokens.
+	ClaimsSchema []ClaimsMappingPolicyClaim `pulumi:"claimsSchema"`
+	// Whether the basic claim set is included in tokens in addition to the mapped claims. Defaults to false.
+	IncludeBasicClaimSet *bool `pulumi:"includeBasicClaimSet"`
+}
+
+// ClaimsMappingPolicyDefinitionInput is an input type that accepts ClaimsMappingPolicyDefinitionArgs and ClaimsMappingPolicyDefinitionOutput values.
+// You can construct a concrete instance of `ClaimsMappingPolicyDefinitionInput` via:
+//
+//	ClaimsMappingPolicyDefinitionArgs{...}
+type ClaimsMappingPolicyDefinitionInput interface {
+	pulumi.Input
+
+	ToClaimsMappingPolicyDefinitionOutput() ClaimsMappingPolicyDefinitionOutput
+	ToClaimsMappingPolicyDefinitionOutputWithContext(context.Context) ClaimsMappingPolicyDefinitionOutput
+}
+
+// The definition of a claims mapping policy.
+type ClaimsMappingPolicyDefinitionArgs struct {
+	// The claims emitted in tokens.
+	ClaimsSchema ClaimsMappingPolicyClaimArrayInput `pulumi:"claimsSchema"`
+	// Whether the basic claim set is included in tokens in addition to the mapped claims. Defaults to false.
+	IncludeBasicClaimSet pulumi.BoolPtrInput `pulumi:"includeBasicClaimSet"`
+}
+
+func (ClaimsMappingPolicyDefinitionArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ClaimsMappingPolicyDefinition)(nil)).Elem()
+}
+
+func (i ClaimsMappingPolicyDefinitionArgs) ToClaimsMappingPolicyDefinitionOutput() ClaimsMappingPolicyDefinitionOutput {
+	return i.ToClaimsMappingPolicyDefinitionOutputWithContext(context.Background())
+}
+
+func (i ClaimsMappingPolicyDefinitionArgs) ToClaimsMappingPolicyDefinitionOutputWithContext(ctx context.Context) ClaimsMappingPolicyDefinitionOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ClaimsMappingPolicyDefinitionOutput)
+}
+
+func (i ClaimsMappingPolicyDefinitionArgs) ToClaimsMappingPolicyDefinitionPtrOutput() ClaimsMappingPolicyDefinitionPtrOutput {
+	return i.ToClaimsMappingPolicyDefinitionPtrOutputWithContext(context.Background())
+}
+
+func (i ClaimsMappingPolicyDefinitionArgs) ToClaimsMappingPolicyDefinitionPtrOutputWithContext(ctx context.Context) ClaimsMappingPolicyDefinitionPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ClaimsMappingPolicyDefinitionOutput).ToClaimsMappingPolicyDefinitionPtrOutputWithContext(ctx)
+}
+
+// ClaimsMappingPolicyDefinitionPtrInput is an input type that accepts ClaimsMappingPolicyDefinitionArgs, ClaimsMappingPolicyDefinitionPtr and ClaimsMappingPolicyDefinitionPtrOutput values.
+// You can construct a concrete instance of `ClaimsMappingPolicyDefinitionPtrInput` via:
+//
+//	        ClaimsMappingPolicyDefinitionArgs{...}
+//
+//	or:
+//
+//	        nil
+type ClaimsMappingPolicyDefinitionPtrInput interface {
+	pulumi.Input
+
+	ToClaimsMappingPolicyDefinitionPtrOutput() ClaimsMappingPolicyDefinitionPtrOutput
+	ToClaimsMappingPolicyDefinitionPtrOutputWithContext(context.Context) ClaimsMappingPolicyDefinitionPtrOutput
+}
+
+type claimsMappingPolicyDefinitionPtrType ClaimsMappingPolicyDefinitionArgs
+
+func ClaimsMappingPolicyDefinitionPtr(v *ClaimsMappingPolicyDefinitionArgs) ClaimsMappingPolicyDefinitionPtrInput {
+	return (*claimsMappingPolicyDefinitionPtrType)(v)
+}
+
+func (*claimsMappingPolicyDefinitionPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**ClaimsMappingPolicyDefinition)(nil)).Elem()
+}
+
+func (i *claimsMappingPolicyDefinitionPtrType) ToClaimsMappingPolicyDefinitionPtrOutput() ClaimsMappingPolicyDefinitionPtrOutput {
+	return i.ToClaimsMappingPolicyDefinitionPtrOutputWithContext(context.Background())
+}
+
+func (i *claimsMappingPolicyDefinitionPtrType) ToClaimsMappingPolicyDefinitionPtrOutputWithContext(ctx context.Context) ClaimsMappingPolicyDefinitionPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ClaimsMappingPolicyDefinitionPtrOutput)
+}
+
+// The definition of a claims mapping policy.
+type ClaimsMappingPolicyDefinitionOutput struct{ *pulumi.OutputState }
+
+func (ClaimsMappingPolicyDefinitionOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ClaimsMappingPolicyDefinition)(nil)).Elem()
+}
+
+func (o ClaimsMappingPolicyDefinitionOutput) ToClaimsMappingPolicyDefinitionOutput() ClaimsMappingPolicyDefinitionOutput {
+	return o
+}
+
+func (o ClaimsMappingPolicyDefinitionOutput) ToClaimsMappingPolicyDefinitionOutputWithContext(ctx context.Context) ClaimsMappingPolicyDefinitionOutput {
+	return o
+}
+
+func (o ClaimsMappingPolicyDefinitionOutput) ToClaimsMappingPolicyDefinitionPtrOutput() ClaimsMappingPolicyDefinitionPtrOutput {
+	return o.ToClaimsMappingPolicyDefinitionPtrOutputWithContext(context.Background())
+}
+
+func (o ClaimsMappingPolicyDefinitionOutput) ToClaimsMappingPolicyDefinitionPtrOutputWithContext(ctx context.Context) ClaimsMappingPolicyDefinitionPtrOutput {
+	return o.ApplyT(func(v ClaimsMappingPolicyDefinition) *ClaimsMappingPolicyDefinition {
+		return &v
+	}).(ClaimsMappingPolicyDefinitionPtrOutput)
+}
+
+// The claims emitted in tokens.
+func (o ClaimsMappingPolicyDefinitionOutput) ClaimsSchema() ClaimsMappingPolicyClaimArrayOutput {
+	return o.ApplyT(func(v ClaimsMappingPolicyDefinition) []ClaimsMappingPolicyClaim { return v.ClaimsSchema }).(ClaimsMappingPolicyClaimArrayOutput)
+}
+
+// Whether the basic claim set is included in tokens in addition to the mapped claims. Defaults to false.
+func (o ClaimsMappingPolicyDefinitionOutput) IncludeBasicClaimSet() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ClaimsMappingPolicyDefinition) *bool { return v.IncludeBasicClaimSet }).(pulumi.BoolPtrOutput)
+}
+
+type ClaimsMappingPolicyDefinitionPtrOutput struct{ *pulumi.OutputState }
+
+func (ClaimsMappingPolicyDefinitionPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ClaimsMappingPolicyDefinition)(nil)).Elem()
+}
+
+func (o ClaimsMappingPolicyDefinitionPtrOutput) ToClaimsMappingPolicyDefinitionPtrOutput() ClaimsMappingPolicyDefinitionPtrOutput {
+	return o
+}
+
+func (o ClaimsMappingPolicyDefinitionPtrOutput) ToClaimsMappingPolicyDefinitionPtrOutputWithContext(ctx context.Context) ClaimsMappingPolicyDefinitionPtrOutput {
+	return o
+}
+
+func (o ClaimsMappingPolicyDefinitionPtrOutput) Elem() ClaimsMappingPolicyDefinitionOutput {
+	return o.ApplyT(func(v *ClaimsMappingPolicyDefinition) ClaimsMappingPolicyDefinition { return *v }).(ClaimsMappingPolicyDefinitionOutput)
+}
+
+// The claims emitted in tokens.
+func (o ClaimsMappingPolicyDefinitionPtrOutput) ClaimsSchema() ClaimsMappingPolicyClaimArrayOutput {
+	return o.ApplyT(func(v *ClaimsMappingPolicyDefinition) []ClaimsMappingPolicyClaim {
+		if v == nil {
+			return nil
+		}
+		return v.ClaimsSchema
+	}).(ClaimsMappingPolicyClaimArrayOutput)
+}
+
+// Whether the basic claim set is included in tokens in addition to the mapped claims. Defaults to false.
+func (o ClaimsMappingPolicyDefinitionPtrOutput) IncludeBasicClaimSet() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *ClaimsMappingPolicyDefinition) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.IncludeBasicClaimSet
+	}).(pulumi.BoolPtrOutput)
+}
+
+// A client application that can use some of the API's scopes without user consent.
+type ExposeApiPreAuthorizedApplication struct {
+	// The app ID of the client application.
+	AppId string `pulumi:"appId"`
+	// The values of the scopes the client application is pre-authorized for.
+	Scopes []string `pulumi:"scopes"`
+}
+
+// ExposeApiPreAuthorizedApplicationInput is an input type that accepts ExposeApiPreAuthorizedApplicationArgs and ExposeApiPreAuthorizedApplicationOutput values.
+// You can construct a concrete instance of `ExposeApiPreAuthorizedApplicationInput` via:
+//
+//	ExposeApiPreAuthorizedApplicationArgs{...}
+type ExposeApiPreAuthorizedApplicationInput interface {
+	pulumi.Input
+
+	ToExposeApiPreAuthorizedApplicationOutput() ExposeApiPreAuthorizedApplicationOutput
+	ToExposeApiPreAuthorizedApplicationOutputWithContext(context.Context) ExposeApiPreAuthorizedApplicationOutput
+}
+
+// A client application that can use some of the API's scopes without user consent.
+type ExposeApiPreAuthorizedApplicationArgs struct {
+	// The app ID of the client application.
+	AppId pulumi.StringInput `pulumi:"appId"`
+	// The values of the scopes the client application is pre-authorized for.
+	Scopes pulumi.StringArrayInput `pulumi:"scopes"`
+}
+
+func (ExposeApiPreAuthorizedApplicationArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ExposeApiPreAuthorizedApplication)(nil)).Elem()
+}
+
+func (i ExposeApiPreAuthorizedApplicationArgs) ToExposeApiPreAuthorizedApplicationOutput() ExposeApiPreAuthorizedApplicationOutput {
+	return i.ToExposeApiPreAuthorizedApplicationOutputWithContext(context.Background())
+}
+
+func (i ExposeApiPreAuthorizedApplicationArgs) ToExposeApiPreAuthorizedApplicationOutputWithContext(ctx context.Context) ExposeApiPreAuthorizedApplicationOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ExposeApiPreAuthorizedApplicationOutput)
+}
+
+// ExposeApiPreAuthorizedApplicationArrayInput is an input type that accepts ExposeApiPreAuthorizedApplicationArray and ExposeApiPreAuthorizedApplicationArrayOutput values.
+// You can construct a concrete instance of `ExposeApiPreAuthorizedApplicationArrayInput` via:
+//
+//	ExposeApiPreAuthorizedApplicationArray{ ExposeApiPreAuthorizedApplicationArgs{...} }
+type ExposeApiPreAuthorizedApplicationArrayInput interface {
+	pulumi.Input
+
+	ToExposeApiPreAuthorizedApplicationArrayOutput() ExposeApiPreAuthorizedApplicationArrayOutput
+	ToExposeApiPreAuthorizedApplicationArrayOutputWithContext(context.Context) ExposeApiPreAuthorizedApplicationArrayOutput
+}
+
+type ExposeApiPreAuthorizedApplicationArray []ExposeApiPreAuthorizedApplicationInput
+
+func (ExposeApiPreAuthorizedApplicationArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ExposeApiPreAuthorizedApplication)(nil)).Elem()
+}
+
+func (i ExposeApiPreAuthorizedApplicationArray) ToExposeApiPreAuthorizedApplicationArrayOutput() ExposeApiPreAuthorizedApplicationArrayOutput {
+	return i.ToExposeApiPreAuthorizedApplicationArrayOutputWithContext(context.Background())
+}
+
+func (i ExposeApiPreAuthorizedApplicationArray) ToExposeApiPreAuthorizedApplicationArrayOutputWithContext(ctx context.Context) ExposeApiPreAuthorizedApplicationArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ExposeApiPreAuthorizedApplicationArrayOutput)
+}
+
+// A client application that can use some of the API's scopes without user consent.
+type ExposeApiPreAuthorizedApplicationOutput struct{ *pulumi.OutputState }
+
+func (ExposeApiPreAuthorizedApplicationOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ExposeApiPreAuthorizedApplication)(nil)).Elem()
+}
+
+func (o ExposeApiPreAuthorizedApplicationOutput) ToExposeApiPreAuthorizedApplicationOutput() ExposeApiPreAuthorizedApplicationOutput {
+	return o
+}
+
+func (o ExposeApiPreAuthorizedApplicationOutput) ToExposeApiPreAuthorizedApplicationOutputWithContext(ctx context.Context) ExposeApiPreAuthorizedApplicationOutput {
+	return o
+}
+
+// The app ID of the client application.
+func (o ExposeApiPreAuthorizedApplicationOutput) AppId() pulumi.StringOutput {
+	return o.ApplyT(func(v ExposeApiPreAuthorizedApplication) string { return v.AppId }).(pulumi.StringOutput)
+}
+
+// The values of the scopes the client application is pre-authorized for.
+func (o ExposeApiPreAuthorizedApplicationOutput) Scopes() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v ExposeApiPreAuthorizedApplication) []string { return v.Scopes }).(pulumi.StringArrayOutput)
+}
+
+type ExposeApiPreAuthorizedApplicationArrayOutput struct{ *pulumi.OutputState }
+
+func (ExposeApiPreAuthorizedApplicationArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ExposeApiPreAuthorizedApplication)(nil)).Elem()
+}
+
+func (o ExposeApiPreAuthorizedApplicationArrayOutput) ToExposeApiPreAuthorizedApplicationArrayOutput() ExposeApiPreAuthorizedApplicationArrayOutput {
+	return o
+}
+
+func (o ExposeApiPreAuthorizedApplicationArrayOutput) ToExposeApiPreAuthorizedApplicationArrayOutputWithContext(ctx context.Context) ExposeApiPreAuthorizedApplicationArrayOutput {
+	return o
+}
+
+func (o ExposeApiPreAuthorizedApplicationArrayOutput) Index(i pulumi.IntInput) ExposeApiPreAuthorizedApplicationOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ExposeApiPreAuthorizedApplication {
+		return vs[0].([]ExposeApiPreAuthorizedApplication)[vs[1].(int)]
+	}).(ExposeApiPreAuthorizedApplicationOutput)
+}
+
+// Builds the subject of a federated identity credential for GitHub Actions. Exactly one of branch, tag, environment and pullRequest must be set.
+type GitHubFederatedSubject struct {
+	// Trust workflows running on this branch.
+	Branch *string `pulumi:"branch"`
+	// Trust jobs that use this deployment environment.
+	Environment *string `pulumi:"environment"`
+	// Trust workflows triggered by pull requests.
+	PullRequest *bool `pulumi:"pullRequest"`
+	// The repository, in the form 'owner/repository'.
+	Repository string `pulumi:"repository"`
+	// Trust workflows running on this tag.
+	Tag *string `pulumi:"tag"`
+}
+
+// GitHubFederatedSubjectInput is an input type that accepts GitHubFederatedSubjectArgs and GitHubFederatedSubjectOutput values.
+// You can construct a concrete instance of `GitHubFederatedSubjectInput` via:
+//
+//	GitHubFederatedSubjectArgs{...}
+type GitHubFederatedSubjectInput interface {
+	pulumi.Input
+
+	ToGitHubFederatedSubjectOutput() GitHubFederatedSubjectOutput
+	ToGitHubFederatedSubjectOutputWithContext(context.Context) GitHubFederatedSubjectOutput
+}
+
+// Builds the subject of a federated identity credential for GitHub Actions. Exactly one of branch, tag, environment and pullRequest must be set.
+type GitHubFederatedSubjectArgs struct {
+	// Trust workflows running on this branch.
+	Branch pulumi.StringPtrInput `pulumi:"branch"`
+	// Trust jobs that use this deployment environment.
+	Environment pulumi.StringPtrInput `pulumi:"environment"`
+	// Trust workflows triggered by pull requests.
+	PullRequest pulumi.BoolPtrInput `pulumi:"pullRequest"`
+	// The repository, in the form 'owner/repository'.
+	Repository pulumi.StringInput `pulumi:"repository"`
+	// Trust workflows running on this tag.
+	Tag pulumi.StringPtrInput `pulumi:"tag"`
+}
+
+func (GitHubFederatedSubjectArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GitHubFederatedSubject)(nil)).Elem()
+}
+
+func (i GitHubFederatedSubjectArgs) ToGitHubFederatedSubjectOutput() GitHubFederatedSubjectOutput {
+	return i.ToGitHubFederatedSubjectOutputWithContext(context.Background())
+}
+
+func (i GitHubFederatedSubjectArgs) ToGitHubFederatedSubjectOutputWithContext(ctx context.Context) GitHubFederatedSubjectOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(GitHubFederatedSubjectOutput)
+}
+
+func (i GitHubFederatedSubjectArgs) ToGitHubFederatedSubjectPtrOutput() GitHubFederatedSubjectPtrOutput {
+	return i.ToGitHubFederatedSubjectPtrOutputWithContext(context.Background())
+}
+
+func (i GitHubFederatedSubjectArgs) ToGitHubFederatedSubjectPtrOutputWithContext(ctx context.Context) GitHubFederatedSubjectPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(GitHubFederatedSubjectOutput).ToGitHubFederatedSubjectPtrOutputWithContext(ctx)
+}
+
+// GitHubFederatedSubjectPtrInput is an input type that accepts GitHubFederatedSubjectArgs, GitHubFederatedSubjectPtr and GitHubFederatedSubjectPtrOutput values.
+// You can construct a concrete instance of `GitHubFederatedSubjectPtrInput` via:
+//
+//	        GitHubFederatedSubjectArgs{...}
+//
+//	or:
+//
+//	        nil
+type GitHubFederatedSubjectPtrInput interface {
+	pulumi.Input
+
+	ToGitHubFederatedSubjectPtrOutput() GitHubFederatedSubjectPtrOutput
+	ToGitHubFederatedSubjectPtrOutputWithContext(context.Context) GitHubFederatedSubjectPtrOutput
+}
+
+type gitHubFederatedSubjectPtrType GitHubFederatedSubjectArgs
+
+func GitHubFederatedSubjectPtr(v *GitHubFederatedSubjectArgs) GitHubFederatedSubjectPtrInput {
+	return (*gitHubFederatedSubjectPtrType)(v)
+}
+
+func (*gitHubFederatedSubjectPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**GitHubFederatedSubject)(nil)).Elem()
+}
+
+func (i *gitHubFederatedSubjectPtrType) ToGitHubFederatedSubjectPtrOutput() GitHubFederatedSubjectPtrOutput {
+	return i.ToGitHubFederatedSubjectPtrOutputWithContext(context.Background())
+}
+
+func (i *gitHubFederatedSubjectPtrType) ToGitHubFederatedSubjectPtrOutputWithContext(ctx context.Context) GitHubFederatedSubjectPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(GitHubFederatedSubjectPtrOutput)
+}
+
+// Builds the subject of a federated identity credential for GitHub Actions. Exactly one of branch, tag, environment and pullRequest must be set.
+type GitHubFederatedSubjectOutput struct{ *pulumi.OutputState }
+
+func (GitHubFederatedSubjectOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GitHubFederatedSubject)(nil)).Elem()
+}
+
+func (o GitHubFederatedSubjectOutput) ToGitHubFederatedSubjectOutput() GitHubFederatedSubjectOutput {
+	return o
+}
+
+func (o GitHubFederatedSubjectOutput) ToGitHubFederatedSubjectOutputWithContext(ctx context.Context) GitHubFederatedSubjectOutput {
+	return o
+}
+
+func (o GitHubFederatedSubjectOutput) ToGitHubFederatedSubjectPtrOutput() GitHubFederatedSubjectPtrOutput {
+	return o.ToGitHubFederatedSubjectPtrOutputWithContext(context.Background())
+}
+
+func (o GitHubFederatedSubjectOutput) ToGitHubFederatedSubjectPtrOutputWithContext(ctx context.Context) GitHubFederatedSubjectPtrOutput {
+	return o.ApplyT(func(v GitHubFederatedSubject) *GitHubFederatedSubject {
+		return &v
+	}).(GitHubFederatedSubjectPtrOutput)
+}
+
+// Trust workflows running on this branch.
+func (o GitHubFederatedSubjectOutput) Branch() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v GitHubFederatedSubject) *string { return v.Branch }).(pulumi.StringPtrOutput)
+}
+
+// Trust jobs that use this deployment environment.
+func (o GitHubFederatedSubjectOutput) Environment() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v GitHubFederatedSubject) *string { return v.Environment }).(pulumi.StringPtrOutput)
+}
+
+// Trust workflows triggered by pull requests.
+func (o GitHubFederatedSubjectOutput) PullRequest() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v GitHubFederatedSubject) *bool { return v.PullRequest }).(pulumi.BoolPtrOutput)
+}
+
+// The repository, in the form 'owner/repository'.
+func (o GitHubFederatedSubjectOutput) Repository() pulumi.StringOutput {
+	return o.ApplyT(func(v GitHubFederatedSubject) string { return v.Repository }).(pulumi.StringOutput)
+}
+
+// Trust workflows running on this tag.
+func (o GitHubFederatedSubjectOutput) Tag() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v GitHubFederatedSubject) *string { return v.Tag }).(pulumi.StringPtrOutput)
+}
+
+type GitHubFederatedSubjectPtrOutput struct{ *pulumi.OutputState }
+
+func (GitHubFederatedSubjectPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**GitHubFederatedSubject)(nil)).Elem()
+}
+
+func (o GitHubFederatedSubjectPtrOutput) ToGitHubFederatedSubjectPtrOutput() GitHubFederatedSubjectPtrOutput {
+	return o
+}
+
+func (o GitHubFederatedSubjectPtrOutput) ToGitHubFederatedSubjectPtrOutputWithContext(ctx context.Context) GitHubFederatedSubjectPtrOutput {
+	return o
+}
+
+func (o GitHubFederatedSubjectPtrOutput) Elem() GitHubFederatedSubjectOutput {
+	return o.ApplyT(func(v *GitHubFederatedSubject) GitHubFederatedSubject { return *v }).(GitHubFederatedSubjectOutput)
+}
+
+// Trust workflows running on this branch.
+func (o GitHubFederatedSubjectPtrOutput) Branch() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GitHubFederatedSubject) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Branch
+	}).(pulumi.StringPtrOutput)
+}
+
+// Trust jobs that use this deployment environment.
+func (o GitHubFederatedSubjectPtrOutput) Environment() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GitHubFederatedSubject) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Environment
+	}).(pulumi.StringPtrOutput)
+}
+
+// Trust workflows triggered by pull requests.
+func (o GitHubFederatedSubjectPtrOutput) PullRequest() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *GitHubFederatedSubject) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.PullRequest
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The repository, in the form 'owner/repository'.
+func (o GitHubFederatedSubjectPtrOutput) Repository() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GitHubFederatedSubject) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.Repository
+	}).(pulumi.StringPtrOutput)
+}
+
+// Trust workflows running on this tag.
+func (o GitHubFederatedSubjectPtrOutput) Tag() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GitHubFederatedSubject) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Tag
+	}).(pulumi.StringPtrOutput)
+}
+
+// Builds the subject of a federated identity credential for a Kubernetes service account.
+type KubernetesFederatedSubject struct {
+	// The namespace of the service account.
+	Namespace string `pulumi:"namespace"`
+	// The name of the service account.
+	ServiceAccount string `pulumi:"serviceAccount"`
+}
+
+// KubernetesFederatedSubjectInput is an input type that accepts KubernetesFederatedSubjectArgs and KubernetesFederatedSubjectOutput values.
+// You can construct a concrete instance of `KubernetesFederatedSubjectInput` via:
+//
+//	KubernetesFederatedSubjectArgs{...}
+type KubernetesFederatedSubjectInput interface {
+	pulumi.Input
+
+	ToKubernetesFederatedSubjectOutput() KubernetesFederatedSubjectOutput
+	ToKubernetesFederatedSubjectOutputWithContext(context.Context) KubernetesFederatedSubjectOutput
+}
+
+// Builds the subject of a federated identity credential for a Kubernetes service account.
+type KubernetesFederatedSubjectArgs struct {
+	// The namespace of the service account.
+	Namespace pulumi.StringInput `pulumi:"namespace"`
+	// The name of the service account.
+	ServiceAccount pulumi.StringInput `pulumi:"serviceAccount"`
+}
+
+func (KubernetesFederatedSubjectArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*KubernetesFederatedSubject)(nil)).Elem()
+}
+
+func (i KubernetesFederatedSubjectArgs) ToKubernetesFederatedSubjectOutput() KubernetesFederatedSubjectOutput {
+	return i.ToKubernetesFederatedSubjectOutputWithContext(context.Background())
+}
+
+func (i KubernetesFederatedSubjectArgs) ToKubernetesFederatedSubjectOutputWithContext(ctx context.Context) KubernetesFederatedSubjectOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(KubernetesFederatedSubjectOutput)
+}
+
+func (i KubernetesFederatedSubjectArgs) ToKubernetesFederatedSubjectPtrOutput() KubernetesFederatedSubjectPtrOutput {
+	return i.ToKubernetesFederatedSubjectPtrOutputWithContext(context.Background())
+}
+
+func (i KubernetesFederatedSubjectArgs) ToKubernetesFederatedSubjectPtrOutputWithContext(ctx context.Context) KubernetesFederatedSubjectPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(KubernetesFederatedSubjectOutput).ToKubernetesFederatedSubjectPtrOutputWithContext(ctx)
+}
+
+// KubernetesFederatedSubjectPtrInput is an input type that accepts KubernetesFederatedSubjectArgs, KubernetesFederatedSubjectPtr and KubernetesFederatedSubjectPtrOutput values.
+// You can construct a concrete instance of `KubernetesFederatedSubjectPtrInput` via:
+//
+//	        KubernetesFederatedSubjectArgs{...}
+//
+//	or:
+//
+//	        nil
+type KubernetesFederatedSubjectPtrInput interface {
+	pulumi.Input
+
+	ToKubernetesFederatedSubjectPtrOutput() KubernetesFederatedSubjectPtrOutput
+	ToKubernetesFederatedSubjectPtrOutputWithContext(context.Context) KubernetesFederatedSubjectPtrOutput
+}
+
+type kubernetesFederatedSubjectPtrType KubernetesFederatedSubjectArgs
+
+func KubernetesFederatedSubjectPtr(v *KubernetesFederatedSubjectArgs) KubernetesFederatedSubjectPtrInput {
+	return (*kubernetesFederatedSubjectPtrType)(v)
+}
+
+func (*kubernetesFederatedSubjectPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**KubernetesFederatedSubject)(nil)).Elem()
+}
+
+func (i *kubernetesFederatedSubjectPtrType) ToKubernetesFederatedSubjectPtrOutput() KubernetesFederatedSubjectPtrOutput {
+	return i.ToKubernetesFederatedSubjectPtrOutputWithContext(context.Background())
+}
+
+func (i *kubernetesFederatedSubjectPtrType) ToKubernetesFederatedSubjectPtrOutputWithContext(ctx context.Context) KubernetesFederatedSubjectPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(KubernetesFederatedSubjectPtrOutput)
+}
+
+// Builds the subject of a federated identity credential for a Kubernetes service account.
+type KubernetesFederatedSubjectOutput struct{ *pulumi.OutputState }
+
+func (KubernetesFederatedSubjectOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*KubernetesFederatedSubject)(nil)).Elem()
+}
+
+func (o KubernetesFederatedSubjectOutput) ToKubernetesFederatedSubjectOutput() KubernetesFederatedSubjectOutput {
+	return o
+}
+
+func (o KubernetesFederatedSubjectOutput) ToKubernetesFederatedSubjectOutputWithContext(ctx context.Context) KubernetesFederatedSubjectOutput {
+	return o
+}
+
+func (o KubernetesFederatedSubjectOutput) ToKubernetesFederatedSubjectPtrOutput() KubernetesFederatedSubjectPtrOutput {
+	return o.ToKubernetesFederatedSubjectPtrOutputWithContext(context.Background())
+}
+
+func (o KubernetesFederatedSubjectOutput) ToKubernetesFederatedSubjectPtrOutputWithContext(ctx context.Context) KubernetesFederatedSubjectPtrOutput {
+	return o.ApplyT(func(v KubernetesFederatedSubject) *KubernetesFederatedSubject {
+		return &v
+	}).(KubernetesFederatedSubjectPtrOutput)
+}
+
+// The namespace of the service account.
+func (o KubernetesFederatedSubjectOutput) Namespace() pulumi.StringOutput {
+	return o.ApplyT(func(v KubernetesFederatedSubject) string { return v.Namespace }).(pulumi.StringOutput)
+}
+
+// The name of the service account.
+func (o KubernetesFederatedSubjectOutput) ServiceAccount() pulumi.StringOutput {
+	return o.ApplyT(func(v KubernetesFederatedSubject) string { return v.ServiceAccount }).(pulumi.StringOutput)
+}
+
+type KubernetesFederatedSubjectPtrOutput struct{ *pulumi.OutputState }
+
+func (KubernetesFederatedSubjectPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**KubernetesFederatedSubject)(nil)).Elem()
+}
+
+func (o KubernetesFederatedSubjectPtrOutput) ToKubernetesFederatedSubjectPtrOutput() KubernetesFederatedSubjectPtrOutput {
+	return o
+}
+
+func (o KubernetesFederatedSubjectPtrOutput) ToKubernetesFederatedSubjectPtrOutputWithContext(ctx context.Context) KubernetesFederatedSubjectPtrOutput {
+	return o
+}
+
+func (o KubernetesFederatedSubjectPtrOutput) Elem() KubernetesFederatedSubjectOutput {
+	return o.ApplyT(func(v *KubernetesFederatedSubject) KubernetesFederatedSubject { return *v }).(KubernetesFederatedSubjectOutput)
+}
+
+// The namespace of the service account.
+func (o KubernetesFederatedSubjectPtrOutput) Namespace() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *KubernetesFederatedSubject) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.Namespace
+	}).(pulumi.StringPtrOutput)
+}
+
+// The name of the service account.
+func (o KubernetesFederatedSubjectPtrOutput) ServiceAccount() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *KubernetesFederatedSubject) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.ServiceAccount
+	}).(pulumi.StringPtrOutput)
+}
+
+// An API the application requires permissions on, with the permission names resolved to IDs.
+type RequiredResourceAccessResolvedResource struct {
+	// The IDs of the application permissions, by value.
+	AppRoleIds map[string]string `pulumi:"appRoleIds"`
+	// The app ID of the API.
+	ResourceAppId string `pulumi:"resourceAppId"`
+	// The object ID of the API's service principal.
+	ResourceId string `pulumi:"resourceId"`
+	// The IDs of the delegated permissions, by value.
+	ScopeIds map[string]string `pulumi:"scopeIds"`
+}
+
+// RequiredResourceAccessResolvedResourceInput is an input type that accepts RequiredResourceAccessResolvedResourceArgs and RequiredResourceAccessResolvedResourceOutput values.
+// You can construct a concrete instance of `RequiredResourceAccessResolvedResourceInput` via:
+//
+//	RequiredResourceAccessResolvedResourceArgs{...}
+type RequiredResourceAccessResolvedResourceInput interface {
+	pulumi.Input
+
+	ToRequiredResourceAccessResolvedResourceOutput() RequiredResourceAccessResolvedResourceOutput
+	ToRequiredResourceAccessResolvedResourceOutputWithContext(context.Context) RequiredResourceAccessResolvedResourceOutput
+}
+
+// An API the application requires permissions on, with the permission names resolved to IDs.
+type RequiredResourceAccessResolvedResourceArgs struct {
+	// The IDs of the application permissions, by value.
+	AppRoleIds pulumi.StringMapInput `pulumi:"appRoleIds"`
+	// The app ID of the API.
+	ResourceAppId pulumi.StringInput `pulumi:"resourceAppId"`
+	// The object ID of the API's service principal.
+	ResourceId pulumi.StringInput `pulumi:"resourceId"`
+	// The IDs of the delegated permissions, by value.
+	ScopeIds pulumi.StringMapInput `pulumi:"scopeIds"`
+}
+
+func (RequiredResourceAccessResolvedResourceArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*RequiredResourceAccessResolvedResource)(nil)).Elem()
+}
+
+func (i RequiredResourceAccessResolvedResourceArgs) ToRequiredResourceAccessResolvedResourceOutput() RequiredResourceAccessResolvedResourceOutput {
+	return i.ToRequiredResourceAccessResolvedResourceOutputWithContext(context.Background())
+}
+
+func (i RequiredResourceAccessResolvedResourceArgs) ToRequiredResourceAccessResolvedResourceOutputWithContext(ctx context.Context) RequiredResourceAccessResolvedResourceOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(RequiredResourceAccessResolvedResourceOutput)
+}
+
+// RequiredResourceAccessResolvedResourceArrayInput is an input type that accepts RequiredResourceAccessResolvedResourceArray and RequiredResourceAccessResolvedResourceArrayOutput values.
+// You can construct a concrete instance of `RequiredResourceAccessResolvedResourceArrayInput` via:
+//
+//	RequiredResourceAccessResolvedResourceArray{ RequiredResourceAccessResolvedResourceArgs{...} }
+type RequiredResourceAccessResolvedResourceArrayInput interface {
+	pulumi.Input
+
+	ToRequiredResourceAccessResolvedResourceArrayOutput() RequiredResourceAccessResolvedResourceArrayOutput
+	ToRequiredResourceAccessResolvedResourceArrayOutputWithContext(context.Context) RequiredResourceAccessResolvedResourceArrayOutput
+}
+
+type RequiredResourceAccessResolvedResourceArray []RequiredResourceAccessResolvedResourceInput
+
+func (RequiredResourceAccessResolvedResourceArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]RequiredResourceAccessResolvedResource)(nil)).Elem()
+}
+
+func (i RequiredResourceAccessResolvedResourceArray) ToRequiredResourceAccessResolvedResourceArrayOutput() RequiredResourceAccessResolvedResourceArrayOutput {
+	return i.ToRequiredResourceAccessResolvedResourceArrayOutputWithContext(context.Background())
+}
+
+func (i RequiredResourceAccessResolvedResourceArray) ToRequiredResourceAccessResolvedResourceArrayOutputWithContext(ctx context.Context) RequiredResourceAccessResolvedResourceArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(RequiredResourceAccessResolvedResourceArrayOutput)
+}
+
+// An API the application requires permissions on, with the permission names resolved to IDs.
+type RequiredResourceAccessResolvedResourceOutput struct{ *pulumi.OutputState }
+
+func (RequiredResourceAccessResolvedResourceOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*RequiredResourceAccessResolvedResource)(nil)).Elem()
+}
+
+func (o RequiredResourceAccessResolvedResourceOutput) ToRequiredResourceAccessResolvedResourceOutput() RequiredResourceAccessResolvedResourceOutput {
+	return o
+}
+
+func (o RequiredResourceAccessResolvedResourceOutput) ToRequiredResourceAccessResolvedResourceOutputWithContext(ctx context.Context) RequiredResourceAccessResolvedResourceOutput {
+	return o
+}
+
+// The IDs of the application permissions, by value.
+func (o RequiredResourceAccessResolvedResourceOutput) AppRoleIds() pulumi.StringMapOutput {
+	return o.ApplyT(func(v RequiredResourceAccessResolvedResource) map[string]string { return v.AppRoleIds }).(pulumi.StringMapOutput)
+}
+
+// The app ID of the API.
+func (o RequiredResourceAccessResolvedResourceOutput) ResourceAppId() pulumi.StringOutput {
+	return o.ApplyT(func(v RequiredResourceAccessResolvedResource) string { return v.ResourceAppId }).(pulumi.StringOutput)
+}
+
+// The object ID of the API's service principal.
+func (o RequiredResourceAccessResolvedResourceOutput) ResourceId() pulumi.StringOutput {
+	return o.ApplyT(func(v RequiredResourceAccessResolvedResource) string { return v.ResourceId }).(pulumi.StringOutput)
+}
+
+// The IDs of the delegated permissions, by value.
+func (o RequiredResourceAccessResolvedResourceOutput) ScopeIds() pulumi.StringMapOutput {
+	return o.ApplyT(func(v RequiredResourceAccessResolvedResource) map[string]string { return v.ScopeIds }).(pulumi.StringMapOutput)
+}
+
+type RequiredResourceAccessResolvedResourceArrayOutput struct{ *pulumi.OutputState }
+
+func (RequiredResourceAccessResolvedResourceArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]RequiredResourceAccessResolvedResource)(nil)).Elem()
+}
+
+func (o RequiredResourceAccessResolvedResourceArrayOutput) ToRequiredResourceAccessResolvedResourceArrayOutput() RequiredResourceAccessResolvedResourceArrayOutput {
+	return o
+}
+
+func (o RequiredResourceAccessResolvedResourceArrayOutput) ToRequiredResourceAccessResolvedResourceArrayOutputWithContext(ctx context.Context) RequiredResourceAccessResolvedResourceArrayOutput {
+	return o
+}
+
+func (o RequiredResourceAccessResolvedResourceArrayOutput) Index(i pulumi.IntInput) RequiredResourceAccessResolvedResourceOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) RequiredResourceAccessResolvedResource {
+		return vs[0].([]RequiredResourceAccessResolvedResource)[vs[1].(int)]
+	}).(RequiredResourceAccessResolvedResourceOutput)
+}
+
+// An API the application requires permissions on, with the permissions given by name.
+type RequiredResourceAccessResource struct {
+	// The values of the application permissions (app roles) to require, like 'User.Read.All'.
+	ApplicationPermissions []string `pulumi:"applicationPermissions"`
+	// The values of the delegated permissions (scopes) to require, like 'User.Read' or 'openid'.
+	DelegatedPermissions []string `pulumi:"delegatedPermissions"`
+	// The API, either as the app ID of its application or as one of the well-known names: 'MicrosoftGraph', 'AzureADGraph', 'AzureKeyVault', 'AzureServiceManagement', 'AzureStorage', 'Office365ExchangeOnline' or 'SharePointOnline'.
+	ResourceApp string `pulumi:"resourceApp"`
+}
+
+// RequiredResourceAccessResourceInput is an input type that accepts RequiredResourceAccessResourceArgs and RequiredResourceAccessResourceOutput values.
+// You can construct a concrete instance of `RequiredResourceAccessResourceInput` via:
+//
+//	RequiredResourceAccessResourceArgs{...}
+type RequiredResourceAccessResourceInput interface {
+	pulumi.Input
+
+	ToRequiredResourceAccessResourceOutput() RequiredResourceAccessResourceOutput
+	ToRequiredResourceAccessResourceOutputWithContext(context.Context) RequiredResourceAccessResourceOutput
+}
+
+// An API the application requires permissions on, with the permissions given by name.
+type RequiredResourceAccessResourceArgs struct {
+	// The values of the application permissions (app roles) to require, like 'User.Read.All'.
+	ApplicationPermissions pulumi.StringArrayInput `pulumi:"applicationPermissions"`
+	// The values of the delegated permissions (scopes) to require, like 'User.Read' or 'openid'.
+	DelegatedPermissions pulumi.StringArrayInput `pulumi:"delegatedPermissions"`
+	// The API, either as the app ID of its application or as one of the well-known names: 'MicrosoftGraph', 'AzureADGraph', 'AzureKeyVault', 'AzureServiceManagement', 'AzureStorage', 'Office365ExchangeOnline' or 'SharePointOnline'.
+	ResourceApp pulumi.StringInput `pulumi:"resourceApp"`
+}
+
+func (RequiredResourceAccessResourceArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*RequiredResourceAccessResource)(nil)).Elem()
+}
+
+func (i RequiredResourceAccessResourceArgs) ToRequiredResourceAccessResourceOutput() RequiredResourceAccessResourceOutput {
+	return i.ToRequiredResourceAccessResourceOutputWithContext(context.Background())
+}
+
+func (i RequiredResourceAccessResourceArgs) ToRequiredResourceAccessResourceOutputWithContext(ctx context.Context) RequiredResourceAccessResourceOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(RequiredResourceAccessResourceOutput)
+}
+
+// RequiredResourceAccessResourceArrayInput is an input type that accepts RequiredResourceAccessResourceArray and RequiredResourceAccessResourceArrayOutput values.
+// You can construct a concrete instance of `RequiredResourceAccessResourceArrayInput` via:
+//
+//	RequiredResourceAccessResourceArray{ RequiredResourceAccessResourceArgs{...} }
+type RequiredResourceAccessResourceArrayInput interface {
+	pulumi.Input
+
+	ToRequiredResourceAccessResourceArrayOutput() RequiredResourceAccessResourceArrayOutput
+	ToRequiredResourceAccessResourceArrayOutputWithContext(context.Context) RequiredResourceAccessResourceArrayOutput
+}
+
+type RequiredResourceAccessResourceArray []RequiredResourceAccessResourceInput
+
+func (RequiredResourceAccessResourceArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]RequiredResourceAccessResource)(nil)).Elem()
+}
+
+func (i RequiredResourceAccessResourceArray) ToRequiredResourceAccessResourceArrayOutput() RequiredResourceAccessResourceArrayOutput {
+	return i.ToRequiredResourceAccessResourceArrayOutputWithContext(context.Background())
+}
+
+func (i RequiredResourceAccessResourceArray) ToRequiredResourceAccessResourceArrayOutputWithContext(ctx context.Context) RequiredResourceAccessResourceArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(RequiredResourceAccessResourceArrayOutput)
+}
+
+// An API the application requires permissions on, with the permissions given by name.
+type RequiredResourceAccessResourceOutput struct{ *pulumi.OutputState }
+
+func (RequiredResourceAccessResourceOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*RequiredResourceAccessResource)(nil)).Elem()
+}
+
+func (o RequiredResourceAccessResourceOutput) ToRequiredResourceAccessResourceOutput() RequiredResourceAccessResourceOutput {
+	return o
+}
+
+func (o RequiredResourceAccessResourceOutput) ToRequiredResourceAccessResourceOutputWithContext(ctx context.Context) RequiredResourceAccessResourceOutput {
+	return o
+}
+
+// The values of the application permissions (app roles) to require, like 'User.Read.All'.
+func (o RequiredResourceAccessResourceOutput) ApplicationPermissions() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v RequiredResourceAccessResource) []string { return v.ApplicationPermissions }).(pulumi.StringArrayOutput)
+}
+
+// The values of the delegated permissions (scopes) to require, like 'User.Read' or 'openid'.
+func (o RequiredResourceAccessResourceOutput) DelegatedPermissions() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v RequiredResourceAccessResource) []string { return v.DelegatedPermissions }).(pulumi.StringArrayOutput)
+}
+
+// The API, either as the app ID of its application or as one of the well-known names: 'MicrosoftGraph', 'AzureADGraph', 'AzureKeyVault', 'AzureServiceManagement', 'AzureStorage', 'Office365ExchangeOnline' or 'SharePointOnline'.
+func (o RequiredResourceAccessResourceOutput) ResourceApp() pulumi.StringOutput {
+	return o.ApplyT(func(v RequiredResourceAccessResource) string { return v.ResourceApp }).(pulumi.StringOutput)
+}
+
+type RequiredResourceAccessResourceArrayOutput struct{ *pulumi.OutputState }
+
+func (RequiredResourceAccessResourceArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]RequiredResourceAccessResource)(nil)).Elem()
+}
+
+func (o RequiredResourceAccessResourceArrayOutput) ToRequiredResourceAccessResourceArrayOutput() RequiredResourceAccessResourceArrayOutput {
+	return o
+}
+
+func (o RequiredResourceAccessResourceArrayOutput) ToRequiredResourceAccessResourceArrayOutputWithContext(ctx context.Context) RequiredResourceAccessResourceArrayOutput {
+	return o
+}
+
+func (o RequiredResourceAccessResourceArrayOutput) Index(i pulumi.IntInput) RequiredResourceAccessResourceOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) RequiredResourceAccessResource {
+		return vs[0].([]RequiredResourceAccessResource)[vs[1].(int)]
+	}).(RequiredResourceAccessResourceOutput)
+}
+
+// The definition of a token lifetime policy.
+type TokenLifetimePolicyDefinition struct {
+	// The lifetime of access and ID tokens as a time span between '00:10:00' and '1.00:00:00', e.g. '01:00:00' for an hour.
+	AccessTokenLifetime string `pulumi:"accessTokenLifetime"`
+}
+
+// TokenLifetimePolicyDefinitionInput is an input type that accepts TokenLifetimePolicyDefinitionArgs and TokenLifetimePolicyDefinitionOutput values.
+// You can construct a concrete instance of `TokenLifetimePolicyDefinitionInput` via:
+//
+//	TokenLifetimePolicyDefinitionArgs{...}
+type TokenLifetimePolicyDefinitionInput interface {
+	pulumi.Input
+
+	ToTokenLifetimePolicyDefinitionOutput() TokenLifetimePolicyDefinitionOutput
+	ToTokenLifetimePolicyDefinitionOutputWithContext(context.Context) TokenLifetimePolicyDefinitionOutput
+}
+
+// The definition of a token lifetime policy.
+type TokenLifetimePolicyDefinitionArgs struct {
+	// The lifetime of access and ID tokens as a time span between '00:10:00' and '1.00:00:00', e.g. '01:00:00' for an hour.
+	AccessTokenLifetime pulumi.StringInput `pulumi:"accessTokenLifetime"`
+}
+
+func (TokenLifetimePolicyDefinitionArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*TokenLifetimePolicyDefinition)(nil)).Elem()
+}
+
+func (i TokenLifetimePolicyDefinitionArgs) ToTokenLifetimePolicyDefinitionOutput() TokenLifetimePolicyDefinitionOutput {
+	return i.ToTokenLifetimePolicyDefinitionOutputWithContext(context.Background())
+}
+
+func (i TokenLifetimePolicyDefinitionArgs) ToTokenLifetimePolicyDefinitionOutputWithContext(ctx context.Context) TokenLifetimePolicyDefinitionOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(TokenLifetimePolicyDefinitionOutput)
+}
+
+func (i TokenLifetimePolicyDefinitionArgs) ToTokenLifetimePolicyDefinitionPtrOutput() TokenLifetimePolicyDefinitionPtrOutput {
+	return i.ToTokenLifetimePolicyDefinitionPtrOutputWithContext(context.Background())
+}
+
+func (i TokenLifetimePolicyDefinitionArgs) ToTokenLifetimePolicyDefinitionPtrOutputWithContext(ctx context.Context) TokenLifetimePolicyDefinitionPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(TokenLifetimePolicyDefinitionOutput).ToTokenLifetimePolicyDefinitionPtrOutputWithContext(ctx)
+}
+
+// TokenLifetimePolicyDefinitionPtrInput is an input type that accepts TokenLifetimePolicyDefinitionArgs, TokenLifetimePolicyDefinitionPtr and TokenLifetimePolicyDefinitionPtrOutput values.
+// You can construct a concrete instance of `TokenLifetimePolicyDefinitionPtrInput` via:
+//
+//	        TokenLifetimePolicyDefinitionArgs{...}
+//
+//	or:
+//
+//	        nil
+type TokenLifetimePolicyDefinitionPtrInput interface {
+	pulumi.Input
+
+	ToTokenLifetimePolicyDefinitionPtrOutput() TokenLifetimePolicyDefinitionPtrOutput
+	ToTokenLifetimePolicyDefinitionPtrOutputWithContext(context.Context) TokenLifetimePolicyDefinitionPtrOutput
+}
+
+type tokenLifetimePolicyDefinitionPtrType TokenLifetimePolicyDefinitionArgs
+
+func TokenLifetimePolicyDefinitionPtr(v *TokenLifetimePolicyDefinitionArgs) TokenLifetimePolicyDefinitionPtrInput {
+	return (*tokenLifetimePolicyDefinitionPtrType)(v)
+}
+
+func (*tokenLifetimePolicyDefinitionPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**TokenLifetimePolicyDefinition)(nil)).Elem()
+}
+
+func (i *tokenLifetimePolicyDefinitionPtrType) ToTokenLifetimePolicyDefinitionPtrOutput() TokenLifetimePolicyDefinitionPtrOutput {
+	return i.ToTokenLifetimePolicyDefinitionPtrOutputWithContext(context.Background())
+}
+
+func (i *tokenLifetimePolicyDefinitionPtrType) ToTokenLifetimePolicyDefinitionPtrOutputWithContext(ctx context.Context) TokenLifetimePolicyDefinitionPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(TokenLifetimePolicyDefinitionPtrOutput)
+}
+
+// The definition of a token lifetime policy.
+type TokenLifetimePolicyDefinitionOutput struct{ *pulumi.OutputState }
+
+func (TokenLifetimePolicyDefinitionOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*TokenLifetimePolicyDefinition)(nil)).Elem()
+}
+
+func (o TokenLifetimePolicyDefinitionOutput) ToTokenLifetimePolicyDefinitionOutput() TokenLifetimePolicyDefinitionOutput {
+	return o
+}
+
+func (o TokenLifetimePolicyDefinitionOutput) ToTokenLifetimePolicyDefinitionOutputWithContext(ctx context.Context) TokenLifetimePolicyDefinitionOutput {
+	return o
+}
+
+func (o TokenLifetimePolicyDefinitionOutput) ToTokenLifetimePolicyDefinitionPtrOutput() TokenLifetimePolicyDefinitionPtrOutput {
+	return o.ToTokenLifetimePolicyDefinitionPtrOutputWithContext(context.Background())
+}
+
+func (o TokenLifetimePolicyDefinitionOutput) ToTokenLifetimePolicyDefinitionPtrOutputWithContext(ctx context.Context) TokenLifetimePolicyDefinitionPtrOutput {
+	return o.ApplyT(func(v TokenLifetimePolicyDefinition) *TokenLifetimePolicyDefinition {
+		return &v
+	}).(TokenLifetimePolicyDefinitionPtrOutput)
+}
+
+// The lifetime of access and ID tokens as a time span between '00:10:00' and '1.00:00:00', e.g. '01:00:00' for an hour.
+func (o TokenLifetimePolicyDefinitionOutput) AccessTokenLifetime() pulumi.StringOutput {
+	return o.ApplyT(func(v TokenLifetimePolicyDefinition) string { return v.AccessTokenLifetime }).(pulumi.StringOutput)
+}
+
+type TokenLifetimePolicyDefinitionPtrOutput struct{ *pulumi.OutputState }
+
+func (TokenLifetimePolicyDefinitionPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**TokenLifetimePolicyDefinition)(nil)).Elem()
+}
+
+func (o TokenLifetimePolicyDefinitionPtrOutput) ToTokenLifetimePolicyDefinitionPtrOutput() TokenLifetimePolicyDefinitionPtrOutput {
+	return o
+}
+
+func (o TokenLifetimePolicyDefinitionPtrOutput) ToTokenLifetimePolicyDefinitionPtrOutputWithContext(ctx context.Context) TokenLifetimePolicyDefinitionPtrOutput {
+	return o
+}
+
+func (o TokenLifetimePolicyDefinitionPtrOutput) Elem() TokenLifetimePolicyDefinitionOutput {
+	return o.ApplyT(func(v *TokenLifetimePolicyDefinition) TokenLifetimePolicyDefinition { return *v }).(TokenLifetimePolicyDefinitionOutput)
+}
+
+// The lifetime of access and ID tokens as a time span between '00:10:00' and '1.00:00:00', e.g. '01:00:00' for an hour.
+func (o TokenLifetimePolicyDefinitionPtrOutput) AccessTokenLifetime() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *TokenLifetimePolicyDefinition) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.AccessTokenLifetime
+	}).(pulumi.StringPtrOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(ApplicationApiOutput{})
+	pulumi.RegisterOutputType(ApplicationApiPtrOutput{})
+	pulumi.RegisterOutputType(ApplicationAppRoleOutput{})
+	pulumi.RegisterOutputType(ApplicationAppRoleArrayOutput{})
+	pulumi.RegisterOutputType(ApplicationImplicitGrantSettingsOutput{})
+	pulumi.RegisterOutputType(ApplicationImplicitGrantSettingsPtrOutput{})
+	pulumi.RegisterOutputType(ApplicationOptionalClaimOutput{})
+	pulumi.RegisterOutputType(ApplicationOptionalClaimArrayOutput{})
+	pulumi.RegisterOutputType(ApplicationOptionalClaimsOutput{})
+	pulumi.RegisterOutputType(ApplicationOptionalClaimsPtrOutput{})
+	pulumi.RegisterOutputType(ApplicationPermissionScopeOutput{})
+	pulumi.RegisterOutputType(ApplicationPermissionScopeArrayOutput{})
+	pulumi.RegisterOutputType(ApplicationPreAuthorizedApplicationOutput{})
+	pulumi.RegisterOutputType(ApplicationPreAuthorizedApplicationArrayOutput{})
+	pulumi.RegisterOutputType(ApplicationPublicClientOutput{})
+	pulumi.RegisterOutputType(ApplicationPublicClientPtrOutput{})
+	pulumi.RegisterOutputType(ApplicationRequiredResourceAccessOutput{})
+	pulumi.RegisterOutputType(ApplicationRequiredResourceAccessArrayOutput{})
+	pulumi.RegisterOutputType(ApplicationResourceAccessOutput{})
+	pulumi.RegisterOutputType(ApplicationResourceAccessArrayOutput{})
+	pulumi.RegisterOutputType(ApplicationSpaOutput{})
+	pulumi.RegisterOutputType(ApplicationSpaPtrOutput{})
+	pulumi.RegisterOutputType(ApplicationWebOutput{})
+	pulumi.RegisterOutputType(ApplicationWebPtrOutput{})
+	pulumi.RegisterOutputType(ClaimsMappingPolicyClaimOutput{})
+	pulumi.RegisterOutputType(ClaimsMappingPolicyClaimArrayOutput{})
+	pulumi.RegisterOutputType(ClaimsMappingPolicyDefinitionOutput{})
+	pulumi.RegisterOutputType(ClaimsMappingPolicyDefinitionPtrOutput{})
+	pulumi.RegisterOutputType(ExposeApiPreAuthorizedApplicationOutput{})
+	pulumi.RegisterOutputType(ExposeApiPreAuthorizedApplicationArrayOutput{})
+	pulumi.RegisterOutputType(GitHubFederatedSubjectOutput{})
+	pulumi.RegisterOutputType(GitHubFederatedSubjectPtrOutput{})
+	pulumi.RegisterOutputType(KubernetesFederatedSubjectOutput{})
+	pulumi.RegisterOutputType(KubernetesFederatedSubjectPtrOutput{})
+	pulumi.RegisterOutputType(RequiredResourceAccessResolvedResourceOutput{})
+	pulumi.RegisterOutputType(RequiredResourceAccessResolvedResourceArrayOutput{})
+	pulumi.RegisterOutputType(RequiredResourceAccessResourceOutput{})
+	pulumi.RegisterOutputType(RequiredResourceAccessResourceArrayOutput{})
+	pulumi.RegisterOutputType(TokenLifetimePolicyDefinitionOutput{})
+	pulumi.RegisterOutputType(TokenLifetimePolicyDefinitionPtrOutput{})
+}
@@ -0,0 +1,160 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package knapcode
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// Manages the API permissions an application requires, by permission name, and optionally grants admin consent for them. Entries of requiredResourceAccess for other APIs are left untouched. The resource ID is the object ID of the application.
+type RequiredResourceAccess struct {
+	pulumi.CustomResourceState
+
+	// The app ID of the application.
+	AppId pulumi.StringOutput `pulumi:"appId"`
+	// Whether to grant the permissions for the whole tenant, like the 'Grant admin consent' button in the portal does. This needs a service principal for the application. Defaults to false.
+	GrantAdminConsent pulumi.BoolPtrOutput `pulumi:"grantAdminConsent"`
+	// The object ID of the application. Changing this replaces the resource.
+	ObjectId pulumi.StringOutput `pulumi:"objectId"`
+	// The APIs with the permission names resolved to IDs.
+	ResolvedResources RequiredResourceAccessResolvedResourceArrayOutput `pulumi:"resolvedResources"`
+	// The APIs the application requires permissions on. Each API can only be listed once.
+	Resources RequiredResourceAccessResourceArrayOutput `pulumi:"resources"`
+	// The object ID of the application's service principal the permissions are granted to, if admin consent is granted.
+	ServicePrincipalId pulumi.StringPtrOutput `pulumi:"servicePrincipalId"`
+}
+
+// NewRequiredResourceAccess registers a new resource with the given unique name, arguments, and options.
+func NewRequiredResourceAccess(ctx *pulumi.Context,
+	name string, args *RequiredResourceAccessArgs, opts ...pulumi.ResourceOption) (*RequiredResourceAccess, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.ObjectId == nil {
+		return nil, errors.New("invalid value for required argument 'ObjectId'")
+	}
+	if args.Resources == nil {
+		return nil, errors.New("invalid value for required argument 'Resources'")
+	}
+	var resource RequiredResourceAccess
+	err := ctx.RegisterResource("knapcode:index:RequiredResourceAccess", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetRequiredResourceAccess gets an existing RequiredResourceAccess resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetRequiredResourceAccess(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *RequiredResourceAccessState, opts ...pulumi.ResourceOption) (*RequiredResourceAccess, error) {
+	var resource RequiredResourceAccess
+	err := ctx.ReadResource("knapcode:index:RequiredResourceAccess", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering RequiredResourceAccess resources.
+type requiredResourceAccessState struct {
+	// The app ID of the application.
+	AppId *string `pulumi:"appId"`
+	// Whether to grant the permissions for the whole tenant, like the 'Grant admin consent' button in the portal does. This needs a service principal for the application. Defaults to false.
+	GrantAdminConsent *bool `pulumi:"grantAdminConsent"`
+	// The object ID of the application. Changing this replaces the resource.
+	ObjectId *string `pulumi:"objectId"`
+	// The APIs with the permission names resolved to IDs.
+	ResolvedResources []RequiredResourceAccessResolvedResource `pulumi:"resolvedResources"`
+	// The APIs the application requires permissions on. Each API can only be listed once.
+	Resources []RequiredResourceAccessResource `pulumi:"resources"`
+	// The object ID of the application's service principal the permissions are granted to, if admin consent is granted.
+	ServicePrincipalId *string `pulumi:"servicePrincipalId"`
+}
+
+type RequiredResourceAccessState struct {
+	// The app ID of the application.
+	AppId pulumi.StringPtrInput
+	// Whether to grant the permissions for the whole tenant, like the 'Grant admin consent' button in the portal does. This needs a service principal for the application. Defaults to false.
+	GrantAdminConsent pulumi.BoolPtrInput
+	// The object ID of the application. Changing this replaces the resource.
+	ObjectId pulumi.StringPtrInput
+	// The APIs with the permission names resolved to IDs.
+	ResolvedResources RequiredResourceAccessResolvedResourceArrayInput
+	// The APIs the application requires permissions on. Each API can only be listed once.
+	Resources RequiredResourceAccessResourceArrayInput
+	// The object ID of the application's service principal the permissions are granted to, if admin consent is granted.
+	ServicePrincipalId pulumi.StringPtrInput
+}
+
+func (RequiredResourceAccessState) ElementType() reflect.Type {
+	return reflect.TypeOf((*requiredResourceAccessState)(nil)).Elem()
+}
+
+type requiredResourceAccessArgs struct {
+	// Whether to grant the permissions for the whole tenant, like the 'Grant admin consent' button in the portal does. This needs a service principal for the application. Defaults to false.
+	GrantAdminConsent *bool `pulumi:"grantAdminConsent"`
+	// The object ID of the application. Changing this replaces the resource.
+	ObjectId string `pulumi:"objectId"`
+	// The APIs the application requires permissions on. Each API can only be listed once.
+	Resources []RequiredResourceAccessResource `pulumi:"resources"`
+}
+
+// The set of arguments for constructing a RequiredResourceAccess resource.
+type RequiredResourceAccessArgs struct {
+	// Whether to grant the permissions for the whole tenant, like the 'Grant admin consent' button in the portal does. This needs a service principal for the application. Defaults to false.
+	GrantAdminConsent pulumi.BoolPtrInput
+	// The object ID of the application. Changing this replaces the resource.
+	ObjectId pulumi.StringInput
+	// The APIs the application requires permissions on. Each API can only be listed once.
+	Resources RequiredResourceAccessResourceArrayInput
+}
+
+func (RequiredResourceAccessArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*requiredResourceAccessArgs)(nil)).Elem()
+}
+
+type RequiredResourceAccessInput interface {
+	pulumi.Input
+
+	ToRequiredResourceAccessOutput() RequiredResourceAccessOutput
+	ToRequiredResourceAccessOutputWithContext(ctx context.Context) RequiredResourceAccessOutput
+}
+
+func (*RequiredResourceAccess) ElementType() reflect.Type {
+	return reflect.TypeOf((*RequiredResourceAccess)(nil))
+}
+
+func (i *RequiredResourceAccess) ToRequiredResourceAccessOutput() RequiredResourceAccessOutput {
+	return i.ToRequiredResourceAccessOutputWithContext(context.Background())
+}
+
+func (i *RequiredResourceAccess) ToRequiredResourceAccessOutputWithContext(ctx context.Context) RequiredResourceAccessOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(RequiredResourceAccessOutput)
+}
+
+type RequiredResourceAccessOutput struct {
+	*pulumi.OutputState
+}
+
+func (RequiredResourceAccessOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*RequiredResourceAccess)(nil))
+}
+
+func (o RequiredResourceAccessOutput) ToRequiredResourceAccessOutput() RequiredResourceAccessOutput {
+	return o
+}
+
+func (o RequiredResourceAccessOutput) ToRequiredResourceAccessOutputWithContext(ctx context.Context) RequiredResourceAccessOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(RequiredResourceAccessOutput{})
+}
@@ -0,0 +1,34 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package knapcode
+
+import (
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// Restores a soft-deleted application from the directory's deleted items and waits for it to be available. An application that is not deleted is left as-is. Functions also run during previews, so use the RestoredApplication resource to only restore the application when the stack is updated.
+func RestoreDeletedApplication(ctx *pulumi.Context, args *RestoreDeletedApplicationArgs, opts ...pulumi.InvokeOption) (*RestoreDeletedApplicationResult, error) {
+	var rv RestoreDeletedApplicationResult
+	err := ctx.Invoke("knapcode:index:restoreDeletedApplication", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type RestoreDeletedApplicationArgs struct {
+	// The display name of the deleted application. Either this or `objectId` must be set.
+	DisplayName *string `pulumi:"displayName"`
+	// The object ID of the deleted application. Either this or `displayName` must be set.
+	ObjectId *string `pulumi:"objectId"`
+}
+
+type RestoreDeletedApplicationResult struct {
+	// The application (client) ID of the restored application.
+	AppId string `pulumi:"appId"`
+	// The display name of the restored application.
+	DisplayName string `pulumi:"displayName"`
+	// The object ID of the restored application.
+	ObjectId string `pulumi:"objectId"`
+}
@@ -0,0 +1,131 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package knapcode
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// Restores a soft-deleted application from the directory's deleted items, keeping its object ID and application ID. Deleting this resource leaves the application in place.
+type RestoredApplication struct {
+	pulumi.CustomResourceState
+
+	// The application (client) ID of the restored application.
+	AppId pulumi.StringOutput `pulumi:"appId"`
+	// The display name of the restored application.
+	DisplayName pulumi.StringOutput `pulumi:"displayName"`
+	// The object ID of the restored application.
+	ObjectId pulumi.StringOutput `pulumi:"objectId"`
+}
+
+// NewRestoredApplication registers a new resource with the given unique name, arguments, and options.
+func NewRestoredApplication(ctx *pulumi.Context,
+	name string, args *RestoredApplicationArgs, opts ...pulumi.ResourceOption) (*RestoredApplication, error) {
+	if args == nil {
+		args = &RestoredApplicationArgs{}
+	}
+
+	var resource RestoredApplication
+	err := ctx.RegisterResource("knapcode:index:RestoredApplication", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetRestoredApplication gets an existing RestoredApplication resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetRestoredApplication(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *RestoredApplicationState, opts ...pulumi.ResourceOption) (*RestoredApplication, error) {
+	var resource RestoredApplication
+	err := ctx.ReadResource("knapcode:index:RestoredApplication", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering RestoredApplication resources.
+type restoredApplicationState struct {
+	// The application (client) ID of the restored application.
+	AppId *string `pulumi:"appId"`
+	// The display name of the restored application.
+	DisplayName *string `pulumi:"displayName"`
+	// The object ID of the restored application.
+	ObjectId *string `pulumi:"objectId"`
+}
+
+type RestoredApplicationState struct {
+	// The application (client) ID of the restored application.
+	AppId pulumi.StringPtrInput
+	// The display name of the restored application.
+	DisplayName pulumi.StringPtrInput
+	// The object ID of the restored application.
+	ObjectId pulumi.StringPtrInput
+}
+
+func (RestoredApplicationState) ElementType() reflect.Type {
+	return reflect.TypeOf((*restoredApplicationState)(nil)).Elem()
+}
+
+type restoredApplicationArgs struct {
+	// The display name of the deleted application. Either this or `objectId` must be set.
+	DisplayName *string `pulumi:"displayName"`
+	// The object ID of the deleted application. Either this or `displayName` must be set.
+	ObjectId *string `pulumi:"objectId"`
+}
+
+// The set of arguments for constructing a RestoredApplication resource.
+type RestoredApplicationArgs struct {
+	// The display name of the deleted application. Either this or `objectId` must be set.
+	DisplayName pulumi.StringPtrInput
+	// The object ID of the deleted application. Either this or `displayName` must be set.
+	ObjectId pulumi.StringPtrInput
+}
+
+func (RestoredApplicationArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*restoredApplicationArgs)(nil)).Elem()
+}
+
+type RestoredApplicationInput interface {
+	pulumi.Input
+
+	ToRestoredApplicationOutput() RestoredApplicationOutput
+	ToRestoredApplicationOutputWithContext(ctx context.Context) RestoredApplicationOutput
+}
+
+func (*RestoredApplication) ElementType() reflect.Type {
+	return reflect.TypeOf((*RestoredApplication)(nil))
+}
+
+func (i *RestoredApplication) ToRestoredApplicationOutput() RestoredApplicationOutput {
+	return i.ToRestoredApplicationOutputWithContext(context.Background())
+}
+
+func (i *RestoredApplication) ToRestoredApplicationOutputWithContext(ctx context.Context) RestoredApplicationOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(RestoredApplicationOutput)
+}
+
+type RestoredApplicationOutput struct {
+	*pulumi.OutputState
+}
+
+func (RestoredApplicationOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*RestoredApplication)(nil))
+}
+
+func (o RestoredApplicationOutput) ToRestoredApplicationOutput() RestoredApplicationOutput {
+	return o
+}
+
+func (o RestoredApplicationOutput) ToRestoredApplicationOutputWithContext(ctx context.Context) RestoredApplicationOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(RestoredApplicationOutput{})
+}
@@ -0,0 +1,171 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package knapcode
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// The service principal (enterprise application) of an application, managed through Microsoft Graph. The resource ID is the object ID of the service principal, which is also used to import it.
+type ServicePrincipal struct {
+	pulumi.CustomResourceState
+
+	// Whether users can sign in to the application. Defaults to true.
+	AccountEnabled pulumi.BoolPtrOutput `pulumi:"accountEnabled"`
+	// The app ID (client ID) of the application. Changing this replaces the service principal.
+	AppId pulumi.StringOutput `pulumi:"appId"`
+	// Whether users and other apps must be assigned an app role before they can get tokens for the application. Defaults to false.
+	AppRoleAssignmentRequired pulumi.BoolPtrOutput `pulumi:"appRoleAssignmentRequired"`
+	// The display name of the service principal, copied from the application.
+	DisplayName pulumi.StringOutput `pulumi:"displayName"`
+	// Free text notes about the service principal.
+	Notes pulumi.StringPtrOutput `pulumi:"notes"`
+	// The object ID of the service principal.
+	ObjectId pulumi.StringOutput `pulumi:"objectId"`
+	// Tags on the service principal.
+	Tags pulumi.StringArrayOutput `pulumi:"tags"`
+}
+
+// NewServicePrincipal registers a new resource with the given unique name, arguments, and options.
+func NewServicePrincipal(ctx *pulumi.Context,
+	name string, args *ServicePrincipalArgs, opts ...pulumi.ResourceOption) (*ServicePrincipal, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.AppId == nil {
+		return nil, errors.New("invalid value for required argument 'AppId'")
+	}
+	var resource ServicePrincipal
+	err := ctx.RegisterResource("knapcode:index:ServicePrincipal", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetServicePrincipal gets an existing ServicePrincipal resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetServicePrincipal(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *ServicePrincipalState, opts ...pulumi.ResourceOption) (*ServicePrincipal, error) {
+	var resource ServicePrincipal
+	err := ctx.ReadResource("knapcode:index:ServicePrincipal", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering ServicePrincipal resources.
+type servicePrincipalState struct {
+	// Whether users can sign in to the application. Defaults to true.
+	AccountEnabled *bool `pulumi:"accountEnabled"`
+	// The app ID (client ID) of the application. Changing this replaces the service principal.
+	AppId *string `pulumi:"appId"`
+	// Whether users and other apps must be assigned an app role before they can get tokens for the application. Defaults to false.
+	AppRoleAssignmentRequired *bool `pulumi:"appRoleAssignmentRequired"`
+	// The display name of the service principal, copied from the application.
+	DisplayName *string `pulumi:"displayName"`
+	// Free text notes about the service principal.
+	Notes *string `pulumi:"notes"`
+	// The object ID of the service principal.
+	ObjectId *string `pulumi:"objectId"`
+	// Tags on the service principal.
+	Tags []string `pulumi:"tags"`
+}
+
+type ServicePrincipalState struct {
+	// Whether users can sign in to the application. Defaults to true.
+	AccountEnabled pulumi.BoolPtrInput
+	// The app ID (client ID) of the application. Changing this replaces the service principal.
+	AppId pulumi.StringPtrInput
+	// Whether users and other apps must be assigned an app role before they can get tokens for the application. Defaults to false.
+	AppRoleAssignmentRequired pulumi.BoolPtrInput
+	// The display name of the service principal, copied from the application.
+	DisplayName pulumi.StringPtrInput
+	// Free text notes about the service principal.
+	Notes pulumi.StringPtrInput
+	// The object ID of the service principal.
+	ObjectId pulumi.StringPtrInput
+	// Tags on the service principal.
+	Tags pulumi.StringArrayInput
+}
+
+func (ServicePrincipalState) ElementType() reflect.Type {
+	return reflect.TypeOf((*servicePrincipalState)(nil)).Elem()
+}
+
+type servicePrincipalArgs struct {
+	// Whether users can sign in to the application. Defaults to true.
+	AccountEnabled *bool `pulumi:"accountEnabled"`
+	// The app ID (client ID) of the application. Changing this replaces the service principal.
+	AppId string `pulumi:"appId"`
+	// Whether users and other apps must be assigned an app role before they can get tokens for the application. Defaults to false.
+	AppRoleAssignmentRequired *bool `pulumi:"appRoleAssignmentRequired"`
+	// Free text notes about the service principal.
+	Notes *string `pulumi:"notes"`
+	// Tags on the service principal.
+	Tags []string `pulumi:"tags"`
+}
+
+// The set of arguments for constructing a ServicePrincipal resource.
+type ServicePrincipalArgs struct {
+	// Whether users can sign in to the application. Defaults to true.
+	AccountEnabled pulumi.BoolPtrInput
+	// The app ID (client ID) of the application. Changing this replaces the service principal.
+	AppId pulumi.StringInput
+	// Whether users and other apps must be assigned an app role before they can get tokens for the application. Defaults to false.
+	AppRoleAssignmentRequired pulumi.BoolPtrInput
+	// Free text notes about the service principal.
+	Notes pulumi.StringPtrInput
+	// Tags on the service principal.
+	Tags pulumi.StringArrayInput
+}
+
+func (ServicePrincipalArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*servicePrincipalArgs)(nil)).Elem()
+}
+
+type ServicePrincipalInput interface {
+	pulumi.Input
+
+	ToServicePrincipalOutput() ServicePrincipalOutput
+	ToServicePrincipalOutputWithContext(ctx context.Context) ServicePrincipalOutput
+}
+
+func (*ServicePrincipal) ElementType() reflect.Type {
+	return reflect.TypeOf((*ServicePrincipal)(nil))
+}
+
+func (i *ServicePrincipal) ToServicePrincipalOutput() ServicePrincipalOutput {
+	return i.ToServicePrincipalOutputWithContext(context.Background())
+}
+
+func (i *ServicePrincipal) ToServicePrincipalOutputWithContext(ctx context.Context) ServicePrincipalOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ServicePrincipalOutput)
+}
+
+type ServicePrincipalOutput struct {
+	*pulumi.OutputState
+}
+
+func (ServicePrincipalOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ServicePrincipal)(nil))
+}
+
+func (o ServicePrincipalOutput) ToServicePrincipalOutput() ServicePrincipalOutput {
+	return o
+}
+
+func (o ServicePrincipalOutput) ToServicePrincipalOutputWithContext(ctx context.Context) ServicePrincipalOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(ServicePrincipalOutput{})
+}
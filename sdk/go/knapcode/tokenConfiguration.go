@@ -0,0 +1,139 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package knapcode
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// Manages the optional claims and group membership claims of an application. Other settings are left untouched, so don't set 'optionalClaims' on an Application resource for the same application. The resource ID is the object ID of the application, which is also used to import it.
+type TokenConfiguration struct {
+	pulumi.CustomResourceState
+
+	// The groups included in the groups claim. Must be set when the 'groups' optional claim is used. Defaults to 'None'.
+	GroupMembershipClaims pulumi.StringPtrOutput `pulumi:"groupMembershipClaims"`
+	// The object ID of the application. Changing this replaces the resource.
+	ObjectId pulumi.StringOutput `pulumi:"objectId"`
+	// The optional claims included in the tokens issued for the application. Built-in claims and their additional properties are validated.
+	OptionalClaims ApplicationOptionalClaimsPtrOutput `pulumi:"optionalClaims"`
+}
+
+// NewTokenConfiguration registers a new resource with the given unique name, arguments, and options.
+func NewTokenConfiguration(ctx *pulumi.Context,
+	name string, args *TokenConfigurationArgs, opts ...pulumi.ResourceOption) (*TokenConfiguration, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.ObjectId == nil {
+		return nil, errors.New("invalid value for required argument 'ObjectId'")
+	}
+	var resource TokenConfiguration
+	err := ctx.RegisterResource("knapcode:index:TokenConfiguration", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetTokenConfiguration gets an existing TokenConfiguration resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetTokenConfiguration(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *TokenConfigurationState, opts ...pulumi.ResourceOption) (*TokenConfiguration, error) {
+	var resource TokenConfiguration
+	err := ctx.ReadResource("knapcode:index:TokenConfiguration", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering TokenConfiguration resources.
+type tokenConfigurationState struct {
+	// The groups included in the groups claim. Must be set when the 'groups' optional claim is used. Defaults to 'None'.
+	GroupMembershipClaims *string `pulumi:"groupMembershipClaims"`
+	// The object ID of the application. Changing this replaces the resource.
+	ObjectId *string `pulumi:"objectId"`
+	// The optional claims included in the tokens issued for the application. Built-in claims and their additional properties are validated.
+	OptionalClaims *ApplicationOptionalClaims `pulumi:"optionalClaims"`
+}
+
+type TokenConfigurationState struct {
+	// The groups included in the groups claim. Must be set when the 'groups' optional claim is used. Defaults to 'None'.
+	GroupMembershipClaims *GroupMembershipClaims
+	// The object ID of the application. Changing this replaces the resource.
+	ObjectId pulumi.StringPtrInput
+	// The optional claims included in the tokens issued for the application. Built-in claims and their additional properties are validated.
+	OptionalClaims ApplicationOptionalClaimsPtrInput
+}
+
+func (TokenConfigurationState) ElementType() reflect.Type {
+	return reflect.TypeOf((*tokenConfigurationState)(nil)).Elem()
+}
+
+type tokenConfigurationArgs struct {
+	// The groups included in the groups claim. Must be set when the 'groups' optional claim is used. Defaults to 'None'.
+	GroupMembershipClaims *string `pulumi:"groupMembershipClaims"`
+	// The object ID of the application. Changing this replaces the resource.
+	ObjectId string `pulumi:"objectId"`
+	// The optional claims included in the tokens issued for the application. Built-in claims and their additional properties are validated.
+	OptionalClaims *ApplicationOptionalClaims `pulumi:"optionalClaims"`
+}
+
+// The set of arguments for constructing a TokenConfiguration resource.
+type TokenConfigurationArgs struct {
+	// The groups included in the groups claim. Must be set when the 'groups' optional claim is used. Defaults to 'None'.
+	GroupMembershipClaims *GroupMembershipClaims
+	// The object ID of the application. Changing this replaces the resource.
+	ObjectId pulumi.StringInput
+	// The optional claims included in the tokens issued for the application. Built-in claims and their additional properties are validated.
+	OptionalClaims ApplicationOptionalClaimsPtrInput
+}
+
+func (TokenConfigurationArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*tokenConfigurationArgs)(nil)).Elem()
+}
+
+type TokenConfigurationInput interface {
+	pulumi.Input
+
+	ToTokenConfigurationOutput() TokenConfigurationOutput
+	ToTokenConfigurationOutputWithContext(ctx context.Context) TokenConfigurationOutput
+}
+
+func (*TokenConfiguration) ElementType() reflect.Type {
+	return reflect.TypeOf((*TokenConfiguration)(nil))
+}
+
+func (i *TokenConfiguration) ToTokenConfigurationOutput() TokenConfigurationOutput {
+	return i.ToTokenConfigurationOutputWithContext(context.Background())
+}
+
+func (i *TokenConfiguration) ToTokenConfigurationOutputWithContext(ctx context.Context) TokenConfigurationOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(TokenConfigurationOutput)
+}
+
+type TokenConfigurationOutput struct {
+	*pulumi.OutputState
+}
+
+func (TokenConfigurationOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*TokenConfiguration)(nil))
+}
+
+func (o TokenConfigurationOutput) ToTokenConfigurationOutput() TokenConfigurationOutput {
+	return o
+}
+
+func (o TokenConfigurationOutput) ToTokenConfigurationOutputWithContext(ctx context.Context) TokenConfigurationOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(TokenConfigurationOutput{})
+}
@@ -0,0 +1,148 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package knapcode
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// A token lifetime policy that sets the lifetime of access and ID tokens. The resource ID is the ID of the policy, which is also used to import it.
+type TokenLifetimePolicy struct {
+	pulumi.CustomResourceState
+
+	// The token lifetimes set by the policy. Changing the definition updates the policy in place.
+	Definition TokenLifetimePolicyDefinitionOutput `pulumi:"definition"`
+	// The display name of the policy.
+	DisplayName pulumi.StringOutput `pulumi:"displayName"`
+	// Whether the policy applies to every application in the organization that has no policy assigned. Defaults to false.
+	IsOrganizationDefault pulumi.BoolPtrOutput `pulumi:"isOrganizationDefault"`
+	// The ID of the policy.
+	PolicyId pulumi.StringOutput `pulumi:"policyId"`
+}
+
+// NewTokenLifetimePolicy registers a new resource with the given unique name, arguments, and options.
+func NewTokenLifetimePolicy(ctx *pulumi.Context,
+	name string, args *TokenLifetimePolicyArgs, opts ...pulumi.ResourceOption) (*TokenLifetimePolicy, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Definition == nil {
+		return nil, errors.New("invalid value for required argument 'Definition'")
+	}
+	if args.DisplayName == nil {
+		return nil, errors.New("invalid value for required argument 'DisplayName'")
+	}
+	var resource TokenLifetimePolicy
+	err := ctx.RegisterResource("knapcode:index:TokenLifetimePolicy", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetTokenLifetimePolicy gets an existing TokenLifetimePolicy resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetTokenLifetimePolicy(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *TokenLifetimePolicyState, opts ...pulumi.ResourceOption) (*TokenLifetimePolicy, error) {
+	var resource TokenLifetimePolicy
+	err := ctx.ReadResource("knapcode:index:TokenLifetimePolicy", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering TokenLifetimePolicy resources.
+type tokenLifetimePolicyState struct {
+	// The token lifetimes set by the policy. Changing the definition updates the policy in place.
+	Definition *TokenLifetimePolicyDefinition `pulumi:"definition"`
+	// The display name of the policy.
+	DisplayName *string `pulumi:"displayName"`
+	// Whether the policy applies to every application in the organization that has no policy assigned. Defaults to false.
+	IsOrganizationDefault *bool `pulumi:"isOrganizationDefault"`
+	// The ID of the policy.
+	PolicyId *string `pulumi:"policyId"`
+}
+
+type TokenLifetimePolicyState struct {
+	// The token lifetimes set by the policy. Changing the definition updates the policy in place.
+	Definition TokenLifetimePolicyDefinitionPtrInput
+	// The display name of the policy.
+	DisplayName pulumi.StringPtrInput
+	// Whether the policy applies to every application in the organization that has no policy assigned. Defaults to false.
+	IsOrganizationDefault pulumi.BoolPtrInput
+	// The ID of the policy.
+	PolicyId pulumi.StringPtrInput
+}
+
+func (TokenLifetimePolicyState) ElementType() reflect.Type {
+	return reflect.TypeOf((*tokenLifetimePolicyState)(nil)).Elem()
+}
+
+type tokenLifetimePolicyArgs struct {
+	// The token lifetimes set by the policy. Changing the definition updates the policy in place.
+	Definition TokenLifetimePolicyDefinition `pulumi:"definition"`
+	// The display name of the policy.
+	DisplayName string `pulumi:"displayName"`
+	// Whether the policy applies to every application in the organization that has no policy assigned. Defaults to false.
+	IsOrganizationDefault *bool `pulumi:"isOrganizationDefault"`
+}
+
+// The set of arguments for constructing a TokenLifetimePolicy resource.
+type TokenLifetimePolicyArgs struct {
+	// The token lifetimes set by the policy. Changing the definition updates the policy in place.
+	Definition TokenLifetimePolicyDefinitionInput
+	// The display name of the policy.
+	DisplayName pulumi.StringInput
+	// Whether the policy applies to every application in the organization that has no policy assigned. Defaults to false.
+	IsOrganizationDefault pulumi.BoolPtrInput
+}
+
+func (TokenLifetimePolicyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*tokenLifetimePolicyArgs)(nil)).Elem()
+}
+
+type TokenLifetimePolicyInput interface {
+	pulumi.Input
+
+	ToTokenLifetimePolicyOutput() TokenLifetimePolicyOutput
+	ToTokenLifetimePolicyOutputWithContext(ctx context.Context) TokenLifetimePolicyOutput
+}
+
+func (*TokenLifetimePolicy) ElementType() reflect.Type {
+	return reflect.TypeOf((*TokenLifetimePolicy)(nil))
+}
+
+func (i *TokenLifetimePolicy) ToTokenLifetimePolicyOutput() TokenLifetimePolicyOutput {
+	return i.ToTokenLifetimePolicyOutputWithContext(context.Background())
+}
+
+func (i *TokenLifetimePolicy) ToTokenLifetimePolicyOutputWithContext(ctx context.Context) TokenLifetimePolicyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(TokenLifetimePolicyOutput)
+}
+
+type TokenLifetimePolicyOutput struct {
+	*pulumi.OutputState
+}
+
+func (TokenLifetimePolicyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*TokenLifetimePolicy)(nil))
+}
+
+func (o TokenLifetimePolicyOutput) ToTokenLifetimePolicyOutput() TokenLifetimePolicyOutput {
+	return o
+}
+
+func (o TokenLifetimePolicyOutput) ToTokenLifetimePolicyOutputWithContext(ctx context.Context) TokenLifetimePolicyOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(TokenLifetimePolicyOutput{})
+}
@@ -0,0 +1,132 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package knapcode
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// Assigns a token lifetime policy to the application of a service principal, since Microsoft Graph only assigns token lifetime policies to applications. The application must be in this tenant. The resource ID is the object ID of the service principal and the policy ID separated by a slash, which is also used to import it.
+type TokenLifetimePolicyAssignment struct {
+	pulumi.CustomResourceState
+
+	// The ID of the policy. Changing this replaces the resource.
+	PolicyId pulumi.StringOutput `pulumi:"policyId"`
+	// The object ID of the service principal. Changing this replaces the resource.
+	ServicePrincipalId pulumi.StringOutput `pulumi:"servicePrincipalId"`
+}
+
+// NewTokenLifetimePolicyAssignment registers a new resource with the given unique name, arguments, and options.
+func NewTokenLifetimePolicyAssignment(ctx *pulumi.Context,
+	name string, args *TokenLifetimePolicyAssignmentArgs, opts ...pulumi.ResourceOption) (*TokenLifetimePolicyAssignment, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.PolicyId == nil {
+		return nil, errors.New("invalid value for required argument 'PolicyId'")
+	}
+	if args.ServicePrincipalId == nil {
+		return nil, errors.New("invalid value for required argument 'ServicePrincipalId'")
+	}
+	var resource TokenLifetimePolicyAssignment
+	err := ctx.RegisterResource("knapcode:index:TokenLifetimePolicyAssignment", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetTokenLifetimePolicyAssignment gets an existing TokenLifetimePolicyAssignment resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetTokenLifetimePolicyAssignment(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *TokenLifetimePolicyAssignmentState, opts ...pulumi.ResourceOption) (*TokenLifetimePolicyAssignment, error) {
+	var resource TokenLifetimePolicyAssignment
+	err := ctx.ReadResource("knapcode:index:TokenLifetimePolicyAssignment", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering TokenLifetimePolicyAssignment resources.
+type tokenLifetimePolicyAssignmentState struct {
+	// The ID of the policy. Changing this replaces the resource.
+	PolicyId *string `pulumi:"policyId"`
+	// The object ID of the service principal. Changing this replaces the resource.
+	ServicePrincipalId *string `pulumi:"servicePrincipalId"`
+}
+
+type TokenLifetimePolicyAssignmentState struct {
+	// The ID of the policy. Changing this replaces the resource.
+	PolicyId pulumi.StringPtrInput
+	// The object ID of the service principal. Changing this replaces the resource.
+	ServicePrincipalId pulumi.StringPtrInput
+}
+
+func (TokenLifetimePolicyAssignmentState) ElementType() reflect.Type {
+	return reflect.TypeOf((*tokenLifetimePolicyAssignmentState)(nil)).Elem()
+}
+
+type tokenLifetimePolicyAssignmentArgs struct {
+	// The ID of the policy. Changing this replaces the resource.
+	PolicyId string `pulumi:"policyId"`
+	// The object ID of the service principal. Changing this replaces the resource.
+	ServicePrincipalId string `pulumi:"servicePrincipalId"`
+}
+
+// The set of arguments for constructing a TokenLifetimePolicyAssignment resource.
+type TokenLifetimePolicyAssignmentArgs struct {
+	// The ID of the policy. Changing this replaces the resource.
+	PolicyId pulumi.StringInput
+	// The object ID of the service principal. Changing this replaces the resource.
+	ServicePrincipalId pulumi.StringInput
+}
+
+func (TokenLifetimePolicyAssignmentArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*tokenLifetimePolicyAssignmentArgs)(nil)).Elem()
+}
+
+type TokenLifetimePolicyAssignmentInput interface {
+	pulumi.Input
+
+	ToTokenLifetimePolicyAssignmentOutput() TokenLifetimePolicyAssignmentOutput
+	ToTokenLifetimePolicyAssignmentOutputWithContext(ctx context.Context) TokenLifetimePolicyAssignmentOutput
+}
+
+func (*TokenLifetimePolicyAssignment) ElementType() reflect.Type {
+	return reflect.TypeOf((*TokenLifetimePolicyAssignment)(nil))
+}
+
+func (i *TokenLifetimePolicyAssignment) ToTokenLifetimePolicyAssignmentOutput() TokenLifetimePolicyAssignmentOutput {
+	return i.ToTokenLifetimePolicyAssignmentOutputWithContext(context.Background())
+}
+
+func (i *TokenLifetimePolicyAssignment) ToTokenLifetimePolicyAssignmentOutputWithContext(ctx context.Context) TokenLifetimePolicyAssignmentOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(TokenLifetimePolicyAssignmentOutput)
+}
+
+type TokenLifetimePolicyAssignmentOutput struct {
+	*pulumi.OutputState
+}
+
+func (TokenLifetimePolicyAssignmentOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*TokenLifetimePolicyAssignment)(nil))
+}
+
+func (o TokenLifetimePolicyAssignmentOutput) ToTokenLifetimePolicyAssignmentOutput() TokenLifetimePolicyAssignmentOutput {
+	return o
+}
+
+func (o TokenLifetimePolicyAssignmentOutput) ToTokenLifetimePolicyAssignmentOutputWithContext(ctx context.Context) TokenLifetimePolicyAssignmentOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(TokenLifetimePolicyAssignmentOutput{})
+}
@@ -0,0 +1,119 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Grants application permissions of an API, like Microsoft Graph, to a service principal by permission name. The resource ID is the principal's object ID and the API's app ID separated by a slash, which is also the format used to import a grant.
+ */
+export class ApiPermissionGrant extends pulumi.CustomResource {
+    /**
+     * Get an existing ApiPermissionGrant resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): ApiPermissionGrant {
+        return new ApiPermissionGrant(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'knapcode:index:ApiPermissionGrant';
+
+    /**
+     * Returns true if the given object is an instance of ApiPermissionGrant.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is ApiPermissionGrant {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === ApiPermissionGrant.__pulumiType;
+    }
+
+    /**
+     * The IDs of the granted app roles, by permission name.
+     */
+    public /*out*/ readonly appRoleIds!: pulumi.Output<{[key: string]: string}>;
+    /**
+     * The names of the application permissions to grant, e.g. 'User.Read.All'.
+     */
+    public readonly permissions!: pulumi.Output<string[]>;
+    /**
+     * The object ID of the service principal, e.g. a managed identity, that is granted the permissions. Changing this replaces the grant.
+     */
+    public readonly principalId!: pulumi.Output<string>;
+    /**
+     * The API, either one of the well-known names 'MicrosoftGraph', 'AzureADGraph', 'AzureKeyVault', 'AzureServiceManagement', 'AzureStorage', 'Office365ExchangeOnline' and 'SharePointOnline', or an app ID. Changing this replaces the grant.
+     */
+    public readonly resourceApp!: pulumi.Output<string>;
+    /**
+     * The app ID of the API.
+     */
+    public /*out*/ readonly resourceAppId!: pulumi.Output<string>;
+    /**
+     * The object ID of the API's service principal.
+     */
+    public /*out*/ readonly resourceId!: pulumi.Output<string>;
+
+    /**
+     * Create a ApiPermissionGrant resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: ApiPermissionGrantArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.permissions === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'permissions'");
+            }
+            if ((!args || args.principalId === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'principalId'");
+            }
+            if ((!args || args.resourceApp === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'resourceApp'");
+            }
+            inputs["permissions"] = args ? args.permissions : undefined;
+            inputs["principalId"] = args ? args.principalId : undefined;
+            inputs["resourceApp"] = args ? args.resourceApp : undefined;
+            inputs["appRoleIds"] = undefined /*out*/;
+            inputs["resourceAppId"] = undefined /*out*/;
+            inputs["resourceId"] = undefined /*out*/;
+        } else {
+            inputs["appRoleIds"] = undefined /*out*/;
+            inputs["permissions"] = undefined /*out*/;
+            inputs["principalId"] = undefined /*out*/;
+            inputs["resourceApp"] = undefined /*out*/;
+            inputs["resourceAppId"] = undefined /*out*/;
+            inputs["resourceId"] = undefined /*out*/;
+        }
+        if (!opts.version) {
+            opts = pulumi.mergeOptions(opts, { version: utilities.getVersion()});
+        }
+        super(ApiPermissionGrant.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a ApiPermissionGrant resource.
+ */
+export interface ApiPermissionGrantArgs {
+    /**
+     * The names of the application permissions to grant, e.g. 'User.Read.All'.
+     */
+    readonly permissions: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The object ID of the service principal, e.g. a managed identity, that is granted the permissions. Changing this replaces the grant.
+     */
+    readonly principalId: pulumi.Input<string>;
+    /**
+     * The API, either one of the well-known names 'MicrosoftGraph', 'AzureADGraph', 'AzureKeyVault', 'AzureServiceManagement', 'AzureStorage', 'Office365ExchangeOnline' and 'SharePointOnline', or an app ID. Changing this replaces the grant.
+     */
+    readonly resourceApp: pulumi.Input<string>;
+}
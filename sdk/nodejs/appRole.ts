@@ -0,0 +1,144 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * A single app role of an application. The other app roles of the application are left untouched, so roles can be defined from several stacks. The resource ID is the application's object ID and the role ID separated by a slash, which is also the format used to import a role.
+ */
+export class AppRole extends pulumi.CustomResource {
+    /**
+     * Get an existing AppRole resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): AppRole {
+        return new AppRole(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'knapcode:index:AppRole';
+
+    /**
+     * Returns true if the given object is an instance of AppRole.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is AppRole {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === AppRole.__pulumiType;
+    }
+
+    /**
+     * Who can be assigned the role: 'User' for users and groups, 'Application' for applications, or both.
+     */
+    public readonly allowedMemberTypes!: pulumi.Output<string[]>;
+    /**
+     * The description of the role.
+     */
+    public readonly description!: pulumi.Output<string>;
+    /**
+     * The display name of the role.
+     */
+    public readonly displayName!: pulumi.Output<string>;
+    /**
+     * Whether the role is enabled. Defaults to true.
+     */
+    public readonly isEnabled!: pulumi.Output<boolean>;
+    /**
+     * The object ID of the application. Changing this replaces the role.
+     */
+    public readonly objectId!: pulumi.Output<string>;
+    /**
+     * The ID of the role. Defaults to a GUID derived from the value. Changing this replaces the role.
+     */
+    public readonly roleId!: pulumi.Output<string>;
+    /**
+     * The value of the role, which appears in the roles claim of tokens.
+     */
+    public readonly value!: pulumi.Output<string | undefined>;
+
+    /**
+     * Create a AppRole resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: AppRoleArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.allowedMemberTypes === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'allowedMemberTypes'");
+            }
+            if ((!args || args.description === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'description'");
+            }
+            if ((!args || args.displayName === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'displayName'");
+            }
+            if ((!args || args.objectId === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'objectId'");
+            }
+            inputs["allowedMemberTypes"] = args ? args.allowedMemberTypes : undefined;
+            inputs["description"] = args ? args.description : undefined;
+            inputs["displayName"] = args ? args.displayName : undefined;
+            inputs["isEnabled"] = args ? args.isEnabled : undefined;
+            inputs["objectId"] = args ? args.objectId : undefined;
+            inputs["roleId"] = args ? args.roleId : undefined;
+            inputs["value"] = args ? args.value : undefined;
+        } else {
+            inputs["allowedMemberTypes"] = undefined /*out*/;
+            inputs["description"] = undefined /*out*/;
+            inputs["displayName"] = undefined /*out*/;
+            inputs["isEnabled"] = undefined /*out*/;
+            inputs["objectId"] = undefined /*out*/;
+            inputs["roleId"] = undefined /*out*/;
+            inputs["value"] = undefined /*out*/;
+        }
+        if (!opts.version) {
+            opts = pulumi.mergeOptions(opts, { version: utilities.getVersion()});
+        }
+        super(AppRole.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a AppRole resource.
+ */
+export interface AppRoleArgs {
+    /**
+     * Who can be assigned the role: 'User' for users and groups, 'Application' for applications, or both.
+     */
+    readonly allowedMemberTypes: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The description of the role.
+     */
+    readonly description: pulumi.Input<string>;
+    /**
+     * The display name of the role.
+     */
+    readonly displayName: pulumi.Input<string>;
+    /**
+     * Whether the role is enabled. Defaults to true.
+     */
+    readonly isEnabled?: pulumi.Input<boolean>;
+    /**
+     * The object ID of the application. Changing this replaces the role.
+     */
+    readonly objectId: pulumi.Input<string>;
+    /**
+     * The ID of the role. Defaults to a GUID derived from the value. Changing this replaces the role.
+     */
+    readonly roleId?: pulumi.Input<string>;
+    /**
+     * The value of the role, which appears in the roles claim of tokens.
+     */
+    readonly value?: pulumi.Input<string>;
+}
@@ -0,0 +1,138 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Assigns an app role of an application to a user, group or service principal. The resource ID is the object ID of the resource service principal and the assignment ID separated by a slash, which is also the format used to import an assignment.
+ */
+export class AppRoleAssignment extends pulumi.CustomResource {
+    /**
+     * Get an existing AppRoleAssignment resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): AppRoleAssignment {
+        return new AppRoleAssignment(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'knapcode:index:AppRoleAssignment';
+
+    /**
+     * Returns true if the given object is an instance of AppRoleAssignment.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is AppRoleAssignment {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === AppRoleAssignment.__pulumiType;
+    }
+
+    /**
+     * The value of the app role to assign. Changing this replaces the assignment.
+     */
+    public readonly appRole!: pulumi.Output<string | undefined>;
+    /**
+     * The ID of the app role to assign, instead of its value. If neither this nor 'appRole' is set, the principal is assigned to the application without a specific role. Changing this replaces the assignment.
+     */
+    public readonly appRoleId!: pulumi.Output<string | undefined>;
+    /**
+     * The ID of the app role assignment.
+     */
+    public /*out*/ readonly assignmentId!: pulumi.Output<string>;
+    /**
+     * The display name of the principal.
+     */
+    public /*out*/ readonly principalDisplayName!: pulumi.Output<string>;
+    /**
+     * The object ID of the user, group or service principal (e.g. a managed identity) that is assigned the role. Changing this replaces the assignment.
+     */
+    public readonly principalId!: pulumi.Output<string>;
+    /**
+     * The type of the principal: 'User', 'Group' or 'ServicePrincipal'.
+     */
+    public /*out*/ readonly principalType!: pulumi.Output<string>;
+    /**
+     * The ID of the assigned app role.
+     */
+    public /*out*/ readonly resolvedAppRoleId!: pulumi.Output<string>;
+    /**
+     * The display name of the resource service principal.
+     */
+    public /*out*/ readonly resourceDisplayName!: pulumi.Output<string>;
+    /**
+     * The object ID of the service principal of the application that defines the app role. Changing this replaces the assignment.
+     */
+    public readonly resourceId!: pulumi.Output<string>;
+
+    /**
+     * Create a AppRoleAssignment resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: AppRoleAssignmentArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.principalId === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'principalId'");
+            }
+            if ((!args || args.resourceId === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'resourceId'");
+            }
+            inputs["appRole"] = args ? args.appRole : undefined;
+            inputs["appRoleId"] = args ? args.appRoleId : undefined;
+            inputs["principalId"] = args ? args.principalId : undefined;
+            inputs["resourceId"] = args ? args.resourceId : undefined;
+            inputs["assignmentId"] = undefined /*out*/;
+            inputs["principalDisplayName"] = undefined /*out*/;
+            inputs["principalType"] = undefined /*out*/;
+            inputs["resolvedAppRoleId"] = undefined /*out*/;
+            inputs["resourceDisplayName"] = undefined /*out*/;
+        } else {
+            inputs["appRole"] = undefined /*out*/;
+            inputs["appRoleId"] = undefined /*out*/;
+            inputs["assignmentId"] = undefined /*out*/;
+            inputs["principalDisplayName"] = undefined /*out*/;
+            inputs["principalId"] = undefined /*out*/;
+            inputs["principalType"] = undefined /*out*/;
+            inputs["resolvedAppRoleId"] = undefined /*out*/;
+            inputs["resourceDisplayName"] = undefined /*out*/;
+            inputs["resourceId"] = undefined /*out*/;
+        }
+        if (!opts.version) {
+            opts = pulumi.mergeOptions(opts, { version: utilities.getVersion()});
+        }
+        super(AppRoleAssignment.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a AppRoleAssignment resource.
+ */
+export interface AppRoleAssignmentArgs {
+    /**
+     * The value of the app role to assign. Changing this replaces the assignment.
+     */
+    readonly appRole?: pulumi.Input<string>;
+    /**
+     * The ID of the app role to assign, instead of its value. If neither this nor 'appRole' is set, the principal is assigned to the application without a specific role. Changing this replaces the assignment.
+     */
+    readonly appRoleId?: pulumi.Input<string>;
+    /**
+     * The object ID of the user, group or service principal (e.g. a managed identity) that is assigned the role. Changing this replaces the assignment.
+     */
+    readonly principalId: pulumi.Input<string>;
+    /**
+     * The object ID of the service principal of the application that defines the app role. Changing this replaces the assignment.
+     */
+    readonly resourceId: pulumi.Input<string>;
+}
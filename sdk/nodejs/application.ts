@@ -0,0 +1,208 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs, enums } from "./types";
+import * as utilities from "./utilities";
+
+/**
+ * An application (app registration) managed entirely through Microsoft Graph. Settings that are not set are reset to their defaults. The resource ID is the object ID of the application, which is also used to import it.
+ */
+export class Application extends pulumi.CustomResource {
+    /**
+     * Get an existing Application resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): Application {
+        return new Application(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'knapcode:index:Application';
+
+    /**
+     * Returns true if the given object is an instance of Application.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is Application {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === Application.__pulumiType;
+    }
+
+    /**
+     * Settings for an application that exposes an API.
+     */
+    public readonly api!: pulumi.Output<outputs.ApplicationApi | undefined>;
+    /**
+     * The app ID (client ID) of the application.
+     */
+    public /*out*/ readonly appId!: pulumi.Output<string>;
+    /**
+     * The roles defined by the application.
+     */
+    public readonly appRoles!: pulumi.Output<outputs.ApplicationAppRole[] | undefined>;
+    /**
+     * The display name of the application.
+     */
+    public readonly displayName!: pulumi.Output<string>;
+    /**
+     * The URIs that identify the application within its tenant.
+     */
+    public readonly identifierUris!: pulumi.Output<string[] | undefined>;
+    /**
+     * Free text notes about the application.
+     */
+    public readonly notes!: pulumi.Output<string | undefined>;
+    /**
+     * The object ID of the application.
+     */
+    public /*out*/ readonly objectId!: pulumi.Output<string>;
+    /**
+     * Optional claims included in tokens.
+     */
+    public readonly optionalClaims!: pulumi.Output<outputs.ApplicationOptionalClaims | undefined>;
+    /**
+     * Settings for a public client.
+     */
+    public readonly publicClient!: pulumi.Output<outputs.ApplicationPublicClient | undefined>;
+    /**
+     * Permanently delete the application from the directory's deleted items when the resource is deleted, releasing its identifier URIs. Defaults to false.
+     */
+    public readonly purgeOnDelete!: pulumi.Output<boolean | undefined>;
+    /**
+     * The permissions the application requires on other applications.
+     */
+    public readonly requiredResourceAccess!: pulumi.Output<outputs.ApplicationRequiredResourceAccess[] | undefined>;
+    /**
+     * The accounts that can sign in. Defaults to 'AzureADMyOrg'.
+     */
+    public readonly signInAudience!: pulumi.Output<enums.SignInAudience | undefined>;
+    /**
+     * Settings for a single-page application.
+     */
+    public readonly spa!: pulumi.Output<outputs.ApplicationSpa | undefined>;
+    /**
+     * Tags on the application.
+     */
+    public readonly tags!: pulumi.Output<string[] | undefined>;
+    /**
+     * Settings for a web application.
+     */
+    public readonly web!: pulumi.Output<outputs.ApplicationWeb | undefined>;
+
+    /**
+     * Create a Application resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: ApplicationArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.displayName === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'displayName'");
+            }
+            inputs["api"] = args ? args.api : undefined;
+            inputs["appRoles"] = args ? args.appRoles : undefined;
+            inputs["displayName"] = args ? args.displayName : undefined;
+            inputs["identifierUris"] = args ? args.identifierUris : undefined;
+            inputs["notes"] = args ? args.notes : undefined;
+            inputs["optionalClaims"] = args ? args.optionalClaims : undefined;
+            inputs["publicClient"] = args ? args.publicClient : undefined;
+            inputs["purgeOnDelete"] = args ? args.purgeOnDelete : undefined;
+            inputs["requiredResourceAccess"] = args ? args.requiredResourceAccess : undefined;
+            inputs["signInAudience"] = args ? args.signInAudience : undefined;
+            inputs["spa"] = args ? args.spa : undefined;
+            inputs["tags"] = args ? args.tags : undefined;
+            inputs["web"] = args ? args.web : undefined;
+            inputs["appId"] = undefined /*out*/;
+            inputs["objectId"] = undefined /*out*/;
+        } else {
+            inputs["api"] = undefined /*out*/;
+            inputs["appId"] = undefined /*out*/;
+            inputs["appRoles"] = undefined /*out*/;
+            inputs["displayName"] = undefined /*out*/;
+            inputs["identifierUris"] = undefined /*out*/;
+            inputs["notes"] = undefined /*out*/;
+            inputs["objectId"] = undefined /*out*/;
+            inputs["optionalClaims"] = undefined /*out*/;
+            inputs["publicClient"] = undefined /*out*/;
+            inputs["purgeOnDelete"] = undefined /*out*/;
+            inputs["requiredResourceAccess"] = undefined /*out*/;
+            inputs["signInAudience"] = undefined /*out*/;
+            inputs["spa"] = undefined /*out*/;
+            inputs["tags"] = undefined /*out*/;
+            inputs["web"] = undefined /*out*/;
+        }
+        if (!opts.version) {
+            opts = pulumi.mergeOptions(opts, { version: utilities.getVersion()});
+        }
+        super(Application.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a Application resource.
+ */
+export interface ApplicationArgs {
+    /**
+     * Settings for an application that exposes an API.
+     */
+    readonly api?: pulumi.Input<inputs.ApplicationApi>;
+    /**
+     * The roles defined by the application.
+     */
+    readonly appRoles?: pulumi.Input<pulumi.Input<inputs.ApplicationAppRole>[]>;
+    /**
+     * The display name of the application.
+     */
+    readonly displayName: pulumi.Input<string>;
+    /**
+     * The URIs that identify the application within its tenant.
+     */
+    readonly identifierUris?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Free text notes about the application.
+     */
+    readonly notes?: pulumi.Input<string>;
+    /**
+     * Optional claims included in tokens.
+     */
+    readonly optionalClaims?: pulumi.Input<inputs.ApplicationOptionalClaims>;
+    /**
+     * Settings for a public client.
+     */
+    readonly publicClient?: pulumi.Input<inputs.ApplicationPublicClient>;
+    /**
+     * Permanently delete the application from the directory's deleted items when the resource is deleted, releasing its identifier URIs. Defaults to false.
+     */
+    readonly purgeOnDelete?: pulumi.Input<boolean>;
+    /**
+     * The permissions the application requires on other applications.
+     */
+    readonly requiredResourceAccess?: pulumi.Input<pulumi.Input<inputs.ApplicationRequiredResourceAccess>[]>;
+    /**
+     * The accounts that can sign in. Defaults to 'AzureADMyOrg'.
+     */
+    readonly signInAudience?: pulumi.Input<enums.SignInAudience>;
+    /**
+     * Settings for a single-page application.
+     */
+    readonly spa?: pulumi.Input<inputs.ApplicationSpa>;
+    /**
+     * Tags on the application.
+     */
+    readonly tags?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Settings for a web application.
+     */
+    readonly web?: pulumi.Input<inputs.ApplicationWeb>;
+}
@@ -0,0 +1,135 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * The marketing, support, terms of service and privacy statement URLs and the publisher domain of an application, shown on the consent prompt. Deleting the resource clears the URLs. The resource ID is the object ID of the application, which is also used to import it.
+ */
+export class ApplicationBranding extends pulumi.CustomResource {
+    /**
+     * Get an existing ApplicationBranding resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): ApplicationBranding {
+        return new ApplicationBranding(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'knapcode:index:ApplicationBranding';
+
+    /**
+     * Returns true if the given object is an instance of ApplicationBranding.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is ApplicationBranding {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === ApplicationBranding.__pulumiType;
+    }
+
+    /**
+     * The host name the URLs that are not set are derived from, e.g. 'example.com' gives 'https://example.com/privacy' for the privacy statement.
+     */
+    public readonly hostName!: pulumi.Output<string | undefined>;
+    /**
+     * The URL of the application's marketing page. Defaults to 'https://{hostName}' when 'hostName' is set.
+     */
+    public readonly marketingUrl!: pulumi.Output<string | undefined>;
+    /**
+     * The object ID of the application. Changing this replaces the resource.
+     */
+    public readonly objectId!: pulumi.Output<string>;
+    /**
+     * The URL of the application's privacy statement, shown on the consent prompt. Defaults to 'https://{hostName}/privacy' when 'hostName' is set.
+     */
+    public readonly privacyStatementUrl!: pulumi.Output<string | undefined>;
+    /**
+     * The publisher domain shown on the consent prompt. Must be a verified domain of the tenant. It is left unchanged when not set.
+     */
+    public readonly publisherDomain!: pulumi.Output<string | undefined>;
+    /**
+     * The URL of the application's support page. Defaults to 'https://{hostName}/support' when 'hostName' is set.
+     */
+    public readonly supportUrl!: pulumi.Output<string | undefined>;
+    /**
+     * The URL of the application's terms of service, shown on the consent prompt. Defaults to 'https://{hostName}/terms' when 'hostName' is set.
+     */
+    public readonly termsOfServiceUrl!: pulumi.Output<string | undefined>;
+
+    /**
+     * Create a ApplicationBranding resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: ApplicationBrandingArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.objectId === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'objectId'");
+            }
+            inputs["hostName"] = args ? args.hostName : undefined;
+            inputs["marketingUrl"] = args ? args.marketingUrl : undefined;
+            inputs["objectId"] = args ? args.objectId : undefined;
+            inputs["privacyStatementUrl"] = args ? args.privacyStatementUrl : undefined;
+            inputs["publisherDomain"] = args ? args.publisherDomain : undefined;
+            inputs["supportUrl"] = args ? args.supportUrl : undefined;
+            inputs["termsOfServiceUrl"] = args ? args.termsOfServiceUrl : undefined;
+        } else {
+            inputs["hostName"] = undefined /*out*/;
+            inputs["marketingUrl"] = undefined /*out*/;
+            inputs["objectId"] = undefined /*out*/;
+            inputs["privacyStatementUrl"] = undefined /*out*/;
+            inputs["publisherDomain"] = undefined /*out*/;
+            inputs["supportUrl"] = undefined /*out*/;
+            inputs["termsOfServiceUrl"] = undefined /*out*/;
+        }
+        if (!opts.version) {
+            opts = pulumi.mergeOptions(opts, { version: utilities.getVersion()});
+        }
+        super(ApplicationBranding.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a ApplicationBranding resource.
+ */
+export interface ApplicationBrandingArgs {
+    /**
+     * The host name the URLs that are not set are derived from, e.g. 'example.com' gives 'https://example.com/privacy' for the privacy statement.
+     */
+    readonly hostName?: pulumi.Input<string>;
+    /**
+     * The URL of the application's marketing page. Defaults to 'https://{hostName}' when 'hostName' is set.
+     */
+    readonly marketingUrl?: pulumi.Input<string>;
+    /**
+     * The object ID of the application. Changing this replaces the resource.
+     */
+    readonly objectId: pulumi.Input<string>;
+    /**
+     * The URL of the application's privacy statement, shown on the consent prompt. Defaults to 'https://{hostName}/privacy' when 'hostName' is set.
+     */
+    readonly privacyStatementUrl?: pulumi.Input<string>;
+    /**
+     * The publisher domain shown on the consent prompt. Must be a verified domain of the tenant. It is left unchanged when not set.
+     */
+    readonly publisherDomain?: pulumi.Input<string>;
+    /**
+     * The URL of the application's support page. Defaults to 'https://{hostName}/support' when 'hostName' is set.
+     */
+    readonly supportUrl?: pulumi.Input<string>;
+    /**
+     * The URL of the application's terms of service, shown on the consent prompt. Defaults to 'https://{hostName}/terms' when 'hostName' is set.
+     */
+    readonly termsOfServiceUrl?: pulumi.Input<string>;
+}
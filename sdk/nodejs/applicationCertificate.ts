@@ -0,0 +1,122 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * A certificate in the key credentials of an application, used for certificate-based client authentication. Other key credentials on the application are left untouched.
+ */
+export class ApplicationCertificate extends pulumi.CustomResource {
+    /**
+     * Get an existing ApplicationCertificate resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): ApplicationCertificate {
+        return new ApplicationCertificate(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'knapcode:index:ApplicationCertificate';
+
+    /**
+     * Returns true if the given object is an instance of ApplicationCertificate.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is ApplicationCertificate {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === ApplicationCertificate.__pulumiType;
+    }
+
+    /**
+     * The certificate, either PEM encoded or as base64 encoded DER. Only the public certificate is needed.
+     */
+    public readonly certificate!: pulumi.Output<string>;
+    /**
+     * A friendly name for the certificate. Defaults to the certificate subject.
+     */
+    public readonly displayName!: pulumi.Output<string | undefined>;
+    /**
+     * When the certificate expires.
+     */
+    public /*out*/ readonly endDateTime!: pulumi.Output<string>;
+    /**
+     * The key ID of the certificate, derived from the application and the certificate thumbprint.
+     */
+    public /*out*/ readonly keyId!: pulumi.Output<string>;
+    /**
+     * The object ID of the application.
+     */
+    public readonly objectId!: pulumi.Output<string>;
+    /**
+     * When the certificate becomes valid.
+     */
+    public /*out*/ readonly startDateTime!: pulumi.Output<string>;
+    /**
+     * The SHA-1 thumbprint of the certificate, as uppercase hex.
+     */
+    public /*out*/ readonly thumbprint!: pulumi.Output<string>;
+
+    /**
+     * Create a ApplicationCertificate resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: ApplicationCertificateArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.certificate === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'certificate'");
+            }
+            if ((!args || args.objectId === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'objectId'");
+            }
+            inputs["certificate"] = args ? args.certificate : undefined;
+            inputs["displayName"] = args ? args.displayName : undefined;
+            inputs["objectId"] = args ? args.objectId : undefined;
+            inputs["endDateTime"] = undefined /*out*/;
+            inputs["keyId"] = undefined /*out*/;
+            inputs["startDateTime"] = undefined /*out*/;
+            inputs["thumbprint"] = undefined /*out*/;
+        } else {
+            inputs["certificate"] = undefined /*out*/;
+            inputs["displayName"] = undefined /*out*/;
+            inputs["endDateTime"] = undefined /*out*/;
+            inputs["keyId"] = undefined /*out*/;
+            inputs["objectId"] = undefined /*out*/;
+            inputs["startDateTime"] = undefined /*out*/;
+            inputs["thumbprint"] = undefined /*out*/;
+        }
+        if (!opts.version) {
+            opts = pulumi.mergeOptions(opts, { version: utilities.getVersion()});
+        }
+        super(ApplicationCertificate.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a ApplicationCertificate resource.
+ */
+export interface ApplicationCertificateArgs {
+    /**
+     * The certificate, either PEM encoded or as base64 encoded DER. Only the public certificate is needed.
+     */
+    readonly certificate: pulumi.Input<string>;
+    /**
+     * A friendly name for the certificate. Defaults to the certificate subject.
+     */
+    readonly displayName?: pulumi.Input<string>;
+    /**
+     * The object ID of the application.
+     */
+    readonly objectId: pulumi.Input<string>;
+}
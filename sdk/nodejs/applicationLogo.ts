@@ -0,0 +1,107 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * The logo of an application, shown on the consent prompt and in My Apps. The logo must be a PNG, JPEG, GIF or BMP image of at most 100 KB. Microsoft Graph can't remove a logo, so deleting the resource leaves the logo in place. The resource ID is the object ID of the application, which is also used to import it.
+ */
+export class ApplicationLogo extends pulumi.CustomResource {
+    /**
+     * Get an existing ApplicationLogo resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): ApplicationLogo {
+        return new ApplicationLogo(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'knapcode:index:ApplicationLogo';
+
+    /**
+     * Returns true if the given object is an instance of ApplicationLogo.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is ApplicationLogo {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === ApplicationLogo.__pulumiType;
+    }
+
+    /**
+     * The base64 encoded logo. Only one of 'source' and 'content' can be set.
+     */
+    public readonly content!: pulumi.Output<string | undefined>;
+    /**
+     * The hex encoded SHA-256 of the logo. The logo is only uploaded again when it changes.
+     */
+    public /*out*/ readonly contentSha256!: pulumi.Output<string>;
+    /**
+     * The detected image type of the logo, e.g. 'image/png'.
+     */
+    public /*out*/ readonly contentType!: pulumi.Output<string>;
+    /**
+     * The object ID of the application. Changing this replaces the resource.
+     */
+    public readonly objectId!: pulumi.Output<string>;
+    /**
+     * The path of the logo file. Only one of 'source' and 'content' can be set.
+     */
+    public readonly source!: pulumi.Output<string | undefined>;
+
+    /**
+     * Create a ApplicationLogo resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: ApplicationLogoArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.objectId === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'objectId'");
+            }
+            inputs["content"] = args ? args.content : undefined;
+            inputs["objectId"] = args ? args.objectId : undefined;
+            inputs["source"] = args ? args.source : undefined;
+            inputs["contentSha256"] = undefined /*out*/;
+            inputs["contentType"] = undefined /*out*/;
+        } else {
+            inputs["content"] = undefined /*out*/;
+            inputs["contentSha256"] = undefined /*out*/;
+            inputs["contentType"] = undefined /*out*/;
+            inputs["objectId"] = undefined /*out*/;
+            inputs["source"] = undefined /*out*/;
+        }
+        if (!opts.version) {
+            opts = pulumi.mergeOptions(opts, { version: utilities.getVersion()});
+        }
+        super(ApplicationLogo.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a ApplicationLogo resource.
+ */
+export interface ApplicationLogoArgs {
+    /**
+     * The base64 encoded logo. Only one of 'source' and 'content' can be set.
+     */
+    readonly content?: pulumi.Input<string>;
+    /**
+     * The object ID of the application. Changing this replaces the resource.
+     */
+    readonly objectId: pulumi.Input<string>;
+    /**
+     * The path of the logo file. Only one of 'source' and 'content' can be set.
+     */
+    readonly source?: pulumi.Input<string>;
+}
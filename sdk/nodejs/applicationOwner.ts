@@ -0,0 +1,100 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Adds an owner to an application. The resource ID is the object ID of the application and the object ID of the owner separated by a slash, which is also used to import it.
+ */
+export class ApplicationOwner extends pulumi.CustomResource {
+    /**
+     * Get an existing ApplicationOwner resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): ApplicationOwner {
+        return new ApplicationOwner(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'knapcode:index:ApplicationOwner';
+
+    /**
+     * Returns true if the given object is an instance of ApplicationOwner.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is ApplicationOwner {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === ApplicationOwner.__pulumiType;
+    }
+
+    /**
+     * The object ID of the application. Changing this replaces the resource.
+     */
+    public readonly objectId!: pulumi.Output<string>;
+    /**
+     * The display name of the owner.
+     */
+    public /*out*/ readonly ownerDisplayName!: pulumi.Output<string>;
+    /**
+     * The object ID of the user or service principal to add as an owner. Changing this replaces the resource.
+     */
+    public readonly ownerId!: pulumi.Output<string>;
+    /**
+     * The type of the owner, e.g. 'user' or 'servicePrincipal'.
+     */
+    public /*out*/ readonly ownerType!: pulumi.Output<string>;
+
+    /**
+     * Create a ApplicationOwner resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: ApplicationOwnerArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.objectId === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'objectId'");
+            }
+            if ((!args || args.ownerId === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'ownerId'");
+            }
+            inputs["objectId"] = args ? args.objectId : undefined;
+            inputs["ownerId"] = args ? args.ownerId : undefined;
+            inputs["ownerDisplayName"] = undefined /*out*/;
+            inputs["ownerType"] = undefined /*out*/;
+        } else {
+            inputs["objectId"] = undefined /*out*/;
+            inputs["ownerDisplayName"] = undefined /*out*/;
+            inputs["ownerId"] = undefined /*out*/;
+            inputs["ownerType"] = undefined /*out*/;
+        }
+        if (!opts.version) {
+            opts = pulumi.mergeOptions(opts, { version: utilities.getVersion()});
+        }
+        super(ApplicationOwner.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a ApplicationOwner resource.
+ */
+export interface ApplicationOwnerArgs {
+    /**
+     * The object ID of the application. Changing this replaces the resource.
+     */
+    readonly objectId: pulumi.Input<string>;
+    /**
+     * The object ID of the user or service principal to add as an owner. Changing this replaces the resource.
+     */
+    readonly ownerId: pulumi.Input<string>;
+}
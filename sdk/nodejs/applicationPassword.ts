@@ -0,0 +1,135 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * A client secret for an application, managed with the Microsoft Graph `addPassword` and `removePassword` actions. Every change replaces the client secret.
+ */
+export class ApplicationPassword extends pulumi.CustomResource {
+    /**
+     * Get an existing ApplicationPassword resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): ApplicationPassword {
+        return new ApplicationPassword(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'knapcode:index:ApplicationPassword';
+
+    /**
+     * Returns true if the given object is an instance of ApplicationPassword.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is ApplicationPassword {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === ApplicationPassword.__pulumiType;
+    }
+
+    /**
+     * A friendly name for the client secret.
+     */
+    public readonly displayName!: pulumi.Output<string | undefined>;
+    /**
+     * When the client secret expires, as an RFC 3339 date and time. Defaults to two years after the start.
+     */
+    public readonly endDateTime!: pulumi.Output<string | undefined>;
+    /**
+     * The first few characters of the client secret.
+     */
+    public /*out*/ readonly hint!: pulumi.Output<string>;
+    /**
+     * The key ID of the client secret.
+     */
+    public /*out*/ readonly keyId!: pulumi.Output<string>;
+    /**
+     * The object ID of the application.
+     */
+    public readonly objectId!: pulumi.Output<string>;
+    /**
+     * Arbitrary values that replace the client secret with a new one whenever they change.
+     */
+    public readonly rotateWhenChanged!: pulumi.Output<{[key: string]: string} | undefined>;
+    /**
+     * The client secret.
+     */
+    public /*out*/ readonly secretText!: pulumi.Output<string>;
+    /**
+     * When the client secret becomes valid, as an RFC 3339 date and time. Defaults to now.
+     */
+    public readonly startDateTime!: pulumi.Output<string | undefined>;
+
+    /**
+     * Create a ApplicationPassword resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: ApplicationPasswordArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.objectId === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'objectId'");
+            }
+            inputs["displayName"] = args ? args.displayName : undefined;
+            inputs["endDateTime"] = args ? args.endDateTime : undefined;
+            inputs["objectId"] = args ? args.objectId : undefined;
+            inputs["rotateWhenChanged"] = args ? args.rotateWhenChanged : undefined;
+            inputs["startDateTime"] = args ? args.startDateTime : undefined;
+            inputs["hint"] = undefined /*out*/;
+            inputs["keyId"] = undefined /*out*/;
+            inputs["secretText"] = undefined /*out*/;
+        } else {
+            inputs["displayName"] = undefined /*out*/;
+            inputs["endDateTime"] = undefined /*out*/;
+            inputs["hint"] = undefined /*out*/;
+            inputs["keyId"] = undefined /*out*/;
+            inputs["objectId"] = undefined /*out*/;
+            inputs["rotateWhenChanged"] = undefined /*out*/;
+            inputs["secretText"] = undefined /*out*/;
+            inputs["startDateTime"] = undefined /*out*/;
+        }
+        if (!opts.version) {
+            opts = pulumi.mergeOptions(opts, { version: utilities.getVersion()});
+        }
+        const secretOpts = { additionalSecretOutputs: ["secretText"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
+        super(ApplicationPassword.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a ApplicationPassword resource.
+ */
+export interface ApplicationPasswordArgs {
+    /**
+     * A friendly name for the client secret.
+     */
+    readonly displayName?: pulumi.Input<string>;
+    /**
+     * When the client secret expires, as an RFC 3339 date and time. Defaults to two years after the start.
+     */
+    readonly endDateTime?: pulumi.Input<string>;
+    /**
+     * The object ID of the application.
+     */
+    readonly objectId: pulumi.Input<string>;
+    /**
+     * Arbitrary values that replace the client secret with a new one whenever they change.
+     */
+    readonly rotateWhenChanged?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * When the client secret becomes valid, as an RFC 3339 date and time. Defaults to now.
+     */
+    readonly startDateTime?: pulumi.Input<string>;
+}
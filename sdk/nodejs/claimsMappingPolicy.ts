@@ -0,0 +1,105 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs, enums } from "./types";
+import * as utilities from "./utilities";
+
+/**
+ * A claims mapping policy that customizes the claims emitted in SAML tokens and JWTs. The resource ID is the ID of the policy, which is also used to import it.
+ */
+export class ClaimsMappingPolicy extends pulumi.CustomResource {
+    /**
+     * Get an existing ClaimsMappingPolicy resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): ClaimsMappingPolicy {
+        return new ClaimsMappingPolicy(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'knapcode:index:ClaimsMappingPolicy';
+
+    /**
+     * Returns true if the given object is an instance of ClaimsMappingPolicy.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is ClaimsMappingPolicy {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === ClaimsMappingPolicy.__pulumiType;
+    }
+
+    /**
+     * The claims emitted by the policy. Changing the definition updates the policy in place.
+     */
+    public readonly definition!: pulumi.Output<outputs.ClaimsMappingPolicyDefinition>;
+    /**
+     * The display name of the policy.
+     */
+    public readonly displayName!: pulumi.Output<string>;
+    /**
+     * Whether the policy applies to every application in the organization that has no policy assigned. Defaults to false.
+     */
+    public readonly isOrganizationDefault!: pulumi.Output<boolean | undefined>;
+    /**
+     * The ID of the policy.
+     */
+    public /*out*/ readonly policyId!: pulumi.Output<string>;
+
+    /**
+     * Create a ClaimsMappingPolicy resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: ClaimsMappingPolicyArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.definition === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'definition'");
+            }
+            if ((!args || args.displayName === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'displayName'");
+            }
+            inputs["definition"] = args ? args.definition : undefined;
+            inputs["displayName"] = args ? args.displayName : undefined;
+            inputs["isOrganizationDefault"] = args ? args.isOrganizationDefault : undefined;
+            inputs["policyId"] = undefined /*out*/;
+        } else {
+            inputs["definition"] = undefined /*out*/;
+            inputs["displayName"] = undefined /*out*/;
+            inputs["isOrganizationDefault"] = undefined /*out*/;
+            inputs["policyId"] = undefined /*out*/;
+        }
+        if (!opts.version) {
+            opts = pulumi.mergeOptions(opts, { version: utilities.getVersion()});
+        }
+        super(ClaimsMappingPolicy.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a ClaimsMappingPolicy resource.
+ */
+export interface ClaimsMappingPolicyArgs {
+    /**
+     * The claims emitted by the policy. Changing the definition updates the policy in place.
+     */
+    readonly definition: pulumi.Input<inputs.ClaimsMappingPolicyDefinition>;
+    /**
+     * The display name of the policy.
+     */
+    readonly displayName: pulumi.Input<string>;
+    /**
+     * Whether the policy applies to every application in the organization that has no policy assigned. Defaults to false.
+     */
+    readonly isOrganizationDefault?: pulumi.Input<boolean>;
+}
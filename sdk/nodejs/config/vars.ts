@@ -0,0 +1,12 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+let __config = new pulumi.Config("knapcode");
+
+/**
+ * The object IDs of users or service principals that are added as owners of every application and service principal created by this provider, so that they can be managed in the portal.
+ */
+export let defaultOwners: string[] | undefined = __config.getObject<string[]>("defaultOwners");
@@ -0,0 +1,132 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Assigns an Azure AD directory role to a principal, for the whole directory or scoped to an administrative unit or an application. Only one scope can be set. The resource ID is the ID of the role assignment, which is also used to import it.
+ */
+export class DirectoryRoleAssignment extends pulumi.CustomResource {
+    /**
+     * Get an existing DirectoryRoleAssignment resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): DirectoryRoleAssignment {
+        return new DirectoryRoleAssignment(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'knapcode:index:DirectoryRoleAssignment';
+
+    /**
+     * Returns true if the given object is an instance of DirectoryRoleAssignment.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is DirectoryRoleAssignment {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === DirectoryRoleAssignment.__pulumiType;
+    }
+
+    /**
+     * The object ID of an administrative unit to scope the assignment to. Changing this replaces the resource.
+     */
+    public readonly administrativeUnitId!: pulumi.Output<string | undefined>;
+    /**
+     * The object ID of an application to scope the assignment to. Changing this replaces the resource.
+     */
+    public readonly applicationObjectId!: pulumi.Output<string | undefined>;
+    /**
+     * The ID of the role assignment.
+     */
+    public /*out*/ readonly assignmentId!: pulumi.Output<string>;
+    /**
+     * The scope of the assignment: '/' for the whole directory, '/administrativeUnits/{id}' for an administrative unit or '/{id}' for an application.
+     */
+    public /*out*/ readonly directoryScopeId!: pulumi.Output<string>;
+    /**
+     * The object ID of the user, group or service principal to assign the role to. Changing this replaces the resource.
+     */
+    public readonly principalId!: pulumi.Output<string>;
+    /**
+     * The directory role, either the display name of a role like 'Application Administrator' or the ID or template ID of a role definition. Changing this replaces the resource.
+     */
+    public readonly role!: pulumi.Output<string>;
+    /**
+     * The template ID of the assigned role.
+     */
+    public /*out*/ readonly roleDefinitionId!: pulumi.Output<string>;
+    /**
+     * The display name of the assigned role.
+     */
+    public /*out*/ readonly roleDisplayName!: pulumi.Output<string>;
+
+    /**
+     * Create a DirectoryRoleAssignment resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: DirectoryRoleAssignmentArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.principalId === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'principalId'");
+            }
+            if ((!args || args.role === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'role'");
+            }
+            inputs["administrativeUnitId"] = args ? args.administrativeUnitId : undefined;
+            inputs["applicationObjectId"] = args ? args.applicationObjectId : undefined;
+            inputs["principalId"] = args ? args.principalId : undefined;
+            inputs["role"] = args ? args.role : undefined;
+            inputs["assignmentId"] = undefined /*out*/;
+            inputs["directoryScopeId"] = undefined /*out*/;
+            inputs["roleDefinitionId"] = undefined /*out*/;
+            inputs["roleDisplayName"] = undefined /*out*/;
+        } else {
+            inputs["administrativeUnitId"] = undefined /*out*/;
+            inputs["applicationObjectId"] = undefined /*out*/;
+            inputs["assignmentId"] = undefined /*out*/;
+            inputs["directoryScopeId"] = undefined /*out*/;
+            inputs["principalId"] = undefined /*out*/;
+            inputs["role"] = undefined /*out*/;
+            inputs["roleDefinitionId"] = undefined /*out*/;
+            inputs["roleDisplayName"] = undefined /*out*/;
+        }
+        if (!opts.version) {
+            opts = pulumi.mergeOptions(opts, { version: utilities.getVersion()});
+        }
+        super(DirectoryRoleAssignment.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a DirectoryRoleAssignment resource.
+ */
+export interface DirectoryRoleAssignmentArgs {
+    /**
+     * The object ID of an administrative unit to scope the assignment to. Changing this replaces the resource.
+     */
+    readonly administrativeUnitId?: pulumi.Input<string>;
+    /**
+     * The object ID of an application to scope the assignment to. Changing this replaces the resource.
+     */
+    readonly applicationObjectId?: pulumi.Input<string>;
+    /**
+     * The object ID of the user, group or service principal to assign the role to. Changing this replaces the resource.
+     */
+    readonly principalId: pulumi.Input<string>;
+    /**
+     * The directory role, either the display name of a role like 'Application Administrator' or the ID or template ID of a role definition. Changing this replaces the resource.
+     */
+    readonly role: pulumi.Input<string>;
+}
@@ -0,0 +1,128 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs, enums } from "./types";
+import * as utilities from "./utilities";
+
+/**
+ * Exposes an application as an API: sets its identifier URI and manages its scopes, pre-authorized client applications and known client applications. Other API settings are left untouched. The resource ID is the object ID of the application, which is also used to import it.
+ */
+export class ExposeApi extends pulumi.CustomResource {
+    /**
+     * Get an existing ExposeApi resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): ExposeApi {
+        return new ExposeApi(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'knapcode:index:ExposeApi';
+
+    /**
+     * Returns true if the given object is an instance of ExposeApi.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is ExposeApi {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === ExposeApi.__pulumiType;
+    }
+
+    /**
+     * The app ID of the application.
+     */
+    public /*out*/ readonly appId!: pulumi.Output<string>;
+    /**
+     * The identifier URI of the API. Defaults to 'api://{appId}'.
+     */
+    public readonly identifierUri!: pulumi.Output<string | undefined>;
+    /**
+     * The identifier URIs of the application.
+     */
+    public /*out*/ readonly identifierUris!: pulumi.Output<string[]>;
+    /**
+     * The app IDs of client applications that are bundled with the API for consent.
+     */
+    public readonly knownClientApplications!: pulumi.Output<string[] | undefined>;
+    /**
+     * The object ID of the application. Changing this replaces the resource.
+     */
+    public readonly objectId!: pulumi.Output<string>;
+    /**
+     * The client applications that can use the API's scopes without user consent.
+     */
+    public readonly preAuthorizedApplications!: pulumi.Output<outputs.ExposeApiPreAuthorizedApplication[] | undefined>;
+    /**
+     * The delegated permissions exposed by the API. IDs default to a GUID derived from the value.
+     */
+    public readonly scopes!: pulumi.Output<outputs.ApplicationPermissionScope[] | undefined>;
+
+    /**
+     * Create a ExposeApi resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: ExposeApiArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.objectId === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'objectId'");
+            }
+            inputs["identifierUri"] = args ? args.identifierUri : undefined;
+            inputs["knownClientApplications"] = args ? args.knownClientApplications : undefined;
+            inputs["objectId"] = args ? args.objectId : undefined;
+            inputs["preAuthorizedApplications"] = args ? args.preAuthorizedApplications : undefined;
+            inputs["scopes"] = args ? args.scopes : undefined;
+            inputs["appId"] = undefined /*out*/;
+            inputs["identifierUris"] = undefined /*out*/;
+        } else {
+            inputs["appId"] = undefined /*out*/;
+            inputs["identifierUri"] = undefined /*out*/;
+            inputs["identifierUris"] = undefined /*out*/;
+            inputs["knownClientApplications"] = undefined /*out*/;
+            inputs["objectId"] = undefined /*out*/;
+            inputs["preAuthorizedApplications"] = undefined /*out*/;
+            inputs["scopes"] = undefined /*out*/;
+        }
+        if (!opts.version) {
+            opts = pulumi.mergeOptions(opts, { version: utilities.getVersion()});
+        }
+        super(ExposeApi.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a ExposeApi resource.
+ */
+export interface ExposeApiArgs {
+    /**
+     * The identifier URI of the API. Defaults to 'api://{appId}'.
+     */
+    readonly identifierUri?: pulumi.Input<string>;
+    /**
+     * The app IDs of client applications that are bundled with the API for consent.
+     */
+    readonly knownClientApplications?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The object ID of the application. Changing this replaces the resource.
+     */
+    readonly objectId: pulumi.Input<string>;
+    /**
+     * The client applications that can use the API's scopes without user consent.
+     */
+    readonly preAuthorizedApplications?: pulumi.Input<pulumi.Input<inputs.ExposeApiPreAuthorizedApplication>[]>;
+    /**
+     * The delegated permissions exposed by the API. IDs default to a GUID derived from the value.
+     */
+    readonly scopes?: pulumi.Input<pulumi.Input<inputs.ApplicationPermissionScope>[]>;
+}
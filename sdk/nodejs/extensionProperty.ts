@@ -0,0 +1,137 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs, enums } from "./types";
+import * as utilities from "./utilities";
+
+/**
+ * A directory extension property owned by an application, which adds a custom attribute to directory objects like users and groups. The resource ID is the object ID of the application and the extension property ID separated by a slash, which is also used to import it.
+ */
+export class ExtensionProperty extends pulumi.CustomResource {
+    /**
+     * Get an existing ExtensionProperty resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): ExtensionProperty {
+        return new ExtensionProperty(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'knapcode:index:ExtensionProperty';
+
+    /**
+     * Returns true if the given object is an instance of ExtensionProperty.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is ExtensionProperty {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === ExtensionProperty.__pulumiType;
+    }
+
+    /**
+     * The full name of the extension property on the target objects, in the form 'extension_{appId}_{name}' where the application ID has no hyphens.
+     */
+    public /*out*/ readonly attributeName!: pulumi.Output<string>;
+    /**
+     * The data type of the values of the extension property. Changing this replaces the resource.
+     */
+    public readonly dataType!: pulumi.Output<enums.ExtensionPropertyDataType>;
+    /**
+     * The ID of the extension property.
+     */
+    public /*out*/ readonly extensionPropertyId!: pulumi.Output<string>;
+    /**
+     * Whether the extension property holds a collection of values. Defaults to false. Changing this replaces the resource.
+     */
+    public readonly isMultiValued!: pulumi.Output<boolean | undefined>;
+    /**
+     * The name of the extension property, without the 'extension_{appId}_' prefix. Can only contain letters, digits and '_' and must start with a letter. Changing this replaces the resource.
+     */
+    public readonly name!: pulumi.Output<string>;
+    /**
+     * The object ID of the application that owns the extension property. Changing this replaces the resource.
+     */
+    public readonly objectId!: pulumi.Output<string>;
+    /**
+     * The kinds of directory objects the extension property can be set on: 'User', 'Group', 'AdministrativeUnit', 'Application', 'Device' or 'Organization'. Changing this replaces the resource.
+     */
+    public readonly targetObjects!: pulumi.Output<string[]>;
+
+    /**
+     * Create a ExtensionProperty resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: ExtensionPropertyArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.dataType === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'dataType'");
+            }
+            if ((!args || args.name === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'name'");
+            }
+            if ((!args || args.objectId === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'objectId'");
+            }
+            if ((!args || args.targetObjects === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'targetObjects'");
+            }
+            inputs["dataType"] = args ? args.dataType : undefined;
+            inputs["isMultiValued"] = args ? args.isMultiValued : undefined;
+            inputs["name"] = args ? args.name : undefined;
+            inputs["objectId"] = args ? args.objectId : undefined;
+            inputs["targetObjects"] = args ? args.targetObjects : undefined;
+            inputs["attributeName"] = undefined /*out*/;
+            inputs["extensionPropertyId"] = undefined /*out*/;
+        } else {
+            inputs["attributeName"] = undefined /*out*/;
+            inputs["dataType"] = undefined /*out*/;
+            inputs["extensionPropertyId"] = undefined /*out*/;
+            inputs["isMultiValued"] = undefined /*out*/;
+            inputs["name"] = undefined /*out*/;
+            inputs["objectId"] = undefined /*out*/;
+            inputs["targetObjects"] = undefined /*out*/;
+        }
+        if (!opts.version) {
+            opts = pulumi.mergeOptions(opts, { version: utilities.getVersion()});
+        }
+        super(ExtensionProperty.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a ExtensionProperty resource.
+ */
+export interface ExtensionPropertyArgs {
+    /**
+     * The data type of the values of the extension property. Changing this replaces the resource.
+     */
+    readonly dataType: pulumi.Input<enums.ExtensionPropertyDataType>;
+    /**
+     * Whether the extension property holds a collection of values. Defaults to false. Changing this replaces the resource.
+     */
+    readonly isMultiValued?: pulumi.Input<boolean>;
+    /**
+     * The name of the extension property, without the 'extension_{appId}_' prefix. Can only contain letters, digits and '_' and must start with a letter. Changing this replaces the resource.
+     */
+    readonly name: pulumi.Input<string>;
+    /**
+     * The object ID of the application that owns the extension property. Changing this replaces the resource.
+     */
+    readonly objectId: pulumi.Input<string>;
+    /**
+     * The kinds of directory objects the extension property can be set on: 'User', 'Group', 'AdministrativeUnit', 'Application', 'Device' or 'Organization'. Changing this replaces the resource.
+     */
+    readonly targetObjects: pulumi.Input<pulumi.Input<string>[]>;
+}
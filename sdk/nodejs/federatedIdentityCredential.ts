@@ -0,0 +1,155 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs, enums } from "./types";
+import * as utilities from "./utilities";
+
+/**
+ * A federated identity credential on an application, letting an external workload like a GitHub Actions workflow or a Kubernetes service account get tokens for the application without a secret. The resource ID is the application's object ID and the credential ID separated by a slash, which is also the format used to import a credential.
+ */
+export class FederatedIdentityCredential extends pulumi.CustomResource {
+    /**
+     * Get an existing FederatedIdentityCredential resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): FederatedIdentityCredential {
+        return new FederatedIdentityCredential(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'knapcode:index:FederatedIdentityCredential';
+
+    /**
+     * Returns true if the given object is an instance of FederatedIdentityCredential.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is FederatedIdentityCredential {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === FederatedIdentityCredential.__pulumiType;
+    }
+
+    /**
+     * The audiences that can appear in the external token.
+     */
+    public readonly audiences!: pulumi.Output<string[]>;
+    /**
+     * The ID of the credential assigned by Microsoft Graph.
+     */
+    public /*out*/ readonly credentialId!: pulumi.Output<string>;
+    /**
+     * A description of the credential.
+     */
+    public readonly description!: pulumi.Output<string | undefined>;
+    /**
+     * Builds the subject for GitHub Actions.
+     */
+    public readonly github!: pulumi.Output<outputs.GitHubFederatedSubject | undefined>;
+    /**
+     * The URL of the external identity provider.
+     */
+    public readonly issuer!: pulumi.Output<string>;
+    /**
+     * Builds the subject for a Kubernetes service account.
+     */
+    public readonly kubernetes!: pulumi.Output<outputs.KubernetesFederatedSubject | undefined>;
+    /**
+     * The name of the credential, unique within the application. Changing this replaces the credential.
+     */
+    public readonly name!: pulumi.Output<string>;
+    /**
+     * The object ID of the application. Changing this replaces the credential.
+     */
+    public readonly objectId!: pulumi.Output<string>;
+    /**
+     * The identity of the external workload.
+     */
+    public readonly subject!: pulumi.Output<string>;
+
+    /**
+     * Create a FederatedIdentityCredential resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: FederatedIdentityCredentialArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.name === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'name'");
+            }
+            if ((!args || args.objectId === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'objectId'");
+            }
+            inputs["audiences"] = args ? args.audiences : undefined;
+            inputs["description"] = args ? args.description : undefined;
+            inputs["github"] = args ? args.github : undefined;
+            inputs["issuer"] = args ? args.issuer : undefined;
+            inputs["kubernetes"] = args ? args.kubernetes : undefined;
+            inputs["name"] = args ? args.name : undefined;
+            inputs["objectId"] = args ? args.objectId : undefined;
+            inputs["subject"] = args ? args.subject : undefined;
+            inputs["credentialId"] = undefined /*out*/;
+        } else {
+            inputs["audiences"] = undefined /*out*/;
+            inputs["credentialId"] = undefined /*out*/;
+            inputs["description"] = undefined /*out*/;
+            inputs["github"] = undefined /*out*/;
+            inputs["issuer"] = undefined /*out*/;
+            inputs["kubernetes"] = undefined /*out*/;
+            inputs["name"] = undefined /*out*/;
+            inputs["objectId"] = undefined /*out*/;
+            inputs["subject"] = undefined /*out*/;
+        }
+        if (!opts.version) {
+            opts = pulumi.mergeOptions(opts, { version: utilities.getVersion()});
+        }
+        super(FederatedIdentityCredential.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a FederatedIdentityCredential resource.
+ */
+export interface FederatedIdentityCredentialArgs {
+    /**
+     * The audiences that can appear in the external token. Defaults to 'api://AzureADTokenExchange'.
+     */
+    readonly audiences?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * A description of the credential.
+     */
+    readonly description?: pulumi.Input<string>;
+    /**
+     * Builds the subject for GitHub Actions.
+     */
+    readonly github?: pulumi.Input<inputs.GitHubFederatedSubject>;
+    /**
+     * The URL of the external identity provider. Defaults to the GitHub Actions issuer when 'github' is set.
+     */
+    readonly issuer?: pulumi.Input<string>;
+    /**
+     * Builds the subject for a Kubernetes service account.
+     */
+    readonly kubernetes?: pulumi.Input<inputs.KubernetesFederatedSubject>;
+    /**
+     * The name of the credential, unique within the application. Changing this replaces the credential.
+     */
+    readonly name: pulumi.Input<string>;
+    /**
+     * The object ID of the application. Changing this replaces the credential.
+     */
+    readonly objectId: pulumi.Input<string>;
+    /**
+     * The identity of the external workload. Set this, 'github' or 'kubernetes'.
+     */
+    readonly subject?: pulumi.Input<string>;
+}
@@ -0,0 +1,54 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs, enums } from "./types";
+import * as utilities from "./utilities";
+
+/**
+ * Looks up an application by object ID, either live or in the directory's deleted items, or a deleted application by display name, without restoring it.
+ */
+export function getDeletedApplication(args?: GetDeletedApplicationArgs, opts?: pulumi.InvokeOptions): Promise<GetDeletedApplicationResult> {
+    args = args || {};
+    if (!opts) {
+        opts = {}
+    }
+
+    if (!opts.version) {
+        opts.version = utilities.getVersion();
+    }
+    return pulumi.runtime.invoke("knapcode:index:getDeletedApplication", {
+        "displayName": args.displayName,
+        "objectId": args.objectId,
+    }, opts);
+}
+
+export interface GetDeletedApplicationArgs {
+    /**
+     * The display name of the deleted application. Either this or `objectId` must be set.
+     */
+    readonly displayName?: string;
+    /**
+     * The object ID of the application. Either this or `displayName` must be set.
+     */
+    readonly objectId?: string;
+}
+
+export interface GetDeletedApplicationResult {
+    /**
+     * The application (client) ID of the application.
+     */
+    readonly appId: string;
+    /**
+     * The display name of the application.
+     */
+    readonly displayName: string;
+    /**
+     * Whether the application is in the directory's deleted items.
+     */
+    readonly isDeleted: boolean;
+    /**
+     * The object ID of the application.
+     */
+    readonly objectId: string;
+}
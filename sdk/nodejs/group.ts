@@ -0,0 +1,128 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs, enums } from "./types";
+import * as utilities from "./utilities";
+
+/**
+ * A security or Microsoft 365 group managed through Microsoft Graph. The resource ID is the object ID of the group, which is also used to import it.
+ */
+export class Group extends pulumi.CustomResource {
+    /**
+     * Get an existing Group resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): Group {
+        return new Group(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'knapcode:index:Group';
+
+    /**
+     * Returns true if the given object is an instance of Group.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is Group {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === Group.__pulumiType;
+    }
+
+    /**
+     * The description of the group.
+     */
+    public readonly description!: pulumi.Output<string | undefined>;
+    /**
+     * The display name of the group.
+     */
+    public readonly displayName!: pulumi.Output<string>;
+    /**
+     * Whether the group can be assigned Azure AD roles. Defaults to false. Changing this replaces the resource.
+     */
+    public readonly isAssignableToRole!: pulumi.Output<boolean | undefined>;
+    /**
+     * The email address of the group, for Microsoft 365 groups.
+     */
+    public /*out*/ readonly mail!: pulumi.Output<string | undefined>;
+    /**
+     * The mail alias of the group. Can only contain letters, digits, '.', '-' and '_'. Defaults to the display name without the other characters.
+     */
+    public readonly mailNickname!: pulumi.Output<string>;
+    /**
+     * The object ID of the group.
+     */
+    public /*out*/ readonly objectId!: pulumi.Output<string>;
+    /**
+     * The kind of group. Both kinds are security enabled, so they can be assigned app roles. Defaults to 'Security'. Changing this replaces the resource.
+     */
+    public readonly type!: pulumi.Output<enums.GroupType | undefined>;
+
+    /**
+     * Create a Group resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: GroupArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.displayName === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'displayName'");
+            }
+            inputs["description"] = args ? args.description : undefined;
+            inputs["displayName"] = args ? args.displayName : undefined;
+            inputs["isAssignableToRole"] = args ? args.isAssignableToRole : undefined;
+            inputs["mailNickname"] = args ? args.mailNickname : undefined;
+            inputs["type"] = args ? args.type : undefined;
+            inputs["mail"] = undefined /*out*/;
+            inputs["objectId"] = undefined /*out*/;
+        } else {
+            inputs["description"] = undefined /*out*/;
+            inputs["displayName"] = undefined /*out*/;
+            inputs["isAssignableToRole"] = undefined /*out*/;
+            inputs["mail"] = undefined /*out*/;
+            inputs["mailNickname"] = undefined /*out*/;
+            inputs["objectId"] = undefined /*out*/;
+            inputs["type"] = undefined /*out*/;
+        }
+        if (!opts.version) {
+            opts = pulumi.mergeOptions(opts, { version: utilities.getVersion()});
+        }
+        super(Group.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a Group resource.
+ */
+export interface GroupArgs {
+    /**
+     * The description of the group.
+     */
+    readonly description?: pulumi.Input<string>;
+    /**
+     * The display name of the group.
+     */
+    readonly displayName: pulumi.Input<string>;
+    /**
+     * Whether the group can be assigned Azure AD roles. Defaults to false. Changing this replaces the resource.
+     */
+    readonly isAssignableToRole?: pulumi.Input<boolean>;
+    /**
+     * The mail alias of the group. Can only contain letters, digits, '.', '-' and '_'. Defaults to the display name without the other characters.
+     */
+    readonly mailNickname?: pulumi.Input<string>;
+    /**
+     * The kind of group. Both kinds are security enabled, so they can be assigned app roles. Defaults to 'Security'. Changing this replaces the resource.
+     */
+    readonly type?: pulumi.Input<enums.GroupType>;
+}
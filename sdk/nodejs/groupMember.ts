@@ -0,0 +1,100 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Adds a member to a group. A member that is already in the group is not an error, and neither is a member that is already gone when the resource is deleted. The resource ID is the object ID of the group and the object ID of the member separated by a slash, which is also used to import it.
+ */
+export class GroupMember extends pulumi.CustomResource {
+    /**
+     * Get an existing GroupMember resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): GroupMember {
+        return new GroupMember(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'knapcode:index:GroupMember';
+
+    /**
+     * Returns true if the given object is an instance of GroupMember.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is GroupMember {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === GroupMember.__pulumiType;
+    }
+
+    /**
+     * The object ID of the group. Changing this replaces the resource.
+     */
+    public readonly groupId!: pulumi.Output<string>;
+    /**
+     * The display name of the member.
+     */
+    public /*out*/ readonly memberDisplayName!: pulumi.Output<string>;
+    /**
+     * The object ID of the user, group or service principal to add as a member. Changing this replaces the resource.
+     */
+    public readonly memberId!: pulumi.Output<string>;
+    /**
+     * The type of the member, e.g. 'user', 'group' or 'servicePrincipal'.
+     */
+    public /*out*/ readonly memberType!: pulumi.Output<string>;
+
+    /**
+     * Create a GroupMember resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: GroupMemberArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.groupId === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'groupId'");
+            }
+            if ((!args || args.memberId === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'memberId'");
+            }
+            inputs["groupId"] = args ? args.groupId : undefined;
+            inputs["memberId"] = args ? args.memberId : undefined;
+            inputs["memberDisplayName"] = undefined /*out*/;
+            inputs["memberType"] = undefined /*out*/;
+        } else {
+            inputs["groupId"] = undefined /*out*/;
+            inputs["memberDisplayName"] = undefined /*out*/;
+            inputs["memberId"] = undefined /*out*/;
+            inputs["memberType"] = undefined /*out*/;
+        }
+        if (!opts.version) {
+            opts = pulumi.mergeOptions(opts, { version: utilities.getVersion()});
+        }
+        super(GroupMember.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a GroupMember resource.
+ */
+export interface GroupMemberArgs {
+    /**
+     * The object ID of the group. Changing this replaces the resource.
+     */
+    readonly groupId: pulumi.Input<string>;
+    /**
+     * The object ID of the user, group or service principal to add as a member. Changing this replaces the resource.
+     */
+    readonly memberId: pulumi.Input<string>;
+}
@@ -5,18 +5,124 @@ import * as pulumi from "@pulumi/pulumi";
 import * as utilities from "./utilities";
 
 // Export members:
+export * from "./apiPermissionGrant";
+export * from "./appRole";
+export * from "./appRoleAssignment";
+export * from "./application";
+export * from "./applicationBranding";
+export * from "./applicationCertificate";
+export * from "./applicationLogo";
+export * from "./applicationOwner";
+export * from "./applicationPassword";
+export * from "./claimsMappingPolicy";
+export * from "./claimsMappingPolicyAssignment";
+export * from "./directoryRoleAssignment";
+export * from "./exposeApi";
+export * from "./extensionProperty";
+export * from "./federatedIdentityCredential";
+export * from "./getDeletedApplication";
+export * from "./group";
+export * from "./groupMember";
 export * from "./prepareAppForWebSignIn";
 export * from "./provider";
+export * from "./requiredResourceAccess";
+export * from "./restoreDeletedApplication";
+export * from "./restoredApplication";
+export * from "./servicePrincipal";
+export * from "./tokenConfiguration";
+export * from "./tokenLifetimePolicy";
+export * from "./tokenLifetimePolicyAssignment";
+
+// Export enums:
+export * from "./types/enums";
+
+// Export sub-modules:
+import * as config from "./config";
+import * as types from "./types";
+
+export {
+    config,
+    types,
+};
 
 // Import resources to register:
+import { ApiPermissionGrant } from "./apiPermissionGrant";
+import { AppRole } from "./appRole";
+import { AppRoleAssignment } from "./appRoleAssignment";
+import { Application } from "./application";
+import { ApplicationBranding } from "./applicationBranding";
+import { ApplicationCertificate } from "./applicationCertificate";
+import { ApplicationLogo } from "./applicationLogo";
+import { ApplicationOwner } from "./applicationOwner";
+import { ApplicationPassword } from "./applicationPassword";
+import { ClaimsMappingPolicy } from "./claimsMappingPolicy";
+import { ClaimsMappingPolicyAssignment } from "./claimsMappingPolicyAssignment";
+import { DirectoryRoleAssignment } from "./directoryRoleAssignment";
+import { ExposeApi } from "./exposeApi";
+import { ExtensionProperty } from "./extensionProperty";
+import { FederatedIdentityCredential } from "./federatedIdentityCredential";
+import { Group } from "./group";
+import { GroupMember } from "./groupMember";
 import { PrepareAppForWebSignIn } from "./prepareAppForWebSignIn";
+import { RequiredResourceAccess } from "./requiredResourceAccess";
+import { RestoredApplication } from "./restoredApplication";
+import { ServicePrincipal } from "./servicePrincipal";
+import { TokenConfiguration } from "./tokenConfiguration";
+import { TokenLifetimePolicy } from "./tokenLifetimePolicy";
+import { TokenLifetimePolicyAssignment } from "./tokenLifetimePolicyAssignment";
 
 const _module = {
     version: utilities.getVersion(),
     construct: (name: string, type: string, urn: string): pulumi.Resource => {
         switch (type) {
+            case "knapcode:index:ApiPermissionGrant":
+                return new ApiPermissionGrant(name, <any>undefined, { urn })
+            case "knapcode:index:AppRole":
+                return new AppRole(name, <any>undefined, { urn })
+            case "knapcode:index:AppRoleAssignment":
+                return new AppRoleAssignment(name, <any>undefined, { urn })
+            case "knapcode:index:Application":
+                return new Application(name, <any>undefined, { urn })
+            case "knapcode:index:ApplicationBranding":
+                return new ApplicationBranding(name, <any>undefined, { urn })
+            case "knapcode:index:ApplicationCertificate":
+                return new ApplicationCertificate(name, <any>undefined, { urn })
+            case "knapcode:index:ApplicationLogo":
+                return new ApplicationLogo(name, <any>undefined, { urn })
+            case "knapcode:index:ApplicationOwner":
+                return new ApplicationOwner(name, <any>undefined, { urn })
+            case "knapcode:index:ApplicationPassword":
+                return new ApplicationPassword(name, <any>undefined, { urn })
+            case "knapcode:index:ClaimsMappingPolicy":
+                return new ClaimsMappingPolicy(name, <any>undefined, { urn })
+            case "knapcode:index:ClaimsMappingPolicyAssignment":
+                return new ClaimsMappingPolicyAssignment(name, <any>undefined, { urn })
+            case "knapcode:index:DirectoryRoleAssignment":
+                return new DirectoryRoleAssignment(name, <any>undefined, { urn })
+            case "knapcode:index:ExposeApi":
+                return new ExposeApi(name, <any>undefined, { urn })
+            case "knapcode:index:ExtensionProperty":
+                return new ExtensionProperty(name, <any>undefined, { urn })
+            case "knapcode:index:FederatedIdentityCredential":
+                return new FederatedIdentityCredential(name, <any>undefined, { urn })
+            case "knapcode:index:Group":
+                return new Group(name, <any>undefined, { urn })
+            case "knapcode:index:GroupMember":
+                return new GroupMember(name, <any>undefined, { urn })
             case "knapcode:index:PrepareAppForWebSignIn":
                 return new PrepareAppForWebSignIn(name, <any>undefined, { urn })
+            case "knapcode:index:RequiredResourceAccess":
+                return new RequiredResourceAccess(name, <any>undefined, { urn })
+            case "knapcode:index:RestoredApplication":
+                return new RestoredApplication(name, <any>undefined, { urn })
+            case "knapcode:index:ServicePrincipal":
+                return new ServicePrincipal(name, <any>undefined, { urn })
+            case "knapcode:index:TokenConfiguration":
+                return new TokenConfiguration(name, <any>undefined, { urn })
+            case "knapcode:index:TokenLifetimePolicy":
+                return new TokenLifetimePolicy(name, <any>undefined, { urn })
+            case "knapcode:index:TokenLifetimePolicyAssignment":
+                return new TokenLifetimePolicyAssignment(name, <any>undefined, { urn })
             default:
                 throw new Error(`unknown resource type ${type}`);
         }
@@ -2,8 +2,12 @@
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs, enums } from "./types";
 import * as utilities from "./utilities";
 
+/**
+ * Prepares an existing app registration for web sign-in on the provided host name using Microsoft Graph.
+ */
 export class PrepareAppForWebSignIn extends pulumi.CustomResource {
     /**
      * Get an existing PrepareAppForWebSignIn resource's state with the given name, ID, and optional extra
@@ -31,6 +35,35 @@ export class PrepareAppForWebSignIn extends pulumi.CustomResource {
         return obj['__pulumiType'] === PrepareAppForWebSignIn.__pulumiType;
     }
 
+    /**
+     * The application settings last written by this resource.
+     */
+    public /*out*/ readonly appliedPatch!: pulumi.Output<any>;
+    public readonly conflictPolicy!: pulumi.Output<enums.ConflictPolicy | undefined>;
+    /**
+     * SHA-256 hash of the application settings last written by this resource.
+     */
+    public /*out*/ readonly fingerprint!: pulumi.Output<string>;
+    public readonly force!: pulumi.Output<boolean | undefined>;
+    public readonly hostName!: pulumi.Output<string>;
+    /**
+     * Whether ID tokens and access tokens can be requested with the OAuth 2.0 implicit flow, for legacy single-page applications.
+     */
+    public readonly implicitGrantSettings!: pulumi.Output<outputs.ApplicationImplicitGrantSettings | undefined>;
+    /**
+     * Whether the application is a public client, e.g. for the device code flow.
+     */
+    public readonly isFallbackPublicClient!: pulumi.Output<boolean | undefined>;
+    public readonly objectId!: pulumi.Output<string>;
+    /**
+     * The redirect URIs of the public client (mobile and desktop) platform, e.g. 'http://localhost' for command-line tools.
+     */
+    public readonly publicClientRedirectUris!: pulumi.Output<string[] | undefined>;
+    public readonly purgeOnDelete!: pulumi.Output<boolean | undefined>;
+    /**
+     * Paths on the host name that are registered as single-page application redirect URIs, e.g. '/' for 'https://{hostName}/'. Each path must start with '/'.
+     */
+    public readonly spaRedirectPaths!: pulumi.Output<string[] | undefined>;
 
     /**
      * Create a PrepareAppForWebSignIn resource with the given unique name, arguments, and options.
@@ -49,9 +82,29 @@ export class PrepareAppForWebSignIn extends pulumi.CustomResource {
             if ((!args || args.objectId === undefined) && !opts.urn) {
                 throw new Error("Missing required property 'objectId'");
             }
+            inputs["conflictPolicy"] = args ? args.conflictPolicy : undefined;
+            inputs["force"] = args ? args.force : undefined;
             inputs["hostName"] = args ? args.hostName : undefined;
+            inputs["implicitGrantSettings"] = args ? args.implicitGrantSettings : undefined;
+            inputs["isFallbackPublicClient"] = args ? args.isFallbackPublicClient : undefined;
             inputs["objectId"] = args ? args.objectId : undefined;
+            inputs["publicClientRedirectUris"] = args ? args.publicClientRedirectUris : undefined;
+            inputs["purgeOnDelete"] = args ? args.purgeOnDelete : undefined;
+            inputs["spaRedirectPaths"] = args ? args.spaRedirectPaths : undefined;
+            inputs["appliedPatch"] = undefined /*out*/;
+            inputs["fingerprint"] = undefined /*out*/;
         } else {
+            inputs["appliedPatch"] = undefined /*out*/;
+            inputs["conflictPolicy"] = undefined /*out*/;
+            inputs["fingerprint"] = undefined /*out*/;
+            inputs["force"] = undefined /*out*/;
+            inputs["hostName"] = undefined /*out*/;
+            inputs["implicitGrantSettings"] = undefined /*out*/;
+            inputs["isFallbackPublicClient"] = undefined /*out*/;
+            inputs["objectId"] = undefined /*out*/;
+            inputs["publicClientRedirectUris"] = undefined /*out*/;
+            inputs["purgeOnDelete"] = undefined /*out*/;
+            inputs["spaRedirectPaths"] = undefined /*out*/;
         }
         if (!opts.version) {
             opts = pulumi.mergeOptions(opts, { version: utilities.getVersion()});
@@ -64,6 +117,34 @@ export class PrepareAppForWebSignIn extends pulumi.CustomResource {
  * The set of arguments for constructing a PrepareAppForWebSignIn resource.
  */
 export interface PrepareAppForWebSignInArgs {
+    /**
+     * What to do when the application was changed outside of Pulumi since it was last written. Defaults to `overwrite`.
+     */
+    readonly conflictPolicy?: pulumi.Input<enums.ConflictPolicy>;
+    /**
+     * Delete the application even if it does not have this resource's ownership tag. The tag is added to the application's `tags` when the resource is created or updated.
+     */
+    readonly force?: pulumi.Input<boolean>;
     readonly hostName: pulumi.Input<string>;
+    /**
+     * Whether ID tokens and access tokens can be requested with the OAuth 2.0 implicit flow, for legacy single-page applications.
+     */
+    readonly implicitGrantSettings?: pulumi.Input<inputs.ApplicationImplicitGrantSettings>;
+    /**
+     * Whether the application is a public client, e.g. for the device code flow.
+     */
+    readonly isFallbackPublicClient?: pulumi.Input<boolean>;
     readonly objectId: pulumi.Input<string>;
+    /**
+     * The redirect URIs of the public client (mobile and desktop) platform, e.g. 'http://localhost' for command-line tools.
+     */
+    readonly publicClientRedirectUris?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Permanently delete the application from the directory's deleted items when the resource is deleted, releasing its identifier URIs.
+     */
+    readonly purgeOnDelete?: pulumi.Input<boolean>;
+    /**
+     * Paths on the host name that are registered as single-page application redirect URIs, e.g. '/' for 'https://{hostName}/'. Each path must start with '/'.
+     */
+    readonly spaRedirectPaths?: pulumi.Input<pulumi.Input<string>[]>;
 }
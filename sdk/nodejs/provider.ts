@@ -4,6 +4,9 @@
 import * as pulumi from "@pulumi/pulumi";
 import * as utilities from "./utilities";
 
+/**
+ * The provider type for the knapcode package.
+ */
 export class Provider extends pulumi.ProviderResource {
     /** @internal */
     public static readonly __pulumiType = 'knapcode';
@@ -31,6 +34,7 @@ export class Provider extends pulumi.ProviderResource {
         let inputs: pulumi.Inputs = {};
         opts = opts || {};
         {
+            inputs["defaultOwners"] = pulumi.output(args ? args.defaultOwners : undefined).apply(JSON.stringify);
         }
         if (!opts.version) {
             opts = pulumi.mergeOptions(opts, { version: utilities.getVersion()});
@@ -43,4 +47,8 @@ export class Provider extends pulumi.ProviderResource {
  * The set of arguments for constructing a Provider resource.
  */
 export interface ProviderArgs {
+    /**
+     * The object IDs of users or service principals that are added as owners of every application and service principal created by this provider, so that they can be managed in the portal.
+     */
+    readonly defaultOwners?: pulumi.Input<pulumi.Input<string>[]>;
 }
@@ -0,0 +1,117 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs, enums } from "./types";
+import * as utilities from "./utilities";
+
+/**
+ * Manages the API permissions an application requires, by permission name, and optionally grants admin consent for them. Entries of requiredResourceAccess for other APIs are left untouched. The resource ID is the object ID of the application.
+ */
+export class RequiredResourceAccess extends pulumi.CustomResource {
+    /**
+     * Get an existing RequiredResourceAccess resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): RequiredResourceAccess {
+        return new RequiredResourceAccess(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'knapcode:index:RequiredResourceAccess';
+
+    /**
+     * Returns true if the given object is an instance of RequiredResourceAccess.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is RequiredResourceAccess {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === RequiredResourceAccess.__pulumiType;
+    }
+
+    /**
+     * The app ID of the application.
+     */
+    public /*out*/ readonly appId!: pulumi.Output<string>;
+    /**
+     * Whether to grant the permissions for the whole tenant, like the 'Grant admin consent' button in the portal does. This needs a service principal for the application. Defaults to false.
+     */
+    public readonly grantAdminConsent!: pulumi.Output<boolean | undefined>;
+    /**
+     * The object ID of the application. Changing this replaces the resource.
+     */
+    public readonly objectId!: pulumi.Output<string>;
+    /**
+     * The APIs with the permission names resolved to IDs.
+     */
+    public /*out*/ readonly resolvedResources!: pulumi.Output<outputs.RequiredResourceAccessResolvedResource[]>;
+    /**
+     * The APIs the application requires permissions on. Each API can only be listed once.
+     */
+    public readonly resources!: pulumi.Output<outputs.RequiredResourceAccessResource[]>;
+    /**
+     * The object ID of the application's service principal the permissions are granted to, if admin consent is granted.
+     */
+    public /*out*/ readonly servicePrincipalId!: pulumi.Output<string | undefined>;
+
+    /**
+     * Create a RequiredResourceAccess resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: RequiredResourceAccessArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.objectId === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'objectId'");
+            }
+            if ((!args || args.resources === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'resources'");
+            }
+            inputs["grantAdminConsent"] = args ? args.grantAdminConsent : undefined;
+            inputs["objectId"] = args ? args.objectId : undefined;
+            inputs["resources"] = args ? args.resources : undefined;
+            inputs["appId"] = undefined /*out*/;
+            inputs["resolvedResources"] = undefined /*out*/;
+            inputs["servicePrincipalId"] = undefined /*out*/;
+        } else {
+            inputs["appId"] = undefined /*out*/;
+            inputs["grantAdminConsent"] = undefined /*out*/;
+            inputs["objectId"] = undefined /*out*/;
+            inputs["resolvedResources"] = undefined /*out*/;
+            inputs["resources"] = undefined /*out*/;
+            inputs["servicePrincipalId"] = undefined /*out*/;
+        }
+        if (!opts.version) {
+            opts = pulumi.mergeOptions(opts, { version: utilities.getVersion()});
+        }
+        super(RequiredResourceAccess.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a RequiredResourceAccess resource.
+ */
+export interface RequiredResourceAccessArgs {
+    /**
+     * Whether to grant the permissions for the whole tenant, like the 'Grant admin consent' button in the portal does. This needs a service principal for the application. Defaults to false.
+     */
+    readonly grantAdminConsent?: pulumi.Input<boolean>;
+    /**
+     * The object ID of the application. Changing this replaces the resource.
+     */
+    readonly objectId: pulumi.Input<string>;
+    /**
+     * The APIs the application requires permissions on. Each API can only be listed once.
+     */
+    readonly resources: pulumi.Input<pulumi.Input<inputs.RequiredResourceAccessResource>[]>;
+}
@@ -0,0 +1,50 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs, enums } from "./types";
+import * as utilities from "./utilities";
+
+/**
+ * Restores a soft-deleted application from the directory's deleted items and waits for it to be available. An application that is not deleted is left as-is. Functions also run during previews, so use the RestoredApplication resource to only restore the application when the stack is updated.
+ */
+export function restoreDeletedApplication(args?: RestoreDeletedApplicationArgs, opts?: pulumi.InvokeOptions): Promise<RestoreDeletedApplicationResult> {
+    args = args || {};
+    if (!opts) {
+        opts = {}
+    }
+
+    if (!opts.version) {
+        opts.version = utilities.getVersion();
+    }
+    return pulumi.runtime.invoke("knapcode:index:restoreDeletedApplication", {
+        "displayName": args.displayName,
+        "objectId": args.objectId,
+    }, opts);
+}
+
+export interface RestoreDeletedApplicationArgs {
+    /**
+     * The display name of the deleted application. Either this or `objectId` must be set.
+     */
+    readonly displayName?: string;
+    /**
+     * The object ID of the deleted application. Either this or `displayName` must be set.
+     */
+    readonly objectId?: string;
+}
+
+export interface RestoreDeletedApplicationResult {
+    /**
+     * The application (client) ID of the restored application.
+     */
+    readonly appId: string;
+    /**
+     * The display name of the restored application.
+     */
+    readonly displayName: string;
+    /**
+     * The object ID of the restored application.
+     */
+    readonly objectId: string;
+}
@@ -0,0 +1,88 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Restores a soft-deleted application from the directory's deleted items, keeping its object ID and application ID. Deleting this resource leaves the application in place.
+ */
+export class RestoredApplication extends pulumi.CustomResource {
+    /**
+     * Get an existing RestoredApplication resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): RestoredApplication {
+        return new RestoredApplication(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'knapcode:index:RestoredApplication';
+
+    /**
+     * Returns true if the given object is an instance of RestoredApplication.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is RestoredApplication {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === RestoredApplication.__pulumiType;
+    }
+
+    /**
+     * The application (client) ID of the restored application.
+     */
+    public /*out*/ readonly appId!: pulumi.Output<string>;
+    /**
+     * The display name of the restored application.
+     */
+    public readonly displayName!: pulumi.Output<string>;
+    /**
+     * The object ID of the restored application.
+     */
+    public readonly objectId!: pulumi.Output<string>;
+
+    /**
+     * Create a RestoredApplication resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args?: RestoredApplicationArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            inputs["displayName"] = args ? args.displayName : undefined;
+            inputs["objectId"] = args ? args.objectId : undefined;
+            inputs["appId"] = undefined /*out*/;
+        } else {
+            inputs["appId"] = undefined /*out*/;
+            inputs["displayName"] = undefined /*out*/;
+            inputs["objectId"] = undefined /*out*/;
+        }
+        if (!opts.version) {
+            opts = pulumi.mergeOptions(opts, { version: utilities.getVersion()});
+        }
+        super(RestoredApplication.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a RestoredApplication resource.
+ */
+export interface RestoredApplicationArgs {
+    /**
+     * The display name of the deleted application. Either this or `objectId` must be set.
+     */
+    readonly displayName?: pulumi.Input<string>;
+    /**
+     * The object ID of the deleted application. Either this or `displayName` must be set.
+     */
+    readonly objectId?: pulumi.Input<string>;
+}
@@ -0,0 +1,127 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * The service principal (enterprise application) of an application, managed through Microsoft Graph. The resource ID is the object ID of the service principal, which is also used to import it.
+ */
+export class ServicePrincipal extends pulumi.CustomResource {
+    /**
+     * Get an existing ServicePrincipal resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): ServicePrincipal {
+        return new ServicePrincipal(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'knapcode:index:ServicePrincipal';
+
+    /**
+     * Returns true if the given object is an instance of ServicePrincipal.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is ServicePrincipal {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === ServicePrincipal.__pulumiType;
+    }
+
+    /**
+     * Whether users can sign in to the application. Defaults to true.
+     */
+    public readonly accountEnabled!: pulumi.Output<boolean | undefined>;
+    /**
+     * The app ID (client ID) of the application. Changing this replaces the service principal.
+     */
+    public readonly appId!: pulumi.Output<string>;
+    /**
+     * Whether users and other apps must be assigned an app role before they can get tokens for the application. Defaults to false.
+     */
+    public readonly appRoleAssignmentRequired!: pulumi.Output<boolean | undefined>;
+    /**
+     * The display name of the service principal, copied from the application.
+     */
+    public /*out*/ readonly displayName!: pulumi.Output<string>;
+    /**
+     * Free text notes about the service principal.
+     */
+    public readonly notes!: pulumi.Output<string | undefined>;
+    /**
+     * The object ID of the service principal.
+     */
+    public /*out*/ readonly objectId!: pulumi.Output<string>;
+    /**
+     * Tags on the service principal.
+     */
+    public readonly tags!: pulumi.Output<string[] | undefined>;
+
+    /**
+     * Create a ServicePrincipal resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: ServicePrincipalArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.appId === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'appId'");
+            }
+            inputs["accountEnabled"] = args ? args.accountEnabled : undefined;
+            inputs["appId"] = args ? args.appId : undefined;
+            inputs["appRoleAssignmentRequired"] = args ? args.appRoleAssignmentRequired : undefined;
+            inputs["notes"] = args ? args.notes : undefined;
+            inputs["tags"] = args ? args.tags : undefined;
+            inputs["displayName"] = undefined /*out*/;
+            inputs["objectId"] = undefined /*out*/;
+        } else {
+            inputs["accountEnabled"] = undefined /*out*/;
+            inputs["appId"] = undefined /*out*/;
+            inputs["appRoleAssignmentRequired"] = undefined /*out*/;
+            inputs["displayName"] = undefined /*out*/;
+            inputs["notes"] = undefined /*out*/;
+            inputs["objectId"] = undefined /*out*/;
+            inputs["tags"] = undefined /*out*/;
+        }
+        if (!opts.version) {
+            opts = pulumi.mergeOptions(opts, { version: utilities.getVersion()});
+        }
+        super(ServicePrincipal.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a ServicePrincipal resource.
+ */
+export interface ServicePrincipalArgs {
+    /**
+     * Whether users can sign in to the application. Defaults to true.
+     */
+    readonly accountEnabled?: pulumi.Input<boolean>;
+    /**
+     * The app ID (client ID) of the application. Changing this replaces the service principal.
+     */
+    readonly appId: pulumi.Input<string>;
+    /**
+     * Whether users and other apps must be assigned an app role before they can get tokens for the application. Defaults to false.
+     */
+    readonly appRoleAssignmentRequired?: pulumi.Input<boolean>;
+    /**
+     * Free text notes about the service principal.
+     */
+    readonly notes?: pulumi.Input<string>;
+    /**
+     * Tags on the service principal.
+     */
+    readonly tags?: pulumi.Input<pulumi.Input<string>[]>;
+}
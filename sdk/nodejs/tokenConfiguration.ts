@@ -0,0 +1,96 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs, enums } from "./types";
+import * as utilities from "./utilities";
+
+/**
+ * Manages the optional claims and group membership claims of an application. Other settings are left untouched, so don't set 'optionalClaims' on an Application resource for the same application. The resource ID is the object ID of the application, which is also used to import it.
+ */
+export class TokenConfiguration extends pulumi.CustomResource {
+    /**
+     * Get an existing TokenConfiguration resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): TokenConfiguration {
+        return new TokenConfiguration(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'knapcode:index:TokenConfiguration';
+
+    /**
+     * Returns true if the given object is an instance of TokenConfiguration.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is TokenConfiguration {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === TokenConfiguration.__pulumiType;
+    }
+
+    /**
+     * The groups included in the groups claim. Must be set when the 'groups' optional claim is used. Defaults to 'None'.
+     */
+    public readonly groupMembershipClaims!: pulumi.Output<enums.GroupMembershipClaims | undefined>;
+    /**
+     * The object ID of the application. Changing this replaces the resource.
+     */
+    public readonly objectId!: pulumi.Output<string>;
+    /**
+     * The optional claims included in the tokens issued for the application. Built-in claims and their additional properties are validated.
+     */
+    public readonly optionalClaims!: pulumi.Output<outputs.ApplicationOptionalClaims | undefined>;
+
+    /**
+     * Create a TokenConfiguration resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: TokenConfigurationArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.objectId === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'objectId'");
+            }
+            inputs["groupMembershipClaims"] = args ? args.groupMembershipClaims : undefined;
+            inputs["objectId"] = args ? args.objectId : undefined;
+            inputs["optionalClaims"] = args ? args.optionalClaims : undefined;
+        } else {
+            inputs["groupMembershipClaims"] = undefined /*out*/;
+            inputs["objectId"] = undefined /*out*/;
+            inputs["optionalClaims"] = undefined /*out*/;
+        }
+        if (!opts.version) {
+            opts = pulumi.mergeOptions(opts, { version: utilities.getVersion()});
+        }
+        super(TokenConfiguration.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a TokenConfiguration resource.
+ */
+export interface TokenConfigurationArgs {
+    /**
+     * The groups included in the groups claim. Must be set when the 'groups' optional claim is used. Defaults to 'None'.
+     */
+    readonly groupMembershipClaims?: pulumi.Input<enums.GroupMembershipClaims>;
+    /**
+     * The object ID of the application. Changing this replaces the resource.
+     */
+    readonly objectId: pulumi.Input<string>;
+    /**
+     * The optional claims included in the tokens issued for the application. Built-in claims and their additional properties are validated.
+     */
+    readonly optionalClaims?: pulumi.Input<inputs.ApplicationOptionalClaims>;
+}
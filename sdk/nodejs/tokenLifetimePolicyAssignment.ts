@@ -0,0 +1,88 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Assigns a token lifetime policy to the application of a service principal, since Microsoft Graph only assigns token lifetime policies to applications. The application must be in this tenant. The resource ID is the object ID of the service principal and the policy ID separated by a slash, which is also used to import it.
+ */
+export class TokenLifetimePolicyAssignment extends pulumi.CustomResource {
+    /**
+     * Get an existing TokenLifetimePolicyAssignment resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): TokenLifetimePolicyAssignment {
+        return new TokenLifetimePolicyAssignment(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'knapcode:index:TokenLifetimePolicyAssignment';
+
+    /**
+     * Returns true if the given object is an instance of TokenLifetimePolicyAssignment.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is TokenLifetimePolicyAssignment {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === TokenLifetimePolicyAssignment.__pulumiType;
+    }
+
+    /**
+     * The ID of the policy. Changing this replaces the resource.
+     */
+    public readonly policyId!: pulumi.Output<string>;
+    /**
+     * The object ID of the service principal. Changing this replaces the resource.
+     */
+    public readonly servicePrincipalId!: pulumi.Output<string>;
+
+    /**
+     * Create a TokenLifetimePolicyAssignment resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: TokenLifetimePolicyAssignmentArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.policyId === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'policyId'");
+            }
+            if ((!args || args.servicePrincipalId === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'servicePrincipalId'");
+            }
+            inputs["policyId"] = args ? args.policyId : undefined;
+            inputs["servicePrincipalId"] = args ? args.servicePrincipalId : undefined;
+        } else {
+            inputs["policyId"] = undefined /*out*/;
+            inputs["servicePrincipalId"] = undefined /*out*/;
+        }
+        if (!opts.version) {
+            opts = pulumi.mergeOptions(opts, { version: utilities.getVersion()});
+        }
+        super(TokenLifetimePolicyAssignment.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a TokenLifetimePolicyAssignment resource.
+ */
+export interface TokenLifetimePolicyAssignmentArgs {
+    /**
+     * The ID of the policy. Changing this replaces the resource.
+     */
+    readonly policyId: pulumi.Input<string>;
+    /**
+     * The object ID of the service principal. Changing this replaces the resource.
+     */
+    readonly servicePrincipalId: pulumi.Input<string>;
+}
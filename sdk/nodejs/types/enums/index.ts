@@ -0,0 +1,123 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+
+export const ConflictPolicy = {
+    /**
+     * Overwrite the external changes and log a warning.
+     */
+    Overwrite: "overwrite",
+    /**
+     * Fail the update and report the external changes.
+     */
+    Fail: "fail",
+    /**
+     * Keep external changes to settings this resource is not changing.
+     */
+    Merge: "merge",
+} as const;
+
+/**
+ * How to handle application settings that were changed outside of Pulumi.
+ */
+export type ConflictPolicy = (typeof ConflictPolicy)[keyof typeof ConflictPolicy];
+
+export const ExtensionPropertyDataType = {
+    /**
+     * Binary data, at most 256 bytes.
+     */
+    Binary: "Binary",
+    /**
+     * A boolean.
+     */
+    Boolean: "Boolean",
+    /**
+     * A date and time in ISO 8601 format, always in UTC.
+     */
+    DateTime: "DateTime",
+    /**
+     * A 32-bit integer.
+     */
+    Integer: "Integer",
+    /**
+     * A 64-bit integer.
+     */
+    LargeInteger: "LargeInteger",
+    /**
+     * A string, at most 256 characters.
+     */
+    String: "String",
+} as const;
+
+/**
+ * The data type of the values of an extension property.
+ */
+export type ExtensionPropertyDataType = (typeof ExtensionPropertyDataType)[keyof typeof ExtensionPropertyDataType];
+
+export const GroupMembershipClaims = {
+    /**
+     * No groups.
+     */
+    None: "None",
+    /**
+     * Security groups and Azure AD roles the user is a member of.
+     */
+    SecurityGroup: "SecurityGroup",
+    /**
+     * Azure AD roles the user is assigned to.
+     */
+    DirectoryRole: "DirectoryRole",
+    /**
+     * Groups assigned to the application the user is a member of.
+     */
+    ApplicationGroup: "ApplicationGroup",
+    /**
+     * Security groups, distribution lists and Azure AD roles the user is a member of.
+     */
+    All: "All",
+} as const;
+
+/**
+ * The groups included in the groups claim of tokens issued for an application.
+ */
+export type GroupMembershipClaims = (typeof GroupMembershipClaims)[keyof typeof GroupMembershipClaims];
+
+export const GroupType = {
+    /**
+     * A security group.
+     */
+    Security: "Security",
+    /**
+     * A Microsoft 365 group, with a mailbox and other collaboration features.
+     */
+    Microsoft365: "Microsoft365",
+} as const;
+
+/**
+ * The kind of a group.
+ */
+export type GroupType = (typeof GroupType)[keyof typeof GroupType];
+
+export const SignInAudience = {
+    /**
+     * Accounts in the application's tenant only.
+     */
+    AzureADMyOrg: "AzureADMyOrg",
+    /**
+     * Accounts in any Azure AD tenant.
+     */
+    AzureADMultipleOrgs: "AzureADMultipleOrgs",
+    /**
+     * Accounts in any Azure AD tenant and personal Microsoft accounts.
+     */
+    AzureADandPersonalMicrosoftAccount: "AzureADandPersonalMicrosoftAccount",
+    /**
+     * Personal Microsoft accounts only.
+     */
+    PersonalMicrosoftAccount: "PersonalMicrosoftAccount",
+} as const;
+
+/**
+ * The Microsoft accounts that can sign in to an application.
+ */
+export type SignInAudience = (typeof SignInAudience)[keyof typeof SignInAudience];
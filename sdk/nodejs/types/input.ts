@@ -0,0 +1,363 @@
+// *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs, enums } from "./types";
+
+/**
+ * Settings for an application that exposes an API.
+ */
+export interface ApplicationApi {
+    /**
+     * Whether claims mapping can be used without a custom signing key.
+     */
+    acceptMappedClaims?: pulumi.Input<boolean>;
+    /**
+     * The app IDs of client applications that are bundled with this application for consent.
+     */
+    knownClientApplications?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The delegated permissions exposed by the API.
+     */
+    oauth2PermissionScopes?: pulumi.Input<pulumi.Input<inputs.ApplicationPermissionScope>[]>;
+    /**
+     * The client applications that are pre-authorized for the API's scopes.
+     */
+    preAuthorizedApplications?: pulumi.Input<pulumi.Input<inputs.ApplicationPreAuthorizedApplication>[]>;
+    /**
+     * The access token version expected by the API, 1 or 2.
+     */
+    requestedAccessTokenVersion?: pulumi.Input<number>;
+}
+
+/**
+ * A role that can be assigned to users, groups or applications.
+ */
+export interface ApplicationAppRole {
+    /**
+     * Who can be assigned the role: 'User' for users and groups, 'Application' for applications, or both.
+     */
+    allowedMemberTypes: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The description of the role.
+     */
+    description: pulumi.Input<string>;
+    /**
+     * The display name of the role.
+     */
+    displayName: pulumi.Input<string>;
+    /**
+     * The ID of the role. Defaults to a GUID derived from the value.
+     */
+    id?: pulumi.Input<string>;
+    /**
+     * Whether the role is enabled. Defaults to true.
+     */
+    isEnabled?: pulumi.Input<boolean>;
+    /**
+     * The value of the role, which appears in the roles claim of tokens.
+     */
+    value?: pulumi.Input<string>;
+}
+
+/**
+ * Whether tokens can be requested with the OAuth 2.0 implicit flow.
+ */
+export interface ApplicationImplicitGrantSettings {
+    /**
+     * Whether access tokens can be requested with the implicit flow.
+     */
+    enableAccessTokenIssuance?: pulumi.Input<boolean>;
+    /**
+     * Whether ID tokens can be requested with the implicit flow.
+     */
+    enableIdTokenIssuance?: pulumi.Input<boolean>;
+}
+
+/**
+ * An optional claim included in tokens.
+ */
+export interface ApplicationOptionalClaim {
+    /**
+     * Additional properties of the claim.
+     */
+    additionalProperties?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Whether the claim is essential for the application.
+     */
+    essential?: pulumi.Input<boolean>;
+    /**
+     * The name of the claim.
+     */
+    name: pulumi.Input<string>;
+    /**
+     * The source of the claim, e.g. 'user' for a directory extension. Not set for built-in claims.
+     */
+    source?: pulumi.Input<string>;
+}
+
+/**
+ * Optional claims included in the tokens issued for an application.
+ */
+export interface ApplicationOptionalClaims {
+    /**
+     * The optional claims in access tokens.
+     */
+    accessToken?: pulumi.Input<pulumi.Input<inputs.ApplicationOptionalClaim>[]>;
+    /**
+     * The optional claims in ID tokens.
+     */
+    idToken?: pulumi.Input<pulumi.Input<inputs.ApplicationOptionalClaim>[]>;
+    /**
+     * The optional claims in SAML tokens.
+     */
+    saml2Token?: pulumi.Input<pulumi.Input<inputs.ApplicationOptionalClaim>[]>;
+}
+
+/**
+ * A delegated permission exposed by an application's API.
+ */
+export interface ApplicationPermissionScope {
+    /**
+     * The description of the scope shown to admins.
+     */
+    adminConsentDescription?: pulumi.Input<string>;
+    /**
+     * The title of the scope shown to admins.
+     */
+    adminConsentDisplayName?: pulumi.Input<string>;
+    /**
+     * The ID of the scope. Defaults to a GUID derived from the value.
+     */
+    id?: pulumi.Input<string>;
+    /**
+     * Whether the scope is enabled. Defaults to true.
+     */
+    isEnabled?: pulumi.Input<boolean>;
+    /**
+     * Whether users ('User') or only admins ('Admin') can consent to the scope. Defaults to 'User'.
+     */
+    type?: pulumi.Input<string>;
+    /**
+     * The description of the scope shown to users.
+     */
+    userConsentDescription?: pulumi.Input<string>;
+    /**
+     * The title of the scope shown to users.
+     */
+    userConsentDisplayName?: pulumi.Input<string>;
+    /**
+     * The value of the scope, which appears in the scp claim of access tokens.
+     */
+    value?: pulumi.Input<string>;
+}
+
+/**
+ * A client application that can use an API's scopes without user consent.
+ */
+export interface ApplicationPreAuthorizedApplication {
+    /**
+     * The app ID of the client application.
+     */
+    appId: pulumi.Input<string>;
+    /**
+     * The IDs of the scopes the client application is pre-authorized for.
+     */
+    delegatedPermissionIds: pulumi.Input<pulumi.Input<string>[]>;
+}
+
+/**
+ * Settings for a public client, like a desktop or mobile application.
+ */
+export interface ApplicationPublicClient {
+    /**
+     * The URLs where tokens are sent for sign-in.
+     */
+    redirectUris?: pulumi.Input<pulumi.Input<string>[]>;
+}
+
+/**
+ * The permissions an application requires on a resource application.
+ */
+export interface ApplicationRequiredResourceAccess {
+    /**
+     * The permissions required on the resource.
+     */
+    resourceAccess: pulumi.Input<pulumi.Input<inputs.ApplicationResourceAccess>[]>;
+    /**
+     * The app ID of the resource application, e.g. '00000003-0000-0000-c000-000000000000' for Microsoft Graph.
+     */
+    resourceAppId: pulumi.Input<string>;
+}
+
+/**
+ * A permission an application requires on a resource.
+ */
+export interface ApplicationResourceAccess {
+    /**
+     * The ID of the scope or app role.
+     */
+    id: pulumi.Input<string>;
+    /**
+     * 'Scope' for a delegated permission or 'Role' for an application permission.
+     */
+    type: pulumi.Input<string>;
+}
+
+/**
+ * Settings for a single-page application.
+ */
+export interface ApplicationSpa {
+    /**
+     * The URLs where tokens are sent for sign-in.
+     */
+    redirectUris?: pulumi.Input<pulumi.Input<string>[]>;
+}
+
+/**
+ * Settings for a web application.
+ */
+export interface ApplicationWeb {
+    /**
+     * The home page of the application.
+     */
+    homePageUrl?: pulumi.Input<string>;
+    /**
+     * The implicit grant settings.
+     */
+    implicitGrantSettings?: pulumi.Input<inputs.ApplicationImplicitGrantSettings>;
+    /**
+     * The URL used to sign out of the application.
+     */
+    logoutUrl?: pulumi.Input<string>;
+    /**
+     * The URLs where tokens are sent for sign-in.
+     */
+    redirectUris?: pulumi.Input<pulumi.Input<string>[]>;
+}
+
+/**
+ * A claim emitted by a claims mapping policy, either from a source attribute or with a constant value.
+ */
+export interface ClaimsMappingPolicyClaim {
+    /**
+     * The attribute of the source the claim value comes from, e.g. 'employeeid'.
+     */
+    id?: pulumi.Input<string>;
+    /**
+     * The name of the claim in JWTs.
+     */
+    jwtClaimType?: pulumi.Input<string>;
+    /**
+     * The URI of the claim in SAML tokens.
+     */
+    samlClaimType?: pulumi.Input<string>;
+    /**
+     * The name format of the claim in SAML tokens.
+     */
+    samlNameFormat?: pulumi.Input<string>;
+    /**
+     * The source of the claim value: 'user', 'application', 'resource', 'audience', 'company' or 'transformation'.
+     */
+    source?: pulumi.Input<string>;
+    /**
+     * A constant value for the claim, instead of a source and an ID.
+     */
+    value?: pulumi.Input<string>;
+}
+
+/**
+ * The definition of a claims mapping policy.
+ */
+export interface ClaimsMappingPolicyDefinition {
+    /**
+     * The claims emitted in tokens.
+     */
+    claimsSchema: pulumi.Input<pulumi.Input<inputs.ClaimsMappingPolicyClaim>[]>;
+    /**
+     * Whether the basic claim set is included in tokens in addition to the mapped claims. Defaults to false.
+     */
+    includeBasicClaimSet?: pulumi.Input<boolean>;
+}
+
+/**
+ * A client application that can use some of the API's scopes without user consent.
+ */
+export interface ExposeApiPreAuthorizedApplication {
+    /**
+     * The app ID of the client application.
+     */
+    appId: pulumi.Input<string>;
+    /**
+     * The values of the scopes the client application is pre-authorized for.
+     */
+    scopes: pulumi.Input<pulumi.Input<string>[]>;
+}
+
+/**
+ * Builds the subject of a federated identity credential for GitHub Actions. Exactly one of branch, tag, environment and pullRequest must be set.
+ */
+export interface GitHubFederatedSubject {
+    /**
+     * Trust workflows running on this branch.
+     */
+    branch?: pulumi.Input<string>;
+    /**
+     * Trust jobs that use this deployment environment.
+     */
+    environment?: pulumi.Input<string>;
+    /**
+     * Trust workflows triggered by pull requests.
+     */
+    pullRequest?: pulumi.Input<boolean>;
+    /**
+     * The repository, in the form 'owner/repository'.
+     */
+    repository: pulumi.Input<string>;
+    /**
+     * Trust workflows running on this tag.
+     */
+    tag?: pulumi.Input<string>;
+}
+
+/**
+ * Builds the subject of a federated identity credential for a Kubernetes service account.
+ */
+export interface KubernetesFederatedSubject {
+    /**
+     * The namespace of the service account.
+     */
+    namespace: pulumi.Input<string>;
+    /**
+     * The name of the service account.
+     */
+    serviceAccount: pulumi.Input<string>;
+}
+
+/**
+ * An API the application requires permissions on, with the permissions given by name.
+ */
+export interface RequiredResourceAccessResource {
+    /**
+     * The values of the application permissions (app roles) to require, like 'User.Read.All'.
+     */
+    applicationPermissions?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The values of the delegated permissions (scopes) to require, like 'User.Read' or 'openid'.
+     */
+    delegatedPermissions?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The API, either as the app ID of its application or as one of the well-known names: 'MicrosoftGraph', 'AzureADGraph', 'AzureKeyVault', 'AzureServiceManagement', 'AzureStorage', 'Office365ExchangeOnline' or 'SharePointOnline'.
+     */
+    resourceApp: pulumi.Input<string>;
+}
+
+/**
+ * The definition of a token lifetime policy.
+ */
+export interface TokenLifetimePolicyDefinition {
+    /**
+     * The lifetime of access and ID tokens as a time span between '00:10:00' and '1.00:00:00', e.g. '01:00:00' for an hour.
+     */
+    accessTokenLifetime: pulumi.Input<string>;
+}
@@ -3,8 +3,41 @@
 # *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 # Export this package's modules as members:
+from ._enums import *
+from .api_permission_grant import *
+from .app_role import *
+from .app_role_assignment import *
+from .application import *
+from .application_branding import *
+from .application_certificate import *
+from .application_logo import *
+from .application_owner import *
+from .application_password import *
+from .claims_mapping_policy import *
+from .claims_mapping_policy_assignment import *
+from .directory_role_assignment import *
+from .expose_api import *
+from .extension_property import *
+from .federated_identity_credential import *
+from .get_deleted_application import *
+from .group import *
+from .group_member import *
 from .prepare_app_for_web_sign_in import *
 from .provider import *
+from .required_resource_access import *
+from .restore_deleted_application import *
+from .restored_application import *
+from .service_principal import *
+from .token_configuration import *
+from .token_lifetime_policy import *
+from .token_lifetime_policy_assignment import *
+from ._inputs import *
+from . import outputs
+
+# Make subpackages available:
+from . import (
+    config,
+)
 
 def _register_module():
     import pulumi
@@ -18,8 +51,54 @@ def _register_module():
             return Module._version
 
         def construct(self, name: str, typ: str, urn: str) -> pulumi.Resource:
-            if typ == "knapcode:index:PrepareAppForWebSignIn":
+            if typ == "knapcode:index:ApiPermissionGrant":
+                return ApiPermissionGrant(name, pulumi.ResourceOptions(urn=urn))
+            elif typ == "knapcode:index:AppRole":
+                return AppRole(name, pulumi.ResourceOptions(urn=urn))
+            elif typ == "knapcode:index:AppRoleAssignment":
+                return AppRoleAssignment(name, pulumi.ResourceOptions(urn=urn))
+            elif typ == "knapcode:index:Application":
+                return Application(name, pulumi.ResourceOptions(urn=urn))
+            elif typ == "knapcode:index:ApplicationBranding":
+                return ApplicationBranding(name, pulumi.ResourceOptions(urn=urn))
+            elif typ == "knapcode:index:ApplicationCertificate":
+                return ApplicationCertificate(name, pulumi.ResourceOptions(urn=urn))
+            elif typ == "knapcode:index:ApplicationLogo":
+                return ApplicationLogo(name, pulumi.ResourceOptions(urn=urn))
+            elif typ == "knapcode:index:ApplicationOwner":
+                return ApplicationOwner(name, pulumi.ResourceOptions(urn=urn))
+            elif typ == "knapcode:index:ApplicationPassword":
+                return ApplicationPassword(name, pulumi.ResourceOptions(urn=urn))
+            elif typ == "knapcode:index:ClaimsMappingPolicy":
+                return ClaimsMappingPolicy(name, pulumi.ResourceOptions(urn=urn))
+            elif typ == "knapcode:index:ClaimsMappingPolicyAssignment":
+                return ClaimsMappingPolicyAssignment(name, pulumi.ResourceOptions(urn=urn))
+            elif typ == "knapcode:index:DirectoryRoleAssignment":
+                return DirectoryRoleAssignment(name, pulumi.ResourceOptions(urn=urn))
+            elif typ == "knapcode:index:ExposeApi":
+                return ExposeApi(name, pulumi.ResourceOptions(urn=urn))
+            elif typ == "knapcode:index:ExtensionProperty":
+                return ExtensionProperty(name, pulumi.ResourceOptions(urn=urn))
+            elif typ == "knapcode:index:FederatedIdentityCredential":
+                return FederatedIdentityCredential(name, pulumi.ResourceOptions(urn=urn))
+            elif typ == "knapcode:index:Group":
+                return Group(name, pulumi.ResourceOptions(urn=urn))
+            elif typ == "knapcode:index:GroupMember":
+                return GroupMember(name, pulumi.ResourceOptions(urn=urn))
+            elif typ == "knapcode:index:PrepareAppForWebSignIn":
                 return PrepareAppForWebSignIn(name, pulumi.ResourceOptions(urn=urn))
+            elif typ == "knapcode:index:RequiredResourceAccess":
+                return RequiredResourceAccess(name, pulumi.ResourceOptions(urn=urn))
+            elif typ == "knapcode:index:RestoredApplication":
+                return RestoredApplication(name, pulumi.ResourceOptions(urn=urn))
+            elif typ == "knapcode:index:ServicePrincipal":
+                return ServicePrincipal(name, pulumi.ResourceOptions(urn=urn))
+            elif typ == "knapcode:index:TokenConfiguration":
+                return TokenConfiguration(name, pulumi.ResourceOptions(urn=urn))
+            elif typ == "knapcode:index:TokenLifetimePolicy":
+                return TokenLifetimePolicy(name, pulumi.ResourceOptions(urn=urn))
+            elif typ == "knapcode:index:TokenLifetimePolicyAssignment":
+                return TokenLifetimePolicyAssignment(name, pulumi.ResourceOptions(urn=urn))
             else:
                 raise Exception(f"unknown resource type {typ}")
 
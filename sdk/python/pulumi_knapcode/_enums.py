@@ -0,0 +1,63 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+from enum import Enum
+
+__all__ = [
+    'ConflictPolicy',
+    'ExtensionPropertyDataType',
+    'GroupMembershipClaims',
+    'GroupType',
+    'SignInAudience',
+]
+
+
+class ConflictPolicy(str, Enum):
+    """
+    How to handle application settings that were changed outside of Pulumi.
+    """
+    OVERWRITE = "overwrite"
+    FAIL = "fail"
+    MERGE = "merge"
+
+
+class ExtensionPropertyDataType(str, Enum):
+    """
+    The data type of the values of an extension property.
+    """
+    BINARY = "Binary"
+    BOOLEAN = "Boolean"
+    DATE_TIME = "DateTime"
+    INTEGER = "Integer"
+    LARGE_INTEGER = "LargeInteger"
+    STRING = "String"
+
+
+class GroupMembershipClaims(str, Enum):
+    """
+    The groups included in the groups claim of tokens issued for an application.
+    """
+    NONE = "None"
+    SECURITY_GROUP = "SecurityGroup"
+    DIRECTORY_ROLE = "DirectoryRole"
+    APPLICATION_GROUP = "ApplicationGroup"
+    ALL = "All"
+
+
+class GroupType(str, Enum):
+    """
+    The kind of a group.
+    """
+    SECURITY = "Security"
+    MICROSOFT365 = "Microsoft365"
+
+
+class SignInAudience(str, Enum):
+    """
+    The Microsoft accounts that can sign in to an application.
+    """
+    AZURE_AD_MY_ORG = "AzureADMyOrg"
+    AZURE_AD_MULTIPLE_ORGS = "AzureADMultipleOrgs"
+    AZURE_A_DAND_PERSONAL_MICROSOFT_ACCOUNT = "AzureADandPersonalMicrosoftAccount"
+    PERSONAL_MICROSOFT_ACCOUNT = "PersonalMicrosoftAccount"
@@ -0,0 +1,1143 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+from ._enums import *
+
+__all__ = [
+    'ApplicationApiArgs',
+    'ApplicationAppRoleArgs',
+    'ApplicationImplicitGrantSettingsArgs',
+    'ApplicationOptionalClaimArgs',
+    'ApplicationOptionalClaimsArgs',
+    'ApplicationPermissionScopeArgs',
+    'ApplicationPreAuthorizedApplicationArgs',
+    'ApplicationPublicClientArgs',
+    'ApplicationRequiredResourceAccessArgs',
+    'ApplicationResourceAccessArgs',
+    'ApplicationSpaArgs',
+    'ApplicationWebArgs',
+    'ClaimsMappingPolicyClaimArgs',
+    'ClaimsMappingPolicyDefinitionArgs',
+    'ExposeApiPreAuthorizedApplicationArgs',
+    'GitHubFederatedSubjectArgs',
+    'KubernetesFederatedSubjectArgs',
+    'RequiredResourceAccessResourceArgs',
+    'TokenLifetimePolicyDefinitionArgs',
+]
+
+@pulumi.input_type
+class ApplicationApiArgs:
+    def __init__(__self__, *,
+                 accept_mapped_claims: Optional[pulumi.Input[bool]] = None,
+                 known_client_applications: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 oauth2_permission_scopes: Optional[pulumi.Input[Sequence[pulumi.Input['ApplicationPermissionScopeArgs']]]] = None,
+                 pre_authorized_applications: Optional[pulumi.Input[Sequence[pulumi.Input['ApplicationPreAuthorizedApplicationArgs']]]] = None,
+                 requested_access_token_version: Optional[pulumi.Input[int]] = None):
+        """
+        Settings for an application that exposes an API.
+        :param pulumi.Input[bool] accept_mapped_claims: Whether claims mapping can be used without a custom signing key.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] known_client_applications: The app IDs of client applications that are bundled with this application for consent.
+        :param pulumi.Input[Sequence[pulumi.Input['ApplicationPermissionScopeArgs']]] oauth2_permission_scopes: The delegated permissions exposed by the API.
+        :param pulumi.Input[Sequence[pulumi.Input['ApplicationPreAuthorizedApplicationArgs']]] pre_authorized_applications: The client applications that are pre-authorized for the API's scopes.
+        :param pulumi.Input[int] requested_access_token_version: The access token version expected by the API, 1 or 2.
+        """
+        if accept_mapped_claims is not None:
+            pulumi.set(__self__, "accept_mapped_claims", accept_mapped_claims)
+        if known_client_applications is not None:
+            pulumi.set(__self__, "known_client_applications", known_client_applications)
+        if oauth2_permission_scopes is not None:
+            pulumi.set(__self__, "oauth2_permission_scopes", oauth2_permission_scopes)
+        if pre_authorized_applications is not None:
+            pulumi.set(__self__, "pre_authorized_applications", pre_authorized_applications)
+        if requested_access_token_version is not None:
+            pulumi.set(__self__, "requested_access_token_version", requested_access_token_version)
+
+    @property
+    @pulumi.getter(name="acceptMappedClaims")
+    def accept_mapped_claims(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether claims mapping can be used without a custom signing key.
+        """
+        return pulumi.get(self, "accept_mapped_claims")
+
+    @accept_mapped_claims.setter
+    def accept_mapped_claims(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "accept_mapped_claims", value)
+
+    @property
+    @pulumi.getter(name="knownClientApplications")
+    def known_client_applications(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        The app IDs of client applications that are bundled with this application for consent.
+        """
+        return pulumi.get(self, "known_client_applications")
+
+    @known_client_applications.setter
+    def known_client_applications(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "known_client_applications", value)
+
+    @property
+    @pulumi.getter(name="oauth2PermissionScopes")
+    def oauth2_permission_scopes(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['ApplicationPermissionScopeArgs']]]]:
+        """
+        The delegated permissions exposed by the API.
+        """
+        return pulumi.get(self, "oauth2_permission_scopes")
+
+    @oauth2_permission_scopes.setter
+    def oauth2_permission_scopes(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['ApplicationPermissionScopeArgs']]]]):
+        pulumi.set(self, "oauth2_permission_scopes", value)
+
+    @property
+    @pulumi.getter(name="preAuthorizedApplications")
+    def pre_authorized_applications(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['ApplicationPreAuthorizedApplicationArgs']]]]:
+        """
+        The client applications that are pre-authorized for the API's scopes.
+        """
+        return pulumi.get(self, "pre_authorized_applications")
+
+    @pre_authorized_applications.setter
+    def pre_authorized_applications(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['ApplicationPreAuthorizedApplicationArgs']]]]):
+        pulumi.set(self, "pre_authorized_applications", value)
+
+    @property
+    @pulumi.getter(name="requestedAccessTokenVersion")
+    def requested_access_token_version(self) -> Optional[pulumi.Input[int]]:
+        """
+        The access token version expected by the API, 1 or 2.
+        """
+        return pulumi.get(self, "requested_access_token_version")
+
+    @requested_access_token_version.setter
+    def requested_access_token_version(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "requested_access_token_version", value)
+
+
+@pulumi.input_type
+class ApplicationAppRoleArgs:
+    def __init__(__self__, *,
+                 allowed_member_types: pulumi.Input[Sequence[pulumi.Input[str]]],
+                 description: pulumi.Input[str],
+                 display_name: pulumi.Input[str],
+                 id: Optional[pulumi.Input[str]] = None,
+                 is_enabled: Optional[pulumi.Input[bool]] = None,
+                 value: Optional[pulumi.Input[str]] = None):
+        """
+        A role that can be assigned to users, groups or applications.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] allowed_member_types: Who can be assigned the role: 'User' for users and groups, 'Application' for applications, or both.
+        :param pulumi.Input[str] description: The description of the role.
+        :param pulumi.Input[str] display_name: The display name of the role.
+        :param pulumi.Input[str] id: The ID of the role. Defaults to a GUID derived from the value.
+        :param pulumi.Input[bool] is_enabled: Whether the role is enabled. Defaults to true.
+        :param pulumi.Input[str] value: The value of the role, which appears in the roles claim of tokens.
+        """
+        pulumi.set(__self__, "allowed_member_types", allowed_member_types)
+        pulumi.set(__self__, "description", description)
+        pulumi.set(__self__, "display_name", display_name)
+        if id is not None:
+            pulumi.set(__self__, "id", id)
+        if is_enabled is not None:
+            pulumi.set(__self__, "is_enabled", is_enabled)
+        if value is not None:
+            pulumi.set(__self__, "value", value)
+
+    @property
+    @pulumi.getter(name="allowedMemberTypes")
+    def allowed_member_types(self) -> pulumi.Input[Sequence[pulumi.Input[str]]]:
+        """
+        Who can be assigned the role: 'User' for users and groups, 'Application' for applications, or both.
+        """
+        return pulumi.get(self, "allowed_member_types")
+
+    @allowed_member_types.setter
+    def allowed_member_types(self, value: pulumi.Input[Sequence[pulumi.Input[str]]]):
+        pulumi.set(self, "allowed_member_types", value)
+
+    @property
+    @pulumi.getter
+    def description(self) -> pulumi.Input[str]:
+        """
+        The description of the role.
+        """
+        return pulumi.get(self, "description")
+
+    @description.setter
+    def description(self, value: pulumi.Input[str]):
+        pulumi.set(self, "description", value)
+
+    @property
+    @pulumi.getter(name="displayName")
+    def display_name(self) -> pulumi.Input[str]:
+        """
+        The display name of the role.
+        """
+        return pulumi.get(self, "display_name")
+
+    @display_name.setter
+    def display_name(self, value: pulumi.Input[str]):
+        pulumi.set(self, "display_name", value)
+
+    @property
+    @pulumi.getter
+    def id(self) -> Optional[pulumi.Input[str]]:
+        """
+        The ID of the role. Defaults to a GUID derived from the value.
+        """
+        return pulumi.get(self, "id")
+
+    @id.setter
+    def id(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "id", value)
+
+    @property
+    @pulumi.getter(name="isEnabled")
+    def is_enabled(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether the role is enabled. Defaults to true.
+        """
+        return pulumi.get(self, "is_enabled")
+
+    @is_enabled.setter
+    def is_enabled(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "is_enabled", value)
+
+    @property
+    @pulumi.getter
+    def value(self) -> Optional[pulumi.Input[str]]:
+        """
+        The value of the role, which appears in the roles claim of tokens.
+        """
+        return pulumi.get(self, "value")
+
+    @value.setter
+    def value(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "value", value)
+
+
+@pulumi.input_type
+class ApplicationImplicitGrantSettingsArgs:
+    def __init__(__self__, *,
+                 enable_access_token_issuance: Optional[pulumi.Input[bool]] = None,
+                 enable_id_token_issuance: Optional[pulumi.Input[bool]] = None):
+        """
+        Whether tokens can be requested with the OAuth 2.0 implicit flow.
+        :param pulumi.Input[bool] enable_access_token_issuance: Whether access tokens can be requested with the implicit flow.
+        :param pulumi.Input[bool] enable_id_token_issuance: Whether ID tokens can be requested with the implicit flow.
+        """
+        if enable_access_token_issuance is not None:
+            pulumi.set(__self__, "enable_access_token_issuance", enable_access_token_issuance)
+        if enable_id_token_issuance is not None:
+            pulumi.set(__self__, "enable_id_token_issuance", enable_id_token_issuance)
+
+    @property
+    @pulumi.getter(name="enableAccessTokenIssuance")
+    def enable_access_token_issuance(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether access tokens can be requested with the implicit flow.
+        """
+        return pulumi.get(self, "enable_access_token_issuance")
+
+    @enable_access_token_issuance.setter
+    def enable_access_token_issuance(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "enable_access_token_issuance", value)
+
+    @property
+    @pulumi.getter(name="enableIdTokenIssuance")
+    def enable_id_token_issuance(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether ID tokens can be requested with the implicit flow.
+        """
+        return pulumi.get(self, "enable_id_token_issuance")
+
+    @enable_id_token_issuance.setter
+    def enable_id_token_issuance(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "enable_id_token_issuance", value)
+
+
+@pulumi.input_type
+class ApplicationOptionalClaimArgs:
+    def __init__(__self__, *,
+                 name: pulumi.Input[str],
+                 additional_properties: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 essential: Optional[pulumi.Input[bool]] = None,
+                 source: Optional[pulumi.Input[str]] = None):
+        """
+        An optional claim included in tokens.
+        :param pulumi.Input[str] name: The name of the claim.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] additional_properties: Additional properties of the claim.
+        :param pulumi.Input[bool] essential: Whether the claim is essential for the application.
+        :param pulumi.Input[str] source: The source of the claim, e.g. 'user' for a directory extension. Not set for built-in claims.
+        """
+        pulumi.set(__self__, "name", name)
+        if additional_properties is not None:
+            pulumi.set(__self__, "additional_properties", additional_properties)
+        if essential is not None:
+            pulumi.set(__self__, "essential", essential)
+        if source is not None:
+            pulumi.set(__self__, "source", source)
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Input[str]:
+        """
+        The name of the claim.
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: pulumi.Input[str]):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter(name="additionalProperties")
+    def additional_properties(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Additional properties of the claim.
+        """
+        return pulumi.get(self, "additional_properties")
+
+    @additional_properties.setter
+    def additional_properties(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "additional_properties", value)
+
+    @property
+    @pulumi.getter
+    def essential(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether the claim is essential for the application.
+        """
+        return pulumi.get(self, "essential")
+
+    @essential.setter
+    def essential(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "essential", value)
+
+    @property
+    @pulumi.getter
+    def source(self) -> Optional[pulumi.Input[str]]:
+        """
+        The source of the claim, e.g. 'user' for a directory extension. Not set for built-in claims.
+        """
+        return pulumi.get(self, "source")
+
+    @source.setter
+    def source(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "source", value)
+
+
+@pulumi.input_type
+class ApplicationOptionalClaimsArgs:
+    def __init__(__self__, *,
+                 access_token: Optional[pulumi.Input[Sequence[pulumi.Input['ApplicationOptionalClaimArgs']]]] = None,
+                 id_token: Optional[pulumi.Input[Sequence[pulumi.Input['ApplicationOptionalClaimArgs']]]] = None,
+                 saml2_token: Optional[pulumi.Input[Sequence[pulumi.Input['ApplicationOptionalClaimArgs']]]] = None):
+        """
+        Optional claims included in the tokens issued for an application.
+        :param pulumi.Input[Sequence[pulumi.Input['ApplicationOptionalClaimArgs']]] access_token: The optional claims in access tokens.
+        :param pulumi.Input[Sequence[pulumi.Input['ApplicationOptionalClaimArgs']]] id_token: The optional claims in ID tokens.
+        :param pulumi.Input[Sequence[pulumi.Input['ApplicationOptionalClaimArgs']]] saml2_token: The optional claims in SAML tokens.
+        """
+        if access_token is not None:
+            pulumi.set(__self__, "access_token", access_token)
+        if id_token is not None:
+            pulumi.set(__self__, "id_token", id_token)
+        if saml2_token is not None:
+            pulumi.set(__self__, "saml2_token", saml2_token)
+
+    @property
+    @pulumi.getter(name="accessToken")
+    def access_token(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['ApplicationOptionalClaimArgs']]]]:
+        """
+        The optional claims in access tokens.
+        """
+        return pulumi.get(self, "access_token")
+
+    @access_token.setter
+    def access_token(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['ApplicationOptionalClaimArgs']]]]):
+        pulumi.set(self, "access_token", value)
+
+    @property
+    @pulumi.getter(name="idToken")
+    def id_token(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['ApplicationOptionalClaimArgs']]]]:
+        """
+        The optional claims in ID tokens.
+        """
+        return pulumi.get(self, "id_token")
+
+    @id_token.setter
+    def id_token(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['ApplicationOptionalClaimArgs']]]]):
+        pulumi.set(self, "id_token", value)
+
+    @property
+    @pulumi.getter(name="saml2Token")
+    def saml2_token(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['ApplicationOptionalClaimArgs']]]]:
+        """
+        The optional claims in SAML tokens.
+        """
+        return pulumi.get(self, "saml2_token")
+
+    @saml2_token.setter
+    def saml2_token(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['ApplicationOptionalClaimArgs']]]]):
+        pulumi.set(self, "saml2_token", value)
+
+
+@pulumi.input_type
+class ApplicationPermissionScopeArgs:
+    def __init__(__self__, *,
+                 admin_consent_description: Optional[pulumi.Input[str]] = None,
+                 admin_consent_display_name: Optional[pulumi.Input[str]] = None,
+                 id: Optional[pulumi.Input[str]] = None,
+                 is_enabled: Optional[pulumi.Input[bool]] = None,
+                 type: Optional[pulumi.Input[str]] = None,
+                 user_consent_description: Optional[pulumi.Input[str]] = None,
+                 user_consent_display_name: Optional[pulumi.Input[str]] = None,
+                 value: Optional[pulumi.Input[str]] = None):
+        """
+        A delegated permission exposed by an application's API.
+        :param pulumi.Input[str] admin_consent_description: The description of the scope shown to admins.
+        :param pulumi.Input[str] admin_consent_display_name: The title of the scope shown to admins.
+        :param pulumi.Input[str] id: The ID of the scope. Defaults to a GUID derived from the value.
+        :param pulumi.Input[bool] is_enabled: Whether the scope is enabled. Defaults to true.
+        :param pulumi.Input[str] type: Whether users ('User') or only admins ('Admin') can consent to the scope. Defaults to 'User'.
+        :param pulumi.Input[str] user_consent_description: The description of the scope shown to users.
+        :param pulumi.Input[str] user_consent_display_name: The title of the scope shown to users.
+        :param pulumi.Input[str] value: The value of the scope, which appears in the scp claim of access tokens.
+        """
+        if admin_consent_description is not None:
+            pulumi.set(__self__, "admin_consent_description", admin_consent_description)
+        if admin_consent_display_name is not None:
+            pulumi.set(__self__, "admin_consent_display_name", admin_consent_display_name)
+        if id is not None:
+            pulumi.set(__self__, "id", id)
+        if is_enabled is not None:
+            pulumi.set(__self__, "is_enabled", is_enabled)
+        if type is not None:
+            pulumi.set(__self__, "type", type)
+        if user_consent_description is not None:
+            pulumi.set(__self__, "user_consent_description", user_consent_description)
+        if user_consent_display_name is not None:
+            pulumi.set(__self__, "user_consent_display_name", user_consent_display_name)
+        if value is not None:
+            pulumi.set(__self__, "value", value)
+
+    @property
+    @pulumi.getter(name="adminConsentDescription")
+    def admin_consent_description(self) -> Optional[pulumi.Input[str]]:
+        """
+        The description of the scope shown to admins.
+        """
+        return pulumi.get(self, "admin_consent_description")
+
+    @admin_consent_description.setter
+    def admin_consent_description(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "admin_consent_description", value)
+
+    @property
+    @pulumi.getter(name="adminConsentDisplayName")
+    def admin_consent_display_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        The title of the scope shown to admins.
+        """
+        return pulumi.get(self, "admin_consent_display_name")
+
+    @admin_consent_display_name.setter
+    def admin_consent_display_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "admin_consent_display_name", value)
+
+    @property
+    @pulumi.getter
+    def id(self) -> Optional[pulumi.Input[str]]:
+        """
+        The ID of the scope. Defaults to a GUID derived from the value.
+        """
+        return pulumi.get(self, "id")
+
+    @id.setter
+    def id(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "id", value)
+
+    @property
+    @pulumi.getter(name="isEnabled")
+    def is_enabled(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether the scope is enabled. Defaults to true.
+        """
+        return pulumi.get(self, "is_enabled")
+
+    @is_enabled.setter
+    def is_enabled(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "is_enabled", value)
+
+    @property
+    @pulumi.getter
+    def type(self) -> Optional[pulumi.Input[str]]:
+        """
+        Whether users ('User') or only admins ('Admin') can consent to the scope. Defaults to 'User'.
+        """
+        return pulumi.get(self, "type")
+
+    @type.setter
+    def type(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "type", value)
+
+    @property
+    @pulumi.getter(name="userConsentDescription")
+    def user_consent_description(self) -> Optional[pulumi.Input[str]]:
+        """
+        The description of the scope shown to users.
+        """
+        return pulumi.get(self, "user_consent_description")
+
+    @user_consent_description.setter
+    def user_consent_description(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "user_consent_description", value)
+
+    @property
+    @pulumi.getter(name="userConsentDisplayName")
+    def user_consent_display_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        The title of the scope shown to users.
+        """
+        return pulumi.get(self, "user_consent_display_name")
+
+    @user_consent_display_name.setter
+    def user_consent_display_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "user_consent_display_name", value)
+
+    @property
+    @pulumi.getter
+    def value(self) -> Optional[pulumi.Input[str]]:
+        """
+        The value of the scope, which appears in the scp claim of access tokens.
+        """
+        return pulumi.get(self, "value")
+
+    @value.setter
+    def value(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "value", value)
+
+
+@pulumi.input_type
+class ApplicationPreAuthorizedApplicationArgs:
+    def __init__(__self__, *,
+                 app_id: pulumi.Input[str],
+                 delegated_permission_ids: pulumi.Input[Sequence[pulumi.Input[str]]]):
+        """
+        A client application that can use an API's scopes without user consent.
+        :param pulumi.Input[str] app_id: The app ID of the client application.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] delegated_permission_ids: The IDs of the scopes the client application is pre-authorized for.
+        """
+        pulumi.set(__self__, "app_id", app_id)
+        pulumi.set(__self__, "delegated_permission_ids", delegated_permission_ids)
+
+    @property
+    @pulumi.getter(name="appId")
+    def app_id(self) -> pulumi.Input[str]:
+        """
+        The app ID of the client application.
+        """
+        return pulumi.get(self, "app_id")
+
+    @app_id.setter
+    def app_id(self, value: pulumi.Input[str]):
+        pulumi.set(self, "app_id", value)
+
+    @property
+    @pulumi.getter(name="delegatedPermissionIds")
+    def delegated_permission_ids(self) -> pulumi.Input[Sequence[pulumi.Input[str]]]:
+        """
+        The IDs of the scopes the client application is pre-authorized for.
+        """
+        return pulumi.get(self, "delegated_permission_ids")
+
+    @delegated_permission_ids.setter
+    def delegated_permission_ids(self, value: pulumi.Input[Sequence[pulumi.Input[str]]]):
+        pulumi.set(self, "delegated_permission_ids", value)
+
+
+@pulumi.input_type
+class ApplicationPublicClientArgs:
+    def __init__(__self__, *,
+                 redirect_uris: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None):
+        """
+        Settings for a public client, like a desktop or mobile application.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] redirect_uris: The URLs where tokens are sent for sign-in.
+        """
+        if redirect_uris is not None:
+            pulumi.set(__self__, "redirect_uris", redirect_uris)
+
+    @property
+    @pulumi.getter(name="redirectUris")
+    def redirect_uris(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        The URLs where tokens are sent for sign-in.
+        """
+        return pulumi.get(self, "redirect_uris")
+
+    @redirect_uris.setter
+    def redirect_uris(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "redirect_uris", value)
+
+
+@pulumi.input_type
+class ApplicationRequiredResourceAccessArgs:
+    def __init__(__self__, *,
+                 resource_access: pulumi.Input[Sequence[pulumi.Input['ApplicationResourceAccessArgs']]],
+                 resource_app_id: pulumi.Input[str]):
+        """
+        The permissions an application requires on a resource application.
+        :param pulumi.Input[Sequence[pulumi.Input['ApplicationResourceAccessArgs']]] resource_access: The permissions required on the resource.
+        :param pulumi.Input[str] resource_app_id: The app ID of the resource application, e.g. '00000003-0000-0000-c000-000000000000' for Microsoft Graph.
+        """
+        pulumi.set(__self__, "resource_access", resource_access)
+        pulumi.set(__self__, "resource_app_id", resource_app_id)
+
+    @property
+    @pulumi.getter(name="resourceAccess")
+    def resource_access(self) -> pulumi.Input[Sequence[pulumi.Input['ApplicationResourceAccessArgs']]]:
+        """
+        The permissions required on the resource.
+        """
+        return pulumi.get(self, "resource_access")
+
+    @resource_access.setter
+    def resource_access(self, value: pulumi.Input[Sequence[pulumi.Input['ApplicationResourceAccessArgs']]]):
+        pulumi.set(self, "resource_access", value)
+
+    @property
+    @pulumi.getter(name="resourceAppId")
+    def resource_app_id(self) -> pulumi.Input[str]:
+        """
+        The app ID of the resource application, e.g. '00000003-0000-0000-c000-000000000000' for Microsoft Graph.
+        """
+        return pulumi.get(self, "resource_app_id")
+
+    @resource_app_id.setter
+    def resource_app_id(self, value: pulumi.Input[str]):
+        pulumi.set(self, "resource_app_id", value)
+
+
+@pulumi.input_type
+class ApplicationResourceAccessArgs:
+    def __init__(__self__, *,
+                 id: pulumi.Input[str],
+                 type: pulumi.Input[str]):
+        """
+        A permission an application requires on a resource.
+        :param pulumi.Input[str] id: The ID of the scope or app role.
+        :param pulumi.Input[str] type: 'Scope' for a delegated permission or 'Role' for an application permission.
+        """
+        pulumi.set(__self__, "id", id)
+        pulumi.set(__self__, "type", type)
+
+    @property
+    @pulumi.getter
+    def id(self) -> pulumi.Input[str]:
+        """
+        The ID of the scope or app role.
+        """
+        return pulumi.get(self, "id")
+
+    @id.setter
+    def id(self, value: pulumi.Input[str]):
+        pulumi.set(self, "id", value)
+
+    @property
+    @pulumi.getter
+    def type(self) -> pulumi.Input[str]:
+        """
+        'Scope' for a delegated permission or 'Role' for an application permission.
+        """
+        return pulumi.get(self, "type")
+
+    @type.setter
+    def type(self, value: pulumi.Input[str]):
+        pulumi.set(self, "type", value)
+
+
+@pulumi.input_type
+class ApplicationSpaArgs:
+    def __init__(__self__, *,
+                 redirect_uris: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None):
+        """
+        Settings for a single-page application.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] redirect_uris: The URLs where tokens are sent for sign-in.
+        """
+        if redirect_uris is not None:
+            pulumi.set(__self__, "redirect_uris", redirect_uris)
+
+    @property
+    @pulumi.getter(name="redirectUris")
+    def redirect_uris(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        The URLs where tokens are sent for sign-in.
+        """
+        return pulumi.get(self, "redirect_uris")
+
+    @redirect_uris.setter
+    def redirect_uris(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "redirect_uris", value)
+
+
+@pulumi.input_type
+class ApplicationWebArgs:
+    def __init__(__self__, *,
+                 home_page_url: Optional[pulumi.Input[str]] = None,
+                 implicit_grant_settings: Optional[pulumi.Input['ApplicationImplicitGrantSettingsArgs']] = None,
+                 logout_url: Optional[pulumi.Input[str]] = None,
+                 redirect_uris: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None):
+        """
+        Settings for a web application.
+        :param pulumi.Input[str] home_page_url: The home page of the application.
+        :param pulumi.Input['ApplicationImplicitGrantSettingsArgs'] implicit_grant_settings: The implicit grant settings.
+        :param pulumi.Input[str] logout_url: The URL used to sign out of the application.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] redirect_uris: The URLs where tokens are sent for sign-in.
+        """
+        if home_page_url is not None:
+            pulumi.set(__self__, "home_page_url", home_page_url)
+        if implicit_grant_settings is not None:
+            pulumi.set(__self__, "implicit_grant_settings", implicit_grant_settings)
+        if logout_url is not None:
+            pulumi.set(__self__, "logout_url", logout_url)
+        if redirect_uris is not None:
+            pulumi.set(__self__, "redirect_uris", redirect_uris)
+
+    @property
+    @pulumi.getter(name="homePageUrl")
+    def home_page_url(self) -> Optional[pulumi.Input[str]]:
+        """
+        The home page of the application.
+        """
+        return pulumi.get(self, "home_page_url")
+
+    @home_page_url.setter
+    def home_page_url(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "home_page_url", value)
+
+    @property
+    @pulumi.getter(name="implicitGrantSettings")
+    def implicit_grant_settings(self) -> Optional[pulumi.Input['ApplicationImplicitGrantSettingsArgs']]:
+        """
+        The implicit grant settings.
+        """
+        return pulumi.get(self, "implicit_grant_settings")
+
+    @implicit_grant_settings.setter
+    def implicit_grant_settings(self, value: Optional[pulumi.Input['ApplicationImplicitGrantSettingsArgs']]):
+        pulumi.set(self, "implicit_grant_settings", value)
+
+    @property
+    @pulumi.getter(name="logoutUrl")
+    def logout_url(self) -> Optional[pulumi.Input[str]]:
+        """
+        The URL used to sign out of the application.
+        """
+        return pulumi.get(self, "logout_url")
+
+    @logout_url.setter
+    def logout_url(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "logout_url", value)
+
+    @property
+    @pulumi.getter(name="redirectUris")
+    def redirect_uris(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        The URLs where tokens are sent for sign-in.
+        """
+        return pulumi.get(self, "redirect_uris")
+
+    @redirect_uris.setter
+    def redirect_uris(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "redirect_uris", value)
+
+
+@pulumi.input_type
+class ClaimsMappingPolicyClaimArgs:
+    def __init__(__self__, *,
+                 id: Optional[pulumi.Input[str]] = None,
+                 jwt_claim_type: Optional[pulumi.Input[str]] = None,
+                 saml_claim_type: Optional[pulumi.Input[str]] = None,
+                 saml_name_format: Optional[pulumi.Input[str]] = None,
+                 source: Optional[pulumi.Input[str]] = None,
+                 value: Optional[pulumi.Input[str]] = None):
+        """
+        A claim emitted by a claims mapping policy, either from a source attribute or with a constant value.
+        :param pulumi.Input[str] id: The attribute of the source the claim value comes from, e.g. 'employeeid'.
+        :param pulumi.Input[str] jwt_claim_type: The name of the claim in JWTs.
+        :param pulumi.Input[str] saml_claim_type: The URI of the claim in SAML tokens.
+        :param pulumi.Input[str] saml_name_format: The name format of the claim in SAML tokens.
+        :param pulumi.Input[str] source: The source of the claim value: 'user', 'application', 'resource', 'audience', 'company' or 'transformation'.
+        :param pulumi.Input[str] value: A constant value for the claim, instead of a source and an ID.
+        """
+        if id is not None:
+            pulumi.set(__self__, "id", id)
+        if jwt_claim_type is not None:
+            pulumi.set(__self__, "jwt_claim_type", jwt_claim_type)
+        if saml_claim_type is not None:
+            pulumi.set(__self__, "saml_claim_type", saml_claim_type)
+        if saml_name_format is not None:
+            pulumi.set(__self__, "saml_name_format", saml_name_format)
+        if source is not None:
+            pulumi.set(__self__, "source", source)
+        if value is not None:
+            pulumi.set(__self__, "value", value)
+
+    @property
+    @pulumi.getter
+    def id(self) -> Optional[pulumi.Input[str]]:
+        """
+        The attribute of the source the claim value comes from, e.g. 'employeeid'.
+        """
+        return pulumi.get(self, "id")
+
+    @id.setter
+    def id(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "id", value)
+
+    @property
+    @pulumi.getter(name="jwtClaimType")
+    def jwt_claim_type(self) -> Optional[pulumi.Input[str]]:
+        """
+        The name of the claim in JWTs.
+        """
+        return pulumi.get(self, "jwt_claim_type")
+
+    @jwt_claim_type.setter
+    def jwt_claim_type(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "jwt_claim_type", value)
+
+    @property
+    @pulumi.getter(name="samlClaimType")
+    def saml_claim_type(self) -> Optional[pulumi.Input[str]]:
+        """
+        The URI of the claim in SAML tokens.
+        """
+        return pulumi.get(self, "saml_claim_type")
+
+    @saml_claim_type.setter
+    def saml_claim_type(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "saml_claim_type", value)
+
+    @property
+    @pulumi.getter(name="samlNameFormat")
+    def saml_name_format(self) -> Optional[pulumi.Input[str]]:
+        """
+        The name format of the claim in SAML tokens.
+        """
+        return pulumi.get(self, "saml_name_format")
+
+    @saml_name_format.setter
+    def saml_name_format(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "saml_name_format", value)
+
+    @property
+    @pulumi.getter
+    def source(self) -> Optional[pulumi.Input[str]]:
+        """
+        The source of the claim value: 'user', 'application', 'resource', 'audience', 'company' or 'transformation'.
+        """
+        return pulumi.get(self, "source")
+
+    @source.setter
+    def source(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "source", value)
+
+    @property
+    @pulumi.getter
+    def value(self) -> Optional[pulumi.Input[str]]:
+        """
+        A constant value for the claim, instead of a source and an ID.
+        """
+        return pulumi.get(self, "value")
+
+    @value.setter
+    def value(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "value", value)
+
+
+@pulumi.input_type
+class ClaimsMappingPolicyDefinitionArgs:
+    def __init__(__self__, *,
+                 claims_schema: pulumi.Input[Sequence[pulumi.Input['ClaimsMappingPolicyClaimArgs']]],
+                 include_basic_claim_set: Optional[pulumi.Input[bool]] = None):
+        """
+        The definition of a claims mapping policy.
+        :param pulumi.Input[Sequence[pulumi.Input['ClaimsMappingPolicyClaimArgs']]] claims_schema: The claims emitted in tokens.
+        :param pulumi.Input[bool] include_basic_claim_set: Whether the basic claim set is included in tokens in addition to the mapped claims. Defaults to false.
+        """
+        pulumi.set(__self__, "claims_schema", claims_schema)
+        if include_basic_claim_set is not None:
+            pulumi.set(__self__, "include_basic_claim_set", include_basic_claim_set)
+
+    @property
+    @pulumi.getter(name="claimsSchema")
+    def claims_schema(self) -> pulumi.Input[Sequence[pulumi.Input['ClaimsMappingPolicyClaimArgs']]]:
+        """
+        The claims emitted in tokens.
+        """
+        return pulumi.get(self, "claims_schema")
+
+    @claims_schema.setter
+    def claims_schema(self, value: pulumi.Input[Sequence[pulumi.Input['ClaimsMappingPolicyClaimArgs']]]):
+        pulumi.set(self, "claims_schema", value)
+
+    @property
+    @pulumi.getter(name="includeBasicClaimSet")
+    def include_basic_claim_set(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether the basic claim set is included in tokens in addition to the mapped claims. Defaults to false.
+        """
+        return pulumi.get(self, "include_basic_claim_set")
+
+    @include_basic_claim_set.setter
+    def include_basic_claim_set(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "include_basic_claim_set", value)
+
+
+@pulumi.input_type
+class ExposeApiPreAuthorizedApplicationArgs:
+    def __init__(__self__, *,
+                 app_id: pulumi.Input[str],
+                 scopes: pulumi.Input[Sequence[pulumi.Input[str]]]):
+        """
+        A client application that can use some of the API's scopes without user consent.
+        :param pulumi.Input[str] app_id: The app ID of the client application.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] scopes: The values of the scopes the client application is pre-authorized for.
+        """
+        pulumi.set(__self__, "app_id", app_id)
+        pulumi.set(__self__, "scopes", scopes)
+
+    @property
+    @pulumi.getter(name="appId")
+    def app_id(self) -> pulumi.Input[str]:
+        """
+        The app ID of the client application.
+        """
+        return pulumi.get(self, "app_id")
+
+    @app_id.setter
+    def app_id(self, value: pulumi.Input[str]):
+        pulumi.set(self, "app_id", value)
+
+    @property
+    @pulumi.getter
+    def scopes(self) -> pulumi.Input[Sequence[pulumi.Input[str]]]:
+        """
+        The values of the scopes the client application is pre-authorized for.
+        """
+        return pulumi.get(self, "scopes")
+
+    @scopes.setter
+    def scopes(self, value: pulumi.Input[Sequence[pulumi.Input[str]]]):
+        pulumi.set(self, "scopes", value)
+
+
+@pulumi.input_type
+class GitHubFederatedSubjectArgs:
+    def __init__(__self__, *,
+                 repository: pulumi.Input[str],
+                 branch: Optional[pulumi.Input[str]] = None,
+                 environment: Optional[pulumi.Input[str]] = None,
+                 pull_request: Optional[pulumi.Input[bool]] = None,
+                 tag: Optional[pulumi.Input[str]] = None):
+        """
+        Builds the subject of a federated identity credential for GitHub Actions. Exactly one of branch, tag, environment and pullRequest must be set.
+        :param pulumi.Input[str] repository: The repository, in the form 'owner/repository'.
+        :param pulumi.Input[str] branch: Trust workflows running on this branch.
+        :param pulumi.Input[str] environment: Trust jobs that use this deployment environment.
+        :param pulumi.Input[bool] pull_request: Trust workflows triggered by pull requests.
+        :param pulumi.Input[str] tag: Trust workflows running on this tag.
+        """
+        pulumi.set(__self__, "repository", repository)
+        if branch is not None:
+            pulumi.set(__self__, "branch", branch)
+        if environment is not None:
+            pulumi.set(__self__, "environment", environment)
+        if pull_request is not None:
+            pulumi.set(__self__, "pull_request", pull_request)
+        if tag is not None:
+            pulumi.set(__self__, "tag", tag)
+
+    @property
+    @pulumi.getter
+    def repository(self) -> pulumi.Input[str]:
+        """
+        The repository, in the form 'owner/repository'.
+        """
+        return pulumi.get(self, "repository")
+
+    @repository.setter
+    def repository(self, value: pulumi.Input[str]):
+        pulumi.set(self, "repository", value)
+
+    @property
+    @pulumi.getter
+    def branch(self) -> Optional[pulumi.Input[str]]:
+        """
+        Trust workflows running on this branch.
+        """
+        return pulumi.get(self, "branch")
+
+    @branch.setter
+    def branch(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "branch", value)
+
+    @property
+    @pulumi.getter
+    def environment(self) -> Optional[pulumi.Input[str]]:
+        """
+        Trust jobs that use this deployment environment.
+        """
+        return pulumi.get(self, "environment")
+
+    @environment.setter
+    def environment(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "environment", value)
+
+    @property
+    @pulumi.getter(name="pullRequest")
+    def pull_request(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Trust workflows triggered by pull requests.
+        """
+        return pulumi.get(self, "pull_request")
+
+    @pull_request.setter
+    def pull_request(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "pull_request", value)
+
+    @property
+    @pulumi.getter
+    def tag(self) -> Optional[pulumi.Input[str]]:
+        """
+        Trust workflows running on this tag.
+        """
+        return pulumi.get(self, "tag")
+
+    @tag.setter
+    def tag(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "tag", value)
+
+
+@pulumi.input_type
+class KubernetesFederatedSubjectArgs:
+    def __init__(__self__, *,
+                 namespace: pulumi.Input[str],
+                 service_account: pulumi.Input[str]):
+        """
+        Builds the subject of a federated identity credential for a Kubernetes service account.
+        :param pulumi.Input[str] namespace: The namespace of the service account.
+        :param pulumi.Input[str] service_account: The name of the service account.
+        """
+        pulumi.set(__self__, "namespace", namespace)
+        pulumi.set(__self__, "service_account", service_account)
+
+    @property
+    @pulumi.getter
+    def namespace(self) -> pulumi.Input[str]:
+        """
+        The namespace of the service account.
+        """
+        return pulumi.get(self, "namespace")
+
+    @namespace.setter
+    def namespace(self, value: pulumi.Input[str]):
+        pulumi.set(self, "namespace", value)
+
+    @property
+    @pulumi.getter(name="serviceAccount")
+    def service_account(self) -> pulumi.Input[str]:
+        """
+        The name of the service account.
+        """
+        return pulumi.get(self, "service_account")
+
+    @service_account.setter
+    def service_account(self, value: pulumi.Input[str]):
+        pulumi.set(self, "service_account", value)
+
+
+@pulumi.input_type
+class RequiredResourceAccessResourceArgs:
+    def __init__(__self__, *,
+                 resource_app: pulumi.Input[str],
+                 application_permissions: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 delegated_permissions: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None):
+        """
+        An API the application requires permissions on, with the permissions given by name.
+        :param pulumi.Input[str] resource_app: The API, either as the app ID of its application or as one of the well-known names: 'MicrosoftGraph', 'AzureADGraph', 'AzureKeyVault', 'AzureServiceManagement', 'AzureStorage', 'Office365ExchangeOnline' or 'SharePointOnline'.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] application_permissions: The values of the application permissions (app roles) to require, like 'User.Read.All'.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] delegated_permissions: The values of the delegated permissions (scopes) to require, like 'User.Read' or 'openid'.
+        """
+        pulumi.set(__self__, "resource_app", resource_app)
+        if application_permissions is not None:
+            pulumi.set(__self__, "application_permissions", application_permissions)
+        if delegated_permissions is not None:
+            pulumi.set(__self__, "delegated_permissions", delegated_permissions)
+
+    @property
+    @pulumi.getter(name="resourceApp")
+    def resource_app(self) -> pulumi.Input[str]:
+        """
+        The API, either as the app ID of its application or as one of the well-known names: 'MicrosoftGraph', 'AzureADGraph', 'AzureKeyVault', 'AzureServiceManagement', 'AzureStorage', 'Office365ExchangeOnline' or 'SharePointOnline'.
+        """
+        return pulumi.get(self, "resource_app")
+
+    @resource_app.setter
+    def resource_app(self, value: pulumi.Input[str]):
+        pulumi.set(self, "resource_app", value)
+
+    @property
+    @pulumi.getter(name="applicationPermissions")
+    def application_permissions(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        The values of the application permissions (app roles) to require, like 'User.Read.All'.
+        """
+        return pulumi.get(self, "application_permissions")
+
+    @application_permissions.setter
+    def application_permissions(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "application_permissions", value)
+
+    @property
+    @pulumi.getter(name="delegatedPermissions")
+    def delegated_permissions(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        The values of the delegated permissions (scopes) to require, like 'User.Read' or 'openid'.
+        """
+        return pulumi.get(self, "delegated_permissions")
+
+    @delegated_permissions.setter
+    def delegated_permissions(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "delegated_permissions", value)
+
+
+@pulumi.input_type
+class TokenLifetimePolicyDefinitionArgs:
+    def __init__(__self__, *,
+                 access_token_lifetime: pulumi.Input[str]):
+        """
+        The definition of a token lifetime policy.
+        :param pulumi.Input[str] access_token_lifetime: The lifetime of access and ID tokens as a time span between '00:10:00' and '1.00:00:00', e.g. '01:00:00' for an hour.
+        """
+        pulumi.set(__self__, "access_token_lifetime", access_token_lifetime)
+
+    @property
+    @pulumi.getter(name="accessTokenLifetime")
+    def access_token_lifetime(self) -> pulumi.Input[str]:
+        """
+        The lifetime of access and ID tokens as a time span between '00:10:00' and '1.00:00:00', e.g. '01:00:00' for an hour.
+        """
+        return pulumi.get(self, "access_token_lifetime")
+
+    @access_token_lifetime.setter
+    def access_token_lifetime(self, value: pulumi.Input[str]):
+        pulumi.set(self, "access_token_lifetime", value)
+
+
@@ -3,11 +3,217 @@
 # *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 SNAKE_TO_CAMEL_CASE_TABLE = {
+    "accept_mapped_claims": "acceptMappedClaims",
+    "access_token": "accessToken",
+    "access_token_lifetime": "accessTokenLifetime",
+    "account_enabled": "accountEnabled",
+    "additional_properties": "additionalProperties",
+    "admin_consent_description": "adminConsentDescription",
+    "admin_consent_display_name": "adminConsentDisplayName",
+    "administrative_unit_id": "administrativeUnitId",
+    "allowed_member_types": "allowedMemberTypes",
+    "app_id": "appId",
+    "app_role": "appRole",
+    "app_role_assignment_required": "appRoleAssignmentRequired",
+    "app_role_id": "appRoleId",
+    "app_role_ids": "appRoleIds",
+    "app_roles": "appRoles",
+    "application_object_id": "applicationObjectId",
+    "application_permissions": "applicationPermissions",
+    "applied_patch": "appliedPatch",
+    "assignment_id": "assignmentId",
+    "attribute_name": "attributeName",
+    "claims_schema": "claimsSchema",
+    "conflict_policy": "conflictPolicy",
+    "content_sha256": "contentSha256",
+    "content_type": "contentType",
+    "credential_id": "credentialId",
+    "data_type": "dataType",
+    "default_owners": "defaultOwners",
+    "delegated_permission_ids": "delegatedPermissionIds",
+    "delegated_permissions": "delegatedPermissions",
+    "directory_scope_id": "directoryScopeId",
+    "display_name": "displayName",
+    "enable_access_token_issuance": "enableAccessTokenIssuance",
+    "enable_id_token_issuance": "enableIdTokenIssuance",
+    "end_date_time": "endDateTime",
+    "extension_property_id": "extensionPropertyId",
+    "grant_admin_consent": "grantAdminConsent",
+    "group_id": "groupId",
+    "group_membership_claims": "groupMembershipClaims",
+    "home_page_url": "homePageUrl",
     "host_name": "hostName",
+    "id_token": "idToken",
+    "identifier_uri": "identifierUri",
+    "identifier_uris": "identifierUris",
+    "implicit_grant_settings": "implicitGrantSettings",
+    "include_basic_claim_set": "includeBasicClaimSet",
+    "is_assignable_to_role": "isAssignableToRole",
+    "is_enabled": "isEnabled",
+    "is_fallback_public_client": "isFallbackPublicClient",
+    "is_multi_valued": "isMultiValued",
+    "is_organization_default": "isOrganizationDefault",
+    "jwt_claim_type": "jwtClaimType",
+    "key_id": "keyId",
+    "known_client_applications": "knownClientApplications",
+    "logout_url": "logoutUrl",
+    "mail_nickname": "mailNickname",
+    "marketing_url": "marketingUrl",
+    "member_display_name": "memberDisplayName",
+    "member_id": "memberId",
+    "member_type": "memberType",
+    "oauth2_permission_scopes": "oauth2PermissionScopes",
     "object_id": "objectId",
+    "optional_claims": "optionalClaims",
+    "owner_display_name": "ownerDisplayName",
+    "owner_id": "ownerId",
+    "owner_type": "ownerType",
+    "policy_id": "policyId",
+    "pre_authorized_applications": "preAuthorizedApplications",
+    "principal_display_name": "principalDisplayName",
+    "principal_id": "principalId",
+    "principal_type": "principalType",
+    "privacy_statement_url": "privacyStatementUrl",
+    "public_client": "publicClient",
+    "public_client_redirect_uris": "publicClientRedirectUris",
+    "publisher_domain": "publisherDomain",
+    "pull_request": "pullRequest",
+    "purge_on_delete": "purgeOnDelete",
+    "redirect_uris": "redirectUris",
+    "requested_access_token_version": "requestedAccessTokenVersion",
+    "required_resource_access": "requiredResourceAccess",
+    "resolved_app_role_id": "resolvedAppRoleId",
+    "resolved_resources": "resolvedResources",
+    "resource_access": "resourceAccess",
+    "resource_app": "resourceApp",
+    "resource_app_id": "resourceAppId",
+    "resource_display_name": "resourceDisplayName",
+    "resource_id": "resourceId",
+    "role_definition_id": "roleDefinitionId",
+    "role_display_name": "roleDisplayName",
+    "role_id": "roleId",
+    "rotate_when_changed": "rotateWhenChanged",
+    "saml2_token": "saml2Token",
+    "saml_claim_type": "samlClaimType",
+    "saml_name_format": "samlNameFormat",
+    "scope_ids": "scopeIds",
+    "secret_text": "secretText",
+    "service_account": "serviceAccount",
+    "service_principal_id": "servicePrincipalId",
+    "sign_in_audience": "signInAudience",
+    "spa_redirect_paths": "spaRedirectPaths",
+    "start_date_time": "startDateTime",
+    "support_url": "supportUrl",
+    "target_objects": "targetObjects",
+    "terms_of_service_url": "termsOfServiceUrl",
+    "user_consent_description": "userConsentDescription",
+    "user_consent_display_name": "userConsentDisplayName",
 }
 
 CAMEL_TO_SNAKE_CASE_TABLE = {
+    "acceptMappedClaims": "accept_mapped_claims",
+    "accessToken": "access_token",
+    "accessTokenLifetime": "access_token_lifetime",
+    "accountEnabled": "account_enabled",
+    "additionalProperties": "additional_properties",
+    "adminConsentDescription": "admin_consent_description",
+    "adminConsentDisplayName": "admin_consent_display_name",
+    "administrativeUnitId": "administrative_unit_id",
+    "allowedMemberTypes": "allowed_member_types",
+    "appId": "app_id",
+    "appRole": "app_role",
+    "appRoleAssignmentRequired": "app_role_assignment_required",
+    "appRoleId": "app_role_id",
+    "appRoleIds": "app_role_ids",
+    "appRoles": "app_roles",
+    "applicationObjectId": "application_object_id",
+    "applicationPermissions": "application_permissions",
+    "appliedPatch": "applied_patch",
+    "assignmentId": "assignment_id",
+    "attributeName": "attribute_name",
+    "claimsSchema": "claims_schema",
+    "conflictPolicy": "conflict_policy",
+    "contentSha256": "content_sha256",
+    "contentType": "content_type",
+    "credentialId": "credential_id",
+    "dataType": "data_type",
+    "defaultOwners": "default_owners",
+    "delegatedPermissionIds": "delegated_permission_ids",
+    "delegatedPermissions": "delegated_permissions",
+    "directoryScopeId": "directory_scope_id",
+    "displayName": "display_name",
+    "enableAccessTokenIssuance": "enable_access_token_issuance",
+    "enableIdTokenIssuance": "enable_id_token_issuance",
+    "endDateTime": "end_date_time",
+    "extensionPropertyId": "extension_property_id",
+    "grantAdminConsent": "grant_admin_consent",
+    "groupId": "group_id",
+    "groupMembershipClaims": "group_membership_claims",
+    "homePageUrl": "home_page_url",
     "hostName": "host_name",
+    "idToken": "id_token",
+    "identifierUri": "identifier_uri",
+    "identifierUris": "identifier_uris",
+    "implicitGrantSettings": "implicit_grant_settings",
+    "includeBasicClaimSet": "include_basic_claim_set",
+    "isAssignableToRole": "is_assignable_to_role",
+    "isEnabled": "is_enabled",
+    "isFallbackPublicClient": "is_fallback_public_client",
+    "isMultiValued": "is_multi_valued",
+    "isOrganizationDefault": "is_organization_default",
+    "jwtClaimType": "jwt_claim_type",
+    "keyId": "key_id",
+    "knownClientApplications": "known_client_applications",
+    "logoutUrl": "logout_url",
+    "mailNickname": "mail_nickname",
+    "marketingUrl": "marketing_url",
+    "memberDisplayName": "member_display_name",
+    "memberId": "member_id",
+    "memberType": "member_type",
+    "oauth2PermissionScopes": "oauth2_permission_scopes",
     "objectId": "object_id",
+    "optionalClaims": "optional_claims",
+    "ownerDisplayName": "owner_display_name",
+    "ownerId": "owner_id",
+    "ownerType": "owner_type",
+    "policyId": "policy_id",
+    "preAuthorizedApplications": "pre_authorized_applications",
+    "principalDisplayName": "principal_display_name",
+    "principalId": "principal_id",
+    "principalType": "principal_type",
+    "privacyStatementUrl": "privacy_statement_url",
+    "publicClient": "public_client",
+    "publicClientRedirectUris": "public_client_redirect_uris",
+    "publisherDomain": "publisher_domain",
+    "pullRequest": "pull_request",
+    "purgeOnDelete": "purge_on_delete",
+    "redirectUris": "redirect_uris",
+    "requestedAccessTokenVersion": "requested_access_token_version",
+    "requiredResourceAccess": "required_resource_access",
+    "resolvedAppRoleId": "resolved_app_role_id",
+    "resolvedResources": "resolved_resources",
+    "resourceAccess": "resource_access",
+    "resourceApp": "resource_app",
+    "resourceAppId": "resource_app_id",
+    "resourceDisplayName": "resource_display_name",
+    "resourceId": "resource_id",
+    "roleDefinitionId": "role_definition_id",
+    "roleDisplayName": "role_display_name",
+    "roleId": "role_id",
+    "rotateWhenChanged": "rotate_when_changed",
+    "saml2Token": "saml2_token",
+    "samlClaimType": "saml_claim_type",
+    "samlNameFormat": "saml_name_format",
+    "scopeIds": "scope_ids",
+    "secretText": "secret_text",
+    "serviceAccount": "service_account",
+    "servicePrincipalId": "service_principal_id",
+    "signInAudience": "sign_in_audience",
+    "spaRedirectPaths": "spa_redirect_paths",
+    "startDateTime": "start_date_time",
+    "supportUrl": "support_url",
+    "targetObjects": "target_objects",
+    "termsOfServiceUrl": "terms_of_service_url",
+    "userConsentDescription": "user_consent_description",
+    "userConsentDisplayName": "user_consent_display_name",
 }
@@ -0,0 +1,139 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+
+__all__ = ['ApiPermissionGrant']
+
+
+class ApiPermissionGrant(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 permissions: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 principal_id: Optional[pulumi.Input[str]] = None,
+                 resource_app: Optional[pulumi.Input[str]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        Grants application permissions of an API, like Microsoft Graph, to a service principal by permission name. The resource ID is the principal's object ID and the API's app ID separated by a slash, which is also the format used to import a grant.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] permissions: The names of the application permissions to grant, e.g. 'User.Read.All'.
+        :param pulumi.Input[str] principal_id: The object ID of the service principal, e.g. a managed identity, that is granted the permissions. Changing this replaces the grant.
+        :param pulumi.Input[str] resource_app: The API, either one of the well-known names 'MicrosoftGraph', 'AzureADGraph', 'AzureKeyVault', 'AzureServiceManagement', 'AzureStorage', 'Office365ExchangeOnline' and 'SharePointOnline', or an app ID. Changing this replaces the grant.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            if permissions is None and not opts.urn:
+                raise TypeError("Missing required property 'permissions'")
+            __props__['permissions'] = permissions
+            if principal_id is None and not opts.urn:
+                raise TypeError("Missing required property 'principal_id'")
+            __props__['principal_id'] = principal_id
+            if resource_app is None and not opts.urn:
+                raise TypeError("Missing required property 'resource_app'")
+            __props__['resource_app'] = resource_app
+            __props__['app_role_ids'] = None
+            __props__['resource_app_id'] = None
+            __props__['resource_id'] = None
+        super(ApiPermissionGrant, __self__).__init__(
+            'knapcode:index:ApiPermissionGrant',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'ApiPermissionGrant':
+        """
+        Get an existing ApiPermissionGrant resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return ApiPermissionGrant(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="appRoleIds")
+    def app_role_ids(self) -> pulumi.Output[Mapping[str, str]]:
+        """
+        The IDs of the granted app roles, by permission name.
+        """
+        return pulumi.get(self, "app_role_ids")
+
+    @property
+    @pulumi.getter
+    def permissions(self) -> pulumi.Output[Sequence[str]]:
+        """
+        The names of the application permissions to grant, e.g. 'User.Read.All'.
+        """
+        return pulumi.get(self, "permissions")
+
+    @property
+    @pulumi.getter(name="principalId")
+    def principal_id(self) -> pulumi.Output[str]:
+        """
+        The object ID of the service principal, e.g. a managed identity, that is granted the permissions. Changing this replaces the grant.
+        """
+        return pulumi.get(self, "principal_id")
+
+    @property
+    @pulumi.getter(name="resourceApp")
+    def resource_app(self) -> pulumi.Output[str]:
+        """
+        The API, either one of the well-known names 'MicrosoftGraph', 'AzureADGraph', 'AzureKeyVault', 'AzureServiceManagement', 'AzureStorage', 'Office365ExchangeOnline' and 'SharePointOnline', or an app ID. Changing this replaces the grant.
+        """
+        return pulumi.get(self, "resource_app")
+
+    @property
+    @pulumi.getter(name="resourceAppId")
+    def resource_app_id(self) -> pulumi.Output[str]:
+        """
+        The app ID of the API.
+        """
+        return pulumi.get(self, "resource_app_id")
+
+    @property
+    @pulumi.getter(name="resourceId")
+    def resource_id(self) -> pulumi.Output[str]:
+        """
+        The object ID of the API's service principal.
+        """
+        return pulumi.get(self, "resource_id")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
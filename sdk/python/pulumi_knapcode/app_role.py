@@ -0,0 +1,158 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+
+__all__ = ['AppRole']
+
+
+class AppRole(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 allowed_member_types: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 description: Optional[pulumi.Input[str]] = None,
+                 display_name: Optional[pulumi.Input[str]] = None,
+                 is_enabled: Optional[pulumi.Input[bool]] = None,
+                 object_id: Optional[pulumi.Input[str]] = None,
+                 role_id: Optional[pulumi.Input[str]] = None,
+                 value: Optional[pulumi.Input[str]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        A single app role of an application. The other app roles of the application are left untouched, so roles can be defined from several stacks. The resource ID is the application's object ID and the role ID separated by a slash, which is also the format used to import a role.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] allowed_member_types: Who can be assigned the role: 'User' for users and groups, 'Application' for applications, or both.
+        :param pulumi.Input[str] description: The description of the role.
+        :param pulumi.Input[str] display_name: The display name of the role.
+        :param pulumi.Input[bool] is_enabled: Whether the role is enabled. Defaults to true.
+        :param pulumi.Input[str] object_id: The object ID of the application. Changing this replaces the role.
+        :param pulumi.Input[str] role_id: The ID of the role. Defaults to a GUID derived from the value. Changing this replaces the role.
+        :param pulumi.Input[str] value: The value of the role, which appears in the roles claim of tokens.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            if allowed_member_types is None and not opts.urn:
+                raise TypeError("Missing required property 'allowed_member_types'")
+            __props__['allowed_member_types'] = allowed_member_types
+            if description is None and not opts.urn:
+                raise TypeError("Missing required property 'description'")
+            __props__['description'] = description
+            if display_name is None and not opts.urn:
+                raise TypeError("Missing required property 'display_name'")
+            __props__['display_name'] = display_name
+            __props__['is_enabled'] = is_enabled
+            if object_id is None and not opts.urn:
+                raise TypeError("Missing required property 'object_id'")
+            __props__['object_id'] = object_id
+            __props__['role_id'] = role_id
+            __props__['value'] = value
+        super(AppRole, __self__).__init__(
+            'knapcode:index:AppRole',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'AppRole':
+        """
+        Get an existing AppRole resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return AppRole(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="allowedMemberTypes")
+    def allowed_member_types(self) -> pulumi.Output[Sequence[str]]:
+        """
+        Who can be assigned the role: 'User' for users and groups, 'Application' for applications, or both.
+        """
+        return pulumi.get(self, "allowed_member_types")
+
+    @property
+    @pulumi.getter
+    def description(self) -> pulumi.Output[str]:
+        """
+        The description of the role.
+        """
+        return pulumi.get(self, "description")
+
+    @property
+    @pulumi.getter(name="displayName")
+    def display_name(self) -> pulumi.Output[str]:
+        """
+        The display name of the role.
+        """
+        return pulumi.get(self, "display_name")
+
+    @property
+    @pulumi.getter(name="isEnabled")
+    def is_enabled(self) -> pulumi.Output[bool]:
+        """
+        Whether the role is enabled. Defaults to true.
+        """
+        return pulumi.get(self, "is_enabled")
+
+    @property
+    @pulumi.getter(name="objectId")
+    def object_id(self) -> pulumi.Output[str]:
+        """
+        The object ID of the application. Changing this replaces the role.
+        """
+        return pulumi.get(self, "object_id")
+
+    @property
+    @pulumi.getter(name="roleId")
+    def role_id(self) -> pulumi.Output[str]:
+        """
+        The ID of the role. Defaults to a GUID derived from the value. Changing this replaces the role.
+        """
+        return pulumi.get(self, "role_id")
+
+    @property
+    @pulumi.getter
+    def value(self) -> pulumi.Output[Optional[str]]:
+        """
+        The value of the role, which appears in the roles claim of tokens.
+        """
+        return pulumi.get(self, "value")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
@@ -0,0 +1,166 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+
+__all__ = ['AppRoleAssignment']
+
+
+class AppRoleAssignment(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 app_role: Optional[pulumi.Input[str]] = None,
+                 app_role_id: Optional[pulumi.Input[str]] = None,
+                 principal_id: Optional[pulumi.Input[str]] = None,
+                 resource_id: Optional[pulumi.Input[str]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        Assigns an app role of an application to a user, group or service principal. The resource ID is the object ID of the resource service principal and the assignment ID separated by a slash, which is also the format used to import an assignment.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] app_role: The value of the app role to assign. Changing this replaces the assignment.
+        :param pulumi.Input[str] app_role_id: The ID of the app role to assign, instead of its value. If neither this nor 'appRole' is set, the principal is assigned to the application without a specific role. Changing this replaces the assignment.
+        :param pulumi.Input[str] principal_id: The object ID of the user, group or service principal (e.g. a managed identity) that is assigned the role. Changing this replaces the assignment.
+        :param pulumi.Input[str] resource_id: The object ID of the service principal of the application that defines the app role. Changing this replaces the assignment.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            __props__['app_role'] = app_role
+            __props__['app_role_id'] = app_role_id
+            if principal_id is None and not opts.urn:
+                raise TypeError("Missing required property 'principal_id'")
+            __props__['principal_id'] = principal_id
+            if resource_id is None and not opts.urn:
+                raise TypeError("Missing required property 'resource_id'")
+            __props__['resource_id'] = resource_id
+            __props__['assignment_id'] = None
+            __props__['principal_display_name'] = None
+            __props__['principal_type'] = None
+            __props__['resolved_app_role_id'] = None
+            __props__['resource_display_name'] = None
+        super(AppRoleAssignment, __self__).__init__(
+            'knapcode:index:AppRoleAssignment',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'AppRoleAssignment':
+        """
+        Get an existing AppRoleAssignment resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return AppRoleAssignment(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="appRole")
+    def app_role(self) -> pulumi.Output[Optional[str]]:
+        """
+        The value of the app role to assign. Changing this replaces the assignment.
+        """
+        return pulumi.get(self, "app_role")
+
+    @property
+    @pulumi.getter(name="appRoleId")
+    def app_role_id(self) -> pulumi.Output[Optional[str]]:
+        """
+        The ID of the app role to assign, instead of its value. If neither this nor 'appRole' is set, the principal is assigned to the application without a specific role. Changing this replaces the assignment.
+        """
+        return pulumi.get(self, "app_role_id")
+
+    @property
+    @pulumi.getter(name="assignmentId")
+    def assignment_id(self) -> pulumi.Output[str]:
+        """
+        The ID of the app role assignment.
+        """
+        return pulumi.get(self, "assignment_id")
+
+    @property
+    @pulumi.getter(name="principalDisplayName")
+    def principal_display_name(self) -> pulumi.Output[str]:
+        """
+        The display name of the principal.
+        """
+        return pulumi.get(self, "principal_display_name")
+
+    @property
+    @pulumi.getter(name="principalId")
+    def principal_id(self) -> pulumi.Output[str]:
+        """
+        The object ID of the user, group or service principal (e.g. a managed identity) that is assigned the role. Changing this replaces the assignment.
+        """
+        return pulumi.get(self, "principal_id")
+
+    @property
+    @pulumi.getter(name="principalType")
+    def principal_type(self) -> pulumi.Output[str]:
+        """
+        The type of the principal: 'User', 'Group' or 'ServicePrincipal'.
+        """
+        return pulumi.get(self, "principal_type")
+
+    @property
+    @pulumi.getter(name="resolvedAppRoleId")
+    def resolved_app_role_id(self) -> pulumi.Output[str]:
+        """
+        The ID of the assigned app role.
+        """
+        return pulumi.get(self, "resolved_app_role_id")
+
+    @property
+    @pulumi.getter(name="resourceDisplayName")
+    def resource_display_name(self) -> pulumi.Output[str]:
+        """
+        The display name of the resource service principal.
+        """
+        return pulumi.get(self, "resource_display_name")
+
+    @property
+    @pulumi.getter(name="resourceId")
+    def resource_id(self) -> pulumi.Output[str]:
+        """
+        The object ID of the service principal of the application that defines the app role. Changing this replaces the assignment.
+        """
+        return pulumi.get(self, "resource_id")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
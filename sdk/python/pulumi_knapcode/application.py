@@ -0,0 +1,239 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+from . import outputs
+from ._enums import *
+from ._inputs import *
+
+__all__ = ['Application']
+
+
+class Application(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 api: Optional[pulumi.Input[pulumi.InputType['ApplicationApiArgs']]] = None,
+                 app_roles: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['ApplicationAppRoleArgs']]]]] = None,
+                 display_name: Optional[pulumi.Input[str]] = None,
+                 identifier_uris: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 notes: Optional[pulumi.Input[str]] = None,
+                 optional_claims: Optional[pulumi.Input[pulumi.InputType['ApplicationOptionalClaimsArgs']]] = None,
+                 public_client: Optional[pulumi.Input[pulumi.InputType['ApplicationPublicClientArgs']]] = None,
+                 purge_on_delete: Optional[pulumi.Input[bool]] = None,
+                 required_resource_access: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['ApplicationRequiredResourceAccessArgs']]]]] = None,
+                 sign_in_audience: Optional[pulumi.Input['SignInAudience']] = None,
+                 spa: Optional[pulumi.Input[pulumi.InputType['ApplicationSpaArgs']]] = None,
+                 tags: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 web: Optional[pulumi.Input[pulumi.InputType['ApplicationWebArgs']]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        An application (app registration) managed entirely through Microsoft Graph. Settings that are not set are reset to their defaults. The resource ID is the object ID of the application, which is also used to import it.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[pulumi.InputType['ApplicationApiArgs']] api: Settings for an application that exposes an API.
+        :param pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['ApplicationAppRoleArgs']]]] app_roles: The roles defined by the application.
+        :param pulumi.Input[str] display_name: The display name of the application.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] identifier_uris: The URIs that identify the application within its tenant.
+        :param pulumi.Input[str] notes: Free text notes about the application.
+        :param pulumi.Input[pulumi.InputType['ApplicationOptionalClaimsArgs']] optional_claims: Optional claims included in tokens.
+        :param pulumi.Input[pulumi.InputType['ApplicationPublicClientArgs']] public_client: Settings for a public client.
+        :param pulumi.Input[bool] purge_on_delete: Permanently delete the application from the directory's deleted items when the resource is deleted, releasing its identifier URIs. Defaults to false.
+        :param pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['ApplicationRequiredResourceAccessArgs']]]] required_resource_access: The permissions the application requires on other applications.
+        :param pulumi.Input['SignInAudience'] sign_in_audience: The accounts that can sign in. Defaults to 'AzureADMyOrg'.
+        :param pulumi.Input[pulumi.InputType['ApplicationSpaArgs']] spa: Settings for a single-page application.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] tags: Tags on the application.
+        :param pulumi.Input[pulumi.InputType['ApplicationWebArgs']] web: Settings for a web application.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            __props__['api'] = api
+            __props__['app_roles'] = app_roles
+            if display_name is None and not opts.urn:
+                raise TypeError("Missing required property 'display_name'")
+            __props__['display_name'] = display_name
+            __props__['identifier_uris'] = identifier_uris
+            __props__['notes'] = notes
+            __props__['optional_claims'] = optional_claims
+            __props__['public_client'] = public_client
+            __props__['purge_on_delete'] = purge_on_delete
+            __props__['required_resource_access'] = required_resource_access
+            __props__['sign_in_audience'] = sign_in_audience
+            __props__['spa'] = spa
+            __props__['tags'] = tags
+            __props__['web'] = web
+            __props__['app_id'] = None
+            __props__['object_id'] = None
+        super(Application, __self__).__init__(
+            'knapcode:index:Application',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'Application':
+        """
+        Get an existing Application resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return Application(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def api(self) -> pulumi.Output[Optional['outputs.ApplicationApi']]:
+        """
+        Settings for an application that exposes an API.
+        """
+        return pulumi.get(self, "api")
+
+    @property
+    @pulumi.getter(name="appId")
+    def app_id(self) -> pulumi.Output[str]:
+        """
+        The app ID (client ID) of the application.
+        """
+        return pulumi.get(self, "app_id")
+
+    @property
+    @pulumi.getter(name="appRoles")
+    def app_roles(self) -> pulumi.Output[Optional[Sequence['outputs.ApplicationAppRole']]]:
+        """
+        The roles defined by the application.
+        """
+        return pulumi.get(self, "app_roles")
+
+    @property
+    @pulumi.getter(name="displayName")
+    def display_name(self) -> pulumi.Output[str]:
+        """
+        The display name of the application.
+        """
+        return pulumi.get(self, "display_name")
+
+    @property
+    @pulumi.getter(name="identifierUris")
+    def identifier_uris(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        The URIs that identify the application within its tenant.
+        """
+        return pulumi.get(self, "identifier_uris")
+
+    @property
+    @pulumi.getter
+    def notes(self) -> pulumi.Output[Optional[str]]:
+        """
+        Free text notes about the application.
+        """
+        return pulumi.get(self, "notes")
+
+    @property
+    @pulumi.getter(name="objectId")
+    def object_id(self) -> pulumi.Output[str]:
+        """
+        The object ID of the application.
+        """
+        return pulumi.get(self, "object_id")
+
+    @property
+    @pulumi.getter(name="optionalClaims")
+    def optional_claims(self) -> pulumi.Output[Optional['outputs.ApplicationOptionalClaims']]:
+        """
+        Optional claims included in tokens.
+        """
+        return pulumi.get(self, "optional_claims")
+
+    @property
+    @pulumi.getter(name="publicClient")
+    def public_client(self) -> pulumi.Output[Optional['outputs.ApplicationPublicClient']]:
+        """
+        Settings for a public client.
+        """
+        return pulumi.get(self, "public_client")
+
+    @property
+    @pulumi.getter(name="purgeOnDelete")
+    def purge_on_delete(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Permanently delete the application from the directory's deleted items when the resource is deleted, releasing its identifier URIs. Defaults to false.
+        """
+        return pulumi.get(self, "purge_on_delete")
+
+    @property
+    @pulumi.getter(name="requiredResourceAccess")
+    def required_resource_access(self) -> pulumi.Output[Optional[Sequence['outputs.ApplicationRequiredResourceAccess']]]:
+        """
+        The permissions the application requires on other applications.
+        """
+        return pulumi.get(self, "required_resource_access")
+
+    @property
+    @pulumi.getter(name="signInAudience")
+    def sign_in_audience(self) -> pulumi.Output[Optional['SignInAudience']]:
+        """
+        The accounts that can sign in. Defaults to 'AzureADMyOrg'.
+        """
+        return pulumi.get(self, "sign_in_audience")
+
+    @property
+    @pulumi.getter
+    def spa(self) -> pulumi.Output[Optional['outputs.ApplicationSpa']]:
+        """
+        Settings for a single-page application.
+        """
+        return pulumi.get(self, "spa")
+
+    @property
+    @pulumi.getter
+    def tags(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Tags on the application.
+        """
+        return pulumi.get(self, "tags")
+
+    @property
+    @pulumi.getter
+    def web(self) -> pulumi.Output[Optional['outputs.ApplicationWeb']]:
+        """
+        Settings for a web application.
+        """
+        return pulumi.get(self, "web")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
@@ -0,0 +1,152 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+
+__all__ = ['ApplicationBranding']
+
+
+class ApplicationBranding(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 host_name: Optional[pulumi.Input[str]] = None,
+                 marketing_url: Optional[pulumi.Input[str]] = None,
+                 object_id: Optional[pulumi.Input[str]] = None,
+                 privacy_statement_url: Optional[pulumi.Input[str]] = None,
+                 publisher_domain: Optional[pulumi.Input[str]] = None,
+                 support_url: Optional[pulumi.Input[str]] = None,
+                 terms_of_service_url: Optional[pulumi.Input[str]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        The marketing, support, terms of service and privacy statement URLs and the publisher domain of an application, shown on the consent prompt. Deleting the resource clears the URLs. The resource ID is the object ID of the application, which is also used to import it.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] host_name: The host name the URLs that are not set are derived from, e.g. 'example.com' gives 'https://example.com/privacy' for the privacy statement.
+        :param pulumi.Input[str] marketing_url: The URL of the application's marketing page. Defaults to 'https://{hostName}' when 'hostName' is set.
+        :param pulumi.Input[str] object_id: The object ID of the application. Changing this replaces the resource.
+        :param pulumi.Input[str] privacy_statement_url: The URL of the application's privacy statement, shown on the consent prompt. Defaults to 'https://{hostName}/privacy' when 'hostName' is set.
+        :param pulumi.Input[str] publisher_domain: The publisher domain shown on the consent prompt. Must be a verified domain of the tenant. It is left unchanged when not set.
+        :param pulumi.Input[str] support_url: The URL of the application's support page. Defaults to 'https://{hostName}/support' when 'hostName' is set.
+        :param pulumi.Input[str] terms_of_service_url: The URL of the application's terms of service, shown on the consent prompt. Defaults to 'https://{hostName}/terms' when 'hostName' is set.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            __props__['host_name'] = host_name
+            __props__['marketing_url'] = marketing_url
+            if object_id is None and not opts.urn:
+                raise TypeError("Missing required property 'object_id'")
+            __props__['object_id'] = object_id
+            __props__['privacy_statement_url'] = privacy_statement_url
+            __props__['publisher_domain'] = publisher_domain
+            __props__['support_url'] = support_url
+            __props__['terms_of_service_url'] = terms_of_service_url
+        super(ApplicationBranding, __self__).__init__(
+            'knapcode:index:ApplicationBranding',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'ApplicationBranding':
+        """
+        Get an existing ApplicationBranding resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return ApplicationBranding(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="hostName")
+    def host_name(self) -> pulumi.Output[Optional[str]]:
+        """
+        The host name the URLs that are not set are derived from, e.g. 'example.com' gives 'https://example.com/privacy' for the privacy statement.
+        """
+        return pulumi.get(self, "host_name")
+
+    @property
+    @pulumi.getter(name="marketingUrl")
+    def marketing_url(self) -> pulumi.Output[Optional[str]]:
+        """
+        The URL of the application's marketing page. Defaults to 'https://{hostName}' when 'hostName' is set.
+        """
+        return pulumi.get(self, "marketing_url")
+
+    @property
+    @pulumi.getter(name="objectId")
+    def object_id(self) -> pulumi.Output[str]:
+        """
+        The object ID of the application. Changing this replaces the resource.
+        """
+        return pulumi.get(self, "object_id")
+
+    @property
+    @pulumi.getter(name="privacyStatementUrl")
+    def privacy_statement_url(self) -> pulumi.Output[Optional[str]]:
+        """
+        The URL of the application's privacy statement, shown on the consent prompt. Defaults to 'https://{hostName}/privacy' when 'hostName' is set.
+        """
+        return pulumi.get(self, "privacy_statement_url")
+
+    @property
+    @pulumi.getter(name="publisherDomain")
+    def publisher_domain(self) -> pulumi.Output[Optional[str]]:
+        """
+        The publisher domain shown on the consent prompt. Must be a verified domain of the tenant. It is left unchanged when not set.
+        """
+        return pulumi.get(self, "publisher_domain")
+
+    @property
+    @pulumi.getter(name="supportUrl")
+    def support_url(self) -> pulumi.Output[Optional[str]]:
+        """
+        The URL of the application's support page. Defaults to 'https://{hostName}/support' when 'hostName' is set.
+        """
+        return pulumi.get(self, "support_url")
+
+    @property
+    @pulumi.getter(name="termsOfServiceUrl")
+    def terms_of_service_url(self) -> pulumi.Output[Optional[str]]:
+        """
+        The URL of the application's terms of service, shown on the consent prompt. Defaults to 'https://{hostName}/terms' when 'hostName' is set.
+        """
+        return pulumi.get(self, "terms_of_service_url")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
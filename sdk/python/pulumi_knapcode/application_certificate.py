@@ -0,0 +1,146 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+
+__all__ = ['ApplicationCertificate']
+
+
+class ApplicationCertificate(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 certificate: Optional[pulumi.Input[str]] = None,
+                 display_name: Optional[pulumi.Input[str]] = None,
+                 object_id: Optional[pulumi.Input[str]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        A certificate in the key credentials of an application, used for certificate-based client authentication. Other key credentials on the application are left untouched.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] certificate: The certificate, either PEM encoded or as base64 encoded DER. Only the public certificate is needed.
+        :param pulumi.Input[str] display_name: A friendly name for the certificate. Defaults to the certificate subject.
+        :param pulumi.Input[str] object_id: The object ID of the application.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            if certificate is None and not opts.urn:
+                raise TypeError("Missing required property 'certificate'")
+            __props__['certificate'] = certificate
+            __props__['display_name'] = display_name
+            if object_id is None and not opts.urn:
+                raise TypeError("Missing required property 'object_id'")
+            __props__['object_id'] = object_id
+            __props__['end_date_time'] = None
+            __props__['key_id'] = None
+            __props__['start_date_time'] = None
+            __props__['thumbprint'] = None
+        super(ApplicationCertificate, __self__).__init__(
+            'knapcode:index:ApplicationCertificate',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'ApplicationCertificate':
+        """
+        Get an existing ApplicationCertificate resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return ApplicationCertificate(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def certificate(self) -> pulumi.Output[str]:
+        """
+        The certificate, either PEM encoded or as base64 encoded DER. Only the public certificate is needed.
+        """
+        return pulumi.get(self, "certificate")
+
+    @property
+    @pulumi.getter(name="displayName")
+    def display_name(self) -> pulumi.Output[Optional[str]]:
+        """
+        A friendly name for the certificate. Defaults to the certificate subject.
+        """
+        return pulumi.get(self, "display_name")
+
+    @property
+    @pulumi.getter(name="endDateTime")
+    def end_date_time(self) -> pulumi.Output[str]:
+        """
+        When the certificate expires.
+        """
+        return pulumi.get(self, "end_date_time")
+
+    @property
+    @pulumi.getter(name="keyId")
+    def key_id(self) -> pulumi.Output[str]:
+        """
+        The key ID of the certificate, derived from the application and the certificate thumbprint.
+        """
+        return pulumi.get(self, "key_id")
+
+    @property
+    @pulumi.getter(name="objectId")
+    def object_id(self) -> pulumi.Output[str]:
+        """
+        The object ID of the application.
+        """
+        return pulumi.get(self, "object_id")
+
+    @property
+    @pulumi.getter(name="startDateTime")
+    def start_date_time(self) -> pulumi.Output[str]:
+        """
+        When the certificate becomes valid.
+        """
+        return pulumi.get(self, "start_date_time")
+
+    @property
+    @pulumi.getter
+    def thumbprint(self) -> pulumi.Output[str]:
+        """
+        The SHA-1 thumbprint of the certificate, as uppercase hex.
+        """
+        return pulumi.get(self, "thumbprint")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
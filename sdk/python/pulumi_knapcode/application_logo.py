@@ -0,0 +1,126 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+
+__all__ = ['ApplicationLogo']
+
+
+class ApplicationLogo(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 content: Optional[pulumi.Input[str]] = None,
+                 object_id: Optional[pulumi.Input[str]] = None,
+                 source: Optional[pulumi.Input[str]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        The logo of an application, shown on the consent prompt and in My Apps. The logo must be a PNG, JPEG, GIF or BMP image of at most 100 KB. Microsoft Graph can't remove a logo, so deleting the resource leaves the logo in place. The resource ID is the object ID of the application, which is also used to import it.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] content: The base64 encoded logo. Only one of 'source' and 'content' can be set.
+        :param pulumi.Input[str] object_id: The object ID of the application. Changing this replaces the resource.
+        :param pulumi.Input[str] source: The path of the logo file. Only one of 'source' and 'content' can be set.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            __props__['content'] = content
+            if object_id is None and not opts.urn:
+                raise TypeError("Missing required property 'object_id'")
+            __props__['object_id'] = object_id
+            __props__['source'] = source
+            __props__['content_sha256'] = None
+            __props__['content_type'] = None
+        super(ApplicationLogo, __self__).__init__(
+            'knapcode:index:ApplicationLogo',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'ApplicationLogo':
+        """
+        Get an existing ApplicationLogo resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return ApplicationLogo(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def content(self) -> pulumi.Output[Optional[str]]:
+        """
+        The base64 encoded logo. Only one of 'source' and 'content' can be set.
+        """
+        return pulumi.get(self, "content")
+
+    @property
+    @pulumi.getter(name="contentSha256")
+    def content_sha256(self) -> pulumi.Output[str]:
+        """
+        The hex encoded SHA-256 of the logo. The logo is only uploaded again when it changes.
+        """
+        return pulumi.get(self, "content_sha256")
+
+    @property
+    @pulumi.getter(name="contentType")
+    def content_type(self) -> pulumi.Output[str]:
+        """
+        The detected image type of the logo, e.g. 'image/png'.
+        """
+        return pulumi.get(self, "content_type")
+
+    @property
+    @pulumi.getter(name="objectId")
+    def object_id(self) -> pulumi.Output[str]:
+        """
+        The object ID of the application. Changing this replaces the resource.
+        """
+        return pulumi.get(self, "object_id")
+
+    @property
+    @pulumi.getter
+    def source(self) -> pulumi.Output[Optional[str]]:
+        """
+        The path of the logo file. Only one of 'source' and 'content' can be set.
+        """
+        return pulumi.get(self, "source")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
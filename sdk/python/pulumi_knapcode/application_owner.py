@@ -0,0 +1,117 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+
+__all__ = ['ApplicationOwner']
+
+
+class ApplicationOwner(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 object_id: Optional[pulumi.Input[str]] = None,
+                 owner_id: Optional[pulumi.Input[str]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        Adds an owner to an application. The resource ID is the object ID of the application and the object ID of the owner separated by a slash, which is also used to import it.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] object_id: The object ID of the application. Changing this replaces the resource.
+        :param pulumi.Input[str] owner_id: The object ID of the user or service principal to add as an owner. Changing this replaces the resource.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            if object_id is None and not opts.urn:
+                raise TypeError("Missing required property 'object_id'")
+            __props__['object_id'] = object_id
+            if owner_id is None and not opts.urn:
+                raise TypeError("Missing required property 'owner_id'")
+            __props__['owner_id'] = owner_id
+            __props__['owner_display_name'] = None
+            __props__['owner_type'] = None
+        super(ApplicationOwner, __self__).__init__(
+            'knapcode:index:ApplicationOwner',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'ApplicationOwner':
+        """
+        Get an existing ApplicationOwner resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return ApplicationOwner(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="objectId")
+    def object_id(self) -> pulumi.Output[str]:
+        """
+        The object ID of the application. Changing this replaces the resource.
+        """
+        return pulumi.get(self, "object_id")
+
+    @property
+    @pulumi.getter(name="ownerDisplayName")
+    def owner_display_name(self) -> pulumi.Output[str]:
+        """
+        The display name of the owner.
+        """
+        return pulumi.get(self, "owner_display_name")
+
+    @property
+    @pulumi.getter(name="ownerId")
+    def owner_id(self) -> pulumi.Output[str]:
+        """
+        The object ID of the user or service principal to add as an owner. Changing this replaces the resource.
+        """
+        return pulumi.get(self, "owner_id")
+
+    @property
+    @pulumi.getter(name="ownerType")
+    def owner_type(self) -> pulumi.Output[str]:
+        """
+        The type of the owner, e.g. 'user' or 'servicePrincipal'.
+        """
+        return pulumi.get(self, "owner_type")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
@@ -0,0 +1,159 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+
+__all__ = ['ApplicationPassword']
+
+
+class ApplicationPassword(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 display_name: Optional[pulumi.Input[str]] = None,
+                 end_date_time: Optional[pulumi.Input[str]] = None,
+                 object_id: Optional[pulumi.Input[str]] = None,
+                 rotate_when_changed: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 start_date_time: Optional[pulumi.Input[str]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        A client secret for an application, managed with the Microsoft Graph `addPassword` and `removePassword` actions. Every change replaces the client secret.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] display_name: A friendly name for the client secret.
+        :param pulumi.Input[str] end_date_time: When the client secret expires, as an RFC 3339 date and time. Defaults to two years after the start.
+        :param pulumi.Input[str] object_id: The object ID of the application.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] rotate_when_changed: Arbitrary values that replace the client secret with a new one whenever they change.
+        :param pulumi.Input[str] start_date_time: When the client secret becomes valid, as an RFC 3339 date and time. Defaults to now.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            __props__['display_name'] = display_name
+            __props__['end_date_time'] = end_date_time
+            if object_id is None and not opts.urn:
+                raise TypeError("Missing required property 'object_id'")
+            __props__['object_id'] = object_id
+            __props__['rotate_when_changed'] = rotate_when_changed
+            __props__['start_date_time'] = start_date_time
+            __props__['hint'] = None
+            __props__['key_id'] = None
+            __props__['secret_text'] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["secretText"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
+        super(ApplicationPassword, __self__).__init__(
+            'knapcode:index:ApplicationPassword',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'ApplicationPassword':
+        """
+        Get an existing ApplicationPassword resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return ApplicationPassword(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="displayName")
+    def display_name(self) -> pulumi.Output[Optional[str]]:
+        """
+        A friendly name for the client secret.
+        """
+        return pulumi.get(self, "display_name")
+
+    @property
+    @pulumi.getter(name="endDateTime")
+    def end_date_time(self) -> pulumi.Output[Optional[str]]:
+        """
+        When the client secret expires, as an RFC 3339 date and time. Defaults to two years after the start.
+        """
+        return pulumi.get(self, "end_date_time")
+
+    @property
+    @pulumi.getter
+    def hint(self) -> pulumi.Output[str]:
+        """
+        The first few characters of the client secret.
+        """
+        return pulumi.get(self, "hint")
+
+    @property
+    @pulumi.getter(name="keyId")
+    def key_id(self) -> pulumi.Output[str]:
+        """
+        The key ID of the client secret.
+        """
+        return pulumi.get(self, "key_id")
+
+    @property
+    @pulumi.getter(name="objectId")
+    def object_id(self) -> pulumi.Output[str]:
+        """
+        The object ID of the application.
+        """
+        return pulumi.get(self, "object_id")
+
+    @property
+    @pulumi.getter(name="rotateWhenChanged")
+    def rotate_when_changed(self) -> pulumi.Output[Optional[Mapping[str, str]]]:
+        """
+        Arbitrary values that replace the client secret with a new one whenever they change.
+        """
+        return pulumi.get(self, "rotate_when_changed")
+
+    @property
+    @pulumi.getter(name="secretText")
+    def secret_text(self) -> pulumi.Output[str]:
+        """
+        The client secret.
+        """
+        return pulumi.get(self, "secret_text")
+
+    @property
+    @pulumi.getter(name="startDateTime")
+    def start_date_time(self) -> pulumi.Output[Optional[str]]:
+        """
+        When the client secret becomes valid, as an RFC 3339 date and time. Defaults to now.
+        """
+        return pulumi.get(self, "start_date_time")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
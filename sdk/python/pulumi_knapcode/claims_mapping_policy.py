@@ -0,0 +1,121 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+from . import outputs
+from ._inputs import *
+
+__all__ = ['ClaimsMappingPolicy']
+
+
+class ClaimsMappingPolicy(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 definition: Optional[pulumi.Input[pulumi.InputType['ClaimsMappingPolicyDefinitionArgs']]] = None,
+                 display_name: Optional[pulumi.Input[str]] = None,
+                 is_organization_default: Optional[pulumi.Input[bool]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        A claims mapping policy that customizes the claims emitted in SAML tokens and JWTs. The resource ID is the ID of the policy, which is also used to import it.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[pulumi.InputType['ClaimsMappingPolicyDefinitionArgs']] definition: The claims emitted by the policy. Changing the definition updates the policy in place.
+        :param pulumi.Input[str] display_name: The display name of the policy.
+        :param pulumi.Input[bool] is_organization_default: Whether the policy applies to every application in the organization that has no policy assigned. Defaults to false.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            if definition is None and not opts.urn:
+                raise TypeError("Missing required property 'definition'")
+            __props__['definition'] = definition
+            if display_name is None and not opts.urn:
+                raise TypeError("Missing required property 'display_name'")
+            __props__['display_name'] = display_name
+            __props__['is_organization_default'] = is_organization_default
+            __props__['policy_id'] = None
+        super(ClaimsMappingPolicy, __self__).__init__(
+            'knapcode:index:ClaimsMappingPolicy',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'ClaimsMappingPolicy':
+        """
+        Get an existing ClaimsMappingPolicy resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return ClaimsMappingPolicy(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def definition(self) -> pulumi.Output['outputs.ClaimsMappingPolicyDefinition']:
+        """
+        The claims emitted by the policy. Changing the definition updates the policy in place.
+        """
+        return pulumi.get(self, "definition")
+
+    @property
+    @pulumi.getter(name="displayName")
+    def display_name(self) -> pulumi.Output[str]:
+        """
+        The display name of the policy.
+        """
+        return pulumi.get(self, "display_name")
+
+    @property
+    @pulumi.getter(name="isOrganizationDefault")
+    def is_organization_default(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Whether the policy applies to every application in the organization that has no policy assigned. Defaults to false.
+        """
+        return pulumi.get(self, "is_organization_default")
+
+    @property
+    @pulumi.getter(name="policyId")
+    def policy_id(self) -> pulumi.Output[str]:
+        """
+        The ID of the policy.
+        """
+        return pulumi.get(self, "policy_id")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
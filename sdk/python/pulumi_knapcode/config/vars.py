@@ -0,0 +1,21 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+
+__all__ = [
+    'default_owners',
+]
+
+__config__ = pulumi.Config('knapcode')
+
+default_owners = __config__.get('defaultOwners')
+"""
+The object IDs of users or service principals that are added as owners of every application and service principal created by this provider, so that they can be managed in the portal.
+"""
+
@@ -0,0 +1,157 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+
+__all__ = ['DirectoryRoleAssignment']
+
+
+class DirectoryRoleAssignment(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 administrative_unit_id: Optional[pulumi.Input[str]] = None,
+                 application_object_id: Optional[pulumi.Input[str]] = None,
+                 principal_id: Optional[pulumi.Input[str]] = None,
+                 role: Optional[pulumi.Input[str]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        Assigns an Azure AD directory role to a principal, for the whole directory or scoped to an administrative unit or an application. Only one scope can be set. The resource ID is the ID of the role assignment, which is also used to import it.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] administrative_unit_id: The object ID of an administrative unit to scope the assignment to. Changing this replaces the resource.
+        :param pulumi.Input[str] application_object_id: The object ID of an application to scope the assignment to. Changing this replaces the resource.
+        :param pulumi.Input[str] principal_id: The object ID of the user, group or service principal to assign the role to. Changing this replaces the resource.
+        :param pulumi.Input[str] role: The directory role, either the display name of a role like 'Application Administrator' or the ID or template ID of a role definition. Changing this replaces the resource.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            __props__['administrative_unit_id'] = administrative_unit_id
+            __props__['application_object_id'] = application_object_id
+            if principal_id is None and not opts.urn:
+                raise TypeError("Missing required property 'principal_id'")
+            __props__['principal_id'] = principal_id
+            if role is None and not opts.urn:
+                raise TypeError("Missing required property 'role'")
+            __props__['role'] = role
+            __props__['assignment_id'] = None
+            __props__['directory_scope_id'] = None
+            __props__['role_definition_id'] = None
+            __props__['role_display_name'] = None
+        super(DirectoryRoleAssignment, __self__).__init__(
+            'knapcode:index:DirectoryRoleAssignment',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'DirectoryRoleAssignment':
+        """
+        Get an existing DirectoryRoleAssignment resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return DirectoryRoleAssignment(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="administrativeUnitId")
+    def administrative_unit_id(self) -> pulumi.Output[Optional[str]]:
+        """
+        The object ID of an administrative unit to scope the assignment to. Changing this replaces the resource.
+        """
+        return pulumi.get(self, "administrative_unit_id")
+
+    @property
+    @pulumi.getter(name="applicationObjectId")
+    def application_object_id(self) -> pulumi.Output[Optional[str]]:
+        """
+        The object ID of an application to scope the assignment to. Changing this replaces the resource.
+        """
+        return pulumi.get(self, "application_object_id")
+
+    @property
+    @pulumi.getter(name="assignmentId")
+    def assignment_id(self) -> pulumi.Output[str]:
+        """
+        The ID of the role assignment.
+        """
+        return pulumi.get(self, "assignment_id")
+
+    @property
+    @pulumi.getter(name="directoryScopeId")
+    def directory_scope_id(self) -> pulumi.Output[str]:
+        """
+        The scope of the assignment: '/' for the whole directory, '/administrativeUnits/{id}' for an administrative unit or '/{id}' for an application.
+        """
+        return pulumi.get(self, "directory_scope_id")
+
+    @property
+    @pulumi.getter(name="principalId")
+    def principal_id(self) -> pulumi.Output[str]:
+        """
+        The object ID of the user, group or service principal to assign the role to. Changing this replaces the resource.
+        """
+        return pulumi.get(self, "principal_id")
+
+    @property
+    @pulumi.getter
+    def role(self) -> pulumi.Output[str]:
+        """
+        The directory role, either the display name of a role like 'Application Administrator' or the ID or template ID of a role definition. Changing this replaces the resource.
+        """
+        return pulumi.get(self, "role")
+
+    @property
+    @pulumi.getter(name="roleDefinitionId")
+    def role_definition_id(self) -> pulumi.Output[str]:
+        """
+        The template ID of the assigned role.
+        """
+        return pulumi.get(self, "role_definition_id")
+
+    @property
+    @pulumi.getter(name="roleDisplayName")
+    def role_display_name(self) -> pulumi.Output[str]:
+        """
+        The display name of the assigned role.
+        """
+        return pulumi.get(self, "role_display_name")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
@@ -0,0 +1,150 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+from . import outputs
+from ._inputs import *
+
+__all__ = ['ExposeApi']
+
+
+class ExposeApi(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 identifier_uri: Optional[pulumi.Input[str]] = None,
+                 known_client_applications: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 object_id: Optional[pulumi.Input[str]] = None,
+                 pre_authorized_applications: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['ExposeApiPreAuthorizedApplicationArgs']]]]] = None,
+                 scopes: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['ApplicationPermissionScopeArgs']]]]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        Exposes an application as an API: sets its identifier URI and manages its scopes, pre-authorized client applications and known client applications. Other API settings are left untouched. The resource ID is the object ID of the application, which is also used to import it.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] identifier_uri: The identifier URI of the API. Defaults to 'api://{appId}'.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] known_client_applications: The app IDs of client applications that are bundled with the API for consent.
+        :param pulumi.Input[str] object_id: The object ID of the application. Changing this replaces the resource.
+        :param pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['ExposeApiPreAuthorizedApplicationArgs']]]] pre_authorized_applications: The client applications that can use the API's scopes without user consent.
+        :param pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['ApplicationPermissionScopeArgs']]]] scopes: The delegated permissions exposed by the API. IDs default to a GUID derived from the value.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            __props__['identifier_uri'] = identifier_uri
+            __props__['known_client_applications'] = known_client_applications
+            if object_id is None and not opts.urn:
+                raise TypeError("Missing required property 'object_id'")
+            __props__['object_id'] = object_id
+            __props__['pre_authorized_applications'] = pre_authorized_applications
+            __props__['scopes'] = scopes
+            __props__['app_id'] = None
+            __props__['identifier_uris'] = None
+        super(ExposeApi, __self__).__init__(
+            'knapcode:index:ExposeApi',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'ExposeApi':
+        """
+        Get an existing ExposeApi resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return ExposeApi(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="appId")
+    def app_id(self) -> pulumi.Output[str]:
+        """
+        The app ID of the application.
+        """
+        return pulumi.get(self, "app_id")
+
+    @property
+    @pulumi.getter(name="identifierUri")
+    def identifier_uri(self) -> pulumi.Output[Optional[str]]:
+        """
+        The identifier URI of the API. Defaults to 'api://{appId}'.
+        """
+        return pulumi.get(self, "identifier_uri")
+
+    @property
+    @pulumi.getter(name="identifierUris")
+    def identifier_uris(self) -> pulumi.Output[Sequence[str]]:
+        """
+        The identifier URIs of the application.
+        """
+        return pulumi.get(self, "identifier_uris")
+
+    @property
+    @pulumi.getter(name="knownClientApplications")
+    def known_client_applications(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        The app IDs of client applications that are bundled with the API for consent.
+        """
+        return pulumi.get(self, "known_client_applications")
+
+    @property
+    @pulumi.getter(name="objectId")
+    def object_id(self) -> pulumi.Output[str]:
+        """
+        The object ID of the application. Changing this replaces the resource.
+        """
+        return pulumi.get(self, "object_id")
+
+    @property
+    @pulumi.getter(name="preAuthorizedApplications")
+    def pre_authorized_applications(self) -> pulumi.Output[Optional[Sequence['outputs.ExposeApiPreAuthorizedApplication']]]:
+        """
+        The client applications that can use the API's scopes without user consent.
+        """
+        return pulumi.get(self, "pre_authorized_applications")
+
+    @property
+    @pulumi.getter
+    def scopes(self) -> pulumi.Output[Optional[Sequence['outputs.ApplicationPermissionScope']]]:
+        """
+        The delegated permissions exposed by the API. IDs default to a GUID derived from the value.
+        """
+        return pulumi.get(self, "scopes")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
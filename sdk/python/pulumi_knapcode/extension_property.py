@@ -0,0 +1,155 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+from ._enums import *
+
+__all__ = ['ExtensionProperty']
+
+
+class ExtensionProperty(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 data_type: Optional[pulumi.Input['ExtensionPropertyDataType']] = None,
+                 is_multi_valued: Optional[pulumi.Input[bool]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 object_id: Optional[pulumi.Input[str]] = None,
+                 target_objects: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        A directory extension property owned by an application, which adds a custom attribute to directory objects like users and groups. The resource ID is the object ID of the application and the extension property ID separated by a slash, which is also used to import it.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input['ExtensionPropertyDataType'] data_type: The data type of the values of the extension property. Changing this replaces the resource.
+        :param pulumi.Input[bool] is_multi_valued: Whether the extension property holds a collection of values. Defaults to false. Changing this replaces the resource.
+        :param pulumi.Input[str] name: The name of the extension property, without the 'extension_{appId}_' prefix. Can only contain letters, digits and '_' and must start with a letter. Changing this replaces the resource.
+        :param pulumi.Input[str] object_id: The object ID of the application that owns the extension property. Changing this replaces the resource.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] target_objects: The kinds of directory objects the extension property can be set on: 'User', 'Group', 'AdministrativeUnit', 'Application', 'Device' or 'Organization'. Changing this replaces the resource.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            if data_type is None and not opts.urn:
+                raise TypeError("Missing required property 'data_type'")
+            __props__['data_type'] = data_type
+            __props__['is_multi_valued'] = is_multi_valued
+            if name is None and not opts.urn:
+                raise TypeError("Missing required property 'name'")
+            __props__['name'] = name
+            if object_id is None and not opts.urn:
+                raise TypeError("Missing required property 'object_id'")
+            __props__['object_id'] = object_id
+            if target_objects is None and not opts.urn:
+                raise TypeError("Missing required property 'target_objects'")
+            __props__['target_objects'] = target_objects
+            __props__['attribute_name'] = None
+            __props__['extension_property_id'] = None
+        super(ExtensionProperty, __self__).__init__(
+            'knapcode:index:ExtensionProperty',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'ExtensionProperty':
+        """
+        Get an existing ExtensionProperty resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return ExtensionProperty(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="attributeName")
+    def attribute_name(self) -> pulumi.Output[str]:
+        """
+        The full name of the extension property on the target objects, in the form 'extension_{appId}_{name}' where the application ID has no hyphens.
+        """
+        return pulumi.get(self, "attribute_name")
+
+    @property
+    @pulumi.getter(name="dataType")
+    def data_type(self) -> pulumi.Output['ExtensionPropertyDataType']:
+        """
+        The data type of the values of the extension property. Changing this replaces the resource.
+        """
+        return pulumi.get(self, "data_type")
+
+    @property
+    @pulumi.getter(name="extensionPropertyId")
+    def extension_property_id(self) -> pulumi.Output[str]:
+        """
+        The ID of the extension property.
+        """
+        return pulumi.get(self, "extension_property_id")
+
+    @property
+    @pulumi.getter(name="isMultiValued")
+    def is_multi_valued(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Whether the extension property holds a collection of values. Defaults to false. Changing this replaces the resource.
+        """
+        return pulumi.get(self, "is_multi_valued")
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Output[str]:
+        """
+        The name of the extension property, without the 'extension_{appId}_' prefix. Can only contain letters, digits and '_' and must start with a letter. Changing this replaces the resource.
+        """
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter(name="objectId")
+    def object_id(self) -> pulumi.Output[str]:
+        """
+        The object ID of the application that owns the extension property. Changing this replaces the resource.
+        """
+        return pulumi.get(self, "object_id")
+
+    @property
+    @pulumi.getter(name="targetObjects")
+    def target_objects(self) -> pulumi.Output[Sequence[str]]:
+        """
+        The kinds of directory objects the extension property can be set on: 'User', 'Group', 'AdministrativeUnit', 'Application', 'Device' or 'Organization'. Changing this replaces the resource.
+        """
+        return pulumi.get(self, "target_objects")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
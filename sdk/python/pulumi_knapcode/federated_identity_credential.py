@@ -0,0 +1,176 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+from . import outputs
+from ._inputs import *
+
+__all__ = ['FederatedIdentityCredential']
+
+
+class FederatedIdentityCredential(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 audiences: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 description: Optional[pulumi.Input[str]] = None,
+                 github: Optional[pulumi.Input[pulumi.InputType['GitHubFederatedSubjectArgs']]] = None,
+                 issuer: Optional[pulumi.Input[str]] = None,
+                 kubernetes: Optional[pulumi.Input[pulumi.InputType['KubernetesFederatedSubjectArgs']]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 object_id: Optional[pulumi.Input[str]] = None,
+                 subject: Optional[pulumi.Input[str]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        A federated identity credential on an application, letting an external workload like a GitHub Actions workflow or a Kubernetes service account get tokens for the application without a secret. The resource ID is the application's object ID and the credential ID separated by a slash, which is also the format used to import a credential.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] audiences: The audiences that can appear in the external token. Defaults to 'api://AzureADTokenExchange'.
+        :param pulumi.Input[str] description: A description of the credential.
+        :param pulumi.Input[pulumi.InputType['GitHubFederatedSubjectArgs']] github: Builds the subject for GitHub Actions.
+        :param pulumi.Input[str] issuer: The URL of the external identity provider. Defaults to the GitHub Actions issuer when 'github' is set.
+        :param pulumi.Input[pulumi.InputType['KubernetesFederatedSubjectArgs']] kubernetes: Builds the subject for a Kubernetes service account.
+        :param pulumi.Input[str] name: The name of the credential, unique within the application. Changing this replaces the credential.
+        :param pulumi.Input[str] object_id: The object ID of the application. Changing this replaces the credential.
+        :param pulumi.Input[str] subject: The identity of the external workload. Set this, 'github' or 'kubernetes'.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            __props__['audiences'] = audiences
+            __props__['description'] = description
+            __props__['github'] = github
+            __props__['issuer'] = issuer
+            __props__['kubernetes'] = kubernetes
+            if name is None and not opts.urn:
+                raise TypeError("Missing required property 'name'")
+            __props__['name'] = name
+            if object_id is None and not opts.urn:
+                raise TypeError("Missing required property 'object_id'")
+            __props__['object_id'] = object_id
+            __props__['subject'] = subject
+            __props__['credential_id'] = None
+        super(FederatedIdentityCredential, __self__).__init__(
+            'knapcode:index:FederatedIdentityCredential',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'FederatedIdentityCredential':
+        """
+        Get an existing FederatedIdentityCredential resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return FederatedIdentityCredential(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def audiences(self) -> pulumi.Output[Sequence[str]]:
+        """
+        The audiences that can appear in the external token.
+        """
+        return pulumi.get(self, "audiences")
+
+    @property
+    @pulumi.getter(name="credentialId")
+    def credential_id(self) -> pulumi.Output[str]:
+        """
+        The ID of the credential assigned by Microsoft Graph.
+        """
+        return pulumi.get(self, "credential_id")
+
+    @property
+    @pulumi.getter
+    def description(self) -> pulumi.Output[Optional[str]]:
+        """
+        A description of the credential.
+        """
+        return pulumi.get(self, "description")
+
+    @property
+    @pulumi.getter
+    def github(self) -> pulumi.Output[Optional['outputs.GitHubFederatedSubject']]:
+        """
+        Builds the subject for GitHub Actions.
+        """
+        return pulumi.get(self, "github")
+
+    @property
+    @pulumi.getter
+    def issuer(self) -> pulumi.Output[str]:
+        """
+        The URL of the external identity provider.
+        """
+        return pulumi.get(self, "issuer")
+
+    @property
+    @pulumi.getter
+    def kubernetes(self) -> pulumi.Output[Optional['outputs.KubernetesFederatedSubject']]:
+        """
+        Builds the subject for a Kubernetes service account.
+        """
+        return pulumi.get(self, "kubernetes")
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Output[str]:
+        """
+        The name of the credential, unique within the application. Changing this replaces the credential.
+        """
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter(name="objectId")
+    def object_id(self) -> pulumi.Output[str]:
+        """
+        The object ID of the application. Changing this replaces the credential.
+        """
+        return pulumi.get(self, "object_id")
+
+    @property
+    @pulumi.getter
+    def subject(self) -> pulumi.Output[str]:
+        """
+        The identity of the external workload.
+        """
+        return pulumi.get(self, "subject")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
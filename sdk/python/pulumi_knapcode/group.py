@@ -0,0 +1,149 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+from ._enums import *
+
+__all__ = ['Group']
+
+
+class Group(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 description: Optional[pulumi.Input[str]] = None,
+                 display_name: Optional[pulumi.Input[str]] = None,
+                 is_assignable_to_role: Optional[pulumi.Input[bool]] = None,
+                 mail_nickname: Optional[pulumi.Input[str]] = None,
+                 type: Optional[pulumi.Input['GroupType']] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        A security or Microsoft 365 group managed through Microsoft Graph. The resource ID is the object ID of the group, which is also used to import it.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] description: The description of the group.
+        :param pulumi.Input[str] display_name: The display name of the group.
+        :param pulumi.Input[bool] is_assignable_to_role: Whether the group can be assigned Azure AD roles. Defaults to false. Changing this replaces the resource.
+        :param pulumi.Input[str] mail_nickname: The mail alias of the group. Can only contain letters, digits, '.', '-' and '_'. Defaults to the display name without the other characters.
+        :param pulumi.Input['GroupType'] type: The kind of group. Both kinds are security enabled, so they can be assigned app roles. Defaults to 'Security'. Changing this replaces the resource.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            __props__['description'] = description
+            if display_name is None and not opts.urn:
+                raise TypeError("Missing required property 'display_name'")
+            __props__['display_name'] = display_name
+            __props__['is_assignable_to_role'] = is_assignable_to_role
+            __props__['mail_nickname'] = mail_nickname
+            __props__['type'] = type
+            __props__['mail'] = None
+            __props__['object_id'] = None
+        super(Group, __self__).__init__(
+            'knapcode:index:Group',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'Group':
+        """
+        Get an existing Group resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return Group(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def description(self) -> pulumi.Output[Optional[str]]:
+        """
+        The description of the group.
+        """
+        return pulumi.get(self, "description")
+
+    @property
+    @pulumi.getter(name="displayName")
+    def display_name(self) -> pulumi.Output[str]:
+        """
+        The display name of the group.
+        """
+        return pulumi.get(self, "display_name")
+
+    @property
+    @pulumi.getter(name="isAssignableToRole")
+    def is_assignable_to_role(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Whether the group can be assigned Azure AD roles. Defaults to false. Changing this replaces the resource.
+        """
+        return pulumi.get(self, "is_assignable_to_role")
+
+    @property
+    @pulumi.getter
+    def mail(self) -> pulumi.Output[Optional[str]]:
+        """
+        The email address of the group, for Microsoft 365 groups.
+        """
+        return pulumi.get(self, "mail")
+
+    @property
+    @pulumi.getter(name="mailNickname")
+    def mail_nickname(self) -> pulumi.Output[str]:
+        """
+        The mail alias of the group. Can only contain letters, digits, '.', '-' and '_'. Defaults to the display name without the other characters.
+        """
+        return pulumi.get(self, "mail_nickname")
+
+    @property
+    @pulumi.getter(name="objectId")
+    def object_id(self) -> pulumi.Output[str]:
+        """
+        The object ID of the group.
+        """
+        return pulumi.get(self, "object_id")
+
+    @property
+    @pulumi.getter
+    def type(self) -> pulumi.Output[Optional['GroupType']]:
+        """
+        The kind of group. Both kinds are security enabled, so they can be assigned app roles. Defaults to 'Security'. Changing this replaces the resource.
+        """
+        return pulumi.get(self, "type")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
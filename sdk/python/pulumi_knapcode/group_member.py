@@ -0,0 +1,117 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+
+__all__ = ['GroupMember']
+
+
+class GroupMember(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 group_id: Optional[pulumi.Input[str]] = None,
+                 member_id: Optional[pulumi.Input[str]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        Adds a member to a group. A member that is already in the group is not an error, and neither is a member that is already gone when the resource is deleted. The resource ID is the object ID of the group and the object ID of the member separated by a slash, which is also used to import it.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] group_id: The object ID of the group. Changing this replaces the resource.
+        :param pulumi.Input[str] member_id: The object ID of the user, group or service principal to add as a member. Changing this replaces the resource.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            if group_id is None and not opts.urn:
+                raise TypeError("Missing required property 'group_id'")
+            __props__['group_id'] = group_id
+            if member_id is None and not opts.urn:
+                raise TypeError("Missing required property 'member_id'")
+            __props__['member_id'] = member_id
+            __props__['member_display_name'] = None
+            __props__['member_type'] = None
+        super(GroupMember, __self__).__init__(
+            'knapcode:index:GroupMember',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'GroupMember':
+        """
+        Get an existing GroupMember resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return GroupMember(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="groupId")
+    def group_id(self) -> pulumi.Output[str]:
+        """
+        The object ID of the group. Changing this replaces the resource.
+        """
+        return pulumi.get(self, "group_id")
+
+    @property
+    @pulumi.getter(name="memberDisplayName")
+    def member_display_name(self) -> pulumi.Output[str]:
+        """
+        The display name of the member.
+        """
+        return pulumi.get(self, "member_display_name")
+
+    @property
+    @pulumi.getter(name="memberId")
+    def member_id(self) -> pulumi.Output[str]:
+        """
+        The object ID of the user, group or service principal to add as a member. Changing this replaces the resource.
+        """
+        return pulumi.get(self, "member_id")
+
+    @property
+    @pulumi.getter(name="memberType")
+    def member_type(self) -> pulumi.Output[str]:
+        """
+        The type of the member, e.g. 'user', 'group' or 'servicePrincipal'.
+        """
+        return pulumi.get(self, "member_type")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
@@ -0,0 +1,1073 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+from . import outputs
+from ._enums import *
+
+__all__ = [
+    'ApplicationApi',
+    'ApplicationAppRole',
+    'ApplicationImplicitGrantSettings',
+    'ApplicationOptionalClaim',
+    'ApplicationOptionalClaims',
+    'ApplicationPermissionScope',
+    'ApplicationPreAuthorizedApplication',
+    'ApplicationPublicClient',
+    'ApplicationRequiredResourceAccess',
+    'ApplicationResourceAccess',
+    'ApplicationSpa',
+    'ApplicationWeb',
+    'ClaimsMappingPolicyClaim',
+    'ClaimsMappingPolicyDefinition',
+    'ExposeApiPreAuthorizedApplication',
+    'GitHubFederatedSubject',
+    'KubernetesFederatedSubject',
+    'RequiredResourceAccessResolvedResource',
+    'RequiredResourceAccessResource',
+    'TokenLifetimePolicyDefinition',
+]
+
+@pulumi.output_type
+class ApplicationApi(dict):
+    """
+    Settings for an application that exposes an API.
+    """
+    def __init__(__self__, *,
+                 accept_mapped_claims: Optional[bool] = None,
+                 known_client_applications: Optional[Sequence[str]] = None,
+                 oauth2_permission_scopes: Optional[Sequence['outputs.ApplicationPermissionScope']] = None,
+                 pre_authorized_applications: Optional[Sequence['outputs.ApplicationPreAuthorizedApplication']] = None,
+                 requested_access_token_version: Optional[int] = None):
+        """
+        Settings for an application that exposes an API.
+        :param bool accept_mapped_claims: Whether claims mapping can be used without a custom signing key.
+        :param Sequence[str] known_client_applications: The app IDs of client applications that are bundled with this application for consent.
+        :param Sequence['ApplicationPermissionScopeArgs'] oauth2_permission_scopes: The delegated permissions exposed by the API.
+        :param Sequence['ApplicationPreAuthorizedApplicationArgs'] pre_authorized_applications: The client applications that are pre-authorized for the API's scopes.
+        :param int requested_access_token_version: The access token version expected by the API, 1 or 2.
+        """
+        if accept_mapped_claims is not None:
+            pulumi.set(__self__, "accept_mapped_claims", accept_mapped_claims)
+        if known_client_applications is not None:
+            pulumi.set(__self__, "known_client_applications", known_client_applications)
+        if oauth2_permission_scopes is not None:
+            pulumi.set(__self__, "oauth2_permission_scopes", oauth2_permission_scopes)
+        if pre_authorized_applications is not None:
+            pulumi.set(__self__, "pre_authorized_applications", pre_authorized_applications)
+        if requested_access_token_version is not None:
+            pulumi.set(__self__, "requested_access_token_version", requested_access_token_version)
+
+    @property
+    @pulumi.getter(name="acceptMappedClaims")
+    def accept_mapped_claims(self) -> Optional[bool]:
+        """
+        Whether claims mapping can be used without a custom signing key.
+        """
+        return pulumi.get(self, "accept_mapped_claims")
+
+    @property
+    @pulumi.getter(name="knownClientApplications")
+    def known_client_applications(self) -> Optional[Sequence[str]]:
+        """
+        The app IDs of client applications that are bundled with this application for consent.
+        """
+        return pulumi.get(self, "known_client_applications")
+
+    @property
+    @pulumi.getter(name="oauth2PermissionScopes")
+    def oauth2_permission_scopes(self) -> Optional[Sequence['outputs.ApplicationPermissionScope']]:
+        """
+        The delegated permissions exposed by the API.
+        """
+        return pulumi.get(self, "oauth2_permission_scopes")
+
+    @property
+    @pulumi.getter(name="preAuthorizedApplications")
+    def pre_authorized_applications(self) -> Optional[Sequence['outputs.ApplicationPreAuthorizedApplication']]:
+        """
+        The client applications that are pre-authorized for the API's scopes.
+        """
+        return pulumi.get(self, "pre_authorized_applications")
+
+    @property
+    @pulumi.getter(name="requestedAccessTokenVersion")
+    def requested_access_token_version(self) -> Optional[int]:
+        """
+        The access token version expected by the API, 1 or 2.
+        """
+        return pulumi.get(self, "requested_access_token_version")
+
+    def _translate_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+
+@pulumi.output_type
+class ApplicationAppRole(dict):
+    """
+    A role that can be assigned to users, groups or applications.
+    """
+    def __init__(__self__, *,
+                 allowed_member_types: Sequence[str],
+                 description: str,
+                 display_name: str,
+                 id: Optional[str] = None,
+                 is_enabled: Optional[bool] = None,
+                 value: Optional[str] = None):
+        """
+        A role that can be assigned to users, groups or applications.
+        :param Sequence[str] allowed_member_types: Who can be assigned the role: 'User' for users and groups, 'Application' for applications, or both.
+        :param str description: The description of the role.
+        :param str display_name: The display name of the role.
+        :param str id: The ID of the role. Defaults to a GUID derived from the value.
+        :param bool is_enabled: Whether the role is enabled. Defaults to true.
+        :param str value: The value of the role, which appears in the roles claim of tokens.
+        """
+        pulumi.set(__self__, "allowed_member_types", allowed_member_types)
+        pulumi.set(__self__, "description", description)
+        pulumi.set(__self__, "display_name", display_name)
+        if id is not None:
+            pulumi.set(__self__, "id", id)
+        if is_enabled is not None:
+            pulumi.set(__self__, "is_enabled", is_enabled)
+        if value is not None:
+            pulumi.set(__self__, "value", value)
+
+    @property
+    @pulumi.getter(name="allowedMemberTypes")
+    def allowed_member_types(self) -> Sequence[str]:
+        """
+        Who can be assigned the role: 'User' for users and groups, 'Application' for applications, or both.
+        """
+        return pulumi.get(self, "allowed_member_types")
+
+    @property
+    @pulumi.getter
+    def description(self) -> str:
+        """
+        The description of the role.
+        """
+        return pulumi.get(self, "description")
+
+    @property
+    @pulumi.getter(name="displayName")
+    def display_name(self) -> str:
+        """
+        The display name of the role.
+        """
+        return pulumi.get(self, "display_name")
+
+    @property
+    @pulumi.getter
+    def id(self) -> Optional[str]:
+        """
+        The ID of the role. Defaults to a GUID derived from the value.
+        """
+        return pulumi.get(self, "id")
+
+    @property
+    @pulumi.getter(name="isEnabled")
+    def is_enabled(self) -> Optional[bool]:
+        """
+        Whether the role is enabled. Defaults to true.
+        """
+        return pulumi.get(self, "is_enabled")
+
+    @property
+    @pulumi.getter
+    def value(self) -> Optional[str]:
+        """
+        The value of the role, which appears in the roles claim of tokens.
+        """
+        return pulumi.get(self, "value")
+
+    def _translate_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+
+@pulumi.output_type
+class ApplicationImplicitGrantSettings(dict):
+    """
+    Whether tokens can be requested with the OAuth 2.0 implicit flow.
+    """
+    def __init__(__self__, *,
+                 enable_access_token_issuance: Optional[bool] = None,
+                 enable_id_token_issuance: Optional[bool] = None):
+        """
+        Whether tokens can be requested with the OAuth 2.0 implicit flow.
+        :param bool enable_access_token_issuance: Whether access tokens can be requested with the implicit flow.
+        :param bool enable_id_token_issuance: Whether ID tokens can be requested with the implicit flow.
+        """
+        if enable_access_token_issuance is not None:
+            pulumi.set(__self__, "enable_access_token_issuance", enable_access_token_issuance)
+        if enable_id_token_issuance is not None:
+            pulumi.set(__self__, "enable_id_token_issuance", enable_id_token_issuance)
+
+    @property
+    @pulumi.getter(name="enableAccessTokenIssuance")
+    def enable_access_token_issuance(self) -> Optional[bool]:
+        """
+        Whether access tokens can be requested with the implicit flow.
+        """
+        return pulumi.get(self, "enable_access_token_issuance")
+
+    @property
+    @pulumi.getter(name="enableIdTokenIssuance")
+    def enable_id_token_issuance(self) -> Optional[bool]:
+        """
+        Whether ID tokens can be requested with the implicit flow.
+        """
+        return pulumi.get(self, "enable_id_token_issuance")
+
+    def _translate_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+
+@pulumi.output_type
+class ApplicationOptionalClaim(dict):
+    """
+    An optional claim included in tokens.
+    """
+    def __init__(__self__, *,
+                 name: str,
+                 additional_properties: Optional[Sequence[str]] = None,
+                 essential: Optional[bool] = None,
+                 source: Optional[str] = None):
+        """
+        An optional claim included in tokens.
+        :param str name: The name of the claim.
+        :param Sequence[str] additional_properties: Additional properties of the claim.
+        :param bool essential: Whether the claim is essential for the application.
+        :param str source: The source of the claim, e.g. 'user' for a directory extension. Not set for built-in claims.
+        """
+        pulumi.set(__self__, "name", name)
+        if additional_properties is not None:
+            pulumi.set(__self__, "additional_properties", additional_properties)
+        if essential is not None:
+            pulumi.set(__self__, "essential", essential)
+        if source is not None:
+            pulumi.set(__self__, "source", source)
+
+    @property
+    @pulumi.getter
+    def name(self) -> str:
+        """
+        The name of the claim.
+        """
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter(name="additionalProperties")
+    def additional_properties(self) -> Optional[Sequence[str]]:
+        """
+        Additional properties of the claim.
+        """
+        return pulumi.get(self, "additional_properties")
+
+    @property
+    @pulumi.getter
+    def essential(self) -> Optional[bool]:
+        """
+        Whether the claim is essential for the application.
+        """
+        return pulumi.get(self, "essential")
+
+    @property
+    @pulumi.getter
+    def source(self) -> Optional[str]:
+        """
+        The source of the claim, e.g. 'user' for a directory extension. Not set for built-in claims.
+        """
+        return pulumi.get(self, "source")
+
+    def _translate_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+
+@pulumi.output_type
+class ApplicationOptionalClaims(dict):
+    """
+    Optional claims included in the tokens issued for an application.
+    """
+    def __init__(__self__, *,
+                 access_token: Optional[Sequence['outputs.ApplicationOptionalClaim']] = None,
+                 id_token: Optional[Sequence['outputs.ApplicationOptionalClaim']] = None,
+                 saml2_token: Optional[Sequence['outputs.ApplicationOptionalClaim']] = None):
+        """
+        Optional claims included in the tokens issued for an application.
+        :param Sequence['ApplicationOptionalClaimArgs'] access_token: The optional claims in access tokens.
+        :param Sequence['ApplicationOptionalClaimArgs'] id_token: The optional claims in ID tokens.
+        :param Sequence['ApplicationOptionalClaimArgs'] saml2_token: The optional claims in SAML tokens.
+        """
+        if access_token is not None:
+            pulumi.set(__self__, "access_token", access_token)
+        if id_token is not None:
+            pulumi.set(__self__, "id_token", id_token)
+        if saml2_token is not None:
+            pulumi.set(__self__, "saml2_token", saml2_token)
+
+    @property
+    @pulumi.getter(name="accessToken")
+    def access_token(self) -> Optional[Sequence['outputs.ApplicationOptionalClaim']]:
+        """
+        The optional claims in access tokens.
+        """
+        return pulumi.get(self, "access_token")
+
+    @property
+    @pulumi.getter(name="idToken")
+    def id_token(self) -> Optional[Sequence['outputs.ApplicationOptionalClaim']]:
+        """
+        The optional claims in ID tokens.
+        """
+        return pulumi.get(self, "id_token")
+
+    @property
+    @pulumi.getter(name="saml2Token")
+    def saml2_token(self) -> Optional[Sequence['outputs.ApplicationOptionalClaim']]:
+        """
+        The optional claims in SAML tokens.
+        """
+        return pulumi.get(self, "saml2_token")
+
+    def _translate_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+
+@pulumi.output_type
+class ApplicationPermissionScope(dict):
+    """
+    A delegated permission exposed by an application's API.
+    """
+    def __init__(__self__, *,
+                 admin_consent_description: Optional[str] = None,
+                 admin_consent_display_name: Optional[str] = None,
+                 id: Optional[str] = None,
+                 is_enabled: Optional[bool] = None,
+                 type: Optional[str] = None,
+                 user_consent_description: Optional[str] = None,
+                 user_consent_display_name: Optional[str] = None,
+                 value: Optional[str] = None):
+        """
+        A delegated permission exposed by an application's API.
+        :param str admin_consent_description: The description of the scope shown to admins.
+        :param str admin_consent_display_name: The title of the scope shown to admins.
+        :param str id: The ID of the scope. Defaults to a GUID derived from the value.
+        :param bool is_enabled: Whether the scope is enabled. Defaults to true.
+        :param str type: Whether users ('User') or only admins ('Admin') can consent to the scope. Defaults to 'User'.
+        :param str user_consent_description: The description of the scope shown to users.
+        :param str user_consent_display_name: The title of the scope shown to users.
+        :param str value: The value of the scope, which appears in the scp claim of access tokens.
+        """
+        if admin_consent_description is not None:
+            pulumi.set(__self__, "admin_consent_description", admin_consent_description)
+        if admin_consent_display_name is not None:
+            pulumi.set(__self__, "admin_consent_display_name", admin_consent_display_name)
+        if id is not None:
+            pulumi.set(__self__, "id", id)
+        if is_enabled is not None:
+            pulumi.set(__self__, "is_enabled", is_enabled)
+        if type is not None:
+            pulumi.set(__self__, "type", type)
+        if user_consent_description is not None:
+            pulumi.set(__self__, "user_consent_description", user_consent_description)
+        if user_consent_display_name is not None:
+            pulumi.set(__self__, "user_consent_display_name", user_consent_display_name)
+        if value is not None:
+            pulumi.set(__self__, "value", value)
+
+    @property
+    @pulumi.getter(name="adminConsentDescription")
+    def admin_consent_description(self) -> Optional[str]:
+        """
+        The description of the scope shown to admins.
+        """
+        return pulumi.get(self, "admin_consent_description")
+
+    @property
+    @pulumi.getter(name="adminConsentDisplayName")
+    def admin_consent_display_name(self) -> Optional[str]:
+        """
+        The title of the scope shown to admins.
+        """
+        return pulumi.get(self, "admin_consent_display_name")
+
+    @property
+    @pulumi.getter
+    def id(self) -> Optional[str]:
+        """
+        The ID of the scope. Defaults to a GUID derived from the value.
+        """
+        return pulumi.get(self, "id")
+
+    @property
+    @pulumi.getter(name="isEnabled")
+    def is_enabled(self) -> Optional[bool]:
+        """
+        Whether the scope is enabled. Defaults to true.
+        """
+        return pulumi.get(self, "is_enabled")
+
+    @property
+    @pulumi.getter
+    def type(self) -> Optional[str]:
+        """
+        Whether users ('User') or only admins ('Admin') can consent to the scope. Defaults to 'User'.
+        """
+        return pulumi.get(self, "type")
+
+    @property
+    @pulumi.getter(name="userConsentDescription")
+    def user_consent_description(self) -> Optional[str]:
+        """
+        The description of the scope shown to users.
+        """
+        return pulumi.get(self, "user_consent_description")
+
+    @property
+    @pulumi.getter(name="userConsentDisplayName")
+    def user_consent_display_name(self) -> Optional[str]:
+        """
+        The title of the scope shown to users.
+        """
+        return pulumi.get(self, "user_consent_display_name")
+
+    @property
+    @pulumi.getter
+    def value(self) -> Optional[str]:
+        """
+        The value of the scope, which appears in the scp claim of access tokens.
+        """
+        return pulumi.get(self, "value")
+
+    def _translate_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+
+@pulumi.output_type
+class ApplicationPreAuthorizedApplication(dict):
+    """
+    A client application that can use an API's scopes without user consent.
+    """
+    def __init__(__self__, *,
+                 app_id: str,
+                 delegated_permission_ids: Sequence[str]):
+        """
+        A client application that can use an API's scopes without user consent.
+        :param str app_id: The app ID of the client application.
+        :param Sequence[str] delegated_permission_ids: The IDs of the scopes the client application is pre-authorized for.
+        """
+        pulumi.set(__self__, "app_id", app_id)
+        pulumi.set(__self__, "delegated_permission_ids", delegated_permission_ids)
+
+    @property
+    @pulumi.getter(name="appId")
+    def app_id(self) -> str:
+        """
+        The app ID of the client application.
+        """
+        return pulumi.get(self, "app_id")
+
+    @property
+    @pulumi.getter(name="delegatedPermissionIds")
+    def delegated_permission_ids(self) -> Sequence[str]:
+        """
+        The IDs of the scopes the client application is pre-authorized for.
+        """
+        return pulumi.get(self, "delegated_permission_ids")
+
+    def _translate_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+
+@pulumi.output_type
+class ApplicationPublicClient(dict):
+    """
+    Settings for a public client, like a desktop or mobile application.
+    """
+    def __init__(__self__, *,
+                 redirect_uris: Optional[Sequence[str]] = None):
+        """
+        Settings for a public client, like a desktop or mobile application.
+        :param Sequence[str] redirect_uris: The URLs where tokens are sent for sign-in.
+        """
+        if redirect_uris is not None:
+            pulumi.set(__self__, "redirect_uris", redirect_uris)
+
+    @property
+    @pulumi.getter(name="redirectUris")
+    def redirect_uris(self) -> Optional[Sequence[str]]:
+        """
+        The URLs where tokens are sent for sign-in.
+        """
+        return pulumi.get(self, "redirect_uris")
+
+    def _translate_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+
+@pulumi.output_type
+class ApplicationRequiredResourceAccess(dict):
+    """
+    The permissions an application requires on a resource application.
+    """
+    def __init__(__self__, *,
+                 resource_access: Sequence['outputs.ApplicationResourceAccess'],
+                 resource_app_id: str):
+        """
+        The permissions an application requires on a resource application.
+        :param Sequence['ApplicationResourceAccessArgs'] resource_access: The permissions required on the resource.
+        :param str resource_app_id: The app ID of the resource application, e.g. '00000003-0000-0000-c000-000000000000' for Microsoft Graph.
+        """
+        pulumi.set(__self__, "resource_access", resource_access)
+        pulumi.set(__self__, "resource_app_id", resource_app_id)
+
+    @property
+    @pulumi.getter(name="resourceAccess")
+    def resource_access(self) -> Sequence['outputs.ApplicationResourceAccess']:
+        """
+        The permissions required on the resource.
+        """
+        return pulumi.get(self, "resource_access")
+
+    @property
+    @pulumi.getter(name="resourceAppId")
+    def resource_app_id(self) -> str:
+        """
+        The app ID of the resource application, e.g. '00000003-0000-0000-c000-000000000000' for Microsoft Graph.
+        """
+        return pulumi.get(self, "resource_app_id")
+
+    def _translate_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+
+@pulumi.output_type
+class ApplicationResourceAccess(dict):
+    """
+    A permission an application requires on a resource.
+    """
+    def __init__(__self__, *,
+                 id: str,
+                 type: str):
+        """
+        A permission an application requires on a resource.
+        :param str id: The ID of the scope or app role.
+        :param str type: 'Scope' for a delegated permission or 'Role' for an application permission.
+        """
+        pulumi.set(__self__, "id", id)
+        pulumi.set(__self__, "type", type)
+
+    @property
+    @pulumi.getter
+    def id(self) -> str:
+        """
+        The ID of the scope or app role.
+        """
+        return pulumi.get(self, "id")
+
+    @property
+    @pulumi.getter
+    def type(self) -> str:
+        """
+        'Scope' for a delegated permission or 'Role' for an application permission.
+        """
+        return pulumi.get(self, "type")
+
+    def _translate_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+
+@pulumi.output_type
+class ApplicationSpa(dict):
+    """
+    Settings for a single-page application.
+    """
+    def __init__(__self__, *,
+                 redirect_uris: Optional[Sequence[str]] = None):
+        """
+        Settings for a single-page application.
+        :param Sequence[str] redirect_uris: The URLs where tokens are sent for sign-in.
+        """
+        if redirect_uris is not None:
+            pulumi.set(__self__, "redirect_uris", redirect_uris)
+
+    @property
+    @pulumi.getter(name="redirectUris")
+    def redirect_uris(self) -> Optional[Sequence[str]]:
+        """
+        The URLs where tokens are sent for sign-in.
+        """
+        return pulumi.get(self, "redirect_uris")
+
+    def _translate_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+
+@pulumi.output_type
+class ApplicationWeb(dict):
+    """
+    Settings for a web application.
+    """
+    def __init__(__self__, *,
+                 home_page_url: Optional[str] = None,
+                 implicit_grant_settings: Optional['outputs.ApplicationImplicitGrantSettings'] = None,
+                 logout_url: Optional[str] = None,
+                 redirect_uris: Optional[Sequence[str]] = None):
+        """
+        Settings for a web application.
+        :param str home_page_url: The home page of the application.
+        :param 'ApplicationImplicitGrantSettingsArgs' implicit_grant_settings: The implicit grant settings.
+        :param str logout_url: The URL used to sign out of the application.
+        :param Sequence[str] redirect_uris: The URLs where tokens are sent for sign-in.
+        """
+        if home_page_url is not None:
+            pulumi.set(__self__, "home_page_url", home_page_url)
+        if implicit_grant_settings is not None:
+            pulumi.set(__self__, "implicit_grant_settings", implicit_grant_settings)
+        if logout_url is not None:
+            pulumi.set(__self__, "logout_url", logout_url)
+        if redirect_uris is not None:
+            pulumi.set(__self__, "redirect_uris", redirect_uris)
+
+    @property
+    @pulumi.getter(name="homePageUrl")
+    def home_page_url(self) -> Optional[str]:
+        """
+        The home page of the application.
+        """
+        return pulumi.get(self, "home_page_url")
+
+    @property
+    @pulumi.getter(name="implicitGrantSettings")
+    def implicit_grant_settings(self) -> Optional['outputs.ApplicationImplicitGrantSettings']:
+        """
+        The implicit grant settings.
+        """
+        return pulumi.get(self, "implicit_grant_settings")
+
+    @property
+    @pulumi.getter(name="logoutUrl")
+    def logout_url(self) -> Optional[str]:
+        """
+        The URL used to sign out of the application.
+        """
+        return pulumi.get(self, "logout_url")
+
+    @property
+    @pulumi.getter(name="redirectUris")
+    def redirect_uris(self) -> Optional[Sequence[str]]:
+        """
+        The URLs where tokens are sent for sign-in.
+        """
+        return pulumi.get(self, "redirect_uris")
+
+    def _translate_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+
+@pulumi.output_type
+class ClaimsMappingPolicyClaim(dict):
+    """
+    A claim emitted by a claims mapping policy, either from a source attribute or with a constant value.
+    """
+    def __init__(__self__, *,
+                 id: Optional[str] = None,
+                 jwt_claim_type: Optional[str] = None,
+                 saml_claim_type: Optional[str] = None,
+                 saml_name_format: Optional[str] = None,
+                 source: Optional[str] = None,
+                 value: Optional[str] = None):
+        """
+        A claim emitted by a claims mapping policy, either from a source attribute or with a constant value.
+        :param str id: The attribute of the source the claim value comes from, e.g. 'employeeid'.
+        :param str jwt_claim_type: The name of the claim in JWTs.
+        :param str saml_claim_type: The URI of the claim in SAML tokens.
+        :param str saml_name_format: The name format of the claim in SAML tokens.
+        :param str source: The source of the claim value: 'user', 'application', 'resource', 'audience', 'company' or 'transformation'.
+        :param str value: A constant value for the claim, instead of a source and an ID.
+        """
+        if id is not None:
+            pulumi.set(__self__, "id", id)
+        if jwt_claim_type is not None:
+            pulumi.set(__self__, "jwt_claim_type", jwt_claim_type)
+        if saml_claim_type is not None:
+            pulumi.set(__self__, "saml_claim_type", saml_claim_type)
+        if saml_name_format is not None:
+            pulumi.set(__self__, "saml_name_format", saml_name_format)
+        if source is not None:
+            pulumi.set(__self__, "source", source)
+        if value is not None:
+            pulumi.set(__self__, "value", value)
+
+    @property
+    @pulumi.getter
+    def id(self) -> Optional[str]:
+        """
+        The attribute of the source the claim value comes from, e.g. 'employeeid'.
+        """
+        return pulumi.get(self, "id")
+
+    @property
+    @pulumi.getter(name="jwtClaimType")
+    def jwt_claim_type(self) -> Optional[str]:
+        """
+        The name of the claim in JWTs.
+        """
+        return pulumi.get(self, "jwt_claim_type")
+
+    @property
+    @pulumi.getter(name="samlClaimType")
+    def saml_claim_type(self) -> Optional[str]:
+        """
+        The URI of the claim in SAML tokens.
+        """
+        return pulumi.get(self, "saml_claim_type")
+
+    @property
+    @pulumi.getter(name="samlNameFormat")
+    def saml_name_format(self) -> Optional[str]:
+        """
+        The name format of the claim in SAML tokens.
+        """
+        return pulumi.get(self, "saml_name_format")
+
+    @property
+    @pulumi.getter
+    def source(self) -> Optional[str]:
+        """
+        The source of the claim value: 'user', 'application', 'resource', 'audience', 'company' or 'transformation'.
+        """
+        return pulumi.get(self, "source")
+
+    @property
+    @pulumi.getter
+    def value(self) -> Optional[str]:
+        """
+        A constant value for the claim, instead of a source and an ID.
+        """
+        return pulumi.get(self, "value")
+
+    def _translate_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+
+@pulumi.output_type
+class ClaimsMappingPolicyDefinition(dict):
+    """
+    The definition of a claims mapping policy.
+    """
+    def __init__(__self__, *,
+                 claims_schema: Sequence['outputs.ClaimsMappingPolicyClaim'],
+                 include_basic_claim_set: Optional[bool] = None):
+        """
+        The definition of a claims mapping policy.
+        :param Sequence['ClaimsMappingPolicyClaimArgs'] claims_schema: The claims emitted in tokens.
+        :param bool include_basic_claim_set: Whether the basic claim set is included in tokens in addition to the mapped claims. Defaults to false.
+        """
+        pulumi.set(__self__, "claims_schema", claims_schema)
+        if include_basic_claim_set is not None:
+            pulumi.set(__self__, "include_basic_claim_set", include_basic_claim_set)
+
+    @property
+    @pulumi.getter(name="claimsSchema")
+    def claims_schema(self) -> Sequence['outputs.ClaimsMappingPolicyClaim']:
+        """
+        The claims emitted in tokens.
+        """
+        return pulumi.get(self, "claims_schema")
+
+    @property
+    @pulumi.getter(name="includeBasicClaimSet")
+    def include_basic_claim_set(self) -> Optional[bool]:
+        """
+        Whether the basic claim set is included in tokens in addition to the mapped claims. Defaults to false.
+        """
+        return pulumi.get(self, "include_basic_claim_set")
+
+    def _translate_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+
+@pulumi.output_type
+class ExposeApiPreAuthorizedApplication(dict):
+    """
+    A client application that can use some of the API's scopes without user consent.
+    """
+    def __init__(__self__, *,
+                 app_id: str,
+                 scopes: Sequence[str]):
+        """
+        A client application that can use some of the API's scopes without user consent.
+        :param str app_id: The app ID of the client application.
+        :param Sequence[str] scopes: The values of the scopes the client application is pre-authorized for.
+        """
+        pulumi.set(__self__, "app_id", app_id)
+        pulumi.set(__self__, "scopes", scopes)
+
+    @property
+    @pulumi.getter(name="appId")
+    def app_id(self) -> str:
+        """
+        The app ID of the client application.
+        """
+        return pulumi.get(self, "app_id")
+
+    @property
+    @pulumi.getter
+    def scopes(self) -> Sequence[str]:
+        """
+        The values of the scopes the client application is pre-authorized for.
+        """
+        return pulumi.get(self, "scopes")
+
+    def _translate_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+
+@pulumi.output_type
+class GitHubFederatedSubject(dict):
+    """
+    Builds the subject of a federated identity credential for GitHub Actions. Exactly one of branch, tag, environment and pullRequest must be set.
+    """
+    def __init__(__self__, *,
+                 repository: str,
+                 branch: Optional[str] = None,
+                 environment: Optional[str] = None,
+                 pull_request: Optional[bool] = None,
+                 tag: Optional[str] = None):
+        """
+        Builds the subject of a federated identity credential for GitHub Actions. Exactly one of branch, tag, environment and pullRequest must be set.
+        :param str repository: The repository, in the form 'owner/repository'.
+        :param str branch: Trust workflows running on this branch.
+        :param str environment: Trust jobs that use this deployment environment.
+        :param bool pull_request: Trust workflows triggered by pull requests.
+        :param str tag: Trust workflows running on this tag.
+        """
+        pulumi.set(__self__, "repository", repository)
+        if branch is not None:
+            pulumi.set(__self__, "branch", branch)
+        if environment is not None:
+            pulumi.set(__self__, "environment", environment)
+        if pull_request is not None:
+            pulumi.set(__self__, "pull_request", pull_request)
+        if tag is not None:
+            pulumi.set(__self__, "tag", tag)
+
+    @property
+    @pulumi.getter
+    def repository(self) -> str:
+        """
+        The repository, in the form 'owner/repository'.
+        """
+        return pulumi.get(self, "repository")
+
+    @property
+    @pulumi.getter
+    def branch(self) -> Optional[str]:
+        """
+        Trust workflows running on this branch.
+        """
+        return pulumi.get(self, "branch")
+
+    @property
+    @pulumi.getter
+    def environment(self) -> Optional[str]:
+        """
+        Trust jobs that use this deployment environment.
+        """
+        return pulumi.get(self, "environment")
+
+    @property
+    @pulumi.getter(name="pullRequest")
+    def pull_request(self) -> Optional[bool]:
+        """
+        Trust workflows triggered by pull requests.
+        """
+        return pulumi.get(self, "pull_request")
+
+    @property
+    @pulumi.getter
+    def tag(self) -> Optional[str]:
+        """
+        Trust workflows running on this tag.
+        """
+        return pulumi.get(self, "tag")
+
+    def _translate_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+
+@pulumi.output_type
+class KubernetesFederatedSubject(dict):
+    """
+    Builds the subject of a federated identity credential for a Kubernetes service account.
+    """
+    def __init__(__self__, *,
+                 namespace: str,
+                 service_account: str):
+        """
+        Builds the subject of a federated identity credential for a Kubernetes service account.
+        :param str namespace: The namespace of the service account.
+        :param str service_account: The name of the service account.
+        """
+        pulumi.set(__self__, "namespace", namespace)
+        pulumi.set(__self__, "service_account", service_account)
+
+    @property
+    @pulumi.getter
+    def namespace(self) -> str:
+        """
+        The namespace of the service account.
+        """
+        return pulumi.get(self, "namespace")
+
+    @property
+    @pulumi.getter(name="serviceAccount")
+    def service_account(self) -> str:
+        """
+        The name of the service account.
+        """
+        return pulumi.get(self, "service_account")
+
+    def _translate_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+
+@pulumi.output_type
+class RequiredResourceAccessResolvedResource(dict):
+    """
+    An API the application requires permissions on, with the permission names resolved to IDs.
+    """
+    def __init__(__self__, *,
+                 app_role_ids: Mapping[str, str],
+                 resource_app_id: str,
+                 resource_id: str,
+                 scope_ids: Mapping[str, str]):
+        """
+        An API the application requires permissions on, with the permission names resolved to IDs.
+        :param Mapping[str, str] app_role_ids: The IDs of the application permissions, by value.
+        :param str resource_app_id: The app ID of the API.
+        :param str resource_id: The object ID of the API's service principal.
+        :param Mapping[str, str] scope_ids: The IDs of the delegated permissions, by value.
+        """
+        pulumi.set(__self__, "app_role_ids", app_role_ids)
+        pulumi.set(__self__, "resource_app_id", resource_app_id)
+        pulumi.set(__self__, "resource_id", resource_id)
+        pulumi.set(__self__, "scope_ids", scope_ids)
+
+    @property
+    @pulumi.getter(name="appRoleIds")
+    def app_role_ids(self) -> Mapping[str, str]:
+        """
+        The IDs of the application permissions, by value.
+        """
+        return pulumi.get(self, "app_role_ids")
+
+    @property
+    @pulumi.getter(name="resourceAppId")
+    def resource_app_id(self) -> str:
+        """
+        The app ID of the API.
+        """
+        return pulumi.get(self, "resource_app_id")
+
+    @property
+    @pulumi.getter(name="resourceId")
+    def resource_id(self) -> str:
+        """
+        The object ID of the API's service principal.
+        """
+        return pulumi.get(self, "resource_id")
+
+    @property
+    @pulumi.getter(name="scopeIds")
+    def scope_ids(self) -> Mapping[str, str]:
+        """
+        The IDs of the delegated permissions, by value.
+        """
+        return pulumi.get(self, "scope_ids")
+
+    def _translate_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+
+@pulumi.output_type
+class RequiredResourceAccessResource(dict):
+    """
+    An API the application requires permissions on, with the permissions given by name.
+    """
+    def __init__(__self__, *,
+                 resource_app: str,
+                 application_permissions: Optional[Sequence[str]] = None,
+                 delegated_permissions: Optional[Sequence[str]] = None):
+        """
+        An API the application requires permissions on, with the permissions given by name.
+        :param str resource_app: The API, either as the app ID of its application or as one of the well-known names: 'MicrosoftGraph', 'AzureADGraph', 'AzureKeyVault', 'AzureServiceManagement', 'AzureStorage', 'Office365ExchangeOnline' or 'SharePointOnline'.
+        :param Sequence[str] application_permissions: The values of the application permissions (app roles) to require, like 'User.Read.All'.
+        :param Sequence[str] delegated_permissions: The values of the delegated permissions (scopes) to require, like 'User.Read' or 'openid'.
+        """
+        pulumi.set(__self__, "resource_app", resource_app)
+        if application_permissions is not None:
+            pulumi.set(__self__, "application_permissions", application_permissions)
+        if delegated_permissions is not None:
+            pulumi.set(__self__, "delegated_permissions", delegated_permissions)
+
+    @property
+    @pulumi.getter(name="resourceApp")
+    def resource_app(self) -> str:
+        """
+        The API, either as the app ID of its application or as one of the well-known names: 'MicrosoftGraph', 'AzureADGraph', 'AzureKeyVault', 'AzureServiceManagement', 'AzureStorage', 'Office365ExchangeOnline' or 'SharePointOnline'.
+        """
+        return pulumi.get(self, "resource_app")
+
+    @property
+    @pulumi.getter(name="applicationPermissions")
+    def application_permissions(self) -> Optional[Sequence[str]]:
+        """
+        The values of the application permissions (app roles) to require, like 'User.Read.All'.
+        """
+        return pulumi.get(self, "application_permissions")
+
+    @property
+    @pulumi.getter(name="delegatedPermissions")
+    def delegated_permissions(self) -> Optional[Sequence[str]]:
+        """
+        The values of the delegated permissions (scopes) to require, like 'User.Read' or 'openid'.
+        """
+        return pulumi.get(self, "delegated_permissions")
+
+    def _translate_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+
+@pulumi.output_type
+class TokenLifetimePolicyDefinition(dict):
+    """
+    The definition of a token lifetime policy.
+    """
+    def __init__(__self__, *,
+                 access_token_lifetime: str):
+        """
+        The definition of a token lifetime policy.
+        :param str access_token_lifetime: The lifetime of access and ID tokens as a time span between '00:10:00' and '1.00:00:00', e.g. '01:00:00' for an hour.
+        """
+        pulumi.set(__self__, "access_token_lifetime", access_token_lifetime)
+
+    @property
+    @pulumi.getter(name="accessTokenLifetime")
+    def access_token_lifetime(self) -> str:
+        """
+        The lifetime of access and ID tokens as a time span between '00:10:00' and '1.00:00:00', e.g. '01:00:00' for an hour.
+        """
+        return pulumi.get(self, "access_token_lifetime")
+
+    def _translate_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+
@@ -7,6 +7,9 @@ import pulumi
 import pulumi.runtime
 from typing import Any, Mapping, Optional, Sequence, Union
 from . import _utilities, _tables
+from . import outputs
+from ._enums import *
+from ._inputs import *
 
 __all__ = ['PrepareAppForWebSignIn']
 
@@ -15,15 +18,30 @@ class PrepareAppForWebSignIn(pulumi.CustomResource):
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 conflict_policy: Optional[pulumi.Input['ConflictPolicy']] = None,
+                 force: Optional[pulumi.Input[bool]] = None,
                  host_name: Optional[pulumi.Input[str]] = None,
+                 implicit_grant_settings: Optional[pulumi.Input[pulumi.InputType['ApplicationImplicitGrantSettingsArgs']]] = None,
+                 is_fallback_public_client: Optional[pulumi.Input[bool]] = None,
                  object_id: Optional[pulumi.Input[str]] = None,
+                 public_client_redirect_uris: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 purge_on_delete: Optional[pulumi.Input[bool]] = None,
+                 spa_redirect_paths: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  __props__=None,
                  __name__=None,
                  __opts__=None):
         """
-        Create a PrepareAppForWebSignIn resource with the given unique name, props, and options.
+        Prepares an existing app registration for web sign-in on the provided host name using Microsoft Graph.
+
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input['ConflictPolicy'] conflict_policy: What to do when the application was changed outside of Pulumi since it was last written. Defaults to `overwrite`.
+        :param pulumi.Input[bool] force: Delete the application even if it does not have this resource's ownership tag. The tag is added to the application's `tags` when the resource is created or updated.
+        :param pulumi.Input[pulumi.InputType['ApplicationImplicitGrantSettingsArgs']] implicit_grant_settings: Whether ID tokens and access tokens can be requested with the OAuth 2.0 implicit flow, for legacy single-page applications.
+        :param pulumi.Input[bool] is_fallback_public_client: Whether the application is a public client, e.g. for the device code flow.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] public_client_redirect_uris: The redirect URIs of the public client (mobile and desktop) platform, e.g. 'http://localhost' for command-line tools.
+        :param pulumi.Input[bool] purge_on_delete: Permanently delete the application from the directory's deleted items when the resource is deleted, releasing its identifier URIs.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] spa_redirect_paths: Paths on the host name that are registered as single-page application redirect URIs, e.g. '/' for 'https://{hostName}/'. Each path must start with '/'.
         """
         if __name__ is not None:
             warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
@@ -42,12 +60,21 @@ class PrepareAppForWebSignIn(pulumi.CustomResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = dict()
 
+            __props__['conflict_policy'] = conflict_policy
+            __props__['force'] = force
             if host_name is None and not opts.urn:
                 raise TypeError("Missing required property 'host_name'")
             __props__['host_name'] = host_name
+            __props__['implicit_grant_settings'] = implicit_grant_settings
+            __props__['is_fallback_public_client'] = is_fallback_public_client
             if object_id is None and not opts.urn:
                 raise TypeError("Missing required property 'object_id'")
             __props__['object_id'] = object_id
+            __props__['public_client_redirect_uris'] = public_client_redirect_uris
+            __props__['purge_on_delete'] = purge_on_delete
+            __props__['spa_redirect_paths'] = spa_redirect_paths
+            __props__['applied_patch'] = None
+            __props__['fingerprint'] = None
         super(PrepareAppForWebSignIn, __self__).__init__(
             'knapcode:index:PrepareAppForWebSignIn',
             resource_name,
@@ -72,6 +99,79 @@ class PrepareAppForWebSignIn(pulumi.CustomResource):
 
         return PrepareAppForWebSignIn(resource_name, opts=opts, __props__=__props__)
 
+    @property
+    @pulumi.getter(name="appliedPatch")
+    def applied_patch(self) -> pulumi.Output[Any]:
+        """
+        The application settings last written by this resource.
+        """
+        return pulumi.get(self, "applied_patch")
+
+    @property
+    @pulumi.getter(name="conflictPolicy")
+    def conflict_policy(self) -> pulumi.Output[Optional['ConflictPolicy']]:
+        return pulumi.get(self, "conflict_policy")
+
+    @property
+    @pulumi.getter
+    def fingerprint(self) -> pulumi.Output[str]:
+        """
+        SHA-256 hash of the application settings last written by this resource.
+        """
+        return pulumi.get(self, "fingerprint")
+
+    @property
+    @pulumi.getter
+    def force(self) -> pulumi.Output[Optional[bool]]:
+        return pulumi.get(self, "force")
+
+    @property
+    @pulumi.getter(name="hostName")
+    def host_name(self) -> pulumi.Output[str]:
+        return pulumi.get(self, "host_name")
+
+    @property
+    @pulumi.getter(name="implicitGrantSettings")
+    def implicit_grant_settings(self) -> pulumi.Output[Optional['outputs.ApplicationImplicitGrantSettings']]:
+        """
+        Whether ID tokens and access tokens can be requested with the OAuth 2.0 implicit flow, for legacy single-page applications.
+        """
+        return pulumi.get(self, "implicit_grant_settings")
+
+    @property
+    @pulumi.getter(name="isFallbackPublicClient")
+    def is_fallback_public_client(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Whether the application is a public client, e.g. for the device code flow.
+        """
+        return pulumi.get(self, "is_fallback_public_client")
+
+    @property
+    @pulumi.getter(name="objectId")
+    def object_id(self) -> pulumi.Output[str]:
+        return pulumi.get(self, "object_id")
+
+    @property
+    @pulumi.getter(name="publicClientRedirectUris")
+    def public_client_redirect_uris(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        The redirect URIs of the public client (mobile and desktop) platform, e.g. 'http://localhost' for command-line tools.
+        """
+        return pulumi.get(self, "public_client_redirect_uris")
+
+    @property
+    @pulumi.getter(name="purgeOnDelete")
+    def purge_on_delete(self) -> pulumi.Output[Optional[bool]]:
+        return pulumi.get(self, "purge_on_delete")
+
+    @property
+    @pulumi.getter(name="spaRedirectPaths")
+    def spa_redirect_paths(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Paths on the host name that are registered as single-page application redirect URIs, e.g. '/' for 'https://{hostName}/'. Each path must start with '/'.
+        """
+        return pulumi.get(self, "spa_redirect_paths")
+
     def translate_output_property(self, prop):
         return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
 
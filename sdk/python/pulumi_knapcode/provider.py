@@ -15,13 +15,16 @@ class Provider(pulumi.ProviderResource):
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 default_owners: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
                  __props__=None,
                  __name__=None,
                  __opts__=None):
         """
-        Create a Knapcode resource with the given unique name, props, and options.
+        The provider type for the knapcode package.
+
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] default_owners: The object IDs of users or service principals that are added as owners of every application and service principal created by this provider, so that they can be managed in the portal.
         """
         if __name__ is not None:
             warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
@@ -40,6 +43,7 @@ class Provider(pulumi.ProviderResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = dict()
 
+            __props__['default_owners'] = pulumi.Output.from_input(default_owners).apply(pulumi.runtime.to_json) if default_owners is not None else None
         super(Provider, __self__).__init__(
             'knapcode',
             resource_name,
@@ -0,0 +1,139 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+from . import outputs
+from ._inputs import *
+
+__all__ = ['RequiredResourceAccess']
+
+
+class RequiredResourceAccess(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 grant_admin_consent: Optional[pulumi.Input[bool]] = None,
+                 object_id: Optional[pulumi.Input[str]] = None,
+                 resources: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['RequiredResourceAccessResourceArgs']]]]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        Manages the API permissions an application requires, by permission name, and optionally grants admin consent for them. Entries of requiredResourceAccess for other APIs are left untouched. The resource ID is the object ID of the application.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[bool] grant_admin_consent: Whether to grant the permissions for the whole tenant, like the 'Grant admin consent' button in the portal does. This needs a service principal for the application. Defaults to false.
+        :param pulumi.Input[str] object_id: The object ID of the application. Changing this replaces the resource.
+        :param pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['RequiredResourceAccessResourceArgs']]]] resources: The APIs the application requires permissions on. Each API can only be listed once.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            __props__['grant_admin_consent'] = grant_admin_consent
+            if object_id is None and not opts.urn:
+                raise TypeError("Missing required property 'object_id'")
+            __props__['object_id'] = object_id
+            if resources is None and not opts.urn:
+                raise TypeError("Missing required property 'resources'")
+            __props__['resources'] = resources
+            __props__['app_id'] = None
+            __props__['resolved_resources'] = None
+            __props__['service_principal_id'] = None
+        super(RequiredResourceAccess, __self__).__init__(
+            'knapcode:index:RequiredResourceAccess',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'RequiredResourceAccess':
+        """
+        Get an existing RequiredResourceAccess resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return RequiredResourceAccess(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="appId")
+    def app_id(self) -> pulumi.Output[str]:
+        """
+        The app ID of the application.
+        """
+        return pulumi.get(self, "app_id")
+
+    @property
+    @pulumi.getter(name="grantAdminConsent")
+    def grant_admin_consent(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Whether to grant the permissions for the whole tenant, like the 'Grant admin consent' button in the portal does. This needs a service principal for the application. Defaults to false.
+        """
+        return pulumi.get(self, "grant_admin_consent")
+
+    @property
+    @pulumi.getter(name="objectId")
+    def object_id(self) -> pulumi.Output[str]:
+        """
+        The object ID of the application. Changing this replaces the resource.
+        """
+        return pulumi.get(self, "object_id")
+
+    @property
+    @pulumi.getter(name="resolvedResources")
+    def resolved_resources(self) -> pulumi.Output[Sequence['outputs.RequiredResourceAccessResolvedResource']]:
+        """
+        The APIs with the permission names resolved to IDs.
+        """
+        return pulumi.get(self, "resolved_resources")
+
+    @property
+    @pulumi.getter
+    def resources(self) -> pulumi.Output[Sequence['outputs.RequiredResourceAccessResource']]:
+        """
+        The APIs the application requires permissions on. Each API can only be listed once.
+        """
+        return pulumi.get(self, "resources")
+
+    @property
+    @pulumi.getter(name="servicePrincipalId")
+    def service_principal_id(self) -> pulumi.Output[Optional[str]]:
+        """
+        The object ID of the application's service principal the permissions are granted to, if admin consent is granted.
+        """
+        return pulumi.get(self, "service_principal_id")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
@@ -0,0 +1,88 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+
+__all__ = [
+    'RestoreDeletedApplicationResult',
+    'AwaitableRestoreDeletedApplicationResult',
+    'restore_deleted_application',
+]
+
+@pulumi.output_type
+class RestoreDeletedApplicationResult:
+    def __init__(__self__, app_id=None, display_name=None, object_id=None):
+        if app_id and not isinstance(app_id, str):
+            raise TypeError("Expected argument 'app_id' to be a str")
+        pulumi.set(__self__, "app_id", app_id)
+        if display_name and not isinstance(display_name, str):
+            raise TypeError("Expected argument 'display_name' to be a str")
+        pulumi.set(__self__, "display_name", display_name)
+        if object_id and not isinstance(object_id, str):
+            raise TypeError("Expected argument 'object_id' to be a str")
+        pulumi.set(__self__, "object_id", object_id)
+
+    @property
+    @pulumi.getter(name="appId")
+    def app_id(self) -> str:
+        """
+        The application (client) ID of the restored application.
+        """
+        return pulumi.get(self, "app_id")
+
+    @property
+    @pulumi.getter(name="displayName")
+    def display_name(self) -> str:
+        """
+        The display name of the restored application.
+        """
+        return pulumi.get(self, "display_name")
+
+    @property
+    @pulumi.getter(name="objectId")
+    def object_id(self) -> str:
+        """
+        The object ID of the restored application.
+        """
+        return pulumi.get(self, "object_id")
+
+
+class AwaitableRestoreDeletedApplicationResult(RestoreDeletedApplicationResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return RestoreDeletedApplicationResult(
+            app_id=self.app_id,
+            display_name=self.display_name,
+            object_id=self.object_id)
+
+
+def restore_deleted_application(display_name: Optional[str] = None,
+                                object_id: Optional[str] = None,
+                                opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableRestoreDeletedApplicationResult:
+    """
+    Restores a soft-deleted application from the directory's deleted items and waits for it to be available. An application that is not deleted is left as-is. Functions also run during previews, so use the RestoredApplication resource to only restore the application when the stack is updated.
+
+
+    :param str display_name: The display name of the deleted application. Either this or `objectId` must be set.
+    :param str object_id: The object ID of the deleted application. Either this or `displayName` must be set.
+    """
+    __args__ = dict()
+    __args__['displayName'] = display_name
+    __args__['objectId'] = object_id
+    if opts is None:
+        opts = pulumi.InvokeOptions()
+    if opts.version is None:
+        opts.version = _utilities.get_version()
+    __ret__ = pulumi.runtime.invoke('knapcode:index:restoreDeletedApplication', __args__, opts=opts, typ=RestoreDeletedApplicationResult).value
+
+    return AwaitableRestoreDeletedApplicationResult(
+        app_id=__ret__.app_id,
+        display_name=__ret__.display_name,
+        object_id=__ret__.object_id)
@@ -0,0 +1,148 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+
+__all__ = ['ServicePrincipal']
+
+
+class ServicePrincipal(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 account_enabled: Optional[pulumi.Input[bool]] = None,
+                 app_id: Optional[pulumi.Input[str]] = None,
+                 app_role_assignment_required: Optional[pulumi.Input[bool]] = None,
+                 notes: Optional[pulumi.Input[str]] = None,
+                 tags: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        The service principal (enterprise application) of an application, managed through Microsoft Graph. The resource ID is the object ID of the service principal, which is also used to import it.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[bool] account_enabled: Whether users can sign in to the application. Defaults to true.
+        :param pulumi.Input[str] app_id: The app ID (client ID) of the application. Changing this replaces the service principal.
+        :param pulumi.Input[bool] app_role_assignment_required: Whether users and other apps must be assigned an app role before they can get tokens for the application. Defaults to false.
+        :param pulumi.Input[str] notes: Free text notes about the service principal.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] tags: Tags on the service principal.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            __props__['account_enabled'] = account_enabled
+            if app_id is None and not opts.urn:
+                raise TypeError("Missing required property 'app_id'")
+            __props__['app_id'] = app_id
+            __props__['app_role_assignment_required'] = app_role_assignment_required
+            __props__['notes'] = notes
+            __props__['tags'] = tags
+            __props__['display_name'] = None
+            __props__['object_id'] = None
+        super(ServicePrincipal, __self__).__init__(
+            'knapcode:index:ServicePrincipal',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'ServicePrincipal':
+        """
+        Get an existing ServicePrincipal resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return ServicePrincipal(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="accountEnabled")
+    def account_enabled(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Whether users can sign in to the application. Defaults to true.
+        """
+        return pulumi.get(self, "account_enabled")
+
+    @property
+    @pulumi.getter(name="appId")
+    def app_id(self) -> pulumi.Output[str]:
+        """
+        The app ID (client ID) of the application. Changing this replaces the service principal.
+        """
+        return pulumi.get(self, "app_id")
+
+    @property
+    @pulumi.getter(name="appRoleAssignmentRequired")
+    def app_role_assignment_required(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Whether users and other apps must be assigned an app role before they can get tokens for the application. Defaults to false.
+        """
+        return pulumi.get(self, "app_role_assignment_required")
+
+    @property
+    @pulumi.getter(name="displayName")
+    def display_name(self) -> pulumi.Output[str]:
+        """
+        The display name of the service principal, copied from the application.
+        """
+        return pulumi.get(self, "display_name")
+
+    @property
+    @pulumi.getter
+    def notes(self) -> pulumi.Output[Optional[str]]:
+        """
+        Free text notes about the service principal.
+        """
+        return pulumi.get(self, "notes")
+
+    @property
+    @pulumi.getter(name="objectId")
+    def object_id(self) -> pulumi.Output[str]:
+        """
+        The object ID of the service principal.
+        """
+        return pulumi.get(self, "object_id")
+
+    @property
+    @pulumi.getter
+    def tags(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Tags on the service principal.
+        """
+        return pulumi.get(self, "tags")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
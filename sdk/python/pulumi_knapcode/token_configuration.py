@@ -0,0 +1,111 @@
+# coding=utf-8
+# *** WARNING: this file was generated by the Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union
+from . import _utilities, _tables
+from . import outputs
+from ._enums import *
+from ._inputs import *
+
+__all__ = ['TokenConfiguration']
+
+
+class TokenConfiguration(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 group_membership_claims: Optional[pulumi.Input['GroupMembershipClaims']] = None,
+                 object_id: Optional[pulumi.Input[str]] = None,
+                 optional_claims: Optional[pulumi.Input[pulumi.InputType['ApplicationOptionalClaimsArgs']]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        Manages the optional claims and group membership claims of an application. Other settings are left untouched, so don't set 'optionalClaims' on an Application resource for the same application. The resource ID is the object ID of the application, which is also used to import it.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input['GroupMembershipClaims'] group_membership_claims: The groups included in the groups claim. Must be set when the 'groups' optional claim is used. Defaults to 'None'.
+        :param pulumi.Input[str] object_id: The object ID of the application. Changing this replaces the resource.
+        :param pulumi.Input[pulumi.InputType['ApplicationOptionalClaimsArgs']] optional_claims: The optional claims included in the tokens issued for the application. Built-in claims and their additional properties are validated.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            __props__['group_membership_claims'] = group_membership_claims
+            if object_id is None and not opts.urn:
+                raise TypeError("Missing required property 'object_id'")
+            __props__['object_id'] = object_id
+            __props__['optional_claims'] = optional_claims
+        super(TokenConfiguration, __self__).__init__(
+            'knapcode:index:TokenConfiguration',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'TokenConfiguration':
+        """
+        Get an existing TokenConfiguration resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return TokenConfiguration(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="groupMembershipClaims")
+    def group_membership_claims(self) -> pulumi.Output[Optional['GroupMembershipClaims']]:
+        """
+        The groups included in the groups claim. Must be set when the 'groups' optional claim is used. Defaults to 'None'.
+        """
+        return pulumi.get(self, "group_membership_claims")
+
+    @property
+    @pulumi.getter(name="objectId")
+    def object_id(self) -> pulumi.Output[str]:
+        """
+        The object ID of the application. Changing this replaces the resource.
+        """
+        return pulumi.get(self, "object_id")
+
+    @property
+    @pulumi.getter(name="optionalClaims")
+    def optional_claims(self) -> pulumi.Output[Optional['outputs.ApplicationOptionalClaims']]:
+        """
+        The optional claims included in the tokens issued for the application. Built-in claims and their additional properties are validated.
+        """
+        return pulumi.get(self, "optional_claims")
+
+    def translate_output_property(self, prop):
+        return _tables.CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return _tables.SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+